@@ -0,0 +1,4 @@
+data "snowflake_dynamic_tables" "current" {
+    database = "MYDB"
+    schema   = "MYSCHEMA"
+}
@@ -0,0 +1,4 @@
+data "snowflake_organization_accounts" "sales" {
+  provider = snowflake.orgadmin
+  pattern  = "SALES%"
+}
@@ -0,0 +1,8 @@
+data "snowflake_parameters" "account" {
+  pattern = "%TIMEZONE%"
+}
+
+data "snowflake_parameters" "database" {
+  object_type = "DATABASE"
+  object_name = "MYDB"
+}
@@ -0,0 +1,2 @@
+# format is the account name; admin settings are not read back
+terraform import snowflake_account.example accountName
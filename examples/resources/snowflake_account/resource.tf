@@ -0,0 +1,21 @@
+provider "snowflake" {
+  alias = "orgadmin"
+  role  = "ORGADMIN"
+}
+
+resource snowflake_account sales {
+  provider = snowflake.orgadmin
+
+  name                 = "SALES"
+  admin_name           = "admin"
+  admin_password       = var.admin_password
+  email                = "admin@example.com"
+  must_change_password = true
+  edition              = "ENTERPRISE"
+  region               = "AWS_US_WEST_2"
+  comment              = "Sales business unit"
+
+  # set to true and apply before destroying the account, which can then be restored for 14 days
+  grace_period_in_days = 14
+  drop_on_destroy      = false
+}
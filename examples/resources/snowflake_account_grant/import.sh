@@ -1,2 +1,2 @@
-# format is account name | | | privilege | true/false for with_grant_option
-terraform import snowflake_account_grant.example 'accountName|||USAGE|true'
+# format is account name | | | privilege | comma separated roles | true/false for with_grant_option
+terraform import snowflake_account_grant.example 'accountName|||USAGE|ROLE1,ROLE2|true'
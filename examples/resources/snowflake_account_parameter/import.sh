@@ -0,0 +1,2 @@
+# format is the parameter name
+terraform import snowflake_account_parameter.example 'TIMEZONE'
@@ -0,0 +1,9 @@
+resource "snowflake_account_parameter" "timezone" {
+  key   = "TIMEZONE"
+  value = "America/Los_Angeles"
+}
+
+resource "snowflake_account_parameter" "retention" {
+  key   = "MIN_DATA_RETENTION_TIME_IN_DAYS"
+  value = "7"
+}
@@ -0,0 +1,2 @@
+# format is database name | schema name | alert name
+terraform import snowflake_alert.example 'dbName|schemaName|alertName'
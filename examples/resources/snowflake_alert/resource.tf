@@ -0,0 +1,11 @@
+resource snowflake_alert alert {
+  database  = "db"
+  schema    = "schema"
+  name      = "alert"
+  warehouse = "warehouse"
+  schedule  = "10 MINUTE"
+  condition = "select * from foo where bar > 100"
+  action    = "call system$send_email('my_integration', 'me@example.com', 'Alert', 'bar is too high')"
+  enabled   = true
+  comment   = "my alert"
+}
@@ -0,0 +1,11 @@
+resource snowflake_authentication_policy policy {
+  name     = "authentication_policy"
+  database = "database"
+  schema   = "schema"
+
+  authentication_methods     = ["PASSWORD", "SAML"]
+  mfa_authentication_methods = ["PASSWORD"]
+  mfa_enrollment             = "REQUIRED"
+
+  comment = "Enforce MFA for password logins"
+}
@@ -0,0 +1,5 @@
+resource snowflake_authentication_policy_attachment attach {
+  authentication_policy = snowflake_authentication_policy.policy.fully_qualified_name
+  set_for_account       = false
+  users                 = ["user1", "user2"]
+}
@@ -8,3 +8,16 @@ resource "snowflake_database" "test2" {
   name    = "testing_2"
   comment = "test comment 2"
 }
+
+resource "snowflake_database" "primary" {
+  name = "primary"
+
+  replication_configuration {
+    accounts             = ["myorg.secondary_account"]
+    ignore_edition_check = true
+  }
+
+  failover_configuration {
+    accounts = ["myorg.secondary_account"]
+  }
+}
@@ -1,2 +1,2 @@
-# format is database name | | | privilege | true/false for with_grant_option
-terraform import snowflake_database_grant.example 'databaseName|||USAGE|false'
+# format is database name | | | privilege | comma separated roles | true/false for with_grant_option
+terraform import snowflake_database_grant.example 'databaseName|||USAGE|ROLE1,ROLE2|false'
@@ -0,0 +1,12 @@
+resource "snowflake_database" "secondary" {
+  name         = "secondary"
+  from_replica = "myorg.primary_account.primary"
+}
+
+resource "snowflake_database_refresh" "secondary" {
+  database = snowflake_database.secondary.name
+
+  triggers = {
+    schedule = "2022-01-01"
+  }
+}
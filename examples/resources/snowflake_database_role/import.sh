@@ -0,0 +1,2 @@
+# format is database name | database role name
+terraform import snowflake_database_role.example 'dbName|roleName'
@@ -0,0 +1,5 @@
+resource snowflake_database_role role {
+  database = "db"
+  name     = "reader"
+  comment  = "A database role."
+}
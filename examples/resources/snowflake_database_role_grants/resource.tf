@@ -0,0 +1,7 @@
+resource snowflake_database_role_grants grants {
+  database_name      = "db"
+  database_role_name = "reader"
+
+  roles          = ["role1", "role2"]
+  database_roles = ["writer"]
+}
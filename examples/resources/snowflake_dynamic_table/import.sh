@@ -0,0 +1,2 @@
+# format is database name | schema name | dynamic table name
+terraform import snowflake_dynamic_table.example 'dbName|schemaName|dynamicTableName'
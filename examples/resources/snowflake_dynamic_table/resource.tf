@@ -0,0 +1,14 @@
+resource snowflake_dynamic_table dynamic_table {
+  database   = "db"
+  schema     = "schema"
+  name       = "dynamic_table"
+  warehouse  = "warehouse"
+  target_lag = "5 minutes"
+  query      = "select id, count(*) as orders from orders group by id"
+  cluster_by = ["id"]
+  comment    = "orders per customer"
+
+  refresh_triggers = {
+    backfill = "2023-01-01"
+  }
+}
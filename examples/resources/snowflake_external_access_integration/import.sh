@@ -0,0 +1,2 @@
+# format is the integration name
+terraform import snowflake_external_access_integration.example 'integrationName'
@@ -0,0 +1,21 @@
+resource snowflake_external_access_integration integration {
+  name                           = "example_api"
+  allowed_network_rules          = [snowflake_network_rule.rule.fully_qualified_name]
+  allowed_authentication_secrets = [snowflake_secret.secret.fully_qualified_name]
+  enabled                        = true
+  comment                        = "access to the example.com API"
+}
+
+resource snowflake_function function {
+  database                     = "db"
+  schema                       = "schema"
+  name                         = "call_example_api"
+  language                     = "java"
+  return_type                  = "VARCHAR"
+  handler                      = "ExampleApi.call"
+  statement                    = file("ExampleApi.java")
+  external_access_integrations = [snowflake_external_access_integration.integration.name]
+  secrets = {
+    cred = snowflake_secret.secret.fully_qualified_name
+  }
+}
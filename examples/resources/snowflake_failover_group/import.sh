@@ -0,0 +1,2 @@
+# format is the failover group name
+terraform import snowflake_failover_group.example 'failoverGroupName'
@@ -0,0 +1,17 @@
+resource "snowflake_failover_group" "primary" {
+  name                      = "DR"
+  object_types              = ["DATABASES", "ROLES", "USERS", "WAREHOUSES", "INTEGRATIONS"]
+  allowed_databases         = ["PRODUCTION"]
+  allowed_integration_types = ["SECURITY INTEGRATIONS"]
+  allowed_accounts          = ["myorg.secondary_account"]
+  replication_schedule      = "10 MINUTE"
+}
+
+# in the secondary account
+resource "snowflake_failover_group" "secondary" {
+  name          = "DR"
+  as_replica_of = "myorg.primary_account.DR"
+
+  # set during failover drills
+  promote_to_primary = false
+}
@@ -1,2 +1,2 @@
-# format is integration name ||| privilege | true/false for with_grant_option
-terraform import snowflake_integration_grant.example 'intName|||USAGE|true'
+# format is integration name ||| privilege | comma separated roles | true/false for with_grant_option
+terraform import snowflake_integration_grant.example 'intName|||USAGE|ROLE1,ROLE2|true'
@@ -0,0 +1,2 @@
+# format is database name | schema name | object type | object name | column name
+terraform import snowflake_masking_policy_attachment.example 'dbName|schemaName|TABLE|tableName|columnName'
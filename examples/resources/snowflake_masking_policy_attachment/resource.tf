@@ -0,0 +1,16 @@
+resource "snowflake_masking_policy" "email" {
+  name               = "EMAIL_MASK"
+  database           = "EXAMPLE_DB"
+  schema             = "EXAMPLE_SCHEMA"
+  value_data_type    = "string"
+  masking_expression = "case when current_role() in ('ANALYST') then val else sha2(val, 512) end"
+  return_data_type   = "string"
+}
+
+resource "snowflake_masking_policy_attachment" "email" {
+  database       = "EXAMPLE_DB"
+  schema         = "EXAMPLE_SCHEMA"
+  object_name    = "USERS"
+  column         = "EMAIL"
+  masking_policy = snowflake_masking_policy.email.fully_qualified_name
+}
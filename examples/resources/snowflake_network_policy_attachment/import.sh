@@ -1 +1,2 @@
-terraform import snowflake_network_policy_attachment.example attachment_policyname
+# format is network policy name followed by _attachment; every user of the account is checked for the policy
+terraform import snowflake_network_policy_attachment.example policyname_attachment
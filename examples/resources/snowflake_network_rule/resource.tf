@@ -0,0 +1,9 @@
+resource snowflake_network_rule rule {
+  database   = "db"
+  schema     = "schema"
+  name       = "example_api"
+  type       = "HOST_PORT"
+  mode       = "EGRESS"
+  value_list = ["api.example.com:443"]
+  comment    = "egress to the example.com API"
+}
@@ -0,0 +1,2 @@
+# format is object type | database name | schema name | object name
+terraform import snowflake_object_grants.example 'SCHEMA|dbName||schemaName'
@@ -0,0 +1,21 @@
+resource snowflake_object_grants grants {
+  object_type   = "SCHEMA"
+  database_name = "db"
+  object_name   = "schema"
+
+  privilege {
+    name  = "USAGE"
+    roles = ["role1", "role2"]
+  }
+
+  privilege {
+    name              = "CREATE TABLE"
+    roles             = ["role1"]
+    with_grant_option = true
+  }
+
+  privilege {
+    name           = "CREATE VIEW"
+    database_roles = ["reader"]
+  }
+}
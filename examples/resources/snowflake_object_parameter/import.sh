@@ -0,0 +1,2 @@
+# format is parameter name | object type | database name | schema name | object name, leaving the parts the object is not named by empty
+terraform import snowflake_object_parameter.example 'DATA_RETENTION_TIME_IN_DAYS|SCHEMA|dbName||schemaName'
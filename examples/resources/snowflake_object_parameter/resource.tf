@@ -0,0 +1,23 @@
+resource "snowflake_database" "db" {
+  name = "MYDB"
+}
+
+resource "snowflake_schema" "schema" {
+  database = snowflake_database.db.name
+  name     = "MYSCHEMA"
+}
+
+resource "snowflake_object_parameter" "schema_retention" {
+  key         = "DATA_RETENTION_TIME_IN_DAYS"
+  value       = "30"
+  object_type = "SCHEMA"
+  database    = snowflake_database.db.name
+  object_name = snowflake_schema.schema.name
+}
+
+resource "snowflake_object_parameter" "user_timezone" {
+  key         = "TIMEZONE"
+  value       = "UTC"
+  object_type = "USER"
+  object_name = "MYUSER"
+}
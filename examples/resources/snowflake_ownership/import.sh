@@ -0,0 +1,2 @@
+# format is object type | database name | schema name | object name
+terraform import snowflake_ownership.example 'TABLE|dbName|schemaName|tableName'
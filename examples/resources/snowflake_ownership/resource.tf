@@ -0,0 +1,10 @@
+resource snowflake_ownership ownership {
+  object_type   = "TABLE"
+  database_name = "db"
+  schema_name   = "schema"
+  object_name   = "table"
+
+  role               = "role1"
+  current_grants     = "COPY"
+  restore_on_destroy = true
+}
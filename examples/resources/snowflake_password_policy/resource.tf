@@ -0,0 +1,14 @@
+resource snowflake_password_policy policy {
+  name     = "password_policy"
+  database = "database"
+  schema   = "schema"
+
+  min_length        = 12
+  min_special_chars = 1
+  max_age_days      = 60
+  max_retries       = 3
+  lockout_time_mins = 30
+  history           = 5
+
+  comment = "Password complexity for all users"
+}
@@ -0,0 +1,5 @@
+resource snowflake_password_policy_attachment attach {
+  password_policy = snowflake_password_policy.policy.fully_qualified_name
+  set_for_account = false
+  users           = ["user1", "user2"]
+}
@@ -1,2 +1,2 @@
-# format is database name | schema name | pipe name | privilege | true/false for with_grant_option
-terraform import snowflake_pipe_grant.example 'dbName|schemaName|pipeName|OPERATE|false'
+# format is database name | schema name | pipe name | privilege | comma separated roles | true/false for with_grant_option
+terraform import snowflake_pipe_grant.example 'dbName|schemaName|pipeName|OPERATE|ROLE1,ROLE2|false'
@@ -31,3 +31,18 @@ var X=1
 return X
 EOT
 }
+
+resource "snowflake_procedure" "python_proc" {
+  name            = "SAMPLEPYTHONPROC"
+  database        = snowflake_database.db.name
+  schema          = snowflake_schema.schema.name
+  language        = "python"
+  runtime_version = "3.8"
+  packages        = ["snowflake-snowpark-python"]
+  handler         = "run"
+  return_type     = "VARCHAR"
+  statement       = <<EOT
+def run(session):
+  return session.sql("select current_version()").collect()[0][0]
+EOT
+}
@@ -0,0 +1,2 @@
+# format is the replication group name
+terraform import snowflake_replication_group.example 'replicationGroupName'
@@ -0,0 +1,14 @@
+resource "snowflake_replication_group" "primary" {
+  name                 = "REPORTING"
+  object_types         = ["DATABASES", "SHARES"]
+  allowed_databases    = ["REPORTING"]
+  allowed_shares       = ["REPORTING_SHARE"]
+  allowed_accounts     = ["myorg.reporting_account"]
+  replication_schedule = "USING CRON 0 0 * * * UTC"
+}
+
+# in the secondary account
+resource "snowflake_replication_group" "secondary" {
+  name          = "REPORTING"
+  as_replica_of = "myorg.primary_account.REPORTING"
+}
@@ -1 +1,2 @@
-terraform import snowflake_resource_monitor_grant.example name
+# format is resource monitor name | | | privilege | comma separated roles | true/false for with_grant_option
+terraform import snowflake_resource_monitor_grant.example 'monitorName|||MONITOR|ROLE1,ROLE2|false'
@@ -1 +1,2 @@
-terraform import snowflake_role_grants.example rolename
+# format is role name | | | | comma separated roles | false
+terraform import snowflake_role_grants.example 'roleName||||ROLE1,ROLE2|false'
@@ -0,0 +1,2 @@
+# format is database name | schema name | object type | object name
+terraform import snowflake_row_access_policy_attachment.example 'dbName|schemaName|TABLE|tableName'
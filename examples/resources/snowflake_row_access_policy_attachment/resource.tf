@@ -0,0 +1,17 @@
+resource "snowflake_row_access_policy" "region" {
+  name     = "REGION_POLICY"
+  database = "EXAMPLE_DB"
+  schema   = "EXAMPLE_SCHEMA"
+  signature = {
+    R = "VARCHAR"
+  }
+  row_access_expression = "r = 'EU' or current_role() = 'ADMIN'"
+}
+
+resource "snowflake_row_access_policy_attachment" "orders" {
+  database          = "EXAMPLE_DB"
+  schema            = "EXAMPLE_SCHEMA"
+  object_name       = "ORDERS"
+  row_access_policy = snowflake_row_access_policy.region.fully_qualified_name
+  columns           = ["REGION"]
+}
@@ -1,2 +1,2 @@
-# format is database name | schema name | | privilege | true/false for with_grant_option
-terraform import snowflake_schema_grant.example 'databaseName|schemaName||MONITOR|false'
+# format is database name | schema name | | privilege | comma separated roles | true/false for with_grant_option
+terraform import snowflake_schema_grant.example 'databaseName|schemaName||MONITOR|ROLE1,ROLE2|false'
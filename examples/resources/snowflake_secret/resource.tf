@@ -0,0 +1,9 @@
+resource snowflake_secret secret {
+  database = "db"
+  schema   = "schema"
+  name     = "api_credentials"
+  type     = "PASSWORD"
+  username = "api_user"
+  password = var.api_password
+  comment  = "credentials of the example.com API"
+}
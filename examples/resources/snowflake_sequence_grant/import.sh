@@ -1,2 +1,2 @@
-# format is database name | schema name | sequence name | privilege | true/false for with_grant_option
-terraform import snowflake_sequence_grant.example 'dbName|schemaName|sequenceName|USAGE|false'
+# format is database name | schema name | sequence name | privilege | comma separated roles | true/false for with_grant_option
+terraform import snowflake_sequence_grant.example 'dbName|schemaName|sequenceName|USAGE|ROLE1,ROLE2|false'
@@ -0,0 +1,10 @@
+resource snowflake_session_policy policy {
+  name     = "session_policy"
+  database = "database"
+  schema   = "schema"
+
+  idle_timeout_mins    = 30
+  ui_idle_timeout_mins = 60
+
+  comment = "Log idle users out after half an hour"
+}
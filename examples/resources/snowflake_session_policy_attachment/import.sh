@@ -0,0 +1,2 @@
+# format is the fully qualified name of the policy; every user the policy is set on is attached
+terraform import snowflake_session_policy_attachment.example '"dbName"."schemaName"."policyName"'
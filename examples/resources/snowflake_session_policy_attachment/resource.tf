@@ -0,0 +1,5 @@
+resource snowflake_session_policy_attachment attach {
+  session_policy  = snowflake_session_policy.policy.fully_qualified_name
+  set_for_account = false
+  users           = ["user1", "user2"]
+}
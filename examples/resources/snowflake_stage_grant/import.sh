@@ -1,2 +1,2 @@
-# format is database name | schema name | stage name | privilege | true/false for with_grant_option
-terraform import snowflake_stage_grant.example 'databaseName|schemaName|stageName|USAGE|true'
+# format is database name | schema name | stage name | privilege | comma separated roles | true/false for with_grant_option
+terraform import snowflake_stage_grant.example 'databaseName|schemaName|stageName|USAGE|ROLE1,ROLE2|true'
@@ -11,3 +11,23 @@ resource snowflake_stream stream {
 
   owner = "role1"
 }
+
+resource snowflake_stream view_stream {
+  database = "db"
+  schema   = "schema"
+  name     = "view_stream"
+
+  on_view = "db.schema.view"
+
+  at {
+    offset = -3600
+  }
+}
+
+resource snowflake_stream stage_stream {
+  database = "db"
+  schema   = "schema"
+  name     = "stage_stream"
+
+  on_stage = "db.schema.stage"
+}
@@ -1,2 +1,2 @@
-# format is database name | schema name | stream name | privilege | true/false for with_grant_option
-terraform import snowflake_stream_grant.example 'dbName|schemaName|streamName|SELECT|false'
+# format is database name | schema name | stream name | privilege | comma separated roles | true/false for with_grant_option
+terraform import snowflake_stream_grant.example 'dbName|schemaName|streamName|SELECT|ROLE1,ROLE2|false'
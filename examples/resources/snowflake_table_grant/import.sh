@@ -1,2 +1,2 @@
-# format is database name | schema name | table name | privilege | true/false for with_grant_option
-terraform import snowflake_table_grant.example 'databaseName|schemaName|tableName|MODIFY|true'
+# format is database name | schema name | table name | privilege | comma separated roles | true/false for with_grant_option
+terraform import snowflake_table_grant.example 'databaseName|schemaName|tableName|MODIFY|ROLE1,ROLE2|true'
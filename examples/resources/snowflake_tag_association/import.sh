@@ -0,0 +1,2 @@
+# format is tag | object type | object database | object schema | object name | column name
+terraform import snowflake_tag_association.example 'tagDb.tagSchema.tagName|TABLE|dbName|schemaName|tableName|columnName'
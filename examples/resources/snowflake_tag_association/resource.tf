@@ -0,0 +1,23 @@
+resource "snowflake_tag" "cost_center" {
+  name           = "COST_CENTER"
+  database       = "EXAMPLE_DB"
+  schema         = "EXAMPLE_SCHEMA"
+  allowed_values = ["finance", "engineering"]
+}
+
+resource "snowflake_tag_association" "warehouse" {
+  tag         = snowflake_tag.cost_center.fully_qualified_name
+  tag_value   = "finance"
+  object_type = "WAREHOUSE"
+  object_name = "EXAMPLE_WAREHOUSE"
+}
+
+resource "snowflake_tag_association" "column" {
+  tag             = snowflake_tag.cost_center.fully_qualified_name
+  tag_value       = "engineering"
+  object_type     = "TABLE"
+  object_database = "EXAMPLE_DB"
+  object_schema   = "EXAMPLE_SCHEMA"
+  object_name     = "USERS"
+  column          = "EMAIL"
+}
@@ -0,0 +1,2 @@
+# format is tag | masking policy
+terraform import snowflake_tag_masking_policy_association.example 'tagDb.tagSchema.tagName|policyDb.policySchema.policyName'
@@ -0,0 +1,19 @@
+resource "snowflake_tag" "pii" {
+  name     = "PII"
+  database = "EXAMPLE_DB"
+  schema   = "EXAMPLE_SCHEMA"
+}
+
+resource "snowflake_masking_policy" "pii" {
+  name               = "PII_MASK"
+  database           = "EXAMPLE_DB"
+  schema             = "EXAMPLE_SCHEMA"
+  value_data_type    = "string"
+  masking_expression = "case when current_role() in ('ANALYST') then val else '***' end"
+  return_data_type   = "string"
+}
+
+resource "snowflake_tag_masking_policy_association" "pii" {
+  tag            = snowflake_tag.pii.fully_qualified_name
+  masking_policy = snowflake_masking_policy.pii.fully_qualified_name
+}
@@ -1,2 +1,2 @@
-# format is database name | schema name | view name | privilege | true/false for with_grant_option
-terraform import snowflake_view_grant.example 'dbName|schemaName|viewName|USAGE|false'
+# format is database name | schema name | view name | privilege | comma separated roles | true/false for with_grant_option
+terraform import snowflake_view_grant.example 'dbName|schemaName|viewName|USAGE|ROLE1,ROLE2|false'
@@ -1,2 +1,2 @@
-# format is warehouse name | | | privilege | true/false for with_grant_option
-terraform import snowflake_warehouse_grant.example 'warehouseName|||MODIFY|true'
+# format is warehouse name | | | privilege | comma separated roles | true/false for with_grant_option
+terraform import snowflake_warehouse_grant.example 'warehouseName|||MODIFY|ROLE1,ROLE2|true'
@@ -4,13 +4,29 @@ import (
 	"flag"
 	"fmt"
 	"log"
+	"os"
 
 	"github.com/chanzuckerberg/go-misc/ver"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/generate"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/provider"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/render"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "generate" {
+		if err := generate.Main(os.Args[2:], os.Stderr); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "render" {
+		if err := render.Main(os.Args[2:], os.Stdin, os.Stdout, os.Stderr); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	version := flag.Bool("version", false, "spit out version for resources here")
 	flag.Parse()
 
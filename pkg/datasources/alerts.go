@@ -0,0 +1,120 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var alertsSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database from which to return the alerts from.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema from which to return the alerts from.",
+	},
+	"alerts": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The alerts in the schema",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"database": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"schema": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"warehouse": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"schedule": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"condition": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"action": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"enabled": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func Alerts() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadAlerts,
+		Schema:      alertsSchema,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(defaultReadTimeout),
+		},
+	}
+}
+
+func ReadAlerts(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+
+	currentAlerts, err := snowflake.ListAlerts(ctx, databaseName, schemaName, db)
+	if err == sql.ErrNoRows {
+		// If not found, mark resource to be removed from statefile during apply or refresh
+		log.Printf("[DEBUG] alerts in schema (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		log.Printf("[DEBUG] unable to parse alerts in schema (%s)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	alerts := []map[string]interface{}{}
+
+	for _, alert := range currentAlerts {
+		alertMap := map[string]interface{}{}
+
+		alertMap["name"] = alert.Name
+		alertMap["database"] = alert.DatabaseName
+		alertMap["schema"] = alert.SchemaName
+		alertMap["warehouse"] = alert.Warehouse.String
+		alertMap["schedule"] = alert.Schedule.String
+		alertMap["condition"] = alert.Condition.String
+		alertMap["action"] = alert.Action.String
+		alertMap["enabled"] = alert.IsEnabled()
+		alertMap["comment"] = alert.Comment.String
+
+		alerts = append(alerts, alertMap)
+	}
+
+	d.SetId(fmt.Sprintf(`%v|%v`, databaseName, schemaName))
+	return diag.FromErr(d.Set("alerts", alerts))
+}
@@ -0,0 +1,65 @@
+package datasources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlerts(t *testing.T) {
+	databaseName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	schemaName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	alertName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: alerts(databaseName, schemaName, alertName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_alerts.a", "database", databaseName),
+					resource.TestCheckResourceAttr("data.snowflake_alerts.a", "schema", schemaName),
+					resource.TestCheckResourceAttr("data.snowflake_alerts.a", "alerts.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_alerts.a", "alerts.0.name", alertName),
+					resource.TestCheckResourceAttr("data.snowflake_alerts.a", "alerts.0.schedule", "15 MINUTE"),
+				),
+			},
+		},
+	})
+}
+
+func alerts(databaseName string, schemaName string, alertName string) string {
+	return fmt.Sprintf(`
+
+	resource snowflake_database "test" {
+	   name = "%v"
+	}
+
+	resource snowflake_schema "test"{
+		name 	 = "%v"
+		database = snowflake_database.test.name
+	}
+
+	resource snowflake_warehouse "test" {
+		name = snowflake_database.test.name
+	}
+
+	resource snowflake_alert "test" {
+		name      = "%v"
+		database  = snowflake_database.test.name
+		schema    = snowflake_schema.test.name
+		warehouse = snowflake_warehouse.test.name
+		schedule  = "15 MINUTE"
+		condition = "SELECT 1"
+		action    = "SELECT 1"
+	}
+
+	data snowflake_alerts "a" {
+		database   = snowflake_alert.test.database
+		schema     = snowflake_alert.test.schema
+		depends_on = [snowflake_alert.test]
+	}
+	`, databaseName, schemaName, alertName)
+}
@@ -1,11 +1,13 @@
 package datasources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -32,15 +34,19 @@ var currentAccountSchema = map[string]*schema.Schema{
 // CurrentAccount the Snowflake current account resource
 func CurrentAccount() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadCurrentAccount,
-		Schema: currentAccountSchema,
+		ReadContext: ReadCurrentAccount,
+		Schema:      currentAccountSchema,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(defaultReadTimeout),
+		},
 	}
 }
 
 // ReadCurrentAccount read the current snowflake account information
-func ReadCurrentAccount(d *schema.ResourceData, meta interface{}) error {
+func ReadCurrentAccount(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	acc, err := snowflake.ReadCurrentAccount(db)
+	acc, err := snowflake.ReadCurrentAccount(ctx, db)
 
 	if err != nil {
 		log.Printf("[DEBUG] current_account failed to decode")
@@ -1,11 +1,13 @@
 package datasources
 
 import (
+	"context"
 	"database/sql"
 	"log"
 	"strconv"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/jmoiron/sqlx"
 )
@@ -53,17 +55,21 @@ var databaseSchema = map[string]*schema.Schema{
 // Database the Snowflake Database resource
 func Database() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadDatabase,
-		Schema: databaseSchema,
+		ReadContext: ReadDatabase,
+		Schema:      databaseSchema,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(defaultReadTimeout),
+		},
 	}
 }
 
 // ReadDatabase read the database meta-data information
-func ReadDatabase(d *schema.ResourceData, meta interface{}) error {
+func ReadDatabase(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	dbx := sqlx.NewDb(db, "snowflake")
 	log.Printf("[DEBUG] database: %v", d.Get("name"))
-	dbData, err := snowflake.ListDatabase(dbx, d.Get("name").(string))
+	dbData, err := snowflake.ListDatabase(ctx, dbx, d.Get("name").(string))
 	if err != nil {
 		log.Printf("[DEBUG] list database failed to decode")
 		d.SetId("")
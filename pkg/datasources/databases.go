@@ -1,11 +1,13 @@
 package datasources
 
 import (
+	"context"
 	"database/sql"
 	"log"
 	"strconv"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/jmoiron/sqlx"
 )
@@ -61,16 +63,20 @@ var databasesSchema = map[string]*schema.Schema{
 // Databases the Snowflake current account resource
 func Databases() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadDatabases,
-		Schema: databasesSchema,
+		ReadContext: ReadDatabases,
+		Schema:      databasesSchema,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(defaultReadTimeout),
+		},
 	}
 }
 
 // ReadDatabases read the current snowflake account information
-func ReadDatabases(d *schema.ResourceData, meta interface{}) error {
+func ReadDatabases(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	dbx := sqlx.NewDb(db, "snowflake")
-	dbs, err := snowflake.ListDatabases(dbx)
+	dbs, err := snowflake.ListDatabases(ctx, dbx)
 	if err != nil {
 		log.Printf("[DEBUG] list databases failed to decode")
 		d.SetId("")
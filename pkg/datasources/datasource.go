@@ -0,0 +1,7 @@
+package datasources
+
+import "time"
+
+// defaultReadTimeout bounds every data source read unless it is overridden in
+// the data source's `timeouts {}` block.
+const defaultReadTimeout = 20 * time.Minute
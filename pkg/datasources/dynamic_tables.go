@@ -0,0 +1,120 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var dynamicTablesSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database from which to return the dynamic tables from.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema from which to return the dynamic tables from.",
+	},
+	"dynamic_tables": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The dynamic tables in the schema",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"database": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"schema": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"warehouse": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"target_lag": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"refresh_mode": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"scheduling_state": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"rows": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func DynamicTables() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadDynamicTables,
+		Schema:      dynamicTablesSchema,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(defaultReadTimeout),
+		},
+	}
+}
+
+func ReadDynamicTables(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+
+	currentDynamicTables, err := snowflake.ListDynamicTables(ctx, databaseName, schemaName, db)
+	if err == sql.ErrNoRows {
+		// If not found, mark resource to be removed from statefile during apply or refresh
+		log.Printf("[DEBUG] dynamic tables in schema (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		log.Printf("[DEBUG] unable to parse dynamic tables in schema (%s)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	dynamicTables := []map[string]interface{}{}
+
+	for _, dynamicTable := range currentDynamicTables {
+		dynamicTableMap := map[string]interface{}{}
+
+		dynamicTableMap["name"] = dynamicTable.Name
+		dynamicTableMap["database"] = dynamicTable.DatabaseName
+		dynamicTableMap["schema"] = dynamicTable.SchemaName
+		dynamicTableMap["warehouse"] = dynamicTable.Warehouse.String
+		dynamicTableMap["target_lag"] = dynamicTable.TargetLag.String
+		dynamicTableMap["refresh_mode"] = dynamicTable.RefreshMode.String
+		dynamicTableMap["scheduling_state"] = dynamicTable.SchedulingState.String
+		dynamicTableMap["rows"] = dynamicTable.Rows.Int64
+		dynamicTableMap["comment"] = dynamicTable.Comment.String
+
+		dynamicTables = append(dynamicTables, dynamicTableMap)
+	}
+
+	d.SetId(fmt.Sprintf(`%v|%v`, databaseName, schemaName))
+	return diag.FromErr(d.Set("dynamic_tables", dynamicTables))
+}
@@ -0,0 +1,64 @@
+package datasources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDynamicTables(t *testing.T) {
+	databaseName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	schemaName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	dynamicTableName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: dynamicTables(databaseName, schemaName, dynamicTableName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_dynamic_tables.dt", "database", databaseName),
+					resource.TestCheckResourceAttr("data.snowflake_dynamic_tables.dt", "schema", schemaName),
+					resource.TestCheckResourceAttr("data.snowflake_dynamic_tables.dt", "dynamic_tables.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_dynamic_tables.dt", "dynamic_tables.0.name", dynamicTableName),
+					resource.TestCheckResourceAttr("data.snowflake_dynamic_tables.dt", "dynamic_tables.0.target_lag", "DOWNSTREAM"),
+				),
+			},
+		},
+	})
+}
+
+func dynamicTables(databaseName string, schemaName string, dynamicTableName string) string {
+	return fmt.Sprintf(`
+
+	resource snowflake_database "test" {
+	   name = "%v"
+	}
+
+	resource snowflake_schema "test"{
+		name 	 = "%v"
+		database = snowflake_database.test.name
+	}
+
+	resource snowflake_warehouse "test" {
+		name = snowflake_database.test.name
+	}
+
+	resource snowflake_dynamic_table "test" {
+		name       = "%v"
+		database   = snowflake_database.test.name
+		schema     = snowflake_schema.test.name
+		warehouse  = snowflake_warehouse.test.name
+		target_lag = "DOWNSTREAM"
+		query      = "SELECT 1 AS ID"
+	}
+
+	data snowflake_dynamic_tables "dt" {
+		database   = snowflake_dynamic_table.test.database
+		schema     = snowflake_dynamic_table.test.schema
+		depends_on = [snowflake_dynamic_table.test]
+	}
+	`, databaseName, schemaName, dynamicTableName)
+}
@@ -1,11 +1,13 @@
 package datasources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -55,17 +57,21 @@ var externalFunctionsSchema = map[string]*schema.Schema{
 
 func ExternalFunctions() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadExternalFunctions,
-		Schema: externalFunctionsSchema,
+		ReadContext: ReadExternalFunctions,
+		Schema:      externalFunctionsSchema,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(defaultReadTimeout),
+		},
 	}
 }
 
-func ReadExternalFunctions(d *schema.ResourceData, meta interface{}) error {
+func ReadExternalFunctions(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
 
-	currentExternalFunctions, err := snowflake.ListExternalFunctions(databaseName, schemaName, db)
+	currentExternalFunctions, err := snowflake.ListExternalFunctions(ctx, databaseName, schemaName, db)
 	if err == sql.ErrNoRows {
 		// If not found, mark resource to be removed from statefile during apply or refresh
 		log.Printf("[DEBUG] external functions in schema (%s) not found", d.Id())
@@ -92,5 +98,5 @@ func ReadExternalFunctions(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.SetId(fmt.Sprintf(`%v|%v`, databaseName, schemaName))
-	return d.Set("external_functions", externalFunctions)
+	return diag.FromErr(d.Set("external_functions", externalFunctions))
 }
@@ -1,11 +1,13 @@
 package datasources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -50,17 +52,21 @@ var externalTablesSchema = map[string]*schema.Schema{
 
 func ExternalTables() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadExternalTables,
-		Schema: externalTablesSchema,
+		ReadContext: ReadExternalTables,
+		Schema:      externalTablesSchema,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(defaultReadTimeout),
+		},
 	}
 }
 
-func ReadExternalTables(d *schema.ResourceData, meta interface{}) error {
+func ReadExternalTables(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
 
-	currentExternalTables, err := snowflake.ListExternalTables(databaseName, schemaName, db)
+	currentExternalTables, err := snowflake.ListExternalTables(ctx, databaseName, schemaName, db)
 	if err == sql.ErrNoRows {
 		// If not found, mark resource to be removed from statefile during apply or refresh
 		log.Printf("[DEBUG] external tables in schema (%s) not found", d.Id())
@@ -86,5 +92,5 @@ func ReadExternalTables(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.SetId(fmt.Sprintf(`%v|%v`, databaseName, schemaName))
-	return d.Set("external_tables", externalTables)
+	return diag.FromErr(d.Set("external_tables", externalTables))
 }
@@ -1,11 +1,13 @@
 package datasources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -55,17 +57,21 @@ var fileFormatsSchema = map[string]*schema.Schema{
 
 func FileFormats() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadFileFormats,
-		Schema: fileFormatsSchema,
+		ReadContext: ReadFileFormats,
+		Schema:      fileFormatsSchema,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(defaultReadTimeout),
+		},
 	}
 }
 
-func ReadFileFormats(d *schema.ResourceData, meta interface{}) error {
+func ReadFileFormats(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
 
-	currentFileFormats, err := snowflake.ListFileFormats(databaseName, schemaName, db)
+	currentFileFormats, err := snowflake.ListFileFormats(ctx, databaseName, schemaName, db)
 	if err == sql.ErrNoRows {
 		// If not found, mark resource to be removed from statefile during apply or refresh
 		log.Printf("[DEBUG] file formats in schema (%s) not found", d.Id())
@@ -92,5 +98,5 @@ func ReadFileFormats(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.SetId(fmt.Sprintf(`%v|%v`, databaseName, schemaName))
-	return d.Set("file_formats", fileFormats)
+	return diag.FromErr(d.Set("file_formats", fileFormats))
 }
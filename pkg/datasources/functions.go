@@ -1,11 +1,13 @@
 package datasources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -61,17 +63,21 @@ var functionsSchema = map[string]*schema.Schema{
 
 func Functions() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadFunctions,
-		Schema: functionsSchema,
+		ReadContext: ReadFunctions,
+		Schema:      functionsSchema,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(defaultReadTimeout),
+		},
 	}
 }
 
-func ReadFunctions(d *schema.ResourceData, meta interface{}) error {
+func ReadFunctions(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
 
-	currentFunctions, err := snowflake.ListFunctions(databaseName, schemaName, db)
+	currentFunctions, err := snowflake.ListFunctions(ctx, databaseName, schemaName, db)
 	if err == sql.ErrNoRows {
 		// If not found, mark resource to be removed from statefile during apply or refresh
 		log.Printf("[DEBUG] functions in schema (%s) not found", d.Id())
@@ -90,7 +96,7 @@ func ReadFunctions(d *schema.ResourceData, meta interface{}) error {
 
 		functionSignatureMap, err := parseArguments(function.Arguments.String)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		functionMap["name"] = function.Name.String
@@ -104,5 +110,5 @@ func ReadFunctions(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.SetId(fmt.Sprintf(`%v|%v`, databaseName, schemaName))
-	return d.Set("functions", functions)
+	return diag.FromErr(d.Set("functions", functions))
 }
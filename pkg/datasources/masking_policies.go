@@ -1,11 +1,13 @@
 package datasources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -55,17 +57,21 @@ var maskingPoliciesSchema = map[string]*schema.Schema{
 
 func MaskingPolicies() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadMaskingPolicies,
-		Schema: maskingPoliciesSchema,
+		ReadContext: ReadMaskingPolicies,
+		Schema:      maskingPoliciesSchema,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(defaultReadTimeout),
+		},
 	}
 }
 
-func ReadMaskingPolicies(d *schema.ResourceData, meta interface{}) error {
+func ReadMaskingPolicies(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
 
-	currentMaskingPolicies, err := snowflake.ListMaskingPolicies(databaseName, schemaName, db)
+	currentMaskingPolicies, err := snowflake.ListMaskingPolicies(ctx, databaseName, schemaName, db)
 	if err == sql.ErrNoRows {
 		// If not found, mark resource to be removed from statefile during apply or refresh
 		log.Printf("[DEBUG] masking policies in schema (%s) not found", d.Id())
@@ -92,5 +98,5 @@ func ReadMaskingPolicies(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.SetId(fmt.Sprintf(`%v|%v`, databaseName, schemaName))
-	return d.Set("masking_policies", maskingPolicies)
+	return diag.FromErr(d.Set("masking_policies", maskingPolicies))
 }
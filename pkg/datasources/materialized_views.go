@@ -1,11 +1,13 @@
 package datasources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -50,17 +52,21 @@ var materializedViewsSchema = map[string]*schema.Schema{
 
 func MaterializedViews() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadMaterializedViews,
-		Schema: materializedViewsSchema,
+		ReadContext: ReadMaterializedViews,
+		Schema:      materializedViewsSchema,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(defaultReadTimeout),
+		},
 	}
 }
 
-func ReadMaterializedViews(d *schema.ResourceData, meta interface{}) error {
+func ReadMaterializedViews(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
 
-	currentViews, err := snowflake.ListMaterializedViews(databaseName, schemaName, db)
+	currentViews, err := snowflake.ListMaterializedViews(ctx, databaseName, schemaName, db)
 	if err == sql.ErrNoRows {
 		// If not found, mark resource to be removed from statefile during apply or refresh
 		log.Printf("[DEBUG] materialized views in schema (%s) not found", d.Id())
@@ -86,5 +92,5 @@ func ReadMaterializedViews(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.SetId(fmt.Sprintf(`%v|%v`, databaseName, schemaName))
-	return d.Set("materialized_views", views)
+	return diag.FromErr(d.Set("materialized_views", views))
 }
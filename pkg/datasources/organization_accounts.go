@@ -0,0 +1,116 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var organizationAccountsSchema = map[string]*schema.Schema{
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the accounts by name with a LIKE pattern, e.g. `SALES%`.",
+	},
+	"accounts": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The accounts of the organization",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"organization_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"account_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"region_group": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"snowflake_region": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"edition": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"account_url": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"created_on": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"account_locator": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"is_org_admin": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+// OrganizationAccounts lists the accounts of the organization. It requires
+// the provider to use the ORGADMIN role.
+func OrganizationAccounts() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadOrganizationAccounts,
+		Schema:      organizationAccountsSchema,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(defaultReadTimeout),
+		},
+	}
+}
+
+func ReadOrganizationAccounts(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	pattern := d.Get("pattern").(string)
+
+	currentAccounts, err := snowflake.ListOrganizationAccounts(ctx, pattern, db)
+	if err != nil {
+		log.Printf("[DEBUG] unable to show organization accounts like %q: %v", pattern, err)
+		d.SetId("")
+		return nil
+	}
+
+	accounts := []map[string]interface{}{}
+
+	for _, account := range currentAccounts {
+		accountMap := map[string]interface{}{}
+
+		accountMap["organization_name"] = account.OrganizationName.String
+		accountMap["account_name"] = account.AccountName.String
+		accountMap["region_group"] = account.RegionGroup.String
+		accountMap["snowflake_region"] = account.SnowflakeRegion.String
+		accountMap["edition"] = account.Edition.String
+		accountMap["account_url"] = account.AccountURL.String
+		accountMap["created_on"] = account.CreatedOn.String
+		accountMap["comment"] = account.Comment.String
+		accountMap["account_locator"] = account.AccountLocator.String
+		accountMap["is_org_admin"] = account.IsOrgAdmin.Bool
+
+		accounts = append(accounts, accountMap)
+	}
+
+	d.SetId(fmt.Sprintf("organization_accounts|%v", pattern))
+	return diag.FromErr(d.Set("accounts", accounts))
+}
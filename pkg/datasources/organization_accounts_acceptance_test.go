@@ -0,0 +1,34 @@
+package datasources_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOrganizationAccounts(t *testing.T) {
+	if _, ok := os.LookupEnv("SNOWFLAKE_ORGADMIN_TESTS"); !ok {
+		t.Skip("Skipping TestAccOrganizationAccounts, set SNOWFLAKE_ORGADMIN_TESTS to run it with the ORGADMIN role")
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: organizationAccounts(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.snowflake_organization_accounts.a", "accounts.#"),
+					resource.TestCheckResourceAttrSet("data.snowflake_organization_accounts.a", "accounts.0.account_name"),
+					resource.TestCheckResourceAttrSet("data.snowflake_organization_accounts.a", "accounts.0.edition"),
+				),
+			},
+		},
+	})
+}
+
+func organizationAccounts() string {
+	return `
+	data snowflake_organization_accounts "a" {}
+	`
+}
@@ -0,0 +1,139 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var parametersSchema = map[string]*schema.Schema{
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the parameters by name with a LIKE pattern, e.g. `%TIME%`.",
+	},
+	"object_type": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "ACCOUNT",
+		Description:  "Type of the object to return the parameters of, one of ACCOUNT, DATABASE, SCHEMA, TABLE, TASK, USER or WAREHOUSE.",
+		ValidateFunc: validation.StringInSlice([]string{"ACCOUNT", "DATABASE", "SCHEMA", "TABLE", "TASK", "USER", "WAREHOUSE"}, true),
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The database of the schema, table or task to return the parameters of.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The schema of the table or task to return the parameters of.",
+	},
+	"object_name": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Name of the object to return the parameters of, unless object_type is ACCOUNT.",
+	},
+	"parameters": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The parameters of the account or object",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"default": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"level": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"description": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func Parameters() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadParameters,
+		Schema:      parametersSchema,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(defaultReadTimeout),
+		},
+	}
+}
+
+func ReadParameters(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	pattern := d.Get("pattern").(string)
+	objectType := strings.ToUpper(d.Get("object_type").(string))
+
+	names := []string{}
+	for _, key := range []string{"database", "schema", "object_name"} {
+		if n := d.Get(key).(string); n != "" {
+			names = append(names, n)
+		}
+	}
+
+	builder := snowflake.AccountParameter(pattern)
+	if objectType != "ACCOUNT" {
+		builder = snowflake.ObjectParameter(pattern, objectType, names...)
+	}
+
+	rows, err := snowflake.QueryContext(ctx, db, builder.Show())
+	if err != nil {
+		log.Printf("[DEBUG] unable to show parameters of %v %v", objectType, strings.Join(names, "."))
+		d.SetId("")
+		return nil
+	}
+	defer rows.Close()
+
+	currentParameters, err := snowflake.ScanParameters(rows)
+	if err != nil {
+		log.Printf("[DEBUG] unable to parse parameters of %v %v", objectType, strings.Join(names, "."))
+		d.SetId("")
+		return nil
+	}
+
+	parameters := []map[string]interface{}{}
+
+	for _, parameter := range currentParameters {
+		parameterMap := map[string]interface{}{}
+
+		parameterMap["key"] = parameter.Key.String
+		parameterMap["value"] = parameter.Value.String
+		parameterMap["default"] = parameter.DefaultValue.String
+		parameterMap["level"] = parameter.Level.String
+		parameterMap["description"] = parameter.Description.String
+		parameterMap["type"] = parameter.Type.String
+
+		parameters = append(parameters, parameterMap)
+	}
+
+	d.SetId(fmt.Sprintf(`%v|%v|%v`, objectType, strings.Join(names, "."), pattern))
+	return diag.FromErr(d.Set("parameters", parameters))
+}
@@ -0,0 +1,48 @@
+package datasources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccParameters(t *testing.T) {
+	databaseName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: parameters(databaseName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.snowflake_parameters.account", "parameters.#"),
+					resource.TestCheckResourceAttr("data.snowflake_parameters.database", "parameters.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_parameters.database", "parameters.0.key", "DATA_RETENTION_TIME_IN_DAYS"),
+					resource.TestCheckResourceAttr("data.snowflake_parameters.database", "parameters.0.value", "3"),
+					resource.TestCheckResourceAttr("data.snowflake_parameters.database", "parameters.0.level", "DATABASE"),
+				),
+			},
+		},
+	})
+}
+
+func parameters(databaseName string) string {
+	return fmt.Sprintf(`
+	resource snowflake_database "d" {
+		name                        = "%v"
+		data_retention_time_in_days = 3
+	}
+
+	data snowflake_parameters "account" {
+		pattern = "%%TIME%%"
+	}
+
+	data snowflake_parameters "database" {
+		object_type = "DATABASE"
+		object_name = snowflake_database.d.name
+		pattern     = "DATA_RETENTION_TIME_IN_DAYS"
+	}
+	`, databaseName)
+}
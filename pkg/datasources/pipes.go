@@ -1,11 +1,13 @@
 package datasources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -55,17 +57,21 @@ var pipesSchema = map[string]*schema.Schema{
 
 func Pipes() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadPipes,
-		Schema: pipesSchema,
+		ReadContext: ReadPipes,
+		Schema:      pipesSchema,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(defaultReadTimeout),
+		},
 	}
 }
 
-func ReadPipes(d *schema.ResourceData, meta interface{}) error {
+func ReadPipes(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
 
-	currentPipes, err := snowflake.ListPipes(databaseName, schemaName, db)
+	currentPipes, err := snowflake.ListPipes(ctx, databaseName, schemaName, db)
 	if err == sql.ErrNoRows {
 		// If not found, mark resource to be removed from statefile during apply or refresh
 		log.Printf("[DEBUG] pipes in schema (%s) not found", d.Id())
@@ -92,5 +98,5 @@ func ReadPipes(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.SetId(fmt.Sprintf(`%v|%v`, databaseName, schemaName))
-	return d.Set("pipes", pipes)
+	return diag.FromErr(d.Set("pipes", pipes))
 }
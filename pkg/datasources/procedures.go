@@ -1,6 +1,7 @@
 package datasources
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -9,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -64,17 +66,21 @@ var proceduresSchema = map[string]*schema.Schema{
 
 func Procedures() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadProcedures,
-		Schema: proceduresSchema,
+		ReadContext: ReadProcedures,
+		Schema:      proceduresSchema,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(defaultReadTimeout),
+		},
 	}
 }
 
-func ReadProcedures(d *schema.ResourceData, meta interface{}) error {
+func ReadProcedures(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
 
-	currentProcedures, err := snowflake.ListProcedures(databaseName, schemaName, db)
+	currentProcedures, err := snowflake.ListProcedures(ctx, databaseName, schemaName, db)
 	if err == sql.ErrNoRows {
 		// If not found, mark resource to be removed from statefile during apply or refresh
 		log.Printf("[DEBUG] procedures in schema (%s) not found", d.Id())
@@ -93,7 +99,7 @@ func ReadProcedures(d *schema.ResourceData, meta interface{}) error {
 
 		procedureSignatureMap, err := parseArguments(procedure.Arguments.String)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		procedureMap["name"] = procedure.Name.String
@@ -107,7 +113,7 @@ func ReadProcedures(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.SetId(fmt.Sprintf(`%v|%v`, databaseName, schemaName))
-	return d.Set("procedures", procedures)
+	return diag.FromErr(d.Set("procedures", procedures))
 }
 
 func parseArguments(arguments string) (map[string]interface{}, error) {
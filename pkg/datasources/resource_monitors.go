@@ -1,11 +1,13 @@
 package datasources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -41,15 +43,19 @@ var resourceMonitorsSchema = map[string]*schema.Schema{
 
 func ResourceMonitors() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadResourceMonitors,
-		Schema: resourceMonitorsSchema,
+		ReadContext: ReadResourceMonitors,
+		Schema:      resourceMonitorsSchema,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(defaultReadTimeout),
+		},
 	}
 }
 
-func ReadResourceMonitors(d *schema.ResourceData, meta interface{}) error {
+func ReadResourceMonitors(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 
-	account, err := snowflake.ReadCurrentAccount(db)
+	account, err := snowflake.ReadCurrentAccount(ctx, db)
 	if err != nil {
 		log.Print("[DEBUG] unable to retrieve current account")
 		d.SetId("")
@@ -58,7 +64,7 @@ func ReadResourceMonitors(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(fmt.Sprintf("%s.%s", account.Account, account.Region))
 
-	currentResourceMonitors, err := snowflake.ListResourceMonitors(db)
+	currentResourceMonitors, err := snowflake.ListResourceMonitors(ctx, db)
 	if err == sql.ErrNoRows {
 		// If not found, mark resource to be removed from statefile during apply or refresh
 		log.Printf("[DEBUG] no resource monitors found in account (%s)", d.Id())
@@ -83,5 +89,5 @@ func ReadResourceMonitors(d *schema.ResourceData, meta interface{}) error {
 		resourceMonitors = append(resourceMonitors, resourceMonitorMap)
 	}
 
-	return d.Set("resource_monitors", resourceMonitors)
+	return diag.FromErr(d.Set("resource_monitors", resourceMonitors))
 }
@@ -1,11 +1,13 @@
 package datasources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -20,17 +22,21 @@ var roleSchema = map[string]*schema.Schema{
 // Role Snowflake Role resource
 func Role() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadRole,
-		Schema: roleSchema,
+		ReadContext: ReadRole,
+		Schema:      roleSchema,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(defaultReadTimeout),
+		},
 	}
 }
 
 // ReadRole Reads the database metadata information
-func ReadRole(d *schema.ResourceData, meta interface{}) error {
+func ReadRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	id := d.Id()
 
-	row := snowflake.QueryRow(db, fmt.Sprintf("SHOW ROLES LIKE '%s'", id))
+	row := snowflake.QueryRowContext(ctx, db, fmt.Sprintf("SHOW ROLES LIKE '%s'", snowflake.EscapeString(id)))
 	role, err := snowflake.ScanRole(row)
 
 	if err == sql.ErrNoRows {
@@ -39,17 +45,17 @@ func ReadRole(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("name", role.Name.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return err
+	return diag.FromErr(err)
 }
@@ -1,11 +1,13 @@
 package datasources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -50,17 +52,21 @@ var rowAccessPoliciesSchema = map[string]*schema.Schema{
 
 func RowAccessPolicies() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadRowAccessPolicies,
-		Schema: rowAccessPoliciesSchema,
+		ReadContext: ReadRowAccessPolicies,
+		Schema:      rowAccessPoliciesSchema,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(defaultReadTimeout),
+		},
 	}
 }
 
-func ReadRowAccessPolicies(d *schema.ResourceData, meta interface{}) error {
+func ReadRowAccessPolicies(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
 
-	currentRowAccessPolicies, err := snowflake.ListRowAccessPolicies(databaseName, schemaName, db)
+	currentRowAccessPolicies, err := snowflake.ListRowAccessPolicies(ctx, databaseName, schemaName, db)
 	if err == sql.ErrNoRows {
 		// If not found, mark resource to be removed from statefile during apply or refresh
 		log.Printf("[DEBUG] row access policy in schema (%s) not found", d.Id())
@@ -86,5 +92,5 @@ func ReadRowAccessPolicies(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.SetId(fmt.Sprintf(`%v|%v`, databaseName, schemaName))
-	return d.Set("row_access_policies", rowAccessPolicies)
+	return diag.FromErr(d.Set("row_access_policies", rowAccessPolicies))
 }
@@ -1,10 +1,12 @@
 package datasources
 
 import (
+	"context"
 	"database/sql"
 	"log"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -40,18 +42,22 @@ var schemasSchema = map[string]*schema.Schema{
 
 func Schemas() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadSchemas,
-		Schema: schemasSchema,
+		ReadContext: ReadSchemas,
+		Schema:      schemasSchema,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(defaultReadTimeout),
+		},
 	}
 }
 
-func ReadSchemas(d *schema.ResourceData, meta interface{}) error {
+func ReadSchemas(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	databaseName := d.Get("database").(string)
 
 	log.Printf("[DEBUG] database name %s", databaseName)
 
-	currentSchemas, err := snowflake.ListSchemas(databaseName, db)
+	currentSchemas, err := snowflake.ListSchemas(ctx, databaseName, db)
 	if err == sql.ErrNoRows {
 		// If not found, mark resource to be removed from statefile during apply or refresh
 		log.Printf("[DEBUG] schemas in database (%s) not found", d.Id())
@@ -76,5 +82,5 @@ func ReadSchemas(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.SetId(databaseName)
-	return d.Set("schemas", schemas)
+	return diag.FromErr(d.Set("schemas", schemas))
 }
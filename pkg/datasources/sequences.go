@@ -1,11 +1,13 @@
 package datasources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -50,17 +52,21 @@ var sequencesSchema = map[string]*schema.Schema{
 
 func Sequences() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadSequences,
-		Schema: sequencesSchema,
+		ReadContext: ReadSequences,
+		Schema:      sequencesSchema,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(defaultReadTimeout),
+		},
 	}
 }
 
-func ReadSequences(d *schema.ResourceData, meta interface{}) error {
+func ReadSequences(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
 
-	currentSequences, err := snowflake.ListSequences(databaseName, schemaName, db)
+	currentSequences, err := snowflake.ListSequences(ctx, databaseName, schemaName, db)
 	if err == sql.ErrNoRows {
 		// If not found, mark resource to be removed from statefile during apply or refresh
 		log.Printf("[DEBUG] sequences in schema (%s) not found", d.Id())
@@ -86,5 +92,5 @@ func ReadSequences(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.SetId(fmt.Sprintf(`%v|%v`, databaseName, schemaName))
-	return d.Set("sequences", sequences)
+	return diag.FromErr(d.Set("sequences", sequences))
 }
@@ -1,11 +1,13 @@
 package datasources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -41,15 +43,19 @@ var storageIntegrationsSchema = map[string]*schema.Schema{
 
 func StorageIntegrations() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadStorageIntegrations,
-		Schema: storageIntegrationsSchema,
+		ReadContext: ReadStorageIntegrations,
+		Schema:      storageIntegrationsSchema,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(defaultReadTimeout),
+		},
 	}
 }
 
-func ReadStorageIntegrations(d *schema.ResourceData, meta interface{}) error {
+func ReadStorageIntegrations(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 
-	account, err := snowflake.ReadCurrentAccount(db)
+	account, err := snowflake.ReadCurrentAccount(ctx, db)
 	if err != nil {
 		log.Print("[DEBUG] unable to retrieve current account")
 		d.SetId("")
@@ -58,7 +64,7 @@ func ReadStorageIntegrations(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(fmt.Sprintf("%s.%s", account.Account, account.Region))
 
-	currentStorageIntegrations, err := snowflake.ListStorageIntegrations(db)
+	currentStorageIntegrations, err := snowflake.ListStorageIntegrations(ctx, db)
 	if err == sql.ErrNoRows {
 		// If not found, mark resource to be removed from statefile during apply or refresh
 		log.Printf("[DEBUG] no storage integrations found in account (%s)", d.Id())
@@ -83,5 +89,5 @@ func ReadStorageIntegrations(d *schema.ResourceData, meta interface{}) error {
 		storageIntegrations = append(storageIntegrations, storageIntegrationMap)
 	}
 
-	return d.Set("storage_integrations", storageIntegrations)
+	return diag.FromErr(d.Set("storage_integrations", storageIntegrations))
 }
@@ -1,11 +1,13 @@
 package datasources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -55,17 +57,21 @@ var streamsSchema = map[string]*schema.Schema{
 
 func Streams() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadStreams,
-		Schema: streamsSchema,
+		ReadContext: ReadStreams,
+		Schema:      streamsSchema,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(defaultReadTimeout),
+		},
 	}
 }
 
-func ReadStreams(d *schema.ResourceData, meta interface{}) error {
+func ReadStreams(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
 
-	currentStreams, err := snowflake.ListStreams(databaseName, schemaName, db)
+	currentStreams, err := snowflake.ListStreams(ctx, databaseName, schemaName, db)
 	if err == sql.ErrNoRows {
 		// If not found, mark resource to be removed from statefile during apply or refresh
 		log.Printf("[DEBUG] streams in schema (%s) not found", d.Id())
@@ -92,5 +98,5 @@ func ReadStreams(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.SetId(fmt.Sprintf(`%v|%v`, databaseName, schemaName))
-	return d.Set("streams", streams)
+	return diag.FromErr(d.Set("streams", streams))
 }
@@ -1,10 +1,12 @@
 package datasources
 
 import (
+	"context"
 	"database/sql"
 	"log"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -23,18 +25,22 @@ var systemGenerateSCIMAccesstokenSchema = map[string]*schema.Schema{
 
 func SystemGenerateSCIMAccessToken() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadSystemGenerateSCIMAccessToken,
-		Schema: systemGenerateSCIMAccesstokenSchema,
+		ReadContext: ReadSystemGenerateSCIMAccessToken,
+		Schema:      systemGenerateSCIMAccesstokenSchema,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(defaultReadTimeout),
+		},
 	}
 }
 
-// ReadSystemGetAWSSNSIAMPolicy implements schema.ReadFunc
-func ReadSystemGenerateSCIMAccessToken(d *schema.ResourceData, meta interface{}) error {
+// ReadSystemGetAWSSNSIAMPolicy implements schema.ReadContextFunc
+func ReadSystemGenerateSCIMAccessToken(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	integrationName := d.Get("integration_name").(string)
 
 	sel := snowflake.SystemGenerateSCIMAccessToken(integrationName).Select()
-	row := snowflake.QueryRow(db, sel)
+	row := snowflake.QueryRowContext(ctx, db, sel)
 	accessToken, err := snowflake.ScanSCIMAccessToken(row)
 	if err == sql.ErrNoRows {
 		// If not found, mark resource to be removed from statefile during apply or refresh
@@ -50,5 +56,5 @@ func ReadSystemGenerateSCIMAccessToken(d *schema.ResourceData, meta interface{})
 	}
 
 	d.SetId(integrationName)
-	return d.Set("access_token", accessToken.Token)
+	return diag.FromErr(d.Set("access_token", accessToken.Token))
 }
@@ -1,10 +1,12 @@
 package datasources
 
 import (
+	"context"
 	"database/sql"
 	"log"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -42,17 +44,21 @@ var systemGetPrivateLinkConfigSchema = map[string]*schema.Schema{
 
 func SystemGetPrivateLinkConfig() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadSystemGetPrivateLinkConfig,
-		Schema: systemGetPrivateLinkConfigSchema,
+		ReadContext: ReadSystemGetPrivateLinkConfig,
+		Schema:      systemGetPrivateLinkConfigSchema,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(defaultReadTimeout),
+		},
 	}
 }
 
-// ReadSystemGetPrivateLinkConfig implements schema.ReadFunc
-func ReadSystemGetPrivateLinkConfig(d *schema.ResourceData, meta interface{}) error {
+// ReadSystemGetPrivateLinkConfig implements schema.ReadContextFunc
+func ReadSystemGetPrivateLinkConfig(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 
 	sel := snowflake.SystemGetPrivateLinkConfigQuery()
-	row := snowflake.QueryRow(db, sel)
+	row := snowflake.QueryRowContext(ctx, db, sel)
 	rawConfig, err := snowflake.ScanPrivateLinkConfig(row)
 
 	if err == sql.ErrNoRows {
@@ -1,11 +1,13 @@
 package datasources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -27,23 +29,27 @@ var systemGetSnowflakePlatformInfoSchema = map[string]*schema.Schema{
 
 func SystemGetSnowflakePlatformInfo() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadSystemGetSnowflakePlatformInfo,
-		Schema: systemGetSnowflakePlatformInfoSchema,
+		ReadContext: ReadSystemGetSnowflakePlatformInfo,
+		Schema:      systemGetSnowflakePlatformInfoSchema,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(defaultReadTimeout),
+		},
 	}
 }
 
-// ReadSystemGetSnowflakePlatformInfo implements schema.ReadFunc
-func ReadSystemGetSnowflakePlatformInfo(d *schema.ResourceData, meta interface{}) error {
+// ReadSystemGetSnowflakePlatformInfo implements schema.ReadContextFunc
+func ReadSystemGetSnowflakePlatformInfo(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	sel := snowflake.SystemGetSnowflakePlatformInfoQuery()
-	row := snowflake.QueryRow(db, sel)
+	row := snowflake.QueryRowContext(ctx, db, sel)
 
-	acc, err := snowflake.ReadCurrentAccount(db)
+	acc, err := snowflake.ReadCurrentAccount(ctx, db)
 	if err != nil {
 		// If not found, mark resource to be removed from statefile during apply or refresh
 		d.SetId("")
 		log.Printf("[DEBUG] current_account failed to decode")
-		return errors.Wrap(err, "error current_account")
+		return diag.FromErr(errors.Wrap(err, "error current_account"))
 	}
 
 	d.SetId(fmt.Sprintf("%s.%s", acc.Account, acc.Region))
@@ -52,22 +58,22 @@ func ReadSystemGetSnowflakePlatformInfo(d *schema.ResourceData, meta interface{}
 	if err == sql.ErrNoRows {
 		// If not found, mark resource to be removed from statefile during apply or refresh
 		log.Print("[DEBUG] system_get_snowflake_platform_info not found")
-		return errors.Wrap(err, "error system_get_snowflake_platform_info")
+		return diag.FromErr(errors.Wrap(err, "error system_get_snowflake_platform_info"))
 	}
 
 	info, err := rawInfo.GetStructuredConfig()
 	if err != nil {
 		log.Printf("[DEBUG] system_get_snowflake_platform_info failed to decode")
 		d.SetId("")
-		return errors.Wrap(err, "error system_get_snowflake_platform_info")
+		return diag.FromErr(errors.Wrap(err, "error system_get_snowflake_platform_info"))
 	}
 
 	if err = d.Set("azure_vnet_subnet_ids", info.AzureVnetSubnetIds); err != nil {
-		return errors.Wrap(err, "error system_get_snowflake_platform_info")
+		return diag.FromErr(errors.Wrap(err, "error system_get_snowflake_platform_info"))
 	}
 
 	if err = d.Set("aws_vpc_ids", info.AwsVpcIds); err != nil {
-		return errors.Wrap(err, "error system_get_snowflake_platform_info")
+		return diag.FromErr(errors.Wrap(err, "error system_get_snowflake_platform_info"))
 	}
 
 	return nil
@@ -1,11 +1,13 @@
 package datasources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -50,17 +52,21 @@ var tablesSchema = map[string]*schema.Schema{
 
 func Tables() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadTables,
-		Schema: tablesSchema,
+		ReadContext: ReadTables,
+		Schema:      tablesSchema,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(defaultReadTimeout),
+		},
 	}
 }
 
-func ReadTables(d *schema.ResourceData, meta interface{}) error {
+func ReadTables(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
 
-	currentTables, err := snowflake.ListTables(databaseName, schemaName, db)
+	currentTables, err := snowflake.ListTables(ctx, databaseName, schemaName, db)
 	if err == sql.ErrNoRows {
 		// If not found, mark resource to be removed from statefile during apply or refresh
 		log.Printf("[DEBUG] tables in schema (%s) not found", d.Id())
@@ -90,5 +96,5 @@ func ReadTables(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.SetId(fmt.Sprintf(`%v|%v`, databaseName, schemaName))
-	return d.Set("tables", tables)
+	return diag.FromErr(d.Set("tables", tables))
 }
@@ -1,11 +1,13 @@
 package datasources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -50,17 +52,21 @@ var viewsSchema = map[string]*schema.Schema{
 
 func Views() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadViews,
-		Schema: viewsSchema,
+		ReadContext: ReadViews,
+		Schema:      viewsSchema,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(defaultReadTimeout),
+		},
 	}
 }
 
-func ReadViews(d *schema.ResourceData, meta interface{}) error {
+func ReadViews(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
 
-	currentViews, err := snowflake.ListViews(databaseName, schemaName, db)
+	currentViews, err := snowflake.ListViews(ctx, databaseName, schemaName, db)
 	if err == sql.ErrNoRows {
 		// If not found, mark resource to be removed from statefile during apply or refresh
 		log.Printf("[DEBUG] views in schema (%s) not found", d.Id())
@@ -86,5 +92,5 @@ func ReadViews(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.SetId(fmt.Sprintf(`%v|%v`, databaseName, schemaName))
-	return d.Set("views", views)
+	return diag.FromErr(d.Set("views", views))
 }
@@ -1,11 +1,13 @@
 package datasources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -51,15 +53,19 @@ var warehousesSchema = map[string]*schema.Schema{
 
 func Warehouses() *schema.Resource {
 	return &schema.Resource{
-		Read:   ReadWarehouses,
-		Schema: warehousesSchema,
+		ReadContext: ReadWarehouses,
+		Schema:      warehousesSchema,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(defaultReadTimeout),
+		},
 	}
 }
 
-func ReadWarehouses(d *schema.ResourceData, meta interface{}) error {
+func ReadWarehouses(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 
-	account, err := snowflake.ReadCurrentAccount(db)
+	account, err := snowflake.ReadCurrentAccount(ctx, db)
 	if err != nil {
 		log.Print("[DEBUG] unable to retrieve current account")
 		d.SetId("")
@@ -68,7 +74,7 @@ func ReadWarehouses(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(fmt.Sprintf("%s.%s", account.Account, account.Region))
 
-	currentWarehouses, err := snowflake.ListWarehouses(db)
+	currentWarehouses, err := snowflake.ListWarehouses(ctx, db)
 	if err == sql.ErrNoRows {
 		// If not found, mark resource to be removed from statefile during apply or refresh
 		log.Printf("[DEBUG] no warehouses found in account (%s)", d.Id())
@@ -95,5 +101,5 @@ func ReadWarehouses(d *schema.ResourceData, meta interface{}) error {
 		warehouses = append(warehouses, warehouseMap)
 	}
 
-	return d.Set("warehouses", warehouses)
+	return diag.FromErr(d.Set("warehouses", warehouses))
 }
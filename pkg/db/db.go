@@ -3,6 +3,7 @@ package db
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"fmt"
 	"log"
 	"regexp"
@@ -11,6 +12,8 @@ import (
 	"github.com/snowflakedb/gosnowflake"
 )
 
+var instrumentedDriver driver.Driver
+
 func init() {
 	re := regexp.MustCompile(`\r?\n`)
 
@@ -19,9 +22,12 @@ func init() {
 		log.Println(re.ReplaceAllString(s, " "))
 	})
 
-	sql.Register("snowflake-instrumented", instrumentedsql.WrapDriver(&gosnowflake.SnowflakeDriver{}, instrumentedsql.WithLogger(logger)))
+	instrumentedDriver = instrumentedsql.WrapDriver(&gosnowflake.SnowflakeDriver{}, instrumentedsql.WithLogger(logger))
+	sql.Register("snowflake-instrumented", instrumentedDriver)
 }
 
-func Open(dsn string) (*sql.DB, error) {
-	return sql.Open("snowflake-instrumented", dsn)
+// Open opens a connection pool to snowflake. Statements run outside of a
+// transaction are retried on transient errors according to retry.
+func Open(dsn string, retry RetryConfig) (*sql.DB, error) {
+	return sql.OpenDB(&retryConnector{dsn: dsn, driver: instrumentedDriver, cfg: retry}), nil
 }
@@ -0,0 +1,81 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"log"
+)
+
+// OpenOffline opens a connection pool that never reaches Snowflake: every
+// query returns no rows and every statement succeeds without effect. It lets
+// resources run against a blank account, e.g. to render their DDL.
+func OpenOffline() *sql.DB {
+	return sql.OpenDB(offlineConnector{})
+}
+
+type offlineConnector struct{}
+
+func (offlineConnector) Connect(_ context.Context) (driver.Conn, error) {
+	return offlineConn{}, nil
+}
+
+func (offlineConnector) Driver() driver.Driver {
+	return offlineDriver{}
+}
+
+type offlineDriver struct{}
+
+func (offlineDriver) Open(_ string) (driver.Conn, error) {
+	return offlineConn{}, nil
+}
+
+type offlineConn struct{}
+
+func (offlineConn) Prepare(_ string) (driver.Stmt, error) {
+	return nil, errors.New("prepared statements are not supported offline")
+}
+
+func (offlineConn) Close() error {
+	return nil
+}
+
+func (offlineConn) Begin() (driver.Tx, error) {
+	return offlineTx{}, nil
+}
+
+func (offlineConn) ExecContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	log.Print("[DEBUG] offline, ignoring stmt ", query)
+	return driver.RowsAffected(0), nil
+}
+
+func (offlineConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	log.Print("[DEBUG] offline, no rows for query ", query)
+	return offlineRows{}, nil
+}
+
+type offlineTx struct{}
+
+func (offlineTx) Commit() error {
+	return nil
+}
+
+func (offlineTx) Rollback() error {
+	return nil
+}
+
+type offlineRows struct{}
+
+func (offlineRows) Columns() []string {
+	return nil
+}
+
+func (offlineRows) Close() error {
+	return nil
+}
+
+func (offlineRows) Next(_ []driver.Value) error {
+	return io.EOF
+}
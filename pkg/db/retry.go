@@ -0,0 +1,267 @@
+package db
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"log"
+	"math/rand"
+	"time"
+
+	"github.com/snowflakedb/gosnowflake"
+)
+
+// RetryConfig controls how statements failing with a transient Snowflake error
+// are retried.
+type RetryConfig struct {
+	// MaxAttempts is the total number of times a statement is run, including
+	// the first attempt. Values below 2 disable retries.
+	MaxAttempts int
+	// MinBackoff and MaxBackoff bound the jittered exponential delay between
+	// two attempts.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryConfig is used when the provider does not override any of the
+// retry arguments.
+var DefaultRetryConfig = RetryConfig{
+	MaxAttempts: 3,
+	MinBackoff:  500 * time.Millisecond,
+	MaxBackoff:  10 * time.Second,
+}
+
+// retryableCodes are the Snowflake error numbers that describe a condition
+// expected to clear up on its own.
+var retryableCodes = map[int]bool{
+	gosnowflake.ErrCodeServiceUnavailable: true, // HTTP 503 from the Snowflake endpoint
+	gosnowflake.ErrCodeFailedToConnect:    true,
+	gosnowflake.ErrFailedToPostQuery:      true,
+	630:                                   true, // statement queued for too long and was cancelled
+}
+
+// maybeRunCodes are the retryable errors after which the statement may have
+// reached Snowflake and run. Queries are retried after them, but statements
+// run through ExecContext are not since they may not be idempotent.
+var maybeRunCodes = map[int]bool{
+	gosnowflake.ErrFailedToPostQuery: true,
+}
+
+// sessionGoneCodes are the Snowflake error numbers telling that the session of
+// a connection cannot be used anymore. Running the statement again on the same
+// connection would fail the same way, so the connection is reported as bad
+// and database/sql runs the statement on a new one.
+var sessionGoneCodes = map[int]bool{
+	gosnowflake.ErrFailedToRenewSession: true,
+	gosnowflake.ErrSessionGone:          true,
+	390112:                              true, // session expired
+	390114:                              true, // authentication token expired
+}
+
+func snowflakeErrorNumber(err error) (int, bool) {
+	var sfErr *gosnowflake.SnowflakeError
+	if !errors.As(err, &sfErr) {
+		return 0, false
+	}
+	return sfErr.Number, true
+}
+
+// IsRetryable reports whether err is a transient Snowflake failure that is
+// worth retrying a query after.
+func IsRetryable(err error) bool {
+	n, ok := snowflakeErrorNumber(err)
+	return ok && retryableCodes[n]
+}
+
+// isRetryableExec reports whether err is a transient Snowflake failure after
+// which the statement certainly did not run.
+func isRetryableExec(err error) bool {
+	n, ok := snowflakeErrorNumber(err)
+	return ok && retryableCodes[n] && !maybeRunCodes[n]
+}
+
+// isSessionGone reports whether err tells that the session of the connection
+// cannot be used anymore.
+func isSessionGone(err error) bool {
+	n, ok := snowflakeErrorNumber(err)
+	return ok && sessionGoneCodes[n]
+}
+
+// backoff returns the delay before the given (zero-based) retry, using "full
+// jitter": a random duration between MinBackoff and the exponentially growing
+// cap.
+func (c RetryConfig) backoff(retry int) time.Duration {
+	ceiling := c.MinBackoff << uint(retry)
+	if ceiling <= 0 || ceiling > c.MaxBackoff {
+		ceiling = c.MaxBackoff
+	}
+	if ceiling <= c.MinBackoff {
+		return c.MinBackoff
+	}
+	return c.MinBackoff + time.Duration(rand.Int63n(int64(ceiling-c.MinBackoff)))
+}
+
+// do runs f until it succeeds, fails with an error retryable does not accept,
+// runs out of attempts or ctx is done.
+func (c RetryConfig) do(ctx context.Context, retryable func(error) bool, f func() error) error {
+	err := f()
+	for attempt := 1; attempt < c.MaxAttempts && retryable(err); attempt++ {
+		delay := c.backoff(attempt - 1)
+		log.Printf("[DEBUG] retrying after transient error (attempt %d of %d, waiting %v): %v", attempt+1, c.MaxAttempts, delay, err)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+		err = f()
+	}
+	return err
+}
+
+// retryConnector opens connections through a driver whose statements are
+// retried according to cfg.
+type retryConnector struct {
+	dsn    string
+	driver driver.Driver
+	cfg    RetryConfig
+}
+
+func (c *retryConnector) Connect(_ context.Context) (driver.Conn, error) {
+	conn, err := c.driver.Open(c.dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &retryConn{Conn: conn, cfg: c.cfg}, nil
+}
+
+func (c *retryConnector) Driver() driver.Driver {
+	return c.driver
+}
+
+// retryConn retries ExecContext and QueryContext calls that fail with a
+// transient error. Statements inside a transaction are never retried since the
+// transaction is unlikely to have survived the failure. Once its session is
+// gone, the connection reports driver.ErrBadConn so that database/sql replaces
+// it.
+type retryConn struct {
+	driver.Conn
+	cfg  RetryConfig
+	inTx bool
+	bad  bool
+}
+
+// checkSession marks the connection as bad and returns driver.ErrBadConn if err
+// tells that its session is gone.
+func (c *retryConn) checkSession(err error) error {
+	if !isSessionGone(err) {
+		return err
+	}
+	log.Printf("[DEBUG] discarding connection whose session is gone: %v", err)
+	c.bad = true
+	return driver.ErrBadConn
+}
+
+func (c *retryConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	execer, ok := c.Conn.(driver.ExecerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	if c.inTx {
+		res, err := execer.ExecContext(ctx, query, args)
+		return res, c.checkSession(err)
+	}
+
+	var res driver.Result
+	err := c.cfg.do(ctx, isRetryableExec, func() (err error) {
+		res, err = execer.ExecContext(ctx, query, args)
+		return err
+	})
+	return res, c.checkSession(err)
+}
+
+func (c *retryConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	queryer, ok := c.Conn.(driver.QueryerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	if c.inTx {
+		rows, err := queryer.QueryContext(ctx, query, args)
+		return rows, c.checkSession(err)
+	}
+
+	var rows driver.Rows
+	err := c.cfg.do(ctx, IsRetryable, func() (err error) {
+		rows, err = queryer.QueryContext(ctx, query, args)
+		return err
+	})
+	return rows, c.checkSession(err)
+}
+
+func (c *retryConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	if preparer, ok := c.Conn.(driver.ConnPrepareContext); ok {
+		return preparer.PrepareContext(ctx, query)
+	}
+	return c.Conn.Prepare(query)
+}
+
+func (c *retryConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	var tx driver.Tx
+	var err error
+	if beginner, ok := c.Conn.(driver.ConnBeginTx); ok {
+		tx, err = beginner.BeginTx(ctx, opts)
+	} else {
+		tx, err = c.Conn.Begin() // nolint: staticcheck
+	}
+	if err != nil {
+		return nil, err
+	}
+	c.inTx = true
+	return &retryTx{Tx: tx, conn: c}, nil
+}
+
+func (c *retryConn) Ping(ctx context.Context) error {
+	if pinger, ok := c.Conn.(driver.Pinger); ok {
+		return pinger.Ping(ctx)
+	}
+	return nil
+}
+
+func (c *retryConn) ResetSession(ctx context.Context) error {
+	if c.bad {
+		return driver.ErrBadConn
+	}
+	if resetter, ok := c.Conn.(driver.SessionResetter); ok {
+		return resetter.ResetSession(ctx)
+	}
+	return nil
+}
+
+func (c *retryConn) IsValid() bool {
+	return !c.bad
+}
+
+func (c *retryConn) CheckNamedValue(nv *driver.NamedValue) error {
+	if checker, ok := c.Conn.(driver.NamedValueChecker); ok {
+		return checker.CheckNamedValue(nv)
+	}
+	return driver.ErrSkip
+}
+
+// retryTx re-enables retries on its connection once the transaction is over.
+type retryTx struct {
+	driver.Tx
+	conn *retryConn
+}
+
+func (t *retryTx) Commit() error {
+	t.conn.inTx = false
+	return t.Tx.Commit()
+}
+
+func (t *retryTx) Rollback() error {
+	t.conn.inTx = false
+	return t.Tx.Rollback()
+}
@@ -0,0 +1,183 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/snowflakedb/gosnowflake"
+	"github.com/stretchr/testify/require"
+)
+
+var testRetryConfig = RetryConfig{
+	MaxAttempts: 3,
+	MinBackoff:  time.Millisecond,
+	MaxBackoff:  5 * time.Millisecond,
+}
+
+// withRetryingMockDb opens a retrying *sql.DB on top of a sqlmock connection.
+func withRetryingMockDb(t *testing.T, cfg RetryConfig, f func(*sql.DB, sqlmock.Sqlmock)) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.NewWithDSN(t.Name())
+	r.NoError(err)
+	defer mockDB.Close()
+
+	db := sql.OpenDB(&retryConnector{dsn: t.Name(), driver: mockDB.Driver(), cfg: cfg})
+	defer db.Close()
+
+	f(db, mock)
+	r.NoError(mock.ExpectationsWereMet())
+}
+
+func TestIsRetryable(t *testing.T) {
+	r := require.New(t)
+
+	r.True(IsRetryable(&gosnowflake.SnowflakeError{Number: gosnowflake.ErrCodeServiceUnavailable}))
+	r.True(IsRetryable(&gosnowflake.SnowflakeError{Number: gosnowflake.ErrFailedToPostQuery}))
+	r.True(IsRetryable(&gosnowflake.SnowflakeError{Number: 630}))
+	r.False(IsRetryable(&gosnowflake.SnowflakeError{Number: 390112}))
+	r.False(IsRetryable(&gosnowflake.SnowflakeError{Number: 2003}))
+	r.False(IsRetryable(errors.New("syntax error")))
+	r.False(IsRetryable(nil))
+
+	r.True(isRetryableExec(&gosnowflake.SnowflakeError{Number: 630}))
+	r.False(isRetryableExec(&gosnowflake.SnowflakeError{Number: gosnowflake.ErrFailedToPostQuery}))
+
+	r.True(isSessionGone(&gosnowflake.SnowflakeError{Number: 390112}))
+	r.True(isSessionGone(&gosnowflake.SnowflakeError{Number: gosnowflake.ErrSessionGone}))
+	r.False(isSessionGone(&gosnowflake.SnowflakeError{Number: 630}))
+}
+
+func TestBackoff(t *testing.T) {
+	r := require.New(t)
+	cfg := RetryConfig{MaxAttempts: 10, MinBackoff: 10 * time.Millisecond, MaxBackoff: 50 * time.Millisecond}
+
+	for retry := 0; retry < 10; retry++ {
+		d := cfg.backoff(retry)
+		r.GreaterOrEqual(d, cfg.MinBackoff)
+		r.LessOrEqual(d, cfg.MaxBackoff)
+	}
+}
+
+func TestExecRetriesTransientErrors(t *testing.T) {
+	r := require.New(t)
+
+	withRetryingMockDb(t, testRetryConfig, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER WAREHOUSE "wh" RESUME$`).WillReturnError(&gosnowflake.SnowflakeError{Number: 630})
+		mock.ExpectExec(`^ALTER WAREHOUSE "wh" RESUME$`).WillReturnError(&gosnowflake.SnowflakeError{Number: gosnowflake.ErrCodeServiceUnavailable})
+		mock.ExpectExec(`^ALTER WAREHOUSE "wh" RESUME$`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		_, err := db.Exec(`ALTER WAREHOUSE "wh" RESUME`)
+		r.NoError(err)
+	})
+}
+
+func TestQueryGivesUpAfterMaxAttempts(t *testing.T) {
+	r := require.New(t)
+
+	withRetryingMockDb(t, testRetryConfig, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		for i := 0; i < testRetryConfig.MaxAttempts; i++ {
+			mock.ExpectQuery(`^SHOW GRANTS ON DATABASE "db"$`).WillReturnError(&gosnowflake.SnowflakeError{Number: 630})
+		}
+
+		_, err := db.Query(`SHOW GRANTS ON DATABASE "db"`)
+		var sfErr *gosnowflake.SnowflakeError
+		r.True(errors.As(err, &sfErr))
+		r.Equal(630, sfErr.Number)
+	})
+}
+
+func TestExecDoesNotRetryStatementsThatMayHaveRun(t *testing.T) {
+	r := require.New(t)
+
+	withRetryingMockDb(t, testRetryConfig, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^INSERT INTO "t" VALUES \(1\)$`).WillReturnError(&gosnowflake.SnowflakeError{Number: gosnowflake.ErrFailedToPostQuery})
+
+		_, err := db.Exec(`INSERT INTO "t" VALUES (1)`)
+		var sfErr *gosnowflake.SnowflakeError
+		r.True(errors.As(err, &sfErr))
+		r.Equal(gosnowflake.ErrFailedToPostQuery, sfErr.Number)
+	})
+}
+
+func TestQueryRetriesFailedPosts(t *testing.T) {
+	r := require.New(t)
+
+	withRetryingMockDb(t, testRetryConfig, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(`^SHOW ROLES$`).WillReturnError(&gosnowflake.SnowflakeError{Number: gosnowflake.ErrFailedToPostQuery})
+		mock.ExpectQuery(`^SHOW ROLES$`).WillReturnRows(sqlmock.NewRows([]string{"name"}))
+
+		rows, err := db.Query(`SHOW ROLES`)
+		r.NoError(err)
+		r.NoError(rows.Close())
+	})
+}
+
+func TestSessionGoneReportsBadConnection(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.NewWithDSN(t.Name())
+	r.NoError(err)
+	defer mockDB.Close()
+
+	conn, err := mockDB.Driver().Open(t.Name())
+	r.NoError(err)
+	c := &retryConn{Conn: conn, cfg: testRetryConfig}
+	mock.ExpectExec(`^SELECT 1$`).WillReturnError(&gosnowflake.SnowflakeError{Number: 390112})
+
+	_, err = c.ExecContext(context.Background(), `SELECT 1`, nil)
+	r.Equal(driver.ErrBadConn, err)
+	r.False(c.IsValid())
+	r.Equal(driver.ErrBadConn, c.ResetSession(context.Background()))
+	r.NoError(mock.ExpectationsWereMet())
+}
+
+func TestExecDoesNotRetryPermanentErrors(t *testing.T) {
+	r := require.New(t)
+
+	withRetryingMockDb(t, testRetryConfig, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^DROP ROLE "r"$`).WillReturnError(&gosnowflake.SnowflakeError{Number: 2003})
+
+		_, err := db.Exec(`DROP ROLE "r"`)
+		r.Error(err)
+	})
+}
+
+func TestExecDoesNotRetryInsideTransaction(t *testing.T) {
+	r := require.New(t)
+
+	withRetryingMockDb(t, testRetryConfig, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectBegin()
+		mock.ExpectExec(`^REVOKE USAGE ON DATABASE "db" FROM ROLE "r"$`).WillReturnError(&gosnowflake.SnowflakeError{Number: 630})
+		mock.ExpectRollback()
+		mock.ExpectExec(`^SELECT 1$`).WillReturnError(&gosnowflake.SnowflakeError{Number: 630})
+		mock.ExpectExec(`^SELECT 1$`).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		tx, err := db.Begin()
+		r.NoError(err)
+		_, err = tx.Exec(`REVOKE USAGE ON DATABASE "db" FROM ROLE "r"`)
+		r.Error(err)
+		r.NoError(tx.Rollback())
+
+		// retries are enabled again once the transaction is over
+		_, err = db.Exec(`SELECT 1`)
+		r.NoError(err)
+	})
+}
+
+func TestRetryStopsWhenContextIsDone(t *testing.T) {
+	r := require.New(t)
+	cfg := RetryConfig{MaxAttempts: 5, MinBackoff: time.Hour, MaxBackoff: time.Hour}
+
+	withRetryingMockDb(t, cfg, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^SELECT 1$`).WillReturnError(&gosnowflake.SnowflakeError{Number: 630})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err := db.ExecContext(ctx, `SELECT 1`)
+		r.Error(err)
+	})
+}
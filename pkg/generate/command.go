@@ -0,0 +1,105 @@
+package generate
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/provider"
+	"github.com/pkg/errors"
+)
+
+const usage = `Usage: terraform-provider-snowflake generate [options]
+
+Writes configuration and import blocks for existing objects. The connection is
+configured with the same SNOWFLAKE_* environment variables as the provider.
+Without -account or -database, the whole account is generated.
+
+Options:
+`
+
+type stringsFlag []string
+
+func (s *stringsFlag) String() string {
+	return strings.Join(*s, ",")
+}
+
+func (s *stringsFlag) Set(v string) error {
+	*s = append(*s, v)
+	return nil
+}
+
+// Main runs the generate subcommand with the arguments following it.
+func Main(args []string, stderr io.Writer) error {
+	var databases stringsFlag
+	flags := flag.NewFlagSet("generate", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprint(stderr, usage)
+		flags.PrintDefaults()
+	}
+	account := flags.Bool("account", false, "generate account level objects (warehouses, resource monitors, roles and their grants, users, storage integrations)")
+	flags.Var(&databases, "database", "generate a database and everything in it, can be repeated")
+	out := flags.String("out", ".", "directory the .tf files are written to")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	db, err := provider.Connect(ctx, map[string]interface{}{})
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	g := NewGenerator(db)
+	if !*account && len(databases) == 0 {
+		*account = true
+		databases, err = g.Databases(ctx)
+		if err != nil {
+			return errors.Wrap(err, "error listing databases")
+		}
+	}
+
+	if *account {
+		rs, err := g.Account(ctx)
+		if err != nil {
+			return err
+		}
+		err = writeFile(stderr, filepath.Join(*out, "account.tf"), rs)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, database := range databases {
+		rs, err := g.Database(ctx, database)
+		if err != nil {
+			return err
+		}
+		err = writeFile(stderr, filepath.Join(*out, localName(database)+".tf"), rs)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeFile(stderr io.Writer, path string, rs []Resource) error {
+	var buf bytes.Buffer
+	err := Write(&buf, provider.Provider().ResourcesMap, rs)
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(path, buf.Bytes(), 0644)
+	if err != nil {
+		return errors.Wrapf(err, "error writing %v", path)
+	}
+	fmt.Fprintf(stderr, "wrote %d resources to %v\n", len(rs), path)
+	return nil
+}
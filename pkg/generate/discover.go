@@ -0,0 +1,559 @@
+package generate
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// Generator lists the objects of an account and turns them into resources.
+type Generator struct {
+	db    *sql.DB
+	names map[string]map[string]bool
+}
+
+// NewGenerator returns a Generator listing objects through db.
+func NewGenerator(db *sql.DB) *Generator {
+	return &Generator{
+		db:    db,
+		names: map[string]map[string]bool{},
+	}
+}
+
+// resource builds a Resource, making its local name unique within its type.
+func (g *Generator) resource(resourceType string, nameParts []string, idParts []string, attrs map[string]interface{}) (Resource, error) {
+	id, err := importID(idParts...)
+	if err != nil {
+		return Resource{}, err
+	}
+
+	if g.names[resourceType] == nil {
+		g.names[resourceType] = map[string]bool{}
+	}
+	base := localName(nameParts...)
+	name := base
+	for i := 2; g.names[resourceType][name]; i++ {
+		name = fmt.Sprintf("%v_%d", base, i)
+	}
+	g.names[resourceType][name] = true
+
+	return Resource{
+		Type:       resourceType,
+		Name:       name,
+		ID:         id,
+		Attributes: attrs,
+	}, nil
+}
+
+// atoi returns the integer in s, or nil when s is not a number so that the
+// argument is left out.
+func atoi(s string) interface{} {
+	i, err := strconv.Atoi(s)
+	if err != nil {
+		return nil
+	}
+	return i
+}
+
+// Databases returns the names of the databases that can be generated. Shared
+// databases are skipped since they cannot be managed as snowflake_database.
+func (g *Generator) Databases(ctx context.Context) ([]string, error) {
+	dbs, err := snowflake.ListDatabases(ctx, sqlx.NewDb(g.db, "snowflake").Unsafe())
+	if err != nil {
+		return nil, err
+	}
+	names := []string{}
+	for _, d := range dbs {
+		if d.Origin.String != "" {
+			log.Printf("[DEBUG] skipping shared database %v", d.DBName.String)
+			continue
+		}
+		names = append(names, d.DBName.String)
+	}
+	return names, nil
+}
+
+// systemRoles are the roles every account has, they cannot be managed as
+// snowflake_role.
+var systemRoles = map[string]bool{
+	"ACCOUNTADMIN":  true,
+	"ORGADMIN":      true,
+	"PUBLIC":        true,
+	"SECURITYADMIN": true,
+	"SYSADMIN":      true,
+	"USERADMIN":     true,
+}
+
+// Account returns the account level objects: warehouses, resource monitors,
+// roles along with the roles and users they are granted to, users and storage
+// integrations.
+func (g *Generator) Account(ctx context.Context) ([]Resource, error) {
+	rs := []Resource{}
+
+	warehouses, err := snowflake.ListWarehouses(ctx, g.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing warehouses")
+	}
+	for _, w := range warehouses {
+		attrs := map[string]interface{}{
+			"name":              w.Name,
+			"comment":           w.Comment,
+			"warehouse_size":    strings.ToUpper(w.Size),
+			"auto_suspend":      w.AutoSuspend,
+			"auto_resume":       w.AutoResume,
+			"min_cluster_count": w.MinClusterCount,
+			"max_cluster_count": w.MaxClusterCount,
+			"scaling_policy":    w.ScalingPolicy,
+		}
+		if w.ResourceMonitor != "null" {
+			attrs["resource_monitor"] = w.ResourceMonitor
+		}
+		r, err := g.resource("snowflake_warehouse", []string{w.Name}, []string{w.Name}, attrs)
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, r)
+	}
+
+	monitors, err := snowflake.ListResourceMonitors(ctx, g.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing resource monitors")
+	}
+	for _, m := range monitors {
+		attrs := map[string]interface{}{
+			"name": m.Name.String,
+		}
+		if q, err := strconv.ParseFloat(m.CreditQuota.String, 64); err == nil {
+			attrs["credit_quota"] = int(q)
+		}
+		r, err := g.resource("snowflake_resource_monitor", []string{m.Name.String}, []string{m.Name.String}, attrs)
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, r)
+	}
+
+	roles, err := g.roles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rs = append(rs, roles...)
+
+	users, err := snowflake.ListUsers(ctx, g.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing users")
+	}
+	for _, u := range users {
+		r, err := g.resource("snowflake_user", []string{u.Name.String}, []string{u.Name.String}, map[string]interface{}{
+			"name":              u.Name.String,
+			"login_name":        u.LoginName.String,
+			"display_name":      u.DisplayName.String,
+			"first_name":        u.FirstName.String,
+			"last_name":         u.LastName.String,
+			"email":             u.Email.String,
+			"comment":           u.Comment.String,
+			"disabled":          u.Disabled,
+			"default_warehouse": u.DefaultWarehouse.String,
+			"default_namespace": u.DefaultNamespace.String,
+			"default_role":      u.DefaultRole.String,
+		})
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, r)
+	}
+
+	integrations, err := g.storageIntegrations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rs = append(rs, integrations...)
+
+	return rs, nil
+}
+
+// roles returns the roles that are not system roles, each followed by the
+// grants of the role to other roles and users.
+func (g *Generator) roles(ctx context.Context) ([]Resource, error) {
+	roles, err := snowflake.ListRoles(ctx, g.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing roles")
+	}
+
+	rs := []Resource{}
+	for _, role := range roles {
+		name := role.Name.String
+		if systemRoles[name] {
+			continue
+		}
+		r, err := g.resource("snowflake_role", []string{name}, []string{name}, map[string]interface{}{
+			"name":    name,
+			"comment": role.Comment.String,
+		})
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, r)
+
+		grantees, err := snowflake.ListRoleGrantees(ctx, name, g.db)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error listing grants of role %v", name)
+		}
+		grantedRoles := []string{}
+		grantedUsers := []string{}
+		for _, grantee := range grantees {
+			switch grantee.GrantedTo.String {
+			case "ROLE":
+				grantedRoles = append(grantedRoles, grantee.GranteeName.String)
+			case "USER":
+				grantedUsers = append(grantedUsers, grantee.GranteeName.String)
+			}
+		}
+		if len(grantedRoles) == 0 && len(grantedUsers) == 0 {
+			continue
+		}
+		// role_name||||roles|false, the format of snowflake_role_grants IDs
+		r, err = g.resource("snowflake_role_grants", []string{name}, []string{name, "", "", "", strings.Join(grantedRoles, ","), "false"}, map[string]interface{}{
+			"role_name": name,
+			"roles":     grantedRoles,
+			"users":     grantedUsers,
+		})
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, r)
+	}
+	return rs, nil
+}
+
+// storageIntegrations returns the storage integrations along with the
+// properties only DESCRIBE INTEGRATION returns.
+func (g *Generator) storageIntegrations(ctx context.Context) ([]Resource, error) {
+	integrations, err := snowflake.ListStorageIntegrations(ctx, g.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing storage integrations")
+	}
+
+	rs := []Resource{}
+	for _, i := range integrations {
+		name := i.Name.String
+		props, err := snowflake.DescribeStorageIntegration(ctx, name, g.db)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error describing storage integration %v", name)
+		}
+		attrs := map[string]interface{}{
+			"name":                 name,
+			"type":                 i.IntegrationType.String,
+			"enabled":              i.Enabled.Bool,
+			"comment":              i.Comment.String,
+			"storage_provider":     props["STORAGE_PROVIDER"],
+			"storage_aws_role_arn": props["STORAGE_AWS_ROLE_ARN"],
+			"azure_tenant_id":      props["AZURE_TENANT_ID"],
+		}
+		if l := props["STORAGE_ALLOWED_LOCATIONS"]; l != "" {
+			attrs["storage_allowed_locations"] = strings.Split(l, ",")
+		}
+		if l := props["STORAGE_BLOCKED_LOCATIONS"]; l != "" {
+			attrs["storage_blocked_locations"] = strings.Split(l, ",")
+		}
+		r, err := g.resource("snowflake_storage_integration", []string{name}, []string{name}, attrs)
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, r)
+	}
+	return rs, nil
+}
+
+// Database returns the database along with its schemas, database roles and
+// schema objects.
+func (g *Generator) Database(ctx context.Context, name string) ([]Resource, error) {
+	d, err := snowflake.ListDatabase(ctx, sqlx.NewDb(g.db, "snowflake").Unsafe(), name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error listing database %v", name)
+	}
+	if d == nil || !d.DBName.Valid {
+		return nil, fmt.Errorf("database %v not found", name)
+	}
+
+	rs := []Resource{}
+	r, err := g.resource("snowflake_database", []string{name}, []string{name}, map[string]interface{}{
+		"name":                        name,
+		"comment":                     d.Comment.String,
+		"data_retention_time_in_days": atoi(d.RetentionTime.String),
+	})
+	if err != nil {
+		return nil, err
+	}
+	rs = append(rs, r)
+
+	roles, err := snowflake.ListDatabaseRoles(ctx, name, g.db)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error listing database roles in %v", name)
+	}
+	for _, role := range roles {
+		r, err := g.resource("snowflake_database_role", []string{name, role.Name.String}, []string{name, role.Name.String}, map[string]interface{}{
+			"database": name,
+			"name":     role.Name.String,
+			"comment":  role.Comment.String,
+		})
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, r)
+	}
+
+	schemas, err := snowflake.ListSchemas(ctx, name, g.db)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error listing schemas in %v", name)
+	}
+	for _, s := range schemas {
+		if s.Name.String == "INFORMATION_SCHEMA" {
+			continue
+		}
+		r, err := g.resource("snowflake_schema", []string{name, s.Name.String}, []string{name, s.Name.String}, map[string]interface{}{
+			"database":            name,
+			"name":                s.Name.String,
+			"comment":             s.Comment.String,
+			"is_transient":        strings.Contains(s.Options.String, "TRANSIENT"),
+			"is_managed":          strings.Contains(s.Options.String, "MANAGED ACCESS"),
+			"data_retention_days": atoi(s.RetentionTime.String),
+		})
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, r)
+
+		objects, err := g.Schema(ctx, name, s.Name.String)
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, objects...)
+	}
+
+	return rs, nil
+}
+
+// Schema returns the objects in a schema.
+func (g *Generator) Schema(ctx context.Context, database, schema string) ([]Resource, error) {
+	rs := []Resource{}
+	add := func(resourceType, name string, attrs map[string]interface{}) error {
+		attrs["database"] = database
+		attrs["schema"] = schema
+		attrs["name"] = name
+		r, err := g.resource(resourceType, []string{database, schema, name}, []string{database, schema, name}, attrs)
+		if err != nil {
+			return err
+		}
+		rs = append(rs, r)
+		return nil
+	}
+
+	tables, err := snowflake.ListTables(ctx, database, schema, g.db)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error listing tables in %v.%v", database, schema)
+	}
+	for _, t := range tables {
+		if t.Kind.String != "TABLE" || t.IsExternal.String == "Y" {
+			continue
+		}
+		rows, err := snowflake.Query(g.db, snowflake.Table(t.TableName.String, database, schema).ShowColumns())
+		if err != nil {
+			return nil, errors.Wrapf(err, "error describing table %v", t.TableName.String)
+		}
+		columns, err := snowflake.ScanTableDescription(rows)
+		rows.Close()
+		if err != nil {
+			return nil, errors.Wrapf(err, "error describing table %v", t.TableName.String)
+		}
+		err = add("snowflake_table", t.TableName.String, map[string]interface{}{
+			"comment":             t.Comment.String,
+			"column":              snowflake.NewColumns(columns).Flatten(),
+			"cluster_by":          snowflake.ClusterStatementToList(t.ClusterBy.String),
+			"data_retention_days": t.RetentionTime.Int32,
+			"change_tracking":     t.ChangeTracking.String == "ON",
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	materializedViews, err := snowflake.ListMaterializedViews(ctx, database, schema, g.db)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error listing materialized views in %v.%v", database, schema)
+	}
+	materialized := map[string]bool{}
+	for _, v := range materializedViews {
+		materialized[v.Name.String] = true
+		ddl, err := snowflake.ParseDDL(v.Text.String)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error parsing statement of materialized view %v", v.Name.String)
+		}
+		err = add("snowflake_materialized_view", v.Name.String, map[string]interface{}{
+			"warehouse": v.WarehouseName.String,
+			"comment":   v.Comment.String,
+			"is_secure": v.IsSecure,
+			"statement": ddl.Body,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	views, err := snowflake.ListViews(ctx, database, schema, g.db)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error listing views in %v.%v", database, schema)
+	}
+	for _, v := range views {
+		// SHOW VIEWS includes materialized views
+		if materialized[v.Name.String] {
+			continue
+		}
+		ddl, err := snowflake.ParseDDL(v.Text.String)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error parsing statement of view %v", v.Name.String)
+		}
+		err = add("snowflake_view", v.Name.String, map[string]interface{}{
+			"comment":   v.Comment.String,
+			"is_secure": v.IsSecure,
+			"statement": ddl.Body,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sequences, err := snowflake.ListSequences(ctx, database, schema, g.db)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error listing sequences in %v.%v", database, schema)
+	}
+	for _, s := range sequences {
+		err = add("snowflake_sequence", s.Name.String, map[string]interface{}{
+			"comment":   s.Comment.String,
+			"increment": atoi(s.Increment.String),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	stages, err := snowflake.ListStages(ctx, database, schema, g.db)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error listing stages in %v.%v", database, schema)
+	}
+	for _, s := range stages {
+		attrs := map[string]interface{}{}
+		if s.Comment != nil {
+			attrs["comment"] = *s.Comment
+		}
+		if s.StorageIntegration != nil {
+			attrs["storage_integration"] = *s.StorageIntegration
+		}
+		err = add("snowflake_stage", *s.Name, attrs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	fileFormats, err := snowflake.ListFileFormats(ctx, database, schema, g.db)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error listing file formats in %v.%v", database, schema)
+	}
+	for _, f := range fileFormats {
+		err = add("snowflake_file_format", f.FileFormatName.String, map[string]interface{}{
+			"format_type": f.FormatType.String,
+			"comment":     f.Comment.String,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	pipes, err := snowflake.ListPipes(ctx, database, schema, g.db)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error listing pipes in %v.%v", database, schema)
+	}
+	for _, p := range pipes {
+		err = add("snowflake_pipe", p.Name, map[string]interface{}{
+			"copy_statement":    p.Definition,
+			"comment":           p.Comment,
+			"integration":       p.Integration.String,
+			"error_integration": p.ErrorIntegration.String,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	streams, err := snowflake.ListStreams(ctx, database, schema, g.db)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error listing streams in %v.%v", database, schema)
+	}
+	for _, s := range streams {
+		if s.TableName.String == "" {
+			continue
+		}
+		err = add("snowflake_stream", s.StreamName.String, map[string]interface{}{
+			"on_table":    s.TableName.String,
+			"comment":     s.Comment.String,
+			"append_only": s.Mode.String == "APPEND_ONLY",
+			"insert_only": s.Mode.String == "INSERT_ONLY",
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tasks, err := snowflake.ListTasks(ctx, database, schema, g.db)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error listing tasks in %v.%v", database, schema)
+	}
+	for i := range tasks {
+		t := &tasks[i]
+		attrs := map[string]interface{}{
+			"enabled":       t.IsEnabled(),
+			"sql_statement": t.Definition,
+		}
+		if t.Warehouse != nil {
+			attrs["warehouse"] = *t.Warehouse
+		}
+		if t.Schedule != nil {
+			attrs["schedule"] = *t.Schedule
+		}
+		if t.Predecessors != nil {
+			attrs["after"] = t.GetPredecessorName()
+		}
+		if t.Condition != nil {
+			attrs["when"] = *t.Condition
+		}
+		if t.Comment != nil {
+			attrs["comment"] = *t.Comment
+		}
+		err = add("snowflake_task", t.Name, attrs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tags, err := snowflake.ListTags(ctx, database, schema, g.db)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error listing tags in %v.%v", database, schema)
+	}
+	for _, t := range tags {
+		err = add("snowflake_tag", t.Name.String, map[string]interface{}{
+			"comment": t.Comment.String,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rs, nil
+}
@@ -0,0 +1,105 @@
+package generate_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/generate"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratorSchema(t *testing.T) {
+	r := require.New(t)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		empty := func() *sqlmock.Rows { return sqlmock.NewRows([]string{"name"}) }
+
+		tables := sqlmock.NewRows([]string{
+			"created_on", "name", "database_name", "schema_name", "kind", "comment", "cluster_by", "row", "bytes", "owner", "retention_time", "automatic_clustering", "change_tracking", "is_external",
+		}).AddRow(
+			"", "events", "db", "PUBLIC", "TABLE", "", "", "0", "0", "SYSADMIN", 1, "OFF", "OFF", "N",
+		).AddRow(
+			"", "scratch", "db", "PUBLIC", "TEMPORARY", "", "", "0", "0", "SYSADMIN", 1, "OFF", "OFF", "N",
+		)
+		mock.ExpectQuery(`^SHOW TABLES IN SCHEMA "db"."PUBLIC"$`).WillReturnRows(tables)
+		columns := sqlmock.NewRows([]string{"name", "type", "kind", "null?", "default", "comment"}).
+			AddRow("id", "NUMBER(38,0)", "COLUMN", "N", nil, nil)
+		mock.ExpectQuery(`^DESC TABLE "db"."PUBLIC"."events"$`).WillReturnRows(columns)
+		mock.ExpectQuery(`^SHOW MATERIALIZED VIEWS IN SCHEMA "db"."PUBLIC"$`).WillReturnRows(empty())
+		views := sqlmock.NewRows([]string{"name", "database_name", "schema_name", "comment", "is_secure", "text"}).
+			AddRow("recent", "db", "PUBLIC", "", false, "CREATE VIEW recent AS SELECT * FROM events")
+		mock.ExpectQuery(`^SHOW VIEWS IN SCHEMA "db"."PUBLIC"$`).WillReturnRows(views)
+		mock.ExpectQuery(`^SHOW SEQUENCES IN SCHEMA "db"."PUBLIC"$`).WillReturnRows(empty())
+		mock.ExpectQuery(`^SHOW STAGES IN SCHEMA "db"."PUBLIC"$`).WillReturnRows(empty())
+		mock.ExpectQuery(`^SHOW FILE FORMATS IN SCHEMA "db"."PUBLIC"$`).WillReturnRows(empty())
+		mock.ExpectQuery(`^SHOW PIPES IN SCHEMA "db"."PUBLIC"$`).WillReturnRows(empty())
+		mock.ExpectQuery(`^SHOW STREAMS IN SCHEMA "db"."PUBLIC"$`).WillReturnRows(empty())
+		mock.ExpectQuery(`^SHOW TASKS IN SCHEMA "db"."PUBLIC"$`).WillReturnRows(empty())
+		mock.ExpectQuery(`^SHOW TAGS IN SCHEMA "db"."PUBLIC"$`).WillReturnRows(empty())
+
+		rs, err := generate.NewGenerator(db).Schema(context.Background(), "db", "PUBLIC")
+		r.NoError(err)
+		r.Len(rs, 2)
+
+		r.Equal("snowflake_table", rs[0].Type)
+		r.Equal("db_public_events", rs[0].Name)
+		r.Equal("db|PUBLIC|events", rs[0].ID)
+		r.Len(rs[0].Attributes["column"], 1)
+
+		r.Equal("snowflake_view", rs[1].Type)
+		r.Equal("db|PUBLIC|recent", rs[1].ID)
+		r.Equal("SELECT * FROM events", rs[1].Attributes["statement"])
+	})
+}
+
+func TestGeneratorAccount(t *testing.T) {
+	r := require.New(t)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		empty := func() *sqlmock.Rows { return sqlmock.NewRows([]string{"name"}) }
+
+		mock.ExpectQuery(`^SHOW WAREHOUSES$`).WillReturnRows(empty())
+		mock.ExpectQuery(`^SHOW RESOURCE MONITORS$`).WillReturnRows(empty())
+		roles := sqlmock.NewRows([]string{"name", "comment"}).
+			AddRow("SYSADMIN", "").
+			AddRow("analyst", "reads reports")
+		mock.ExpectQuery(`^SHOW ROLES$`).WillReturnRows(roles)
+		grantees := sqlmock.NewRows([]string{"created_on", "role", "granted_to", "grantee_name", "granted_by"}).
+			AddRow("", "analyst", "ROLE", "SYSADMIN", "").
+			AddRow("", "analyst", "USER", "jane", "")
+		mock.ExpectQuery(`^SHOW GRANTS OF ROLE "analyst"$`).WillReturnRows(grantees)
+		users := sqlmock.NewRows([]string{"name", "login_name", "disabled", "has_rsa_public_key"}).
+			AddRow("jane", "JANE", false, false)
+		mock.ExpectQuery(`^SHOW USERS$`).WillReturnRows(users)
+		integrations := sqlmock.NewRows([]string{"name", "type", "category", "enabled", "comment", "created_on"}).
+			AddRow("s3", "EXTERNAL_STAGE", "STORAGE", true, "", "")
+		mock.ExpectQuery(`^SHOW STORAGE INTEGRATIONS$`).WillReturnRows(integrations)
+		props := sqlmock.NewRows([]string{"property", "property_type", "property_value", "property_default"}).
+			AddRow("STORAGE_PROVIDER", "String", "S3", "").
+			AddRow("STORAGE_ALLOWED_LOCATIONS", "List", "s3://a/,s3://b/", "[]").
+			AddRow("STORAGE_AWS_ROLE_ARN", "String", "arn:aws:iam::000000000000:role/snowflake", "")
+		mock.ExpectQuery(`^DESCRIBE STORAGE INTEGRATION "s3"$`).WillReturnRows(props)
+
+		rs, err := generate.NewGenerator(db).Account(context.Background())
+		r.NoError(err)
+		r.Len(rs, 4)
+
+		r.Equal("snowflake_role", rs[0].Type)
+		r.Equal("analyst", rs[0].ID)
+
+		r.Equal("snowflake_role_grants", rs[1].Type)
+		r.Equal("analyst||||SYSADMIN|false", rs[1].ID)
+		r.Equal([]string{"SYSADMIN"}, rs[1].Attributes["roles"])
+		r.Equal([]string{"jane"}, rs[1].Attributes["users"])
+
+		r.Equal("snowflake_user", rs[2].Type)
+		r.Equal("JANE", rs[2].Attributes["login_name"])
+
+		r.Equal("snowflake_storage_integration", rs[3].Type)
+		r.Equal("S3", rs[3].Attributes["storage_provider"])
+		r.Equal([]string{"s3://a/", "s3://b/"}, rs[3].Attributes["storage_allowed_locations"])
+	})
+}
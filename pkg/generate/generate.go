@@ -0,0 +1,185 @@
+// Package generate writes Terraform configuration for objects that already
+// exist in a Snowflake account, together with the import blocks that bring
+// them under management.
+package generate
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// Resource is an existing object rendered as a resource block and an import
+// block.
+type Resource struct {
+	// Type is the resource type, e.g. snowflake_table
+	Type string
+	// Name is the local name of the resource in the configuration
+	Name string
+	// ID is the ID the resource is imported with
+	ID string
+	// Attributes holds the arguments of the resource block, in the shape
+	// ResourceData.Set accepts them
+	Attributes map[string]interface{}
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// localName turns the identifiers of an object into a valid Terraform name.
+func localName(parts ...string) string {
+	name := invalidNameChars.ReplaceAllString(strings.ToLower(strings.Join(parts, "_")), "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') || name[0] == '-' {
+		name = "_" + name
+	}
+	return name
+}
+
+// importID joins parts the way the resources write their pipe-delimited IDs.
+func importID(parts ...string) (string, error) {
+	var buf bytes.Buffer
+	csvWriter := csv.NewWriter(&buf)
+	csvWriter.Comma = '|'
+	err := csvWriter.WriteAll([][]string{parts})
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// Write renders the resource blocks followed by the import blocks of rs.
+// Schemas are looked up in resources to tell nested blocks from arguments.
+func Write(w io.Writer, resources map[string]*schema.Resource, rs []Resource) error {
+	f := hclwrite.NewEmptyFile()
+	body := f.Body()
+
+	for _, r := range rs {
+		res, ok := resources[r.Type]
+		if !ok {
+			return fmt.Errorf("unknown resource type %v", r.Type)
+		}
+		block := body.AppendNewBlock("resource", []string{r.Type, r.Name})
+		err := writeBody(block.Body(), res.Schema, r.Attributes)
+		if err != nil {
+			return errors.Wrapf(err, "error rendering %v.%v", r.Type, r.Name)
+		}
+		body.AppendNewline()
+	}
+
+	for _, r := range rs {
+		block := body.AppendNewBlock("import", nil)
+		block.Body().SetAttributeTraversal("to", hcl.Traversal{
+			hcl.TraverseRoot{Name: r.Type},
+			hcl.TraverseAttr{Name: r.Name},
+		})
+		block.Body().SetAttributeValue("id", cty.StringVal(r.ID))
+		body.AppendNewline()
+	}
+
+	_, err := w.Write(hclwrite.Format(f.Bytes()))
+	return err
+}
+
+// leadingKeys are written first so that blocks read like the examples.
+var leadingKeys = []string{"name", "database", "schema"}
+
+func sortedKeys(attrs map[string]interface{}) []string {
+	rank := func(k string) int {
+		for i, l := range leadingKeys {
+			if k == l {
+				return i
+			}
+		}
+		return len(leadingKeys)
+	}
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if rank(keys[i]) != rank(keys[j]) {
+			return rank(keys[i]) < rank(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+	return keys
+}
+
+func writeBody(body *hclwrite.Body, s map[string]*schema.Schema, attrs map[string]interface{}) error {
+	var blocks []string
+	for _, k := range sortedKeys(attrs) {
+		sch, ok := s[k]
+		if !ok {
+			return fmt.Errorf("unknown argument %v", k)
+		}
+		if _, ok := sch.Elem.(*schema.Resource); ok {
+			blocks = append(blocks, k)
+			continue
+		}
+		v, ok, err := ctyValue(attrs[k])
+		if err != nil {
+			return errors.Wrapf(err, "error rendering argument %v", k)
+		}
+		if ok {
+			body.SetAttributeValue(k, v)
+		}
+	}
+
+	for _, k := range blocks {
+		items, ok := attrs[k].([]interface{})
+		if !ok {
+			return fmt.Errorf("block %v must be a list", k)
+		}
+		for _, item := range items {
+			nested, ok := item.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("block %v must be a list of maps", k)
+			}
+			block := body.AppendNewBlock(k, nil)
+			err := writeBody(block.Body(), s[k].Elem.(*schema.Resource).Schema, nested)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ctyValue converts a value to cty. Empty strings and lists are reported as
+// not set so that defaults are left out of the configuration.
+func ctyValue(v interface{}) (cty.Value, bool, error) {
+	switch v := v.(type) {
+	case nil:
+		return cty.NilVal, false, nil
+	case string:
+		return cty.StringVal(v), v != "", nil
+	case bool:
+		return cty.BoolVal(v), true, nil
+	case int:
+		return cty.NumberIntVal(int64(v)), true, nil
+	case int32:
+		return cty.NumberIntVal(int64(v)), true, nil
+	case int64:
+		return cty.NumberIntVal(v), true, nil
+	case []string:
+		if len(v) == 0 {
+			return cty.NilVal, false, nil
+		}
+		vals := make([]cty.Value, 0, len(v))
+		for _, s := range v {
+			vals = append(vals, cty.StringVal(s))
+		}
+		return cty.ListVal(vals), true, nil
+	default:
+		return cty.NilVal, false, fmt.Errorf("unsupported value %#v", v)
+	}
+}
@@ -0,0 +1,28 @@
+package generate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalName(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal("db_public_my_table", localName("DB", "PUBLIC", "MY_TABLE"))
+	r.Equal("db_my_table_", localName("db", "my table!"))
+	r.Equal("_1db", localName("1db"))
+	r.Equal("_", localName(""))
+}
+
+func TestImportID(t *testing.T) {
+	r := require.New(t)
+
+	id, err := importID("db", "schema", "table")
+	r.NoError(err)
+	r.Equal("db|schema|table", id)
+
+	id, err = importID("db", `we"ird|name`)
+	r.NoError(err)
+	r.Equal(`db|"we""ird|name"`, id)
+}
@@ -0,0 +1,74 @@
+package generate_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/generate"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/provider"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrite(t *testing.T) {
+	r := require.New(t)
+
+	var buf bytes.Buffer
+	err := generate.Write(&buf, provider.Provider().ResourcesMap, []generate.Resource{
+		{
+			Type: "snowflake_table",
+			Name: "db_public_events",
+			ID:   "db|PUBLIC|events",
+			Attributes: map[string]interface{}{
+				"database":        "db",
+				"schema":          "PUBLIC",
+				"name":            "events",
+				"comment":         "",
+				"change_tracking": false,
+				"cluster_by":      []string{"id"},
+				"column": []interface{}{
+					map[string]interface{}{
+						"name":     "id",
+						"type":     "NUMBER(38,0)",
+						"nullable": false,
+						"comment":  "",
+					},
+				},
+			},
+		},
+	})
+	r.NoError(err)
+	r.Equal(`resource "snowflake_table" "db_public_events" {
+  name            = "events"
+  database        = "db"
+  schema          = "PUBLIC"
+  change_tracking = false
+  cluster_by      = ["id"]
+  column {
+    name     = "id"
+    nullable = false
+    type     = "NUMBER(38,0)"
+  }
+}
+
+import {
+  to = snowflake_table.db_public_events
+  id = "db|PUBLIC|events"
+}
+
+`, buf.String())
+}
+
+func TestWriteUnknownArgument(t *testing.T) {
+	r := require.New(t)
+
+	var buf bytes.Buffer
+	err := generate.Write(&buf, provider.Provider().ResourcesMap, []generate.Resource{
+		{
+			Type:       "snowflake_database",
+			Name:       "db",
+			ID:         "db",
+			Attributes: map[string]interface{}{"owner": "SYSADMIN"},
+		},
+	})
+	r.EqualError(err, "error rendering snowflake_database.db: unknown argument owner")
+}
@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pkg/errors"
+)
+
+// Connect opens a connection the way the provider does, from the provider
+// arguments in config and the SNOWFLAKE_* environment variables for the
+// arguments left out. It is used by the subcommands of the provider binary.
+func Connect(ctx context.Context, config map[string]interface{}) (*sql.DB, error) {
+	p := Provider()
+	c := terraform.NewResourceConfigRaw(config)
+	if diags := p.Validate(c); diags.HasError() {
+		return nil, diagsError(diags)
+	}
+	if diags := p.Configure(ctx, c); diags.HasError() {
+		return nil, diagsError(diags)
+	}
+	return p.Meta().(*sql.DB), nil
+}
+
+func diagsError(diags diag.Diagnostics) error {
+	msgs := []string{}
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			msgs = append(msgs, d.Summary)
+		}
+	}
+	return errors.New(strings.Join(msgs, "; "))
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/db"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/resources"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	homedir "github.com/mitchellh/go-homedir"
 	"github.com/pkg/errors"
 	"github.com/snowflakedb/gosnowflake"
@@ -22,6 +23,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // Provider is a provider
@@ -131,6 +133,27 @@ func Provider() *schema.Provider {
 				Required:    true,
 				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_REGION", "us-west-2"),
 			},
+			"retry_max_attempts": {
+				Type:         schema.TypeInt,
+				Description:  "Number of times a statement failing with a transient error (service unavailable, queued too long) is run before giving up. Statements that change objects are only retried when they certainly did not run. Set to 1 to disable retries.",
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SNOWFLAKE_RETRY_MAX_ATTEMPTS", db.DefaultRetryConfig.MaxAttempts),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"retry_min_backoff_ms": {
+				Type:         schema.TypeInt,
+				Description:  "Minimum delay in milliseconds before retrying a statement.",
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SNOWFLAKE_RETRY_MIN_BACKOFF_MS", int(db.DefaultRetryConfig.MinBackoff/time.Millisecond)),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"retry_max_backoff_ms": {
+				Type:         schema.TypeInt,
+				Description:  "Maximum delay in milliseconds before retrying a statement, at least retry_min_backoff_ms. Delays grow exponentially with random jitter up to this value.",
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SNOWFLAKE_RETRY_MAX_BACKOFF_MS", int(db.DefaultRetryConfig.MaxBackoff/time.Millisecond)),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 		},
 		ResourcesMap:   getResources(),
 		DataSourcesMap: getDataSources(),
@@ -202,6 +225,32 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_user_public_keys":           resources.UserPublicKeys(),
 		"snowflake_view":                       resources.View(),
 		"snowflake_warehouse":                  resources.Warehouse(),
+
+		"snowflake_account":                          resources.Account(),
+		"snowflake_account_parameter":                resources.AccountParameter(),
+		"snowflake_alert":                            resources.Alert(),
+		"snowflake_authentication_policy":            resources.AuthenticationPolicy(),
+		"snowflake_authentication_policy_attachment": resources.AuthenticationPolicyAttachment(),
+		"snowflake_database_refresh":                 resources.DatabaseRefresh(),
+		"snowflake_database_role":                    resources.DatabaseRole(),
+		"snowflake_database_role_grants":             resources.DatabaseRoleGrants(),
+		"snowflake_dynamic_table":                    resources.DynamicTable(),
+		"snowflake_external_access_integration":      resources.ExternalAccessIntegration(),
+		"snowflake_failover_group":                   resources.FailoverGroup(),
+		"snowflake_masking_policy_attachment":        resources.MaskingPolicyAttachment(),
+		"snowflake_network_rule":                     resources.NetworkRule(),
+		"snowflake_object_grants":                    resources.ObjectGrants(),
+		"snowflake_object_parameter":                 resources.ObjectParameter(),
+		"snowflake_ownership":                        resources.Ownership(),
+		"snowflake_password_policy":                  resources.PasswordPolicy(),
+		"snowflake_password_policy_attachment":       resources.PasswordPolicyAttachment(),
+		"snowflake_replication_group":                resources.ReplicationGroup(),
+		"snowflake_row_access_policy_attachment":     resources.RowAccessPolicyAttachment(),
+		"snowflake_secret":                           resources.Secret(),
+		"snowflake_session_policy":                   resources.SessionPolicy(),
+		"snowflake_session_policy_attachment":        resources.SessionPolicyAttachment(),
+		"snowflake_tag_association":                  resources.TagAssociation(),
+		"snowflake_tag_masking_policy_association":   resources.TagMaskingPolicyAssociation(),
 	}
 
 	return mergeSchemas(
@@ -212,6 +261,7 @@ func getResources() map[string]*schema.Resource {
 
 func getDataSources() map[string]*schema.Resource {
 	dataSources := map[string]*schema.Resource{
+		"snowflake_alerts":                             datasources.Alerts(),
 		"snowflake_current_account":                    datasources.CurrentAccount(),
 		"snowflake_system_generate_scim_access_token":  datasources.SystemGenerateSCIMAccessToken(),
 		"snowflake_system_get_aws_sns_iam_policy":      datasources.SystemGetAWSSNSIAMPolicy(),
@@ -226,7 +276,9 @@ func getDataSources() map[string]*schema.Resource {
 		"snowflake_sequences":                          datasources.Sequences(),
 		"snowflake_streams":                            datasources.Streams(),
 		"snowflake_tasks":                              datasources.Tasks(),
+		"snowflake_organization_accounts":              datasources.OrganizationAccounts(),
 		"snowflake_pipes":                              datasources.Pipes(),
+		"snowflake_parameters":                         datasources.Parameters(),
 		"snowflake_masking_policies":                   datasources.MaskingPolicies(),
 		"snowflake_external_functions":                 datasources.ExternalFunctions(),
 		"snowflake_external_tables":                    datasources.ExternalTables(),
@@ -238,6 +290,7 @@ func getDataSources() map[string]*schema.Resource {
 		"snowflake_procedures":                         datasources.Procedures(),
 		"snowflake_databases":                          datasources.Databases(),
 		"snowflake_database":                           datasources.Database(),
+		"snowflake_dynamic_tables":                     datasources.DynamicTables(),
 	}
 
 	return dataSources
@@ -259,6 +312,14 @@ func ConfigureProvider(s *schema.ResourceData) (interface{}, error) {
 	oauthClientSecret := s.Get("oauth_client_secret").(string)
 	oauthEndpoint := s.Get("oauth_endpoint").(string)
 	oauthRedirectURL := s.Get("oauth_redirect_url").(string)
+	retry := db.RetryConfig{
+		MaxAttempts: s.Get("retry_max_attempts").(int),
+		MinBackoff:  time.Duration(s.Get("retry_min_backoff_ms").(int)) * time.Millisecond,
+		MaxBackoff:  time.Duration(s.Get("retry_max_backoff_ms").(int)) * time.Millisecond,
+	}
+	if retry.MinBackoff > retry.MaxBackoff {
+		return nil, errors.Errorf("retry_min_backoff_ms (%v) must not be greater than retry_max_backoff_ms (%v)", s.Get("retry_min_backoff_ms"), s.Get("retry_max_backoff_ms"))
+	}
 
 	if oauthRefreshToken != "" {
 		accessToken, err := GetOauthAccessToken(oauthEndpoint, oauthClientID, oauthClientSecret, GetOauthData(oauthRefreshToken, oauthRedirectURL))
@@ -284,7 +345,7 @@ func ConfigureProvider(s *schema.ResourceData) (interface{}, error) {
 		return nil, errors.Wrap(err, "could not build dsn for snowflake connection")
 	}
 
-	db, err := db.Open(dsn)
+	db, err := db.Open(dsn, retry)
 	if err != nil {
 		return nil, errors.Wrap(err, "Could not open snowflake database.")
 	}
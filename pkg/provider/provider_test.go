@@ -12,6 +12,7 @@ import (
 	"testing"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/provider"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	_ "github.com/snowflakedb/gosnowflake"
 	"github.com/stretchr/testify/require"
 )
@@ -22,9 +23,30 @@ func TestProvider(t *testing.T) {
 	r.NoError(err)
 }
 
-// func TestConfigureProvider(t *testing.T) {
-// 	// r := require.New(t)
-// }
+func TestProviderResourcesVersioned(t *testing.T) {
+	for name, res := range provider.Provider().ResourcesMap {
+		t.Run(name, func(t *testing.T) {
+			r := require.New(t)
+			r.GreaterOrEqual(res.SchemaVersion, 1)
+			r.Len(res.StateUpgraders, res.SchemaVersion)
+		})
+	}
+}
+
+func TestConfigureProviderRejectsInvertedBackoff(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, provider.Provider().Schema, map[string]interface{}{
+		"account":              "acct",
+		"username":             "user",
+		"password":             "pass",
+		"region":               "us-west-2",
+		"retry_min_backoff_ms": 2000,
+		"retry_max_backoff_ms": 1000,
+	})
+
+	_, err := provider.ConfigureProvider(d)
+	r.EqualError(err, "retry_min_backoff_ms (2000) must not be greater than retry_max_backoff_ms (1000)")
+}
 
 func TestDSN(t *testing.T) {
 	type args struct {
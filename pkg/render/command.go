@@ -0,0 +1,76 @@
+package render
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/db"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/provider"
+	"github.com/pkg/errors"
+)
+
+const usage = `Usage: terraform-provider-snowflake render [options] PLAN_JSON
+
+Prints the statements each change of a plan would run, as a SQL script.
+PLAN_JSON is the output of "terraform show -json PLAN_FILE", or - to read it
+from standard input.
+
+The connection is configured with the same SNOWFLAKE_* environment variables as
+the provider and is put in dry-run mode: queries run so that resources see the
+existing objects, statements changing objects are only recorded. With -offline,
+no connection is made and resources behave as if the account were empty.
+
+Options:
+`
+
+// Main runs the render subcommand with the arguments following it.
+func Main(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	flags := flag.NewFlagSet("render", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprint(stderr, usage)
+		flags.PrintDefaults()
+	}
+	offline := flags.Bool("offline", false, "do not connect to Snowflake")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return errors.New("expected the path of a JSON plan")
+	}
+
+	var raw []byte
+	var err error
+	if flags.Arg(0) == "-" {
+		raw, err = ioutil.ReadAll(stdin)
+	} else {
+		raw, err = ioutil.ReadFile(flags.Arg(0))
+	}
+	if err != nil {
+		return errors.Wrap(err, "error reading plan")
+	}
+	plan := &Plan{}
+	if err = json.Unmarshal(raw, plan); err != nil {
+		return errors.Wrap(err, "error parsing plan, expected the output of terraform show -json")
+	}
+
+	ctx := context.Background()
+	var sdb *sql.DB
+	if *offline {
+		sdb = db.OpenOffline()
+	} else {
+		sdb, err = provider.Connect(ctx, map[string]interface{}{})
+		if err != nil {
+			return err
+		}
+	}
+	defer sdb.Close()
+
+	return NewRenderer(provider.Provider().ResourcesMap, sdb).Render(ctx, stdout, plan)
+}
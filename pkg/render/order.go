@@ -0,0 +1,339 @@
+package render
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Configuration is the subset of the configuration of a plan read to order
+// the changes: the references of every resource, module call and output.
+type Configuration struct {
+	RootModule ConfigModule `json:"root_module"`
+}
+
+// ConfigModule is the configuration of the root module or of a module call.
+type ConfigModule struct {
+	Resources   []ConfigResource        `json:"resources"`
+	ModuleCalls map[string]ModuleCall   `json:"module_calls"`
+	Outputs     map[string]ConfigOutput `json:"outputs"`
+}
+
+// ConfigResource is a resource block, with its address relative to its module.
+type ConfigResource struct {
+	Address           string          `json:"address"`
+	Expressions       json.RawMessage `json:"expressions"`
+	CountExpression   json.RawMessage `json:"count_expression"`
+	ForEachExpression json.RawMessage `json:"for_each_expression"`
+	DependsOn         []string        `json:"depends_on"`
+}
+
+// ModuleCall is a module block, its expressions setting the variables of the
+// module.
+type ModuleCall struct {
+	Expressions       map[string]json.RawMessage `json:"expressions"`
+	CountExpression   json.RawMessage            `json:"count_expression"`
+	ForEachExpression json.RawMessage            `json:"for_each_expression"`
+	DependsOn         []string                   `json:"depends_on"`
+	Module            ConfigModule               `json:"module"`
+}
+
+// ConfigOutput is an output block of a module.
+type ConfigOutput struct {
+	Expression json.RawMessage `json:"expression"`
+	DependsOn  []string        `json:"depends_on"`
+}
+
+// State is the subset of the prior state of a plan read to order the changes:
+// the dependencies recorded for resources, which are all that is left of
+// resources removed from the configuration.
+type State struct {
+	Values struct {
+		RootModule StateModule `json:"root_module"`
+	} `json:"values"`
+}
+
+// StateModule holds the resources of a module in the state.
+type StateModule struct {
+	Resources    []StateResource `json:"resources"`
+	ChildModules []StateModule   `json:"child_modules"`
+}
+
+// StateResource is a resource instance in the state.
+type StateResource struct {
+	Address   string   `json:"address"`
+	DependsOn []string `json:"depends_on"`
+}
+
+// dependencyGraph links the resources, variables, outputs and module calls of
+// a plan, each named by its absolute address without instance keys, to what
+// they reference.
+type dependencyGraph map[string]map[string]bool
+
+func (g dependencyGraph) add(node string, deps ...string) {
+	if g[node] == nil {
+		g[node] = map[string]bool{}
+	}
+	for _, dep := range deps {
+		if dep != "" && dep != node {
+			g[node][dep] = true
+		}
+	}
+}
+
+// reachable returns every node node depends on, directly or not.
+func (g dependencyGraph) reachable(node string) map[string]bool {
+	seen := map[string]bool{}
+	var visit func(n string)
+	visit = func(n string) {
+		for dep := range g[n] {
+			if !seen[dep] {
+				seen[dep] = true
+				visit(dep)
+			}
+		}
+	}
+	visit(node)
+	return seen
+}
+
+// newDependencyGraph builds the graph of the configuration and prior state of
+// plan. References through locals are not part of the plan, so they are not
+// followed.
+func newDependencyGraph(plan *Plan) dependencyGraph {
+	g := dependencyGraph{}
+	if plan.Configuration != nil {
+		g.addModule("", "", plan.Configuration.RootModule)
+	}
+	if plan.PriorState != nil {
+		g.addStateModule(plan.PriorState.Values.RootModule)
+	}
+	return g
+}
+
+// addModule adds the module at path, e.g. "module.a.", called from a module
+// whose call node is parentCall.
+func (g dependencyGraph) addModule(path, parentCall string, m ConfigModule) {
+	call := path + "call"
+	g.add(call, parentCall)
+
+	for _, res := range m.Resources {
+		node := path + stripKeys(res.Address)
+		g.add(node, call)
+		refs := append(references(res.Expressions), references(res.CountExpression)...)
+		refs = append(refs, references(res.ForEachExpression)...)
+		g.add(node, resolveReferences(path, m, append(refs, res.DependsOn...))...)
+	}
+	for name, out := range m.Outputs {
+		g.add(path+"output."+name, resolveReferences(path, m, append(references(out.Expression), out.DependsOn...))...)
+	}
+	for name, mc := range m.ModuleCalls {
+		childPath := path + "module." + name + "."
+		refs := append(references(mc.CountExpression), references(mc.ForEachExpression)...)
+		g.add(childPath+"call", resolveReferences(path, m, append(refs, mc.DependsOn...))...)
+		for v, expr := range mc.Expressions {
+			g.add(childPath+"var."+v, resolveReferences(path, m, references(expr))...)
+		}
+		g.addModule(childPath, call, mc.Module)
+	}
+}
+
+func (g dependencyGraph) addStateModule(m StateModule) {
+	for _, res := range m.Resources {
+		node := stripKeys(res.Address)
+		for _, dep := range res.DependsOn {
+			g.add(node, stripKeys(dep))
+		}
+	}
+	for _, child := range m.ChildModules {
+		g.addStateModule(child)
+	}
+}
+
+// references collects the references of an expression, or of the nested
+// expressions of a block.
+func references(raw json.RawMessage) []string {
+	if len(raw) == 0 {
+		return nil
+	}
+	var v interface{}
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil
+	}
+
+	refs := []string{}
+	var walk func(v interface{})
+	walk = func(v interface{}) {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			for k, e := range v {
+				if list, ok := e.([]interface{}); ok && k == "references" {
+					for _, ref := range list {
+						if s, ok := ref.(string); ok {
+							refs = append(refs, s)
+						}
+					}
+					continue
+				}
+				walk(e)
+			}
+		case []interface{}:
+			for _, e := range v {
+				walk(e)
+			}
+		}
+	}
+	walk(v)
+	return refs
+}
+
+// resolveReferences returns the nodes the references made in the module at
+// path point to. A reference to a whole module call is only followed when no
+// output of it is referenced.
+func resolveReferences(path string, m ConfigModule, refs []string) []string {
+	outputsReferenced := map[string]bool{}
+	for _, ref := range refs {
+		parts := strings.Split(stripKeys(ref), ".")
+		if parts[0] == "module" && len(parts) > 2 {
+			outputsReferenced[parts[1]] = true
+		}
+	}
+
+	nodes := []string{}
+	for _, ref := range refs {
+		parts := strings.Split(stripKeys(ref), ".")
+		if len(parts) < 2 {
+			continue
+		}
+		switch parts[0] {
+		case "var":
+			nodes = append(nodes, path+"var."+parts[1])
+		case "module":
+			if len(parts) > 2 {
+				nodes = append(nodes, path+"module."+parts[1]+".output."+parts[2])
+			} else if !outputsReferenced[parts[1]] {
+				for name := range m.ModuleCalls[parts[1]].Module.Outputs {
+					nodes = append(nodes, path+"module."+parts[1]+".output."+name)
+				}
+			}
+		case "data":
+			if len(parts) > 2 {
+				nodes = append(nodes, path+"data."+parts[1]+"."+parts[2])
+			}
+		case "local", "each", "count", "path", "terraform", "self":
+		default:
+			nodes = append(nodes, path+parts[0]+"."+parts[1])
+		}
+	}
+	return nodes
+}
+
+// stripKeys removes the instance keys from an address or reference, e.g.
+// module.a["x"].snowflake_role.r[0] becomes module.a.snowflake_role.r.
+func stripKeys(addr string) string {
+	b := strings.Builder{}
+	depth := 0
+	quoted := false
+	for i := 0; i < len(addr); i++ {
+		c := addr[i]
+		switch {
+		case quoted && c == '\\':
+			i++
+		case quoted:
+			quoted = c != '"'
+		case c == '"' && depth > 0:
+			quoted = true
+		case c == '[':
+			depth++
+		case c == ']' && depth > 0:
+			depth--
+		case depth == 0:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// step is one action of a resource change.
+type step struct {
+	change int
+	action string
+}
+
+// orderSteps returns the actions of changes in an order they can be applied
+// in: resources are created and updated after the resources they depend on,
+// and deleted before them. Changes are otherwise kept in plan order, which is
+// also used to break dependency cycles.
+func orderSteps(g dependencyGraph, changes []ResourceChange) []step {
+	steps := []step{}
+	first := make([]int, len(changes))
+	for i, rc := range changes {
+		first[i] = len(steps)
+		for _, action := range rc.Change.Actions {
+			steps = append(steps, step{change: i, action: action})
+		}
+	}
+
+	deletedOnly := func(i int) bool {
+		for _, action := range changes[i].Change.Actions {
+			if action != "delete" {
+				return false
+			}
+		}
+		return true
+	}
+
+	// after[i] lists the steps that have to come before step i
+	after := make([][]int, len(steps))
+	for i := range steps {
+		if i > first[steps[i].change] {
+			after[i] = append(after[i], i-1)
+		}
+	}
+	for a, rcA := range changes {
+		deps := g.reachable(stripKeys(rcA.Address))
+		for b, rcB := range changes {
+			if a == b || !deps[stripKeys(rcB.Address)] {
+				continue
+			}
+			// a depends on b
+			for i := first[a]; i < first[a]+len(rcA.Change.Actions); i++ {
+				for j := first[b]; j < first[b]+len(rcB.Change.Actions); j++ {
+					deleteA, deleteB := steps[i].action == "delete", steps[j].action == "delete"
+					switch {
+					case !deleteA && !deleteB:
+						after[i] = append(after[i], j)
+					case deleteA && deleteB:
+						after[j] = append(after[j], i)
+					case deleteB && deletedOnly(b):
+						after[j] = append(after[j], i)
+					}
+				}
+			}
+		}
+	}
+
+	ordered := make([]step, 0, len(steps))
+	done := make([]bool, len(steps))
+	for len(ordered) < len(steps) {
+		next := -1
+		for i := range steps {
+			if done[i] {
+				continue
+			}
+			if next == -1 {
+				next = i
+			}
+			ready := true
+			for _, j := range after[i] {
+				ready = ready && done[j]
+			}
+			if ready {
+				next = i
+				break
+			}
+		}
+		done[next] = true
+		ordered = append(ordered, steps[next])
+	}
+	return ordered
+}
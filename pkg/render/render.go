@@ -0,0 +1,315 @@
+// Package render prints the statements the provider would run to apply a
+// Terraform plan, without changing anything in the account.
+package render
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/go-cty/cty"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pkg/errors"
+)
+
+// Plan is the subset of the output of `terraform show -json` read by Render.
+type Plan struct {
+	ResourceChanges []ResourceChange `json:"resource_changes"`
+	Configuration   *Configuration   `json:"configuration"`
+	PriorState      *State           `json:"prior_state"`
+}
+
+// ResourceChange is a pending change of a single resource instance.
+type ResourceChange struct {
+	Address string `json:"address"`
+	Mode    string `json:"mode"`
+	Type    string `json:"type"`
+	Change  Change `json:"change"`
+}
+
+// Change holds the values of a resource before and after the change.
+type Change struct {
+	Actions      []string        `json:"actions"`
+	Before       json.RawMessage `json:"before"`
+	After        json.RawMessage `json:"after"`
+	AfterUnknown json.RawMessage `json:"after_unknown"`
+}
+
+// Renderer applies resource changes against a database in dry-run mode and
+// collects the statements they run.
+type Renderer struct {
+	resources map[string]*schema.Resource
+	db        *sql.DB
+	recorder  *snowflake.Recorder
+}
+
+// NewRenderer returns a Renderer applying changes with the given resources
+// through db. db is put in dry-run mode if it is not already.
+func NewRenderer(resources map[string]*schema.Resource, db *sql.DB) *Renderer {
+	recorder := snowflake.DryRunRecorder(db)
+	if recorder == nil {
+		recorder = &snowflake.Recorder{}
+		snowflake.DryRun(db, recorder)
+	}
+	return &Renderer{
+		resources: resources,
+		db:        db,
+		recorder:  recorder,
+	}
+}
+
+// Render writes the statements of every change in plan to w, as a SQL script
+// with a comment introducing each resource. Changes to resources of other
+// providers and data sources are skipped. Changes are written in dependency
+// order, from the references in the configuration of the plan and the
+// dependencies recorded in its prior state: objects are created before the
+// objects depending on them, and dropped after them. A replaced resource is
+// split in two when other changes have to come in between. Resources failing
+// to apply are reported in the script and in the returned error.
+func (r *Renderer) Render(ctx context.Context, w io.Writer, plan *Plan) error {
+	changes := []ResourceChange{}
+	for _, rc := range plan.ResourceChanges {
+		_, ok := r.resources[rc.Type]
+		if rc.Mode != "managed" || !ok {
+			continue
+		}
+		if len(rc.Change.Actions) == 0 || rc.Change.Actions[0] == "no-op" || rc.Change.Actions[0] == "read" {
+			continue
+		}
+		changes = append(changes, rc)
+	}
+
+	steps := orderSteps(newDependencyGraph(plan), changes)
+	failed := []string{}
+	isFailed := map[int]bool{}
+	for i := 0; i < len(steps); {
+		// consecutive actions of the same change are written together
+		rc := changes[steps[i].change]
+		actions := []string{}
+		for ; i < len(steps) && changes[steps[i].change].Address == rc.Address; i++ {
+			actions = append(actions, steps[i].action)
+		}
+		if isFailed[steps[i-1].change] {
+			continue
+		}
+
+		if len(actions) == len(rc.Change.Actions) {
+			fmt.Fprintf(w, "-- %v (%v)\n", rc.Address, strings.Join(actions, ", "))
+		} else {
+			fmt.Fprintf(w, "-- %v (%v: %v)\n", rc.Address, strings.Join(rc.Change.Actions, ", "), strings.Join(actions, ", "))
+		}
+		res := r.resources[rc.Type]
+		if len(actions) > 1 || actions[0] != "delete" {
+			unknown, err := unknownPaths(rc.Change.AfterUnknown, res.Schema)
+			if err != nil {
+				return errors.Wrapf(err, "error reading unknown values of %v", rc.Address)
+			}
+			if len(unknown) > 0 {
+				fmt.Fprintf(w, "-- known after apply, rendered empty: %v\n", strings.Join(unknown, ", "))
+			}
+		}
+
+		stmts, err := r.apply(ctx, res, rc.Change, actions)
+		for _, stmt := range stmts {
+			fmt.Fprintf(w, "%v;\n", stmt)
+		}
+		if err != nil {
+			fmt.Fprintf(w, "-- error: %v\n", strings.ReplaceAll(err.Error(), "\n", " "))
+			failed = append(failed, rc.Address)
+			isFailed[steps[i-1].change] = true
+		}
+		fmt.Fprintln(w)
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("unable to render %v", strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// apply runs actions of a change the way Terraform would and returns the
+// statements they recorded.
+func (r *Renderer) apply(ctx context.Context, res *schema.Resource, change Change, actions []string) ([]string, error) {
+	ty := res.CoreConfigSchema().ImpliedType()
+	before, err := unmarshalValue(change.Before, ty)
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading values before the change")
+	}
+	after, err := unmarshalValue(change.After, ty)
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading values after the change")
+	}
+	if len(change.AfterUnknown) > 0 && !after.IsNull() {
+		var u interface{}
+		if err := json.Unmarshal(change.AfterUnknown, &u); err != nil {
+			return nil, err
+		}
+		after = markUnknown(after, u)
+	}
+
+	// a replacement is applied by Terraform as a delete and a create
+	steps := [][2]cty.Value{}
+	for _, action := range actions {
+		switch action {
+		case "create":
+			steps = append(steps, [2]cty.Value{cty.NullVal(ty), after})
+		case "delete":
+			steps = append(steps, [2]cty.Value{before, cty.NullVal(ty)})
+		case "update":
+			steps = append(steps, [2]cty.Value{before, after})
+		}
+	}
+
+	r.recorder.Take()
+	for _, step := range steps {
+		if err := r.applyStep(ctx, res, step[0], step[1]); err != nil {
+			return r.recorder.Take(), err
+		}
+	}
+	return r.recorder.Take(), nil
+}
+
+// applyStep mirrors the ApplyResourceChange call of the plugin protocol.
+func (r *Renderer) applyStep(ctx context.Context, res *schema.Resource, prior, planned cty.Value) error {
+	priorState, err := res.ShimInstanceStateFromValue(prior)
+	if err != nil {
+		return err
+	}
+
+	var diff *terraform.InstanceDiff
+	if planned.IsNull() {
+		diff = &terraform.InstanceDiff{
+			Attributes: make(map[string]*terraform.ResourceAttrDiff),
+			Meta:       make(map[string]interface{}),
+			Destroy:    true,
+		}
+	} else {
+		diff, err = schema.DiffFromValues(ctx, prior, planned, planned, res)
+		if err != nil {
+			return err
+		}
+	}
+	if diff == nil {
+		diff = &terraform.InstanceDiff{
+			Attributes: make(map[string]*terraform.ResourceAttrDiff),
+			Meta:       make(map[string]interface{}),
+		}
+	}
+
+	_, diags := res.Apply(ctx, priorState, diff, r.db)
+	return diagsError(diags)
+}
+
+func diagsError(diags diag.Diagnostics) error {
+	msgs := []string{}
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			msgs = append(msgs, d.Summary)
+		}
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+	return errors.New(strings.Join(msgs, "; "))
+}
+
+func unmarshalValue(raw json.RawMessage, ty cty.Type) (cty.Value, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return cty.NullVal(ty), nil
+	}
+	return ctyjson.Unmarshal(raw, ty)
+}
+
+// markUnknown marks the values flagged in after_unknown as unknown. Nested
+// values are flagged by a structure mirroring the value.
+func markUnknown(v cty.Value, unknown interface{}) cty.Value {
+	if b, ok := unknown.(bool); ok && b {
+		return cty.UnknownVal(v.Type())
+	}
+	if v.IsNull() || !v.IsKnown() {
+		return v
+	}
+
+	ty := v.Type()
+	switch u := unknown.(type) {
+	case map[string]interface{}:
+		if !ty.IsObjectType() {
+			return v
+		}
+		attrs := map[string]cty.Value{}
+		for name, attr := range v.AsValueMap() {
+			attrs[name] = markUnknown(attr, u[name])
+		}
+		return cty.ObjectVal(attrs)
+	case []interface{}:
+		if !ty.IsListType() && !ty.IsSetType() {
+			return v
+		}
+		elems := []cty.Value{}
+		for i, elem := range v.AsValueSlice() {
+			var eu interface{}
+			if i < len(u) {
+				eu = u[i]
+			}
+			elems = append(elems, markUnknown(elem, eu))
+		}
+		if len(elems) == 0 {
+			return v
+		}
+		if ty.IsSetType() {
+			return cty.SetVal(elems)
+		}
+		return cty.ListVal(elems)
+	}
+	return v
+}
+
+// unknownPaths lists the arguments flagged in after_unknown. Attributes that
+// are only computed, like the ID, are left out since no statement uses them.
+func unknownPaths(raw json.RawMessage, s map[string]*schema.Schema) ([]string, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	var u interface{}
+	if err := json.Unmarshal(raw, &u); err != nil {
+		return nil, err
+	}
+
+	paths := []string{}
+	var walk func(prefix string, u interface{})
+	walk = func(prefix string, u interface{}) {
+		switch u := u.(type) {
+		case bool:
+			if !u {
+				return
+			}
+			sch, ok := s[strings.SplitN(prefix, ".", 2)[0]]
+			if ok && (sch.Required || sch.Optional) {
+				paths = append(paths, prefix)
+			}
+		case map[string]interface{}:
+			for k, v := range u {
+				p := k
+				if prefix != "" {
+					p = prefix + "." + k
+				}
+				walk(p, v)
+			}
+		case []interface{}:
+			for i, v := range u {
+				walk(fmt.Sprintf("%v.%d", prefix, i), v)
+			}
+		}
+	}
+	walk("", u)
+	sort.Strings(paths)
+	return paths, nil
+}
@@ -0,0 +1,114 @@
+package render_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/db"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/provider"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/render"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	r := require.New(t)
+
+	plan := &render.Plan{}
+	r.NoError(json.Unmarshal([]byte(MustFixture(t, "plan.json")), plan))
+
+	sdb := db.OpenOffline()
+	defer sdb.Close()
+
+	var buf bytes.Buffer
+	err := render.NewRenderer(provider.Provider().ResourcesMap, sdb).Render(context.Background(), &buf, plan)
+	r.NoError(err)
+	r.Equal(`-- snowflake_database.db (create)
+CREATE DATABASE "db" COMMENT='a db' DATA_RETENTION_TIME_IN_DAYS=1;
+
+-- snowflake_schema.schema (create)
+-- known after apply, rendered empty: database
+CREATE SCHEMA "schema" DATA_RETENTION_TIME_IN_DAYS = 1;
+
+-- snowflake_role.role (update)
+ALTER ROLE "role" SET COMMENT='new';
+
+-- snowflake_warehouse.wh (delete)
+DROP WAREHOUSE "wh";
+
+`, buf.String())
+}
+
+func TestRenderReplace(t *testing.T) {
+	r := require.New(t)
+
+	plan := &render.Plan{
+		ResourceChanges: []render.ResourceChange{
+			{
+				Address: "snowflake_database_role.reader",
+				Mode:    "managed",
+				Type:    "snowflake_database_role",
+				Change: render.Change{
+					Actions:      []string{"delete", "create"},
+					Before:       []byte(`{"id": "old|reader", "database": "old", "name": "reader", "comment": null}`),
+					After:        []byte(`{"database": "new", "name": "reader", "comment": null}`),
+					AfterUnknown: []byte(`{"id": true}`),
+				},
+			},
+		},
+	}
+
+	sdb := db.OpenOffline()
+	defer sdb.Close()
+
+	var buf bytes.Buffer
+	err := render.NewRenderer(provider.Provider().ResourcesMap, sdb).Render(context.Background(), &buf, plan)
+	r.NoError(err)
+	r.Equal(`-- snowflake_database_role.reader (delete, create)
+DROP DATABASE ROLE "old"."reader";
+CREATE DATABASE ROLE "new"."reader";
+
+`, buf.String())
+}
+
+func TestRenderOrder(t *testing.T) {
+	r := require.New(t)
+
+	plan := &render.Plan{}
+	r.NoError(json.Unmarshal([]byte(MustFixture(t, "plan_order.json")), plan))
+
+	sdb := db.OpenOffline()
+	defer sdb.Close()
+
+	var buf bytes.Buffer
+	err := render.NewRenderer(provider.Provider().ResourcesMap, sdb).Render(context.Background(), &buf, plan)
+	r.NoError(err)
+	// the schema of the module is created after the database passed to it,
+	// the database role is dropped before its database is replaced and
+	// created after, and the grants of a role are revoked before dropping it
+	r.Equal(`-- snowflake_database.db (create)
+CREATE DATABASE "db" DATA_RETENTION_TIME_IN_DAYS=1;
+
+-- module.a.snowflake_schema.s (create)
+CREATE SCHEMA "db"."s" DATA_RETENTION_TIME_IN_DAYS = 1;
+
+-- snowflake_database_role.x["reader"] (delete, create: delete)
+DROP DATABASE ROLE "x"."reader";
+
+-- snowflake_database.x (delete, create)
+DROP DATABASE "x";
+CREATE DATABASE "x" COMMENT='new' DATA_RETENTION_TIME_IN_DAYS=1;
+
+-- snowflake_database_role.x["reader"] (delete, create: create)
+CREATE DATABASE ROLE "x"."reader";
+
+-- snowflake_role_grants.old (delete)
+REVOKE ROLE "old" FROM ROLE "parent";
+
+-- snowflake_role.old (delete)
+DROP ROLE "old";
+
+`, buf.String())
+}
@@ -0,0 +1,287 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	snowflakeValidation "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+)
+
+var (
+	accountNamePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+	regionPattern      = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+)
+
+// diffSuppressAfterCreate ignores changes to the fields only used to create
+// the account, which are not read back and so are missing after an import
+func diffSuppressAfterCreate(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != ""
+}
+
+var accountSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      "Specifies the identifier for the account; must be unique in the organization. Account names start with a letter and only contain letters, numbers and underscores.",
+		ValidateFunc:     validation.StringMatch(accountNamePattern, "account names start with a letter and only contain letters, numbers and underscores"),
+		DiffSuppressFunc: diffCaseInsensitive,
+	},
+	"admin_name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      "Login name of the initial administrator of the account. It is only used to create the account and is not read back, so changing it afterwards has no effect.",
+		DiffSuppressFunc: diffSuppressAfterCreate,
+	},
+	"admin_password": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Sensitive:        true,
+		ForceNew:         true,
+		Description:      "Password of the initial administrator of the account. It is only used to create the account and is not read back, so changing it afterwards has no effect.",
+		ValidateFunc:     snowflakeValidation.ValidatePassword,
+		AtLeastOneOf:     []string{"admin_password", "admin_rsa_public_key"},
+		DiffSuppressFunc: diffSuppressAfterCreate,
+	},
+	"admin_rsa_public_key": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Description:      "Public key the initial administrator of the account authenticates with, instead of or besides a password. It is only used to create the account and is not read back, so changing it afterwards has no effect.",
+		AtLeastOneOf:     []string{"admin_password", "admin_rsa_public_key"},
+		DiffSuppressFunc: diffSuppressAfterCreate,
+	},
+	"must_change_password": {
+		Type:             schema.TypeBool,
+		Optional:         true,
+		Default:          false,
+		ForceNew:         true,
+		Description:      "Specifies whether the initial administrator has to change their password on first login. It is only used to create the account and is not read back, so changing it afterwards has no effect.",
+		DiffSuppressFunc: diffSuppressAfterCreate,
+	},
+	"email": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      "Email address of the initial administrator of the account. It is only used to create the account and is not read back, so changing it afterwards has no effect.",
+		DiffSuppressFunc: diffSuppressAfterCreate,
+	},
+	"edition": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      "Snowflake edition of the account, one of STANDARD, ENTERPRISE or BUSINESS_CRITICAL.",
+		ValidateFunc:     validation.StringInSlice([]string{"STANDARD", "ENTERPRISE", "BUSINESS_CRITICAL"}, true),
+		DiffSuppressFunc: diffCaseInsensitive,
+	},
+	"region_group": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		ForceNew:         true,
+		Description:      "Region group to create the account in, the region group of the current account by default.",
+		ValidateFunc:     validation.StringMatch(regionPattern, "regions and region groups only contain letters, numbers and underscores"),
+		DiffSuppressFunc: diffCaseInsensitive,
+	},
+	"region": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		ForceNew:         true,
+		Description:      "Snowflake region to create the account in, e.g. AWS_US_WEST_2, the region of the current account by default.",
+		ValidateFunc:     validation.StringMatch(regionPattern, "regions and region groups only contain letters, numbers and underscores"),
+		DiffSuppressFunc: diffCaseInsensitive,
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the account.",
+	},
+	"grace_period_in_days": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      3,
+		Description:  "Number of days, from 3 to 90, during which a dropped account can be restored with UNDROP ACCOUNT.",
+		ValidateFunc: validation.IntBetween(3, 90),
+	},
+	"drop_on_destroy": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Allows destroying the resource to drop the account. Destroying the account, or replacing it, fails until this has been set to true and applied.",
+	},
+	"locator": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The account locator of the account.",
+	},
+	"url": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "URL for accessing the account, particularly through the web interface.",
+	},
+	"created_on": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Date and time when the account was created.",
+	},
+}
+
+// Account returns a pointer to the resource representing an account of the
+// organization. It requires the provider to use the ORGADMIN role.
+func Account() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateAccount,
+		ReadContext:   ReadAccount,
+		UpdateContext: UpdateAccount,
+		DeleteContext: DeleteAccount,
+
+		Schema:        accountSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(accountSchema, upgradeUnchangedV0),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+	}
+}
+
+// CreateAccount implements schema.CreateContextFunc
+func CreateAccount(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	name := strings.ToUpper(d.Get("name").(string))
+
+	builder := snowflake.OrganizationAccount(name).
+		WithAdminName(d.Get("admin_name").(string)).
+		WithAdminPassword(d.Get("admin_password").(string)).
+		WithAdminRSAPublicKey(d.Get("admin_rsa_public_key").(string)).
+		WithMustChangePassword(d.Get("must_change_password").(bool)).
+		WithEmail(d.Get("email").(string)).
+		WithEdition(strings.ToUpper(d.Get("edition").(string))).
+		WithRegionGroup(d.Get("region_group").(string)).
+		WithRegion(d.Get("region").(string)).
+		WithComment(d.Get("comment").(string))
+
+	err := snowflake.ExecContext(ctx, db, builder.Create())
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error creating account %v", name))
+	}
+
+	d.SetId(name)
+
+	return ReadAccount(ctx, d, meta)
+}
+
+// accountName returns the name of the account from its ID, which is written
+// unquoted in the statements
+func accountName(d *schema.ResourceData) (string, error) {
+	if !accountNamePattern.MatchString(d.Id()) {
+		return "", errors.Errorf("invalid account name %v in ID", d.Id())
+	}
+	return d.Id(), nil
+}
+
+// ReadAccount implements schema.ReadContextFunc
+func ReadAccount(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	name, err := accountName(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	a, err := snowflake.ReadOrganizationAccount(ctx, db, name)
+	if err == sql.ErrNoRows {
+		// If not found, mark resource to be removed from statefile during apply or refresh
+		log.Printf("[DEBUG] account (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	toSet := map[string]interface{}{
+		"name":         a.AccountName.String,
+		"edition":      a.Edition.String,
+		"region_group": a.RegionGroup.String,
+		"region":       a.SnowflakeRegion.String,
+		"comment":      a.Comment.String,
+		"locator":      a.AccountLocator.String,
+		"url":          a.AccountURL.String,
+		"created_on":   a.CreatedOn.String,
+	}
+	for key, val := range toSet {
+		err = d.Set(key, val) //lintignore:R001
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// UpdateAccount implements schema.UpdateContextFunc. Changing the grace
+// period or drop_on_destroy only changes the state.
+func UpdateAccount(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	name, err := accountName(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	builder := snowflake.OrganizationAccount(name)
+
+	if d.HasChange("comment") {
+		stmt := builder.RemoveComment()
+		if c := d.Get("comment").(string); c != "" {
+			stmt = builder.ChangeComment(c)
+		}
+		err := snowflake.ExecContext(ctx, db, stmt)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error updating comment of account %v", d.Id()))
+		}
+	}
+
+	return ReadAccount(ctx, d, meta)
+}
+
+// DeleteAccount implements schema.DeleteContextFunc. The account is only
+// dropped if drop_on_destroy was applied beforehand, and can be restored
+// during the grace period.
+func DeleteAccount(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+
+	if !d.Get("drop_on_destroy").(bool) {
+		return diag.Errorf("account %v is not dropped unless drop_on_destroy is set: set it to true and apply before destroying or replacing the account", d.Id())
+	}
+
+	name, err := accountName(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	stmt := snowflake.OrganizationAccount(name).Drop(d.Get("grace_period_in_days").(int))
+	err = snowflake.ExecContext(ctx, db, stmt)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error dropping account %v", d.Id()))
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_Account(t *testing.T) {
+	// creating accounts needs the ORGADMIN role and is billed, so the test is opt-in
+	if _, ok := os.LookupEnv("SNOWFLAKE_ORGADMIN_TESTS"); !ok {
+		t.Skip("Skipping TestAccAccount, set SNOWFLAKE_ORGADMIN_TESTS to run it with the ORGADMIN role")
+	}
+
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	password := acctest.RandString(10) + "Aa1"
+
+	resource.Test(t, resource.TestCase{
+		Providers: providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: accountConfig(accName, password, "test comment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_account.test", "name", accName),
+					resource.TestCheckResourceAttr("snowflake_account.test", "edition", "STANDARD"),
+					resource.TestCheckResourceAttr("snowflake_account.test", "comment", "test comment"),
+					resource.TestCheckResourceAttrSet("snowflake_account.test", "region"),
+					resource.TestCheckResourceAttrSet("snowflake_account.test", "locator"),
+				),
+			},
+			{
+				Config: accountConfig(accName, password, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_account.test", "comment", ""),
+				),
+			},
+			{
+				// the fields only used to create the account are ignored afterwards,
+				// as they are after an import
+				Config:   accountConfig(accName, password+"b", ""),
+				PlanOnly: true,
+			},
+			{
+				// the fields only used to create the account are not read back,
+				// so they are missing from the imported state
+				ResourceName:            "snowflake_account.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"admin_name", "admin_password", "email", "must_change_password", "grace_period_in_days", "drop_on_destroy"},
+			},
+		},
+	})
+}
+
+func accountConfig(n string, password string, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_account" "test" {
+	name = "%v"
+	admin_name = "admin"
+	admin_password = "%v"
+	email = "admin@example.com"
+	edition = "STANDARD"
+	comment = "%v"
+	drop_on_destroy = true
+}
+`, n, password, comment)
+}
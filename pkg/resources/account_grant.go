@@ -1,8 +1,11 @@
 package resources
 
 import (
+	"context"
+
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -56,31 +59,41 @@ var accountGrantSchema = map[string]*schema.Schema{
 func AccountGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create: CreateAccountGrant,
-			Read:   ReadAccountGrant,
-			Delete: DeleteAccountGrant,
-			Update: UpdateAccountGrant,
-
-			Schema: accountGrantSchema,
+			CreateContext: CreateAccountGrant,
+			ReadContext:   ReadAccountGrant,
+			DeleteContext: DeleteAccountGrant,
+			UpdateContext: UpdateAccountGrant,
+
+			Schema:        accountGrantSchema,
+			SchemaVersion: 1,
+			StateUpgraders: []schema.StateUpgrader{
+				stateUpgraderV0(accountGrantSchema, upgradeGrantIDV0),
+			},
 			Importer: &schema.ResourceImporter{
 				StateContext: schema.ImportStatePassthroughContext,
 			},
+			Timeouts: &schema.ResourceTimeout{
+				Create: schema.DefaultTimeout(defaultTimeout),
+				Read:   schema.DefaultTimeout(defaultTimeout),
+				Update: schema.DefaultTimeout(defaultTimeout),
+				Delete: schema.DefaultTimeout(defaultTimeout),
+			},
 		},
 		ValidPrivs: validAccountPrivileges,
 	}
 }
 
-// CreateAccountGrant implements schema.CreateFunc
-func CreateAccountGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateAccountGrant implements schema.CreateContextFunc
+func CreateAccountGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	priv := d.Get("privilege").(string)
 	grantOption := d.Get("with_grant_option").(bool)
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 
 	builder := snowflake.AccountGrant()
 
-	err := createGenericGrant(d, meta, builder)
+	err := createGenericGrant(ctx, d, meta, builder)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grantID := &grantID{
@@ -91,42 +104,42 @@ func CreateAccountGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := grantID.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadAccountGrant(d, meta)
+	return ReadAccountGrant(ctx, d, meta)
 }
 
-// ReadAccountGrant implements schema.ReadFunc
-func ReadAccountGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadAccountGrant implements schema.ReadContextFunc
+func ReadAccountGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("privilege", grantID.Privilege)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("with_grant_option", grantID.GrantOption)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	builder := snowflake.AccountGrant()
 
-	return readGenericGrant(d, meta, accountGrantSchema, builder, false, validAccountPrivileges)
+	return diag.FromErr(readGenericGrant(ctx, d, meta, accountGrantSchema, builder, false, validAccountPrivileges))
 }
 
-// DeleteAccountGrant implements schema.DeleteFunc
-func DeleteAccountGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteAccountGrant implements schema.DeleteContextFunc
+func DeleteAccountGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	builder := snowflake.AccountGrant()
 
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
 
-// UpdateAccountGrant implements schema.UpdateFunc
-func UpdateAccountGrant(d *schema.ResourceData, meta interface{}) error {
+// UpdateAccountGrant implements schema.UpdateContextFunc
+func UpdateAccountGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// for now the only thing we can update is roles.
 	// if nothing changed, nothing to update and we're done.
 	if !d.HasChanges("roles") {
@@ -137,23 +150,23 @@ func UpdateAccountGrant(d *schema.ResourceData, meta interface{}) error {
 
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	builder := snowflake.AccountGrant()
 
 	// first revoke
-	err = deleteGenericGrantRolesAndShares(meta, builder, grantID.Privilege, rolesToRevoke, nil)
+	err = deleteGenericGrantRolesAndShares(ctx, meta, builder, grantID.Privilege, rolesToRevoke, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// then add
-	err = createGenericGrantRolesAndShares(meta, builder, grantID.Privilege, grantID.GrantOption, rolesToAdd, nil)
+	err = createGenericGrantRolesAndShares(ctx, meta, builder, grantID.Privilege, grantID.GrantOption, rolesToAdd, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// done, refresh state
-	return ReadAccountGrant(d, meta)
+	return ReadAccountGrant(ctx, d, meta)
 }
@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 	"time"
@@ -36,8 +37,8 @@ func TestAccountGrantCreate(t *testing.T) { //lintignore:AT003
 		mock.ExpectExec(`^GRANT CREATE DATABASE ON ACCOUNT TO ROLE "test-role-1" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT CREATE DATABASE ON ACCOUNT TO ROLE "test-role-2" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadAccountGrant(mock)
-		err := resources.CreateAccountGrant(d, db)
-		r.NoError(err)
+		diags := resources.CreateAccountGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -55,8 +56,8 @@ func TestAccountGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadAccountGrant(mock)
-		err := resources.ReadAccountGrant(d, db)
-		r.NoError(err)
+		diags := resources.ReadAccountGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -73,8 +74,8 @@ func TestMonitorExecution(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadAccountGrant(mock)
-		err := resources.ReadAccountGrant(d, db)
-		r.NoError(err)
+		diags := resources.ReadAccountGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -91,8 +92,8 @@ func TestExecuteTask(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadAccountGrant(mock)
-		err := resources.ReadAccountGrant(d, db)
-		r.NoError(err)
+		diags := resources.ReadAccountGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -120,8 +121,8 @@ func TestApplyMaskingPolicy(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadAccountGrant(mock)
-		err := resources.ReadAccountGrant(d, db)
-		r.NoError(err)
+		diags := resources.ReadAccountGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -0,0 +1,145 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+)
+
+// parameterKeyPattern matches the names of parameters, which are written
+// unquoted in the statements setting them.
+var parameterKeyPattern = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
+var accountParameterSchema = map[string]*schema.Schema{
+	"key": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      "Name of the account parameter, e.g. `TIMEZONE`.",
+		DiffSuppressFunc: diffCaseInsensitive,
+		ValidateFunc:     validation.StringMatch(parameterKeyPattern, "parameter names only contain letters, numbers and underscores"),
+	},
+	"value": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      "Value of the account parameter, as a string. Numbers and booleans are set as such.",
+		DiffSuppressFunc: diffCaseInsensitive,
+	},
+}
+
+// AccountParameter returns a pointer to the resource representing a parameter set on the account
+func AccountParameter() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateAccountParameter,
+		ReadContext:   ReadAccountParameter,
+		UpdateContext: UpdateAccountParameter,
+		DeleteContext: DeleteAccountParameter,
+
+		Schema:        accountParameterSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(accountParameterSchema, upgradeUnchangedV0),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+	}
+}
+
+// CreateAccountParameter implements schema.CreateContextFunc
+func CreateAccountParameter(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	key := strings.ToUpper(d.Get("key").(string))
+
+	stmt := snowflake.AccountParameter(key).Set(d.Get("value").(string))
+	err := snowflake.ExecContext(ctx, db, stmt)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error setting account parameter %v", key))
+	}
+
+	d.SetId(key)
+
+	return ReadAccountParameter(ctx, d, meta)
+}
+
+// ReadAccountParameter implements schema.ReadContextFunc
+func ReadAccountParameter(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	key := d.Id()
+
+	stmt := snowflake.AccountParameter(key).Show()
+	row := snowflake.QueryRowContext(ctx, db, stmt)
+	p, err := snowflake.ScanParameter(row)
+	if err == sql.ErrNoRows {
+		log.Printf("[DEBUG] account parameter (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// a parameter left to its default was unset since it was applied
+	if !p.IsSetOn("ACCOUNT") {
+		log.Printf("[DEBUG] account parameter (%s) not set on the account", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	toSet := map[string]interface{}{
+		"key":   p.Key.String,
+		"value": p.Value.String,
+	}
+	for key, val := range toSet {
+		err = d.Set(key, val) //lintignore:R001
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// UpdateAccountParameter implements schema.UpdateContextFunc
+func UpdateAccountParameter(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+
+	if d.HasChange("value") {
+		stmt := snowflake.AccountParameter(d.Id()).Set(d.Get("value").(string))
+		err := snowflake.ExecContext(ctx, db, stmt)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error updating account parameter %v", d.Id()))
+		}
+	}
+
+	return ReadAccountParameter(ctx, d, meta)
+}
+
+// DeleteAccountParameter implements schema.DeleteContextFunc
+func DeleteAccountParameter(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+
+	stmt := snowflake.AccountParameter(d.Id()).Unset()
+	err := snowflake.ExecContext(ctx, db, stmt)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error unsetting account parameter %v", d.Id()))
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// account parameters are shared by every test of the account, so these steps
+// do not run in parallel
+func TestAcc_AccountParameter(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Providers: providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: accountParameterConfig("CLIENT_ENCRYPTION_KEY_SIZE", "256"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_account_parameter.test", "key", "CLIENT_ENCRYPTION_KEY_SIZE"),
+					resource.TestCheckResourceAttr("snowflake_account_parameter.test", "value", "256"),
+				),
+			},
+			{
+				Config: accountParameterConfig("CLIENT_ENCRYPTION_KEY_SIZE", "128"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_account_parameter.test", "value", "128"),
+				),
+			},
+			{
+				ResourceName:      "snowflake_account_parameter.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func accountParameterConfig(key, value string) string {
+	return fmt.Sprintf(`
+resource "snowflake_account_parameter" "test" {
+	key   = "%v"
+	value = "%v"
+}
+`, key, value)
+}
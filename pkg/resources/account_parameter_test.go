@@ -0,0 +1,94 @@
+package resources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/provider"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/resources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountParameter(t *testing.T) {
+	r := require.New(t)
+	err := resources.AccountParameter().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestAccountParameterCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"key":   "timezone",
+		"value": "America/Los_Angeles",
+	}
+	d := accountParameter(t, "", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER ACCOUNT SET TIMEZONE = 'America/Los_Angeles'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadAccountParameter(mock, "ACCOUNT")
+		diags := resources.CreateAccountParameter(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("TIMEZONE", d.Id())
+		r.Equal("America/Los_Angeles", d.Get("value").(string))
+	})
+}
+
+func TestAccountParameterValidateKey(t *testing.T) {
+	r := require.New(t)
+
+	diags := resources.AccountParameter().Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"key":   "TIMEZONE",
+		"value": "UTC",
+	}))
+	r.Empty(diags)
+
+	diags = resources.AccountParameter().Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"key":   "TIMEZONE = 'UTC'; DROP DATABASE prod; --",
+		"value": "UTC",
+	}))
+	r.NotEmpty(diags)
+	r.Contains(diags[0].Summary, "parameter names only contain letters, numbers and underscores")
+}
+
+func expectReadAccountParameter(mock sqlmock.Sqlmock, level string) {
+	rows := sqlmock.NewRows([]string{"key", "value", "default", "level", "description", "type"}).
+		AddRow("TIMEZONE", "America/Los_Angeles", "America/Los_Angeles", level, "time zone", "STRING")
+	mock.ExpectQuery(`^SHOW PARAMETERS LIKE 'TIMEZONE' IN ACCOUNT$`).WillReturnRows(rows)
+}
+
+func TestAccountParameterRead(t *testing.T) {
+	r := require.New(t)
+
+	d := accountParameter(t, "TIMEZONE", map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadAccountParameter(mock, "ACCOUNT")
+		diags := resources.ReadAccountParameter(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("TIMEZONE", d.Get("key").(string))
+		r.Equal("America/Los_Angeles", d.Get("value").(string))
+
+		// a parameter back at its default is no longer set on the account
+		expectReadAccountParameter(mock, "")
+		diags = resources.ReadAccountParameter(context.Background(), d, db)
+		r.Empty(diags)
+		r.Empty(d.Id())
+	})
+}
+
+func TestAccountParameterDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := accountParameter(t, "TIMEZONE", map[string]interface{}{"key": "TIMEZONE", "value": "UTC"})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER ACCOUNT UNSET TIMEZONE$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		diags := resources.DeleteAccountParameter(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
@@ -0,0 +1,184 @@
+package resources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/provider"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/resources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccount(t *testing.T) {
+	r := require.New(t)
+	err := resources.Account().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestAccountCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":           "sales",
+		"admin_name":     "admin",
+		"admin_password": "Passw0rd1234",
+		"email":          "admin@example.com",
+		"edition":        "enterprise",
+		"region":         "AWS_US_WEST_2",
+		"comment":        "sales unit",
+	}
+
+	d := schema.TestResourceDataRaw(t, resources.Account().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`^CREATE ACCOUNT SALES ADMIN_NAME = 'admin' ADMIN_PASSWORD = 'Passw0rd1234' EMAIL = 'admin@example.com' EDITION = ENTERPRISE REGION = AWS_US_WEST_2 COMMENT = 'sales unit'$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		expectReadAccount(mock)
+		diags := resources.CreateAccount(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("SALES", d.Id())
+		r.Equal("ENTERPRISE", d.Get("edition").(string))
+		r.Equal("PUBLIC", d.Get("region_group").(string))
+		r.Equal("ABC12345", d.Get("locator").(string))
+	})
+}
+
+func TestAccountReadNotFound(t *testing.T) {
+	r := require.New(t)
+
+	d := account(t, "SALES_1", map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		// the name is a LIKE pattern, the underscore matching another account
+		rows := sqlmock.NewRows([]string{"organization_name", "account_name", "region_group", "snowflake_region", "edition", "account_url", "created_on", "comment", "account_locator"}).
+			AddRow("ORG", "SALESX1", "PUBLIC", "AWS_US_WEST_2", "ENTERPRISE", "https://org-salesx1.snowflakecomputing.com", "2023-01-01 00:00:00", "", "XYZ12345")
+		mock.ExpectQuery(`^SHOW ORGANIZATION ACCOUNTS LIKE 'SALES_1'$`).WillReturnRows(rows)
+		diags := resources.ReadAccount(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("", d.Id())
+	})
+}
+
+func TestAccountUpdate(t *testing.T) {
+	r := require.New(t)
+
+	d := account(t, "SALES", map[string]interface{}{
+		"comment": "sales unit",
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER ACCOUNT SALES SET COMMENT = 'sales unit'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadAccount(mock)
+		diags := resources.UpdateAccount(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
+
+func TestAccountDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := account(t, "SALES", map[string]interface{}{
+		"drop_on_destroy":      true,
+		"grace_period_in_days": 14,
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^DROP ACCOUNT SALES GRACE_PERIOD_IN_DAYS = 14$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		diags := resources.DeleteAccount(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("", d.Id())
+	})
+}
+
+func TestAccountDeleteWithoutOptIn(t *testing.T) {
+	r := require.New(t)
+
+	d := account(t, "SALES", map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		diags := resources.DeleteAccount(context.Background(), d, db)
+		r.Len(diags, 1)
+		r.Contains(diags[0].Summary, "account SALES is not dropped unless drop_on_destroy is set")
+		r.Equal("SALES", d.Id())
+	})
+}
+
+func TestAccountPlanAfterImport(t *testing.T) {
+	r := require.New(t)
+
+	imported := map[string]interface{}{
+		"name":         "SALES",
+		"edition":      "ENTERPRISE",
+		"region_group": "PUBLIC",
+		"region":       "AWS_US_WEST_2",
+		"comment":      "sales unit",
+	}
+	config := map[string]interface{}{
+		"name":                 "sales",
+		"admin_name":           "admin",
+		"admin_password":       "Passw0rd1234",
+		"admin_rsa_public_key": "MIIBIjANBgkqh",
+		"must_change_password": true,
+		"email":                "admin@example.com",
+		"edition":              "enterprise",
+		"region":               "AWS_US_WEST_2",
+		"comment":              "sales unit",
+	}
+	_, diff := planUpdateDiff(t, resources.Account(), "SALES", imported, config)
+	r.True(diff == nil || diff.Empty())
+
+	// fields read back still replace the account when they change
+	config["edition"] = "business_critical"
+	_, diff = planUpdateDiff(t, resources.Account(), "SALES", imported, config)
+	r.True(diff.RequiresNew())
+}
+
+func TestAccountValidateRegion(t *testing.T) {
+	r := require.New(t)
+
+	diags := resources.Account().Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":           "sales",
+		"admin_name":     "admin",
+		"admin_password": "Passw0rd1234",
+		"email":          "admin@example.com",
+		"edition":        "enterprise",
+		"region":         "AWS_US_WEST_2 COMMENT = 'x'",
+	}))
+	r.NotEmpty(diags)
+	r.Contains(diags[0].Summary, "regions and region groups only contain letters, numbers and underscores")
+}
+
+func TestAccountInvalidID(t *testing.T) {
+	r := require.New(t)
+
+	d := account(t, "SALES; DROP ACCOUNT OTHER", map[string]interface{}{
+		"drop_on_destroy": true,
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		diags := resources.ReadAccount(context.Background(), d, db)
+		r.Len(diags, 1)
+		r.Contains(diags[0].Summary, "invalid account name SALES; DROP ACCOUNT OTHER in ID")
+
+		diags = resources.DeleteAccount(context.Background(), d, db)
+		r.Len(diags, 1)
+		r.Contains(diags[0].Summary, "invalid account name SALES; DROP ACCOUNT OTHER in ID")
+	})
+}
+
+func expectReadAccount(mock sqlmock.Sqlmock) {
+	rows := sqlmock.NewRows([]string{
+		"organization_name", "account_name", "region_group", "snowflake_region", "edition", "account_url", "created_on", "comment", "account_locator", "account_locator_url", "is_org_admin",
+	}).AddRow(
+		"ORG", "SALES", "PUBLIC", "AWS_US_WEST_2", "ENTERPRISE", "https://org-sales.snowflakecomputing.com", "2023-01-01 00:00:00", "sales unit", "ABC12345", "https://abc12345.snowflakecomputing.com", false,
+	)
+	mock.ExpectQuery(`^SHOW ORGANIZATION ACCOUNTS LIKE 'SALES'$`).WillReturnRows(rows)
+}
@@ -0,0 +1,324 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+var alertSchema = map[string]*schema.Schema{
+	"enabled": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies if the alert should be resumed (enabled) after creation or should remain suspended (default).",
+	},
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the identifier for the alert; must be unique for the database and schema in which the alert is created.",
+		ForceNew:    true,
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database in which to create the alert.",
+		ForceNew:    true,
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema in which to create the alert.",
+		ForceNew:    true,
+	},
+	"warehouse": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The warehouse the alert will use to evaluate its condition and run its action.",
+	},
+	"schedule": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schedule for periodically evaluating the condition of the alert. This can be a cron (`USING CRON <expr> <time_zone>`) or an interval in minutes (`<num> MINUTE`).",
+	},
+	"condition": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      "The SELECT statement that triggers the alert when it returns one or more rows.",
+		DiffSuppressFunc: DiffSuppressStatement,
+	},
+	"action": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      "The SQL statement that should be executed when the alert is triggered.",
+		DiffSuppressFunc: DiffSuppressStatement,
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the alert.",
+	},
+}
+
+type alertID struct {
+	DatabaseName string
+	SchemaName   string
+	AlertName    string
+}
+
+// String() takes in an alertID object and returns a pipe-delimited string:
+// DatabaseName|SchemaName|AlertName
+func (a *alertID) String() (string, error) {
+	var buf bytes.Buffer
+	csvWriter := csv.NewWriter(&buf)
+	csvWriter.Comma = pipeIDDelimiter
+	dataIdentifiers := [][]string{{a.DatabaseName, a.SchemaName, a.AlertName}}
+	err := csvWriter.WriteAll(dataIdentifiers)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// alertIDFromString() takes in a pipe-delimited string: DatabaseName|SchemaName|AlertName
+// and returns an alertID object
+func alertIDFromString(stringID string) (*alertID, error) {
+	reader := csv.NewReader(strings.NewReader(stringID))
+	reader.Comma = pipeIDDelimiter
+	lines, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("Not CSV compatible")
+	}
+
+	if len(lines) != 1 {
+		return nil, fmt.Errorf("1 line per alert")
+	}
+	if len(lines[0]) != 3 {
+		return nil, fmt.Errorf("3 fields allowed")
+	}
+
+	names, err := objectNames(lines[0][:3])
+	if err != nil {
+		return nil, err
+	}
+
+	return &alertID{
+		DatabaseName: names[0].Name(),
+		SchemaName:   names[1].Name(),
+		AlertName:    names[2].Name(),
+	}, nil
+}
+
+// Alert returns a pointer to the resource representing an alert
+func Alert() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateAlert,
+		ReadContext:   ReadAlert,
+		UpdateContext: UpdateAlert,
+		DeleteContext: DeleteAlert,
+
+		Schema:        alertSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(alertSchema, upgradeUnchangedV0),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+	}
+}
+
+// CreateAlert implements schema.CreateContextFunc
+func CreateAlert(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	database := d.Get("database").(string)
+	dbSchema := d.Get("schema").(string)
+	name := d.Get("name").(string)
+
+	builder := snowflake.Alert(name, database, dbSchema).
+		WithWarehouse(d.Get("warehouse").(string)).
+		WithSchedule(d.Get("schedule").(string)).
+		WithCondition(d.Get("condition").(string)).
+		WithAction(d.Get("action").(string))
+
+	if v, ok := d.GetOk("comment"); ok {
+		builder.WithComment(v.(string))
+	}
+
+	err := snowflake.ExecContext(ctx, db, builder.Create())
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error creating alert %v", name))
+	}
+
+	// alerts are always created suspended
+	if d.Get("enabled").(bool) {
+		err = snowflake.ExecContext(ctx, db, builder.Resume())
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error resuming alert %v", name))
+		}
+	}
+
+	alertID := &alertID{
+		DatabaseName: database,
+		SchemaName:   dbSchema,
+		AlertName:    name,
+	}
+	dataIDInput, err := alertID.String()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(dataIDInput)
+
+	return ReadAlert(ctx, d, meta)
+}
+
+// ReadAlert implements schema.ReadContextFunc
+func ReadAlert(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	alertID, err := alertIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	builder := snowflake.Alert(alertID.AlertName, alertID.DatabaseName, alertID.SchemaName)
+	row := snowflake.QueryRowContext(ctx, db, builder.Show())
+	a, err := snowflake.ScanAlert(row)
+	if err == sql.ErrNoRows {
+		// If not found, mark resource to be removed from statefile during apply or refresh
+		log.Printf("[DEBUG] alert (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	toSet := map[string]interface{}{
+		"enabled":   a.IsEnabled(),
+		"name":      a.Name,
+		"database":  a.DatabaseName,
+		"schema":    a.SchemaName,
+		"warehouse": a.Warehouse.String,
+		"schedule":  a.Schedule.String,
+		"condition": a.Condition.String,
+		"action":    a.Action.String,
+		"comment":   a.Comment.String,
+	}
+	for key, val := range toSet {
+		err = d.Set(key, val) //lintignore:R001
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+// UpdateAlert implements schema.UpdateContextFunc
+func UpdateAlert(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	alertID, err := alertIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	builder := snowflake.Alert(alertID.AlertName, alertID.DatabaseName, alertID.SchemaName)
+
+	// a started alert has to be suspended before it can be altered
+	oldEnabled, _ := d.GetChange("enabled")
+	suspended := !oldEnabled.(bool)
+	if d.HasChanges("warehouse", "schedule", "condition", "action", "comment") && !suspended {
+		err = snowflake.ExecContext(ctx, db, builder.Suspend())
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error suspending alert %v", d.Id()))
+		}
+		suspended = true
+	}
+
+	if d.HasChange("warehouse") {
+		err = snowflake.ExecContext(ctx, db, builder.ChangeWarehouse(d.Get("warehouse").(string)))
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error updating warehouse on alert %v", d.Id()))
+		}
+	}
+
+	if d.HasChange("schedule") {
+		err = snowflake.ExecContext(ctx, db, builder.ChangeSchedule(d.Get("schedule").(string)))
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error updating schedule on alert %v", d.Id()))
+		}
+	}
+
+	if d.HasChange("condition") {
+		err = snowflake.ExecContext(ctx, db, builder.ChangeCondition(d.Get("condition").(string)))
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error updating condition on alert %v", d.Id()))
+		}
+	}
+
+	if d.HasChange("action") {
+		err = snowflake.ExecContext(ctx, db, builder.ChangeAction(d.Get("action").(string)))
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error updating action on alert %v", d.Id()))
+		}
+	}
+
+	if d.HasChange("comment") {
+		var q string
+		if c := d.Get("comment").(string); c != "" {
+			q = builder.ChangeComment(c)
+		} else {
+			q = builder.RemoveComment()
+		}
+		err = snowflake.ExecContext(ctx, db, q)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error updating comment on alert %v", d.Id()))
+		}
+	}
+
+	enabled := d.Get("enabled").(bool)
+	if enabled && suspended {
+		err = snowflake.ExecContext(ctx, db, builder.Resume())
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error resuming alert %v", d.Id()))
+		}
+	} else if !enabled && !suspended {
+		err = snowflake.ExecContext(ctx, db, builder.Suspend())
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error suspending alert %v", d.Id()))
+		}
+	}
+
+	return ReadAlert(ctx, d, meta)
+}
+
+// DeleteAlert implements schema.DeleteContextFunc
+func DeleteAlert(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	alertID, err := alertIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	builder := snowflake.Alert(alertID.AlertName, alertID.DatabaseName, alertID.SchemaName)
+	err = snowflake.ExecContext(ctx, db, builder.Drop())
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error deleting alert %v", d.Id()))
+	}
+
+	d.SetId("")
+	return nil
+}
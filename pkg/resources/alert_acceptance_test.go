@@ -0,0 +1,73 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_Alert(t *testing.T) {
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: alertConfig(accName, false, "5 MINUTE", "test comment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_alert.test", "name", accName),
+					resource.TestCheckResourceAttr("snowflake_alert.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("snowflake_alert.test", "schedule", "5 MINUTE"),
+					resource.TestCheckResourceAttr("snowflake_alert.test", "comment", "test comment"),
+				),
+			},
+			{
+				Config: alertConfig(accName, true, "USING CRON 0 9 * * * UTC", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_alert.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("snowflake_alert.test", "schedule", "USING CRON 0 9 * * * UTC"),
+					resource.TestCheckResourceAttr("snowflake_alert.test", "comment", ""),
+				),
+			},
+			{
+				ResourceName:      "snowflake_alert.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func alertConfig(n string, enabled bool, schedule string, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_database" "test" {
+	name = "%[1]v"
+	comment = "Terraform acceptance test"
+}
+
+resource "snowflake_schema" "test" {
+	name = "%[1]v"
+	database = snowflake_database.test.name
+	comment = "Terraform acceptance test"
+}
+
+resource "snowflake_warehouse" "test" {
+	name = "%[1]v"
+}
+
+resource "snowflake_alert" "test" {
+	name = "%[1]v"
+	database = snowflake_database.test.name
+	schema = snowflake_schema.test.name
+	warehouse = snowflake_warehouse.test.name
+	schedule = "%[3]v"
+	condition = "SELECT 1 FROM INFORMATION_SCHEMA.TABLES WHERE 1 = 0"
+	action = "SELECT 1"
+	enabled = %[2]v
+	comment = "%[4]v"
+}
+`, n, enabled, schedule, comment)
+}
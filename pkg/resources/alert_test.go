@@ -0,0 +1,124 @@
+package resources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/provider"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/resources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlert(t *testing.T) {
+	r := require.New(t)
+	err := resources.Alert().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestAlertCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"enabled":   true,
+		"name":      "test_alert",
+		"database":  "test_db",
+		"schema":    "test_schema",
+		"warehouse": "much_warehouse",
+		"schedule":  "1 MINUTE",
+		"condition": "select 1 as c",
+		"action":    "select 1 as c",
+		"comment":   "wow comment",
+	}
+
+	d := schema.TestResourceDataRaw(t, resources.Alert().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`^CREATE ALERT "test_db"."test_schema"."test_alert" WAREHOUSE = "much_warehouse" SCHEDULE = '1 MINUTE' COMMENT = 'wow comment' IF \(EXISTS \(select 1 as c\)\) THEN select 1 as c$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(
+			`^ALTER ALERT "test_db"."test_schema"."test_alert" RESUME$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		expectReadAlert(mock, "started")
+		diags := resources.CreateAlert(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("test_db|test_schema|test_alert", d.Id())
+		r.True(d.Get("enabled").(bool))
+	})
+}
+
+func TestAlertRead(t *testing.T) {
+	r := require.New(t)
+
+	d := alert(t, "test_db|test_schema|test_alert", map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadAlert(mock, "suspended")
+		diags := resources.ReadAlert(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("test_alert", d.Get("name").(string))
+		r.Equal("much_warehouse", d.Get("warehouse").(string))
+		r.Equal("1 MINUTE", d.Get("schedule").(string))
+		r.Equal("select 1 as c", d.Get("condition").(string))
+		r.Equal("wow comment", d.Get("comment").(string))
+		r.False(d.Get("enabled").(bool))
+	})
+}
+
+func TestAlertReadNotFound(t *testing.T) {
+	r := require.New(t)
+
+	d := alert(t, "test_db|test_schema|test_alert", map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"created_on", "name", "database_name", "schema_name", "owner", "comment", "warehouse", "schedule", "state", "condition", "action"})
+		mock.ExpectQuery(`^SHOW ALERTS LIKE 'test_alert' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+		diags := resources.ReadAlert(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("", d.Id())
+	})
+}
+
+func TestAlertUpdate(t *testing.T) {
+	r := require.New(t)
+
+	d := alert(t, "test_db|test_schema|test_alert", map[string]interface{}{
+		"schedule": "5 MINUTE",
+		"comment":  "",
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER ALERT "test_db"."test_schema"."test_alert" SET SCHEDULE = '5 MINUTE'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadAlert(mock, "suspended")
+		diags := resources.UpdateAlert(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
+
+func TestAlertDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := alert(t, "test_db|test_schema|test_alert", map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^DROP ALERT "test_db"."test_schema"."test_alert"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		diags := resources.DeleteAlert(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("", d.Id())
+	})
+}
+
+func expectReadAlert(mock sqlmock.Sqlmock, state string) {
+	rows := sqlmock.NewRows([]string{
+		"created_on", "name", "database_name", "schema_name", "owner", "comment", "warehouse", "schedule", "state", "condition", "action",
+	}).AddRow(
+		"2022-01-01 00:00:00", "test_alert", "test_db", "test_schema", "ACCOUNTADMIN", "wow comment", "much_warehouse", "1 MINUTE", state, "select 1 as c", "select 1 as c",
+	)
+	mock.ExpectQuery(`^SHOW ALERTS LIKE 'test_alert' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+}
@@ -1,12 +1,14 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -93,20 +95,30 @@ var apiIntegrationSchema = map[string]*schema.Schema{
 // APIIntegration returns a pointer to the resource representing an api integration
 func APIIntegration() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateAPIIntegration,
-		Read:   ReadAPIIntegration,
-		Update: UpdateAPIIntegration,
-		Delete: DeleteAPIIntegration,
-
-		Schema: apiIntegrationSchema,
+		CreateContext: CreateAPIIntegration,
+		ReadContext:   ReadAPIIntegration,
+		UpdateContext: UpdateAPIIntegration,
+		DeleteContext: DeleteAPIIntegration,
+
+		Schema:        apiIntegrationSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(apiIntegrationSchema, upgradeUnchangedV0),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 	}
 }
 
-// CreateAPIIntegration implements schema.CreateFunc
-func CreateAPIIntegration(d *schema.ResourceData, meta interface{}) error {
+// CreateAPIIntegration implements schema.CreateContextFunc
+func CreateAPIIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 
@@ -125,49 +137,49 @@ func CreateAPIIntegration(d *schema.ResourceData, meta interface{}) error {
 	// Now, set the API provider
 	err := setAPIProviderSettings(d, stmt)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = snowflake.Exec(db, stmt.Statement())
+	err = snowflake.ExecContext(ctx, db, stmt.Statement())
 	if err != nil {
-		return fmt.Errorf("error creating api integration: %w", err)
+		return diag.FromErr(fmt.Errorf("error creating api integration: %w", err))
 	}
 
 	d.SetId(name)
 
-	return ReadAPIIntegration(d, meta)
+	return ReadAPIIntegration(ctx, d, meta)
 }
 
-// ReadAPIIntegration implements schema.ReadFunc
-func ReadAPIIntegration(d *schema.ResourceData, meta interface{}) error {
+// ReadAPIIntegration implements schema.ReadContextFunc
+func ReadAPIIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	id := d.Id()
 
 	stmt := snowflake.ApiIntegration(id).Show()
-	row := snowflake.QueryRow(db, stmt)
+	row := snowflake.QueryRowContext(ctx, db, stmt)
 
 	// Some properties can come from the SHOW INTEGRATION call
 
 	s, err := snowflake.ScanApiIntegration(row)
 	if err != nil {
-		return fmt.Errorf("Could not show api integration: %w", err)
+		return diag.FromErr(fmt.Errorf("Could not show api integration: %w", err))
 	}
 
 	// Note: category must be API or something is broken
 	if c := s.Category.String; c != "API" {
-		return fmt.Errorf("Expected %v to be an api integration, got %v", id, c)
+		return diag.FromErr(fmt.Errorf("Expected %v to be an api integration, got %v", id, c))
 	}
 
 	if err := d.Set("name", s.Name.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("created_on", s.CreatedOn.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("enabled", s.Enabled.Bool); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Some properties come from the DESCRIBE INTEGRATION call
@@ -175,58 +187,58 @@ func ReadAPIIntegration(d *schema.ResourceData, meta interface{}) error {
 	var k, pType string
 	var v, unused interface{}
 	stmt = snowflake.ApiIntegration(id).Describe()
-	rows, err := db.Query(stmt)
+	rows, err := db.QueryContext(ctx, stmt)
 	if err != nil {
-		return fmt.Errorf("Could not describe api integration: %w", err)
+		return diag.FromErr(fmt.Errorf("Could not describe api integration: %w", err))
 	}
 	defer rows.Close()
 	for rows.Next() {
 		if err := rows.Scan(&k, &pType, &v, &unused); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		switch k {
 		case "ENABLED":
 			// We set this using the SHOW INTEGRATION call so let's ignore it here
 		case "API_ALLOWED_PREFIXES":
 			if err = d.Set("api_allowed_prefixes", strings.Split(v.(string), ",")); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "API_BLOCKED_PREFIXES":
 			if val := v.(string); val != "" {
 				if err = d.Set("api_blocked_prefixes", strings.Split(val, ",")); err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		case "API_AWS_IAM_USER_ARN":
 			if err = d.Set("api_aws_iam_user_arn", v.(string)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "API_AWS_ROLE_ARN":
 			if err = d.Set("api_aws_role_arn", v.(string)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "API_AWS_EXTERNAL_ID":
 			if err = d.Set("api_aws_external_id", v.(string)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "AZURE_CONSENT_URL":
 			if err = d.Set("azure_consent_url", v.(string)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "AZURE_MULTI_TENANT_APP_NAME":
 			if err = d.Set("azure_multi_tenant_app_name", v.(string)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		default:
 			log.Printf("[WARN] unexpected api integration property %v returned from Snowflake", k)
 		}
 	}
 
-	return err
+	return diag.FromErr(err)
 }
 
-// UpdateAPIIntegration implements schema.UpdateFunc
-func UpdateAPIIntegration(d *schema.ResourceData, meta interface{}) error {
+// UpdateAPIIntegration implements schema.UpdateContextFunc
+func UpdateAPIIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	id := d.Id()
 
@@ -248,9 +260,9 @@ func UpdateAPIIntegration(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("api_blocked_prefixes") {
 		v := d.Get("api_blocked_prefixes").([]interface{})
 		if len(v) == 0 {
-			err := snowflake.Exec(db, fmt.Sprintf(`ALTER API INTEGRATION %v UNSET API_BLOCKED_PREFIXES`, id))
+			err := snowflake.ExecContext(ctx, db, fmt.Sprintf(`ALTER API INTEGRATION %v UNSET API_BLOCKED_PREFIXES`, id))
 			if err != nil {
-				return fmt.Errorf("error unsetting api_blocked_prefixes: %w", err)
+				return diag.FromErr(fmt.Errorf("error unsetting api_blocked_prefixes: %w", err))
 			}
 		} else {
 			runSetStatement = true
@@ -262,7 +274,7 @@ func UpdateAPIIntegration(d *schema.ResourceData, meta interface{}) error {
 		runSetStatement = true
 		err := setAPIProviderSettings(d, stmt)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		if d.HasChange("api_aws_role_arn") {
@@ -280,17 +292,17 @@ func UpdateAPIIntegration(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if runSetStatement {
-		if err := snowflake.Exec(db, stmt.Statement()); err != nil {
-			return fmt.Errorf("error updating api integration: %w", err)
+		if err := snowflake.ExecContext(ctx, db, stmt.Statement()); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating api integration: %w", err))
 		}
 	}
 
-	return ReadAPIIntegration(d, meta)
+	return ReadAPIIntegration(ctx, d, meta)
 }
 
-// DeleteAPIIntegration implements schema.DeleteFunc
-func DeleteAPIIntegration(d *schema.ResourceData, meta interface{}) error {
-	return DeleteResource("", snowflake.ApiIntegration)(d, meta)
+// DeleteAPIIntegration implements schema.DeleteContextFunc
+func DeleteAPIIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return DeleteResource("", snowflake.ApiIntegration)(ctx, d, meta)
 }
 
 func setAPIProviderSettings(data *schema.ResourceData, stmt snowflake.SettingBuilder) error {
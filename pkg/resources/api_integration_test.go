@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -36,8 +37,8 @@ func TestAPIIntegrationCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadAPIIntegration(mock)
 
-		err := resources.CreateAPIIntegration(d, db)
-		r.NoError(err)
+		diags := resources.CreateAPIIntegration(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -49,8 +50,8 @@ func TestAPIIntegrationRead(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadAPIIntegration(mock)
 
-		err := resources.ReadAPIIntegration(d, db)
-		r.NoError(err)
+		diags := resources.ReadAPIIntegration(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -61,8 +62,8 @@ func TestAPIIntegrationDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP API INTEGRATION "drop_it"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteAPIIntegration(d, db)
-		r.NoError(err)
+		diags := resources.DeleteAPIIntegration(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -0,0 +1,102 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// authenticationPolicy describes authentication policies. Lists left out of
+// the configuration keep the value Snowflake defaults them to, e.g. ALL.
+var authenticationPolicy = &userPolicyKind{
+	name:    "authentication policy",
+	builder: snowflake.AuthenticationPolicy,
+	properties: map[string]*schema.Schema{
+		"authentication_methods": {
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringInSlice([]string{"ALL", "SAML", "PASSWORD", "OAUTH", "KEYPAIR"}, false)},
+			Optional:    true,
+			Computed:    true,
+			Description: "The authentication methods allowed to log in, any of ALL, SAML, PASSWORD, OAUTH or KEYPAIR.",
+		},
+		"mfa_authentication_methods": {
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringInSlice([]string{"ALL", "SAML", "PASSWORD"}, false)},
+			Optional:    true,
+			Computed:    true,
+			Description: "The authentication methods that require multi-factor authentication, any of ALL, SAML or PASSWORD.",
+		},
+		"mfa_enrollment": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "OPTIONAL",
+			Description:  "Whether users must enroll in multi-factor authentication, one of REQUIRED or OPTIONAL.",
+			ValidateFunc: validation.StringInSlice([]string{"REQUIRED", "OPTIONAL"}, false),
+		},
+		"client_types": {
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringInSlice([]string{"ALL", "SNOWFLAKE_UI", "SNOWSQL", "DRIVERS"}, false)},
+			Optional:    true,
+			Computed:    true,
+			Description: "The clients allowed to log in, any of ALL, SNOWFLAKE_UI, SNOWSQL or DRIVERS.",
+		},
+		"security_integrations": {
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Computed:    true,
+			Description: "The security integrations users can authenticate with, or ALL.",
+		},
+	},
+	keywords: map[string]bool{"mfa_enrollment": true},
+}
+
+var authenticationPolicySchema = authenticationPolicy.schema()
+
+// AuthenticationPolicy returns a pointer to the resource representing an authentication policy
+func AuthenticationPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateAuthenticationPolicy,
+		ReadContext:   ReadAuthenticationPolicy,
+		UpdateContext: UpdateAuthenticationPolicy,
+		DeleteContext: DeleteAuthenticationPolicy,
+
+		Schema:        authenticationPolicySchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(authenticationPolicySchema, upgradeUnchangedV0),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+	}
+}
+
+// CreateAuthenticationPolicy implements schema.CreateContextFunc
+func CreateAuthenticationPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return authenticationPolicy.create(ctx, d, meta)
+}
+
+// ReadAuthenticationPolicy implements schema.ReadContextFunc
+func ReadAuthenticationPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return authenticationPolicy.read(ctx, d, meta)
+}
+
+// UpdateAuthenticationPolicy implements schema.UpdateContextFunc
+func UpdateAuthenticationPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return authenticationPolicy.update(ctx, d, meta)
+}
+
+// DeleteAuthenticationPolicy implements schema.DeleteContextFunc
+func DeleteAuthenticationPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return authenticationPolicy.delete(ctx, d, meta)
+}
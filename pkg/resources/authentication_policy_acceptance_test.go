@@ -0,0 +1,62 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_AuthenticationPolicy(t *testing.T) {
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: authenticationPolicyConfig(accName, "OPTIONAL"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_authentication_policy.test", "name", accName),
+					resource.TestCheckResourceAttr("snowflake_authentication_policy.test", "mfa_enrollment", "OPTIONAL"),
+					resource.TestCheckResourceAttr("snowflake_authentication_policy.test", "authentication_methods.#", "2"),
+				),
+			},
+			{
+				Config: authenticationPolicyConfig(accName, "REQUIRED"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_authentication_policy.test", "mfa_enrollment", "REQUIRED"),
+				),
+			},
+			{
+				ResourceName:      "snowflake_authentication_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func authenticationPolicyConfig(n string, mfaEnrollment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_database" "test" {
+	name = "%[1]v"
+	comment = "Terraform acceptance test"
+}
+
+resource "snowflake_schema" "test" {
+	name = "%[1]v"
+	database = snowflake_database.test.name
+	comment = "Terraform acceptance test"
+}
+
+resource "snowflake_authentication_policy" "test" {
+	name = "%[1]v"
+	database = snowflake_database.test.name
+	schema = snowflake_schema.test.name
+	authentication_methods = ["PASSWORD", "SAML"]
+	mfa_enrollment = "%[2]v"
+}
+`, n, mfaEnrollment)
+}
@@ -0,0 +1,61 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var authenticationPolicyAttachmentSchema = authenticationPolicy.attachmentSchema()
+
+// AuthenticationPolicyAttachment returns a pointer to the resource representing an authentication policy attachment
+func AuthenticationPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateAuthenticationPolicyAttachment,
+		ReadContext:   ReadAuthenticationPolicyAttachment,
+		UpdateContext: UpdateAuthenticationPolicyAttachment,
+		DeleteContext: DeleteAuthenticationPolicyAttachment,
+
+		Schema:        authenticationPolicyAttachmentSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(authenticationPolicyAttachmentSchema, upgradeUnchangedV0),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportAuthenticationPolicyAttachment,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+	}
+}
+
+// CreateAuthenticationPolicyAttachment implements schema.CreateContextFunc
+func CreateAuthenticationPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return authenticationPolicy.createAttachment(ctx, d, meta)
+}
+
+// ImportAuthenticationPolicyAttachment implements schema.StateContextFunc. Every user
+// the policy is set on is attached.
+func ImportAuthenticationPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	return authenticationPolicy.importAttachment(ctx, d, meta)
+}
+
+// ReadAuthenticationPolicyAttachment implements schema.ReadContextFunc
+func ReadAuthenticationPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return authenticationPolicy.readAttachment(ctx, d, meta)
+}
+
+// UpdateAuthenticationPolicyAttachment implements schema.UpdateContextFunc
+func UpdateAuthenticationPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return authenticationPolicy.updateAttachment(ctx, d, meta)
+}
+
+// DeleteAuthenticationPolicyAttachment implements schema.DeleteContextFunc
+func DeleteAuthenticationPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return authenticationPolicy.deleteAttachment(ctx, d, meta)
+}
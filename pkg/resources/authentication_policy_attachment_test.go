@@ -0,0 +1,42 @@
+package resources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/provider"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/resources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthenticationPolicyAttachment(t *testing.T) {
+	r := require.New(t)
+	err := resources.AuthenticationPolicyAttachment().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestAuthenticationPolicyAttachmentUpdate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"authentication_policy": `"test_db"."test_schema"."test_policy"`,
+		"set_for_account":       true,
+	}
+	d := authenticationPolicyAttachment(t, `"test_db"."test_schema"."test_policy"`, in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"POLICY_DB", "POLICY_SCHEMA", "POLICY_NAME", "POLICY_KIND", "REF_ENTITY_NAME", "REF_ENTITY_DOMAIN"})
+		mock.ExpectQuery(`^SELECT \* FROM TABLE\("test_db".INFORMATION_SCHEMA.POLICY_REFERENCES\(POLICY_NAME => '"test_db"."test_schema"."test_policy"'\)\)$`).WillReturnRows(rows)
+		mock.ExpectExec(`^ALTER ACCOUNT SET AUTHENTICATION POLICY "test_db"."test_schema"."test_policy"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		rows = sqlmock.NewRows([]string{"POLICY_DB", "POLICY_SCHEMA", "POLICY_NAME", "POLICY_KIND", "REF_ENTITY_NAME", "REF_ENTITY_DOMAIN"}).
+			AddRow("test_db", "test_schema", "test_policy", "AUTHENTICATION_POLICY", "TEST_ACCOUNT", "ACCOUNT")
+		mock.ExpectQuery(`^SELECT \* FROM TABLE\("test_db".INFORMATION_SCHEMA.POLICY_REFERENCES\(POLICY_NAME => '"test_db"."test_schema"."test_policy"'\)\)$`).WillReturnRows(rows)
+
+		diags := resources.UpdateAuthenticationPolicyAttachment(context.Background(), d, db)
+		r.Empty(diags)
+		r.True(d.Get("set_for_account").(bool))
+	})
+}
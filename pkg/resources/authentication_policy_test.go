@@ -0,0 +1,67 @@
+package resources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/provider"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/resources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthenticationPolicy(t *testing.T) {
+	r := require.New(t)
+	err := resources.AuthenticationPolicy().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestAuthenticationPolicyCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":                       "test_policy",
+		"database":                   "test_db",
+		"schema":                     "test_schema",
+		"mfa_authentication_methods": []interface{}{"PASSWORD", "SAML"},
+		"mfa_enrollment":             "REQUIRED",
+	}
+
+	d := schema.TestResourceDataRaw(t, resources.AuthenticationPolicy().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`^CREATE AUTHENTICATION POLICY "test_db"."test_schema"."test_policy" MFA_AUTHENTICATION_METHODS = \('PASSWORD', 'SAML'\) MFA_ENROLLMENT = REQUIRED$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		expectReadAuthenticationPolicy(mock)
+		diags := resources.CreateAuthenticationPolicy(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("test_db|test_schema|test_policy", d.Id())
+		r.Equal("REQUIRED", d.Get("mfa_enrollment").(string))
+		r.Equal([]interface{}{"PASSWORD", "SAML"}, d.Get("mfa_authentication_methods").([]interface{}))
+		r.Equal([]interface{}{"ALL"}, d.Get("authentication_methods").([]interface{}))
+	})
+}
+
+func expectReadAuthenticationPolicy(mock sqlmock.Sqlmock) {
+	showRows := sqlmock.NewRows([]string{
+		"created_on", "name", "database_name", "schema_name", "kind", "owner", "comment",
+	}).AddRow(
+		"2023-01-01 00:00:00", "test_policy", "test_db", "test_schema", "AUTHENTICATION_POLICY", "ACCOUNTADMIN", "",
+	)
+	mock.ExpectQuery(`^SHOW AUTHENTICATION POLICIES LIKE 'test_policy' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(showRows)
+
+	describeRows := sqlmock.NewRows([]string{"property", "value", "default", "description"}).
+		AddRow("NAME", "test_policy", "null", "Name of the authentication policy.").
+		AddRow("AUTHENTICATION_METHODS", "[ALL]", "[ALL]", "Allowed authentication methods.").
+		AddRow("MFA_AUTHENTICATION_METHODS", "[PASSWORD, SAML]", "[PASSWORD, SAML]", "Authentication methods requiring MFA.").
+		AddRow("MFA_ENROLLMENT", "REQUIRED", "OPTIONAL", "Whether users must enroll in MFA.").
+		AddRow("CLIENT_TYPES", "[ALL]", "[ALL]", "Allowed client types.").
+		AddRow("SECURITY_INTEGRATIONS", "[ALL]", "[ALL]", "Allowed security integrations.")
+	mock.ExpectQuery(`^DESCRIBE AUTHENTICATION POLICY "test_db"."test_schema"."test_policy"$`).WillReturnRows(describeRows)
+}
@@ -0,0 +1,194 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The helpers below build schema.CustomizeDiffFunc rules that are combined per
+// resource with customdiff.All, so that invalid combinations of arguments are
+// rejected during `terraform plan` instead of half way through an apply. Rules
+// skip any argument whose value is not known yet at plan time.
+
+// valuesKnown reports whether all keys have a known value in the plan.
+func valuesKnown(d *schema.ResourceDiff, keys ...string) bool {
+	for _, key := range keys {
+		if !d.NewValueKnown(key) {
+			return false
+		}
+	}
+	return true
+}
+
+// isSet reports whether key is set and whether that is known at plan time.
+// Optional+Computed arguments left out of the configuration show up as unknown
+// in the plan, so the raw configuration is consulted when Terraform sends it.
+func isSet(d *schema.ResourceDiff, key string) (set bool, known bool) {
+	raw := d.GetRawConfig()
+	if !raw.IsNull() && raw.Type().IsObjectType() && raw.Type().HasAttribute(key) {
+		v := raw.GetAttr(key)
+		if !v.IsKnown() {
+			return false, false
+		}
+		return !v.IsNull(), true
+	}
+
+	if !d.NewValueKnown(key) {
+		return false, false
+	}
+	_, ok := d.GetOk(key)
+	return ok, true
+}
+
+// intAtMost rejects plans in which the integer lowKey is greater than highKey.
+// Unset (zero) values are ignored.
+func intAtMost(lowKey, highKey string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !valuesKnown(d, lowKey, highKey) {
+			return nil
+		}
+		low, lowOk := d.GetOk(lowKey)
+		high, highOk := d.GetOk(highKey)
+		if !lowOk || !highOk {
+			return nil
+		}
+		if low.(int) > high.(int) {
+			return fmt.Errorf("%s (%d) must be less than or equal to %s (%d)", lowKey, low.(int), highKey, high.(int))
+		}
+		return nil
+	}
+}
+
+// intsAtMost rejects plans in which an element of one of the integer set or
+// list arguments keys is greater than max.
+func intsAtMost(max int, keys ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		for _, key := range keys {
+			if !valuesKnown(d, key) {
+				continue
+			}
+			var values []interface{}
+			switch v := d.Get(key).(type) {
+			case *schema.Set:
+				values = v.List()
+			case []interface{}:
+				values = v
+			}
+			for _, value := range values {
+				if value.(int) > max {
+					return fmt.Errorf("%s must not contain values greater than %d, got %d", key, max, value.(int))
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// mutuallyExclusive rejects plans in which more than one of keys is set.
+func mutuallyExclusive(keys ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		set := []string{}
+		for _, key := range keys {
+			if ok, known := isSet(d, key); ok && known {
+				set = append(set, key)
+			}
+		}
+		if len(set) > 1 {
+			return fmt.Errorf("only one of %s can be set, got %s", strings.Join(keys, ", "), strings.Join(set, ", "))
+		}
+		return nil
+	}
+}
+
+// requiredWith rejects plans in which key is set but one of others is not.
+func requiredWith(key string, others ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if ok, known := isSet(d, key); !ok || !known {
+			return nil
+		}
+		for _, other := range others {
+			if ok, known := isSet(d, other); known && !ok {
+				return fmt.Errorf("%s must be set when %s is set", other, key)
+			}
+		}
+		return nil
+	}
+}
+
+// requiredForValue rejects plans in which key is set while the set argument
+// setKey does not contain value, or is not set while setKey contains it.
+func requiredForValue(key, setKey, value string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !valuesKnown(d, key, setKey) {
+			return nil
+		}
+		_, ok := d.GetOk(key)
+		contains := d.Get(setKey).(*schema.Set).Contains(value)
+		if ok && !contains {
+			return fmt.Errorf("%s can only be set when %s contains %s", key, setKey, value)
+		}
+		if !ok && contains {
+			return fmt.Errorf("%s must be set when %s contains %s", key, setKey, value)
+		}
+		return nil
+	}
+}
+
+// setOnlyWhen rejects plans in which one of fields is set while the string
+// argument key is none of values. Values are compared ignoring case.
+func setOnlyWhen(key string, values []string, fields ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !valuesKnown(d, key) {
+			return nil
+		}
+		for _, value := range values {
+			if strings.EqualFold(d.Get(key).(string), value) {
+				return nil
+			}
+		}
+		for _, field := range fields {
+			if ok, known := isSet(d, field); ok && known {
+				return fmt.Errorf("%s can only be set when %s is %s", field, key, strings.Join(values, " or "))
+			}
+		}
+		return nil
+	}
+}
+
+// requiredWhen rejects plans in which the string argument key is value but
+// one of fields is unset or empty. The value is compared ignoring case.
+func requiredWhen(key, value string, fields ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !valuesKnown(d, key) || !strings.EqualFold(d.Get(key).(string), value) {
+			return nil
+		}
+		for _, field := range fields {
+			if !valuesKnown(d, field) {
+				continue
+			}
+			if _, ok := d.GetOk(field); !ok {
+				return fmt.Errorf("%s must be set when %s is %s", field, key, value)
+			}
+		}
+		return nil
+	}
+}
+
+// trueWith rejects plans in which one of others is set while the boolean
+// argument key is not true.
+func trueWith(key string, others ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !valuesKnown(d, key) || d.Get(key).(bool) {
+			return nil
+		}
+		for _, other := range others {
+			if ok, known := isSet(d, other); ok && known {
+				return fmt.Errorf("%s must be true when %s is set", key, other)
+			}
+		}
+		return nil
+	}
+}
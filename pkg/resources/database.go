@@ -1,12 +1,15 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -48,6 +51,47 @@ var databaseSchema = map[string]*schema.Schema{
 		ForceNew:      true,
 		ConflictsWith: []string{"from_share", "from_database"},
 	},
+	"replication_configuration": {
+		Type:        schema.TypeList,
+		Description: "When set, promotes the database to a primary database replicated to the accounts given. The replication of databases without it is only read on import.",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"accounts": {
+					Type:        schema.TypeSet,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Required:    true,
+					MinItems:    1,
+					Description: "The accounts, as organization_name.account_name, the database can be replicated to.",
+				},
+				"ignore_edition_check": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Allows replicating to accounts on a lower edition than the account of the database.",
+				},
+			},
+		},
+	},
+	"failover_configuration": {
+		Type:         schema.TypeList,
+		Description:  "When set, allows the replicas of the database in the accounts given to be promoted to primary.",
+		Optional:     true,
+		MaxItems:     1,
+		RequiredWith: []string{"replication_configuration"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"accounts": {
+					Type:        schema.TypeSet,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Required:    true,
+					MinItems:    1,
+					Description: "The accounts, as organization_name.account_name, the database can fail over to. Replication to these accounts must be enabled as well.",
+				},
+			},
+		},
+	},
 	"tag": tagReferenceSchema,
 }
 
@@ -56,98 +100,140 @@ var databaseProperties = []string{"comment", "data_retention_time_in_days", "tag
 // Database returns a pointer to the resource representing a database
 func Database() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateDatabase,
-		Read:   ReadDatabase,
-		Delete: DeleteDatabase,
-		Update: UpdateDatabase,
-
-		Schema: databaseSchema,
+		CreateContext: CreateDatabase,
+		ReadContext:   ReadDatabase,
+		DeleteContext: DeleteDatabase,
+		UpdateContext: UpdateDatabase,
+
+		Schema:        databaseSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(databaseSchema, upgradeUnchangedV0),
+		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportDatabase,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+	}
+}
+
+// ImportDatabase implements schema.StateContextFunc. The replication and
+// failover of the database are only read when in the state, so they are
+// imported here.
+func ImportDatabase(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if err := readDatabaseReplication(ctx, d, meta.(*sql.DB)); err != nil {
+		return nil, err
 	}
+	return []*schema.ResourceData{d}, nil
 }
 
-// CreateDatabase implements schema.CreateFunc
-func CreateDatabase(d *schema.ResourceData, meta interface{}) error {
+// CreateDatabase implements schema.CreateContextFunc
+func CreateDatabase(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	if _, ok := d.GetOk("from_share"); ok {
-		return createDatabaseFromShare(d, meta)
+		return createDatabaseFromShare(ctx, d, meta)
 	}
 
 	if _, ok := d.GetOk("from_database"); ok {
-		return createDatabaseFromDatabase(d, meta)
+		return createDatabaseFromDatabase(ctx, d, meta)
 	}
 
 	if _, ok := d.GetOk("from_replica"); ok {
-		return createDatabaseFromReplica(d, meta)
+		return createDatabaseFromReplica(ctx, d, meta)
+	}
+
+	return CreateResource("database", databaseProperties, databaseSchema, snowflake.Database, createDatabaseReplication)(ctx, d, meta)
+}
+
+// createDatabaseReplication enables the replication and failover of a newly
+// created database, then reads it
+func createDatabaseReplication(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	builder := snowflake.Replication(d.Id())
+
+	if accounts, ignoreEditionCheck, ok := getReplicationConfiguration(d.Get("replication_configuration")); ok {
+		err := snowflake.ExecContext(ctx, db, builder.EnableReplication(accounts, ignoreEditionCheck))
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error enabling replication of database %v", d.Id()))
+		}
+	}
+	if accounts, ok := getFailoverConfiguration(d.Get("failover_configuration")); ok {
+		err := snowflake.ExecContext(ctx, db, builder.EnableFailover(accounts))
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error enabling failover of database %v", d.Id()))
+		}
 	}
 
-	return CreateResource("database", databaseProperties, databaseSchema, snowflake.Database, ReadDatabase)(d, meta)
+	return ReadDatabase(ctx, d, meta)
 }
 
-func createDatabaseFromShare(d *schema.ResourceData, meta interface{}) error {
+func createDatabaseFromShare(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	in := d.Get("from_share").(map[string]interface{})
 	prov := in["provider"]
 	share := in["share"]
 
 	if prov == nil || share == nil {
-		return fmt.Errorf("from_share must contain the keys provider and share, but it had %+v", in)
+		return diag.FromErr(fmt.Errorf("from_share must contain the keys provider and share, but it had %+v", in))
 	}
 
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 	builder := snowflake.DatabaseFromShare(name, prov.(string), share.(string))
 
-	err := snowflake.Exec(db, builder.Create())
+	err := snowflake.ExecContext(ctx, db, builder.Create())
 	if err != nil {
-		return errors.Wrapf(err, "error creating database %v from share %v.%v", name, prov, share)
+		return diag.FromErr(errors.Wrapf(err, "error creating database %v from share %v.%v", name, prov, share))
 	}
 
 	d.SetId(name)
 
-	return ReadDatabase(d, meta)
+	return ReadDatabase(ctx, d, meta)
 }
 
-func createDatabaseFromDatabase(d *schema.ResourceData, meta interface{}) error {
+func createDatabaseFromDatabase(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sourceDb := d.Get("from_database").(string)
 
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 	builder := snowflake.DatabaseFromDatabase(name, sourceDb)
 
-	err := snowflake.Exec(db, builder.Create())
+	err := snowflake.ExecContext(ctx, db, builder.Create())
 	if err != nil {
-		return errors.Wrapf(err, "error creating a clone database %v from database %v", name, sourceDb)
+		return diag.FromErr(errors.Wrapf(err, "error creating a clone database %v from database %v", name, sourceDb))
 	}
 
 	d.SetId(name)
 
-	return ReadDatabase(d, meta)
+	return ReadDatabase(ctx, d, meta)
 }
 
-func createDatabaseFromReplica(d *schema.ResourceData, meta interface{}) error {
+func createDatabaseFromReplica(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sourceDb := d.Get("from_replica").(string)
 
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 	builder := snowflake.DatabaseFromReplica(name, sourceDb)
 
-	err := snowflake.Exec(db, builder.Create())
+	err := snowflake.ExecContext(ctx, db, builder.Create())
 	if err != nil {
-		return errors.Wrapf(err, "error creating a secondary database %v from database %v", name, sourceDb)
+		return diag.FromErr(errors.Wrapf(err, "error creating a secondary database %v from database %v", name, sourceDb))
 	}
 
 	d.SetId(name)
 
-	return ReadDatabase(d, meta)
+	return ReadDatabase(ctx, d, meta)
 }
 
-func ReadDatabase(d *schema.ResourceData, meta interface{}) error {
+func ReadDatabase(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Id()
 
 	stmt := snowflake.Database(name).Show()
-	row := snowflake.QueryRow(db, stmt)
+	row := snowflake.QueryRowContext(ctx, db, stmt)
 
 	database, err := snowflake.ScanDatabase(row)
 
@@ -158,30 +244,177 @@ func ReadDatabase(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return errors.Wrap(err, "unable to scan row for SHOW DATABASES")
+		return diag.FromErr(errors.Wrap(err, "unable to scan row for SHOW DATABASES"))
 	}
 
 	err = d.Set("name", database.DBName.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("comment", database.Comment.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	i, err := strconv.ParseInt(database.RetentionTime.String, 10, 64)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("data_retention_time_in_days", i)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// SHOW REPLICATION DATABASES lists the databases of the whole organization,
+	// so it is only run for databases replicated in their configuration or state
+	_, replicated := d.GetOk("replication_configuration")
+	_, failover := d.GetOk("failover_configuration")
+	if !replicated && !failover {
+		return nil
+	}
+	return diag.FromErr(readDatabaseReplication(ctx, d, db))
+}
+
+// readDatabaseReplication sets the replication and failover configurations
+// of the database from the accounts it is enabled to.
+func readDatabaseReplication(ctx context.Context, d *schema.ResourceData, db *sql.DB) error {
+	replication, err := snowflake.ReadReplicationDatabase(ctx, db, d.Id())
 	if err != nil {
 		return err
 	}
 
-	return d.Set("data_retention_time_in_days", i)
+	replicationConfiguration := []interface{}{}
+	failoverConfiguration := []interface{}{}
+	if replication != nil && replication.IsPrimary.Bool {
+		old, ignoreEditionCheck, _ := getReplicationConfiguration(d.Get("replication_configuration"))
+		if accounts := replication.ReplicationAllowedToAccounts(); len(accounts) > 0 {
+			replicationConfiguration = append(replicationConfiguration, map[string]interface{}{
+				"accounts":             matchAccounts(accounts, old),
+				"ignore_edition_check": ignoreEditionCheck,
+			})
+		}
+		old, _ = getFailoverConfiguration(d.Get("failover_configuration"))
+		if accounts := replication.FailoverAllowedToAccounts(); len(accounts) > 0 {
+			failoverConfiguration = append(failoverConfiguration, map[string]interface{}{
+				"accounts": matchAccounts(accounts, old),
+			})
+		}
+	}
+	err = d.Set("replication_configuration", replicationConfiguration)
+	if err != nil {
+		return err
+	}
+	return d.Set("failover_configuration", failoverConfiguration)
+}
+
+func UpdateDatabase(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return UpdateResource("database", databaseProperties, databaseSchema, snowflake.Database, updateDatabaseReplication)(ctx, d, meta)
 }
 
-func UpdateDatabase(d *schema.ResourceData, meta interface{}) error {
-	return UpdateResource("database", databaseProperties, databaseSchema, snowflake.Database, ReadDatabase)(d, meta)
+// updateDatabaseReplication enables and disables the replication and
+// failover of the database to the accounts added and removed, then reads it
+func updateDatabaseReplication(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	builder := snowflake.Replication(d.Id())
+
+	oldFailover, newFailover := d.GetChange("failover_configuration")
+	oldFailoverAccounts, _ := getFailoverConfiguration(oldFailover)
+	newFailoverAccounts, _ := getFailoverConfiguration(newFailover)
+	oldReplication, newReplication := d.GetChange("replication_configuration")
+	oldReplicationAccounts, _, _ := getReplicationConfiguration(oldReplication)
+	newReplicationAccounts, ignoreEditionCheck, _ := getReplicationConfiguration(newReplication)
+
+	// failover requires replication: disable failover first and enable it last
+	if removed := diffAccounts(oldFailoverAccounts, newFailoverAccounts); len(removed) > 0 {
+		err := snowflake.ExecContext(ctx, db, builder.DisableFailover(removed))
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error disabling failover of database %v", d.Id()))
+		}
+	}
+	if removed := diffAccounts(oldReplicationAccounts, newReplicationAccounts); len(removed) > 0 {
+		err := snowflake.ExecContext(ctx, db, builder.DisableReplication(removed))
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error disabling replication of database %v", d.Id()))
+		}
+	}
+	added := diffAccounts(newReplicationAccounts, oldReplicationAccounts)
+	if d.HasChange("replication_configuration.0.ignore_edition_check") {
+		// the edition check applies to the accounts replication is enabled to
+		added = newReplicationAccounts
+	}
+	if len(added) > 0 {
+		err := snowflake.ExecContext(ctx, db, builder.EnableReplication(added, ignoreEditionCheck))
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error enabling replication of database %v", d.Id()))
+		}
+	}
+	if added := diffAccounts(newFailoverAccounts, oldFailoverAccounts); len(added) > 0 {
+		err := snowflake.ExecContext(ctx, db, builder.EnableFailover(added))
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error enabling failover of database %v", d.Id()))
+		}
+	}
+
+	return ReadDatabase(ctx, d, meta)
 }
 
-func DeleteDatabase(d *schema.ResourceData, meta interface{}) error {
-	return DeleteResource("database", snowflake.Database)(d, meta)
+func DeleteDatabase(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return DeleteResource("database", snowflake.Database)(ctx, d, meta)
+}
+
+// getReplicationConfiguration returns the accounts and edition check of a
+// replication_configuration block, ok being false when there is none
+func getReplicationConfiguration(v interface{}) (accounts []string, ignoreEditionCheck bool, ok bool) {
+	blocks := v.([]interface{})
+	if len(blocks) == 0 || blocks[0] == nil {
+		return nil, false, false
+	}
+	block := blocks[0].(map[string]interface{})
+	return expandStringList(block["accounts"].(*schema.Set).List()), block["ignore_edition_check"].(bool), true
+}
+
+// getFailoverConfiguration returns the accounts of a failover_configuration
+// block, ok being false when there is none
+func getFailoverConfiguration(v interface{}) (accounts []string, ok bool) {
+	blocks := v.([]interface{})
+	if len(blocks) == 0 || blocks[0] == nil {
+		return nil, false
+	}
+	block := blocks[0].(map[string]interface{})
+	return expandStringList(block["accounts"].(*schema.Set).List()), true
+}
+
+// diffAccounts returns the accounts of a missing from b. Account identifiers
+// are case-insensitive.
+func diffAccounts(a, b []string) []string {
+	diff := []string{}
+	for _, account := range a {
+		found := false
+		for _, other := range b {
+			if strings.EqualFold(account, other) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			diff = append(diff, account)
+		}
+	}
+	return diff
+}
+
+// matchAccounts returns the accounts read, spelled as in the configuration
+// when they are there, so that case differences are not reported as changes.
+func matchAccounts(accounts, configured []string) []string {
+	matched := make([]string, len(accounts))
+	for i, account := range accounts {
+		matched[i] = account
+		for _, c := range configured {
+			if strings.EqualFold(account, c) {
+				matched[i] = c
+				break
+			}
+		}
+	}
+	return matched
 }
@@ -1,8 +1,11 @@
 package resources
 
 import (
+	"context"
+
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -58,31 +61,41 @@ var databaseGrantSchema = map[string]*schema.Schema{
 func DatabaseGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create: CreateDatabaseGrant,
-			Read:   ReadDatabaseGrant,
-			Delete: DeleteDatabaseGrant,
-			Update: UpdateDatabaseGrant,
-
-			Schema: databaseGrantSchema,
+			CreateContext: CreateDatabaseGrant,
+			ReadContext:   ReadDatabaseGrant,
+			DeleteContext: DeleteDatabaseGrant,
+			UpdateContext: UpdateDatabaseGrant,
+
+			Schema:        databaseGrantSchema,
+			SchemaVersion: 1,
+			StateUpgraders: []schema.StateUpgrader{
+				stateUpgraderV0(databaseGrantSchema, upgradeGrantIDV0),
+			},
 			Importer: &schema.ResourceImporter{
 				StateContext: schema.ImportStatePassthroughContext,
 			},
+			Timeouts: &schema.ResourceTimeout{
+				Create: schema.DefaultTimeout(defaultTimeout),
+				Read:   schema.DefaultTimeout(defaultTimeout),
+				Update: schema.DefaultTimeout(defaultTimeout),
+				Delete: schema.DefaultTimeout(defaultTimeout),
+			},
 		},
 		ValidPrivs: validDatabasePrivileges,
 	}
 }
 
-// CreateDatabaseGrant implements schema.CreateFunc
-func CreateDatabaseGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateDatabaseGrant implements schema.CreateContextFunc
+func CreateDatabaseGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	dbName := d.Get("database_name").(string)
 	builder := snowflake.DatabaseGrant(dbName)
 	priv := d.Get("privilege").(string)
 	grantOption := d.Get("with_grant_option").(bool)
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 
-	err := createGenericGrant(d, meta, builder)
+	err := createGenericGrant(ctx, d, meta, builder)
 	if err != nil {
-		return errors.Wrap(err, "error creating database grant")
+		return diag.FromErr(errors.Wrap(err, "error creating database grant"))
 	}
 
 	grant := &grantID{
@@ -93,30 +106,30 @@ func CreateDatabaseGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := grant.String()
 	if err != nil {
-		return errors.Wrap(err, "error creating database grant")
+		return diag.FromErr(errors.Wrap(err, "error creating database grant"))
 	}
 	d.SetId(dataIDInput)
 
-	return ReadDatabaseGrant(d, meta)
+	return ReadDatabaseGrant(ctx, d, meta)
 }
 
-// ReadDatabaseGrant implements schema.ReadFunc
-func ReadDatabaseGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadDatabaseGrant implements schema.ReadContextFunc
+func ReadDatabaseGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("database_name", grantID.ResourceName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("privilege", grantID.Privilege)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("with_grant_option", grantID.GrantOption)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// IMPORTED PRIVILEGES is not a real resource, so we can't actually verify
@@ -126,19 +139,19 @@ func ReadDatabaseGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	builder := snowflake.DatabaseGrant(grantID.ResourceName)
-	return readGenericGrant(d, meta, databaseGrantSchema, builder, false, validDatabasePrivileges)
+	return diag.FromErr(readGenericGrant(ctx, d, meta, databaseGrantSchema, builder, false, validDatabasePrivileges))
 }
 
-// DeleteDatabaseGrant implements schema.DeleteFunc
-func DeleteDatabaseGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteDatabaseGrant implements schema.DeleteContextFunc
+func DeleteDatabaseGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	dbName := d.Get("database_name").(string)
 	builder := snowflake.DatabaseGrant(dbName)
 
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
 
-// UpdateDatabaseGrant implements schema.UpdateFunc
-func UpdateDatabaseGrant(d *schema.ResourceData, meta interface{}) error {
+// UpdateDatabaseGrant implements schema.UpdateContextFunc
+func UpdateDatabaseGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// for now the only thing we can update are roles or shares
 	// if nothing changed, nothing to update and we're done
 	if !d.HasChanges("roles", "shares") {
@@ -158,25 +171,25 @@ func UpdateDatabaseGrant(d *schema.ResourceData, meta interface{}) error {
 
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// create the builder
 	builder := snowflake.DatabaseGrant(grantID.ResourceName)
 
 	// first revoke
-	if err := deleteGenericGrantRolesAndShares(
+	if err := deleteGenericGrantRolesAndShares(ctx,
 		meta,
 		builder,
 		grantID.Privilege,
 		rolesToRevoke,
 		sharesToRevoke,
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// then add
-	if err := createGenericGrantRolesAndShares(
+	if err := createGenericGrantRolesAndShares(ctx,
 		meta,
 		builder,
 		grantID.Privilege,
@@ -184,9 +197,9 @@ func UpdateDatabaseGrant(d *schema.ResourceData, meta interface{}) error {
 		rolesToAdd,
 		sharesToAdd,
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Done, refresh state
-	return ReadDatabaseGrant(d, meta)
+	return ReadDatabaseGrant(ctx, d, meta)
 }
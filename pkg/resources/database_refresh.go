@@ -0,0 +1,108 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+var databaseRefreshSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The secondary database to refresh from its primary.",
+		ForceNew:    true,
+	},
+	"triggers": {
+		Type:        schema.TypeMap,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Arbitrary values that refresh the database again when changed.",
+		ForceNew:    true,
+	},
+	"primary": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The primary database the database is refreshed from.",
+	},
+	"replication_allowed_to_accounts": {
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Computed:    true,
+		Description: "The other accounts, as organization_name.account_name, the database is replicated to, the account of the primary database included.",
+	},
+}
+
+// DatabaseRefresh returns a pointer to the resource representing a refresh of a secondary database
+func DatabaseRefresh() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateDatabaseRefresh,
+		ReadContext:   ReadDatabaseRefresh,
+		DeleteContext: DeleteDatabaseRefresh,
+
+		Schema:        databaseRefreshSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(databaseRefreshSchema, upgradeUnchangedV0),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+	}
+}
+
+// CreateDatabaseRefresh implements schema.CreateContextFunc
+func CreateDatabaseRefresh(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	name := d.Get("database").(string)
+
+	err := snowflake.ExecContext(ctx, db, snowflake.Replication(name).Refresh())
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error refreshing database %v", name))
+	}
+
+	d.SetId(name)
+
+	return ReadDatabaseRefresh(ctx, d, meta)
+}
+
+// ReadDatabaseRefresh implements schema.ReadContextFunc
+func ReadDatabaseRefresh(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	name := d.Id()
+
+	replication, err := snowflake.ReadReplicationDatabase(ctx, db, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if replication == nil || replication.IsPrimary.Bool {
+		// If not found, mark resource to be removed from statefile during apply or refresh
+		log.Printf("[DEBUG] secondary database (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	err = d.Set("database", replication.DBName.String)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("primary", replication.Primary.String)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return diag.FromErr(d.Set("replication_allowed_to_accounts", replication.ReplicationAllowedToAccounts()))
+}
+
+// DeleteDatabaseRefresh implements schema.DeleteContextFunc, only removing
+// the refresh from the state
+func DeleteDatabaseRefresh(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,59 @@
+package resources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/provider"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/resources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatabaseRefresh(t *testing.T) {
+	r := require.New(t)
+	err := resources.DatabaseRefresh().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func expectReadSecondaryDatabase(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(`^SELECT CURRENT_ACCOUNT\(\) AS "account", CURRENT_REGION\(\) AS "region";$`).WillReturnRows(
+		sqlmock.NewRows([]string{"account", "region"}).AddRow("CD67890", "AWS_US_EAST_1"),
+	)
+	rows := sqlmock.NewRows([]string{"account_name", "name", "is_primary", "primary", "replication_allowed_to_accounts", "organization_name", "account_locator"}).
+		AddRow("ACCOUNT1", "replica", true, "AWS_US_WEST_2.MYORG.ACCOUNT1.REPLICA", "AWS_US_WEST_2.MYORG.ACCOUNT1, AWS_US_EAST_1.MYORG.ACCOUNT2", "MYORG", "AB12345").
+		AddRow("ACCOUNT2", "replica", false, "AWS_US_WEST_2.MYORG.ACCOUNT1.REPLICA", "AWS_US_WEST_2.MYORG.ACCOUNT1, AWS_US_EAST_1.MYORG.ACCOUNT2", "MYORG", "CD67890")
+	mock.ExpectQuery(`^SHOW REPLICATION DATABASES LIKE 'replica'$`).WillReturnRows(rows)
+}
+
+func TestDatabaseRefreshCreate(t *testing.T) {
+	r := require.New(t)
+
+	d := databaseRefresh(t, "", map[string]interface{}{"database": "replica"})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER DATABASE "replica" REFRESH$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadSecondaryDatabase(mock)
+
+		diags := resources.CreateDatabaseRefresh(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("replica", d.Id())
+		r.Equal("AWS_US_WEST_2.MYORG.ACCOUNT1.REPLICA", d.Get("primary"))
+		// the account of the secondary database is the one excluded
+		r.Equal([]interface{}{"MYORG.ACCOUNT1"}, d.Get("replication_allowed_to_accounts"))
+	})
+}
+
+func TestDatabaseRefreshDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := databaseRefresh(t, "replica", map[string]interface{}{"database": "replica"})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		diags := resources.DeleteDatabaseRefresh(context.Background(), d, db)
+		r.Empty(diags)
+		r.Empty(d.Id())
+	})
+}
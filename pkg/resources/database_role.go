@@ -0,0 +1,235 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+const (
+	databaseRoleIDDelimiter = '|'
+)
+
+var databaseRoleSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which to create the database role.",
+	},
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the identifier for the database role; must be unique for the database in which the role is created.",
+		ValidateFunc: func(val interface{}, key string) ([]string, []error) {
+			return snowflake.ValidateIdentifier(val)
+		},
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the database role.",
+	},
+}
+
+type databaseRoleID struct {
+	DatabaseName string
+	RoleName     string
+}
+
+// String() takes in a databaseRoleID object and returns a pipe-delimited string:
+// DatabaseName|RoleName
+func (ri *databaseRoleID) String() (string, error) {
+	var buf bytes.Buffer
+	csvWriter := csv.NewWriter(&buf)
+	csvWriter.Comma = databaseRoleIDDelimiter
+	dataIdentifiers := [][]string{{ri.DatabaseName, ri.RoleName}}
+	err := csvWriter.WriteAll(dataIdentifiers)
+	if err != nil {
+		return "", err
+	}
+	strDatabaseRoleID := strings.TrimSpace(buf.String())
+	return strDatabaseRoleID, nil
+}
+
+// databaseRoleIDFromString() takes in a pipe-delimited string: DatabaseName|RoleName
+// and returns a databaseRoleID object
+func databaseRoleIDFromString(stringID string) (*databaseRoleID, error) {
+	reader := csv.NewReader(strings.NewReader(stringID))
+	reader.Comma = databaseRoleIDDelimiter
+	lines, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("not CSV compatible")
+	}
+
+	if len(lines) != 1 {
+		return nil, fmt.Errorf("1 line per database role")
+	}
+	if len(lines[0]) != 2 {
+		return nil, fmt.Errorf("2 fields allowed")
+	}
+
+	names, err := objectNames(lines[0][:2])
+	if err != nil {
+		return nil, err
+	}
+
+	return &databaseRoleID{
+		DatabaseName: names[0].Name(),
+		RoleName:     names[1].Name(),
+	}, nil
+}
+
+// DatabaseRole returns a pointer to the resource representing a database role
+func DatabaseRole() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateDatabaseRole,
+		ReadContext:   ReadDatabaseRole,
+		UpdateContext: UpdateDatabaseRole,
+		DeleteContext: DeleteDatabaseRole,
+
+		Schema:        databaseRoleSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(databaseRoleSchema, upgradeUnchangedV0),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+	}
+}
+
+// CreateDatabaseRole implements schema.CreateContextFunc
+func CreateDatabaseRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	database := d.Get("database").(string)
+	name := d.Get("name").(string)
+
+	builder := snowflake.DatabaseRole(database, name)
+	if v, ok := d.GetOk("comment"); ok {
+		builder.WithComment(v.(string))
+	}
+
+	err := snowflake.ExecContext(ctx, db, builder.Create())
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error creating database role %v", name))
+	}
+
+	roleID := &databaseRoleID{
+		DatabaseName: database,
+		RoleName:     name,
+	}
+	dataIDInput, err := roleID.String()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(dataIDInput)
+
+	return ReadDatabaseRole(ctx, d, meta)
+}
+
+// ReadDatabaseRole implements schema.ReadContextFunc
+func ReadDatabaseRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	roleID, err := databaseRoleIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	builder := snowflake.DatabaseRole(roleID.DatabaseName, roleID.RoleName)
+	row := snowflake.QueryRowContext(ctx, db, builder.Show())
+	role, err := snowflake.ScanDatabaseRole(row)
+	if err == sql.ErrNoRows {
+		// If not found, mark resource to be removed from statefile during apply or refresh
+		log.Printf("[DEBUG] database role (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("database", roleID.DatabaseName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("name", role.Name.String); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("comment", role.Comment.String); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// UpdateDatabaseRole implements schema.UpdateContextFunc
+func UpdateDatabaseRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	roleID, err := databaseRoleIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	builder := snowflake.DatabaseRole(roleID.DatabaseName, roleID.RoleName)
+
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		err = snowflake.ExecContext(ctx, db, builder.Rename(name))
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error renaming database role %v", d.Id()))
+		}
+
+		roleID.RoleName = name
+		dataIDInput, err := roleID.String()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(dataIDInput)
+		builder = snowflake.DatabaseRole(roleID.DatabaseName, roleID.RoleName)
+	}
+
+	if d.HasChange("comment") {
+		var stmt string
+		if c := d.Get("comment").(string); c == "" {
+			stmt = builder.RemoveComment()
+		} else {
+			stmt = builder.ChangeComment(c)
+		}
+		err = snowflake.ExecContext(ctx, db, stmt)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error updating comment on database role %v", d.Id()))
+		}
+	}
+
+	return ReadDatabaseRole(ctx, d, meta)
+}
+
+// DeleteDatabaseRole implements schema.DeleteContextFunc
+func DeleteDatabaseRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	roleID, err := databaseRoleIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = snowflake.ExecContext(ctx, db, snowflake.DatabaseRole(roleID.DatabaseName, roleID.RoleName).Drop())
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error deleting database role %v", d.Id()))
+	}
+
+	d.SetId("")
+	return nil
+}
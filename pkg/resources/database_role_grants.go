@@ -0,0 +1,267 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+var databaseRoleGrantsSchema = map[string]*schema.Schema{
+	"database_name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database containing the database role we are granting.",
+	},
+	"database_role_name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The name of the database role we are granting.",
+		ValidateFunc: func(val interface{}, key string) ([]string, []error) {
+			return snowflake.ValidateIdentifier(val)
+		},
+	},
+	"roles": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Grants the database role to these account roles.",
+	},
+	"database_roles": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Grants the database role to these database roles of the same database.",
+	},
+}
+
+// DatabaseRoleGrants returns a pointer to the resource representing the grants
+// of a database role
+func DatabaseRoleGrants() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateDatabaseRoleGrants,
+		ReadContext:   ReadDatabaseRoleGrants,
+		DeleteContext: DeleteDatabaseRoleGrants,
+		UpdateContext: UpdateDatabaseRoleGrants,
+
+		Schema:        databaseRoleGrantsSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(databaseRoleGrantsSchema, upgradeUnchangedV0),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+	}
+}
+
+// CreateDatabaseRoleGrants implements schema.CreateContextFunc
+func CreateDatabaseRoleGrants(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	database := d.Get("database_name").(string)
+	name := d.Get("database_role_name").(string)
+	roles := expandStringList(d.Get("roles").(*schema.Set).List())
+	databaseRoles := expandStringList(d.Get("database_roles").(*schema.Set).List())
+
+	if len(roles) == 0 && len(databaseRoles) == 0 {
+		return diag.FromErr(fmt.Errorf("no roles or database roles specified for database role grants"))
+	}
+
+	builder := snowflake.DatabaseRole(database, name)
+	for _, role := range roles {
+		err := snowflake.ExecContext(ctx, db, builder.GrantToRole(role))
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error granting database role %v to role %v", name, role))
+		}
+	}
+	for _, databaseRole := range databaseRoles {
+		err := snowflake.ExecContext(ctx, db, builder.GrantToDatabaseRole(databaseRole))
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error granting database role %v to database role %v", name, databaseRole))
+		}
+	}
+
+	roleID := &databaseRoleID{
+		DatabaseName: database,
+		RoleName:     name,
+	}
+	dataIDInput, err := roleID.String()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(dataIDInput)
+
+	return ReadDatabaseRoleGrants(ctx, d, meta)
+}
+
+// ReadDatabaseRoleGrants implements schema.ReadContextFunc
+func ReadDatabaseRoleGrants(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	roleID, err := databaseRoleIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tfRoles := expandStringList(d.Get("roles").(*schema.Set).List())
+	tfDatabaseRoles := expandStringList(d.Get("database_roles").(*schema.Set).List())
+
+	roles := make([]string, 0)
+	databaseRoles := make([]string, 0)
+
+	grants, err := readDatabaseRoleGrants(ctx, db, roleID.DatabaseName, roleID.RoleName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, grant := range grants {
+		switch grant.GrantedTo.String {
+		case "ROLE":
+			for _, tfRole := range tfRoles {
+				if tfRole == grant.GranteeName.String {
+					roles = append(roles, grant.GranteeName.String)
+				}
+			}
+		case "DATABASE_ROLE":
+			for _, tfDatabaseRole := range tfDatabaseRoles {
+				if tfDatabaseRole == grant.GranteeName.String {
+					databaseRoles = append(databaseRoles, grant.GranteeName.String)
+				}
+			}
+		default:
+			return diag.FromErr(fmt.Errorf("unknown grant type %s", grant.GrantedTo.String))
+		}
+	}
+
+	if err = d.Set("database_name", roleID.DatabaseName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("database_role_name", roleID.RoleName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("roles", roles); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("database_roles", databaseRoles); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// readDatabaseRoleGrants lists the grants of a database role. Database role
+// grantees are returned without their database prefix.
+func readDatabaseRoleGrants(ctx context.Context, db *sql.DB, database, name string) ([]*roleGrant, error) {
+	sdb := sqlx.NewDb(db, "snowflake")
+
+	stmt := snowflake.DatabaseRole(database, name).ShowGrantsOf()
+	rows, err := sdb.QueryxContext(ctx, stmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	grants := make([]*roleGrant, 0)
+	for rows.Next() {
+		g := &roleGrant{}
+		err = rows.StructScan(g)
+		if err != nil {
+			return nil, err
+		}
+		grants = append(grants, g)
+	}
+
+	for _, g := range grants {
+		if g.GranteeName.Valid {
+			s := g.GranteeName.String
+			if g.GrantedTo.String == "DATABASE_ROLE" {
+				s = strings.TrimPrefix(s, database+".")
+			}
+			s = strings.TrimPrefix(s, `"`)
+			s = strings.TrimSuffix(s, `"`)
+			g.GranteeName = sql.NullString{String: s}
+		}
+	}
+
+	return grants, rows.Err()
+}
+
+// DeleteDatabaseRoleGrants implements schema.DeleteContextFunc
+func DeleteDatabaseRoleGrants(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	roleID, err := databaseRoleIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	builder := snowflake.DatabaseRole(roleID.DatabaseName, roleID.RoleName)
+
+	for _, role := range expandStringList(d.Get("roles").(*schema.Set).List()) {
+		err = snowflake.ExecContext(ctx, db, builder.RevokeFromRole(role))
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error revoking database role %v from role %v", roleID.RoleName, role))
+		}
+	}
+	for _, databaseRole := range expandStringList(d.Get("database_roles").(*schema.Set).List()) {
+		err = snowflake.ExecContext(ctx, db, builder.RevokeFromDatabaseRole(databaseRole))
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error revoking database role %v from database role %v", roleID.RoleName, databaseRole))
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// UpdateDatabaseRoleGrants implements schema.UpdateContextFunc
+func UpdateDatabaseRoleGrants(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	roleID, err := databaseRoleIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	builder := snowflake.DatabaseRole(roleID.DatabaseName, roleID.RoleName)
+
+	x := func(resource string, grant func(string) string, revoke func(string) string) error {
+		o, n := d.GetChange(resource)
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		for _, target := range expandStringList(os.Difference(ns).List()) {
+			err := snowflake.ExecContext(ctx, db, revoke(target))
+			if err != nil {
+				return errors.Wrapf(err, "error revoking database role %v from %v", roleID.RoleName, target)
+			}
+		}
+		for _, target := range expandStringList(ns.Difference(os).List()) {
+			err := snowflake.ExecContext(ctx, db, grant(target))
+			if err != nil {
+				return errors.Wrapf(err, "error granting database role %v to %v", roleID.RoleName, target)
+			}
+		}
+		return nil
+	}
+
+	err = x("roles", builder.GrantToRole, builder.RevokeFromRole)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = x("database_roles", builder.GrantToDatabaseRole, builder.RevokeFromDatabaseRole)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadDatabaseRoleGrants(ctx, d, meta)
+}
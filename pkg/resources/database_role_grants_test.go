@@ -0,0 +1,93 @@
+package resources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/provider"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/resources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatabaseRoleGrants(t *testing.T) {
+	r := require.New(t)
+	err := resources.DatabaseRoleGrants().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestDatabaseRoleGrantsCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"database_name":      "test-db",
+		"database_role_name": "good_name",
+		"roles":              []interface{}{"role1"},
+		"database_roles":     []interface{}{"reader"},
+	}
+	d := schema.TestResourceDataRaw(t, resources.DatabaseRoleGrants().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^GRANT DATABASE ROLE "test-db"."good_name" TO ROLE "role1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^GRANT DATABASE ROLE "test-db"."good_name" TO DATABASE ROLE "test-db"."reader"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadDatabaseRoleGrants(mock)
+		diags := resources.CreateDatabaseRoleGrants(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("test-db|good_name", d.Id())
+	})
+}
+
+func expectReadDatabaseRoleGrants(mock sqlmock.Sqlmock) {
+	rows := sqlmock.NewRows([]string{
+		"created_on", "role", "granted_to", "grantee_name", "granted_by",
+	}).AddRow(
+		"_", "test-db.good_name", "ROLE", "role1", "",
+	).AddRow(
+		"_", "test-db.good_name", "ROLE", "out-of-band", "",
+	).AddRow(
+		"_", "test-db.good_name", "DATABASE_ROLE", "test-db.reader", "",
+	)
+	mock.ExpectQuery(`^SHOW GRANTS OF DATABASE ROLE "test-db"."good_name"$`).WillReturnRows(rows)
+}
+
+func TestDatabaseRoleGrantsRead(t *testing.T) {
+	r := require.New(t)
+
+	d := databaseRoleGrants(t, "test-db|good_name", map[string]interface{}{
+		"database_name":      "test-db",
+		"database_role_name": "good_name",
+		"roles":              []interface{}{"role1", "role2"},
+		"database_roles":     []interface{}{"reader"},
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadDatabaseRoleGrants(mock)
+		diags := resources.ReadDatabaseRoleGrants(context.Background(), d, db)
+		r.Empty(diags)
+	})
+
+	r.ElementsMatch([]interface{}{"role1"}, d.Get("roles").(*schema.Set).List())
+	r.ElementsMatch([]interface{}{"reader"}, d.Get("database_roles").(*schema.Set).List())
+}
+
+func TestDatabaseRoleGrantsDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := databaseRoleGrants(t, "test-db|drop_it", map[string]interface{}{
+		"database_name":      "test-db",
+		"database_role_name": "drop_it",
+		"roles":              []interface{}{"role1"},
+		"database_roles":     []interface{}{"reader"},
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^REVOKE DATABASE ROLE "test-db"."drop_it" FROM ROLE "role1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^REVOKE DATABASE ROLE "test-db"."drop_it" FROM DATABASE ROLE "test-db"."reader"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		diags := resources.DeleteDatabaseRoleGrants(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
@@ -0,0 +1,80 @@
+package resources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/provider"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/resources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatabaseRole(t *testing.T) {
+	r := require.New(t)
+	err := resources.DatabaseRole().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestDatabaseRoleCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"database": "test-db",
+		"name":     "good_name",
+		"comment":  "great comment",
+	}
+	d := schema.TestResourceDataRaw(t, resources.DatabaseRole().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^CREATE DATABASE ROLE "test-db"."good_name" COMMENT = 'great comment'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadDatabaseRole(mock)
+		diags := resources.CreateDatabaseRole(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("test-db|good_name", d.Id())
+	})
+}
+
+func expectReadDatabaseRole(mock sqlmock.Sqlmock) {
+	rows := sqlmock.NewRows([]string{
+		"created_on", "name", "is_default", "is_current", "is_inherited", "granted_to_roles", "granted_to_database_roles", "granted_database_roles", "owner", "comment", "owner_role_type",
+	}).AddRow("created_on", "good_name", "N", "N", "N", "0", "0", "0", "owner", "mock comment", "ROLE")
+	mock.ExpectQuery(`^SHOW DATABASE ROLES LIKE 'good_name' IN DATABASE "test-db"$`).WillReturnRows(rows)
+}
+
+func TestDatabaseRoleRead(t *testing.T) {
+	r := require.New(t)
+
+	d := databaseRole(t, "test-db|good_name", map[string]interface{}{"database": "test-db", "name": "good_name"})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadDatabaseRole(mock)
+		diags := resources.ReadDatabaseRole(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("mock comment", d.Get("comment").(string))
+		r.Equal("good_name", d.Get("name").(string))
+
+		// Test when resource is not found, checking if state will be empty
+		r.NotEmpty(d.State())
+		mock.ExpectQuery(`^SHOW DATABASE ROLES LIKE 'good_name' IN DATABASE "test-db"$`).WillReturnError(sql.ErrNoRows)
+		diags2 := resources.ReadDatabaseRole(context.Background(), d, db)
+		r.Empty(d.State())
+		r.Empty(diags2)
+	})
+}
+
+func TestDatabaseRoleDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := databaseRole(t, "test-db|drop_it", map[string]interface{}{"database": "test-db", "name": "drop_it"})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^DROP DATABASE ROLE "test-db"."drop_it"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		diags := resources.DeleteDatabaseRole(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
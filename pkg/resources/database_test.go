@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -31,8 +32,8 @@ func TestDatabaseCreate(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`CREATE DATABASE "good_name" COMMENT='great comment`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectRead(mock)
-		err := resources.CreateDatabase(d, db)
-		r.NoError(err)
+		diags := resources.CreateDatabase(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -50,8 +51,8 @@ func TestDatabaseRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectRead(mock)
-		err := resources.ReadDatabase(d, db)
-		r.NoError(err)
+		diags := resources.ReadDatabase(context.Background(), d, db)
+		r.Empty(diags)
 		r.Equal("good_name", d.Get("name").(string))
 		r.Equal("mock comment", d.Get("comment").(string))
 		r.Equal(1, d.Get("data_retention_time_in_days").(int))
@@ -65,8 +66,8 @@ func TestDatabaseDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP DATABASE "drop_it"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteDatabase(d, db)
-		r.NoError(err)
+		diags := resources.DeleteDatabase(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -86,8 +87,8 @@ func TestDatabaseCreateFromShare(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`CREATE DATABASE "good_name" FROM SHARE "abc123"."my_share"`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectRead(mock)
-		err := resources.CreateDatabase(d, db)
-		r.NoError(err)
+		diags := resources.CreateDatabase(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -104,8 +105,8 @@ func TestDatabaseCreateFromDatabase(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`CREATE DATABASE "good_name" CLONE "abc123"`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectRead(mock)
-		err := resources.CreateDatabase(d, db)
-		r.NoError(err)
+		diags := resources.CreateDatabase(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -122,7 +123,133 @@ func TestDatabaseCreateFromReplica(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`CREATE DATABASE "good_name" AS REPLICA OF "abc123"`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectRead(mock)
-		err := resources.CreateDatabase(d, db)
+		diags := resources.CreateDatabase(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
+
+func expectReadReplicationDatabases(mock sqlmock.Sqlmock, replicationAccounts, failoverAccounts string) {
+	mock.ExpectQuery(`^SELECT CURRENT_ACCOUNT\(\) AS "account", CURRENT_REGION\(\) AS "region";$`).WillReturnRows(
+		sqlmock.NewRows([]string{"account", "region"}).AddRow("AB12345", "AWS_US_WEST_2"),
+	)
+	expectShowReplicationDatabases(mock, replicationAccounts, failoverAccounts)
+}
+
+// expectShowReplicationDatabases expects the replication of the database to be
+// read once the current account is known.
+func expectShowReplicationDatabases(mock sqlmock.Sqlmock, replicationAccounts, failoverAccounts string) {
+	rows := sqlmock.NewRows([]string{"account_name", "name", "is_primary", "primary", "replication_allowed_to_accounts", "failover_allowed_to_accounts", "organization_name", "account_locator"}).
+		AddRow("ACCOUNT1", "good_name", true, "AWS_US_WEST_2.MYORG.ACCOUNT1.GOOD_NAME", replicationAccounts, failoverAccounts, "MYORG", "AB12345")
+	mock.ExpectQuery(`^SHOW REPLICATION DATABASES LIKE 'good_name'$`).WillReturnRows(rows)
+}
+
+func TestDatabaseCreateReplication(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name": "good_name",
+		"replication_configuration": []interface{}{map[string]interface{}{
+			"accounts":             []interface{}{"myorg.account2"},
+			"ignore_edition_check": true,
+		}},
+		"failover_configuration": []interface{}{map[string]interface{}{
+			"accounts": []interface{}{"myorg.account2"},
+		}},
+	}
+	d := schema.TestResourceDataRaw(t, resources.Database().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^CREATE DATABASE "good_name"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER DATABASE "good_name" ENABLE REPLICATION TO ACCOUNTS myorg.account2 IGNORE EDITION CHECK$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER DATABASE "good_name" ENABLE FAILOVER TO ACCOUNTS myorg.account2$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectRead(mock)
+		expectReadReplicationDatabases(mock, "AWS_US_WEST_2.MYORG.ACCOUNT1, AWS_US_EAST_1.MYORG.ACCOUNT2", "AWS_US_EAST_1.MYORG.ACCOUNT2")
+
+		diags := resources.CreateDatabase(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal([]interface{}{"myorg.account2"}, d.Get("replication_configuration.0.accounts").(*schema.Set).List())
+		r.Equal(true, d.Get("replication_configuration.0.ignore_edition_check"))
+		r.Equal([]interface{}{"myorg.account2"}, d.Get("failover_configuration.0.accounts").(*schema.Set).List())
+	})
+}
+
+func TestDatabaseReadReplication(t *testing.T) {
+	r := require.New(t)
+
+	d := database(t, "good_name", map[string]interface{}{
+		"name": "good_name",
+		"replication_configuration": []interface{}{map[string]interface{}{
+			"accounts": []interface{}{"myorg.account2"},
+		}},
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectRead(mock)
+		expectReadReplicationDatabases(mock, "AWS_US_WEST_2.MYORG.ACCOUNT1, AWS_US_EAST_1.MYORG.ACCOUNT3", "")
+		diags := resources.ReadDatabase(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal([]interface{}{"MYORG.ACCOUNT3"}, d.Get("replication_configuration.0.accounts").(*schema.Set).List())
+		r.Empty(d.Get("failover_configuration"))
+
+		// replication disabled since, the current account is not read again
+		expectRead(mock)
+		expectShowReplicationDatabases(mock, "AWS_US_WEST_2.MYORG.ACCOUNT1", "")
+		diags = resources.ReadDatabase(context.Background(), d, db)
+		r.Empty(diags)
+		r.Empty(d.Get("replication_configuration"))
+
+		// no longer replicated, so the replication is no longer read
+		expectRead(mock)
+		diags = resources.ReadDatabase(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
+
+func TestDatabaseImportReplication(t *testing.T) {
+	r := require.New(t)
+
+	d := database(t, "good_name", map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadReplicationDatabases(mock, "AWS_US_WEST_2.MYORG.ACCOUNT1, AWS_US_EAST_1.MYORG.ACCOUNT2", "AWS_US_EAST_1.MYORG.ACCOUNT2")
+		_, err := resources.ImportDatabase(context.Background(), d, db)
 		r.NoError(err)
+
+		expectRead(mock)
+		expectShowReplicationDatabases(mock, "AWS_US_WEST_2.MYORG.ACCOUNT1, AWS_US_EAST_1.MYORG.ACCOUNT2", "AWS_US_EAST_1.MYORG.ACCOUNT2")
+		diags := resources.ReadDatabase(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal([]interface{}{"MYORG.ACCOUNT2"}, d.Get("replication_configuration.0.accounts").(*schema.Set).List())
+		r.Equal([]interface{}{"MYORG.ACCOUNT2"}, d.Get("failover_configuration.0.accounts").(*schema.Set).List())
+	})
+}
+
+func TestDatabaseUpdateIgnoreEditionCheck(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name": "good_name",
+		"replication_configuration": []interface{}{map[string]interface{}{
+			"accounts": []interface{}{"myorg.account2"},
+		}},
+	}
+	config := map[string]interface{}{
+		"name": "good_name",
+		"replication_configuration": []interface{}{map[string]interface{}{
+			"accounts":             []interface{}{"myorg.account2"},
+			"ignore_edition_check": true,
+		}},
+	}
+	d := updateData(t, resources.Database(), "good_name", in, config)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER DATABASE "good_name" ENABLE REPLICATION TO ACCOUNTS myorg.account2 IGNORE EDITION CHECK$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectRead(mock)
+		expectReadReplicationDatabases(mock, "AWS_US_WEST_2.MYORG.ACCOUNT1, AWS_US_EAST_1.MYORG.ACCOUNT2", "")
+
+		diags := resources.UpdateDatabase(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal(true, d.Get("replication_configuration.0.ignore_edition_check"))
 	})
 }
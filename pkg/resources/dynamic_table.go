@@ -0,0 +1,379 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+)
+
+const (
+	dynamicTableRefreshModeAuto  = "AUTO"
+	dynamicTableInitializeCreate = "ON_CREATE"
+)
+
+var dynamicTableSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the identifier for the dynamic table; must be unique for the database and schema in which the dynamic table is created.",
+		ForceNew:    true,
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database in which to create the dynamic table.",
+		ForceNew:    true,
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema in which to create the dynamic table.",
+		ForceNew:    true,
+	},
+	"query": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      "Specifies the query whose results the dynamic table materializes.",
+		ForceNew:         true,
+		DiffSuppressFunc: DiffSuppressStatement,
+	},
+	"warehouse": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The warehouse the dynamic table is refreshed with.",
+	},
+	"target_lag": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      "Specifies how far behind the base tables the contents of the dynamic table may be, e.g. `5 minutes`, or `DOWNSTREAM` to refresh only when the dynamic tables depending on it are.",
+		DiffSuppressFunc: diffCaseInsensitive,
+	},
+	"refresh_mode": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      dynamicTableRefreshModeAuto,
+		Description:  "Specifies the refresh mode of the dynamic table, one of AUTO, FULL or INCREMENTAL. With AUTO, Snowflake picks FULL or INCREMENTAL on creation.",
+		ForceNew:     true,
+		ValidateFunc: validation.StringInSlice([]string{dynamicTableRefreshModeAuto, "FULL", "INCREMENTAL"}, false),
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			// the refresh mode is read back as the one Snowflake picked
+			return strings.EqualFold(old, new) || (old != "" && new == dynamicTableRefreshModeAuto)
+		},
+	},
+	"initialize": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      dynamicTableInitializeCreate,
+		Description:  "Specifies when the dynamic table is first refreshed, ON_CREATE or ON_SCHEDULE.",
+		ForceNew:     true,
+		ValidateFunc: validation.StringInSlice([]string{dynamicTableInitializeCreate, "ON_SCHEDULE"}, false),
+	},
+	"cluster_by": {
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "A list of one or more columns/expressions to be used as clustering key(s) for the dynamic table.",
+	},
+	"suspended": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies if the scheduled refreshes of the dynamic table should be suspended.",
+	},
+	"refresh_triggers": {
+		Type:        schema.TypeMap,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Arbitrary values that refresh the dynamic table manually when changed.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the dynamic table.",
+	},
+	"rows": {
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "Number of rows in the dynamic table.",
+	},
+	"owner": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Name of the role that owns the dynamic table.",
+	},
+}
+
+type dynamicTableID struct {
+	DatabaseName     string
+	SchemaName       string
+	DynamicTableName string
+}
+
+// String() takes in a dynamicTableID object and returns a pipe-delimited string:
+// DatabaseName|SchemaName|DynamicTableName
+func (dti *dynamicTableID) String() (string, error) {
+	var buf bytes.Buffer
+	csvWriter := csv.NewWriter(&buf)
+	csvWriter.Comma = pipeIDDelimiter
+	dataIdentifiers := [][]string{{dti.DatabaseName, dti.SchemaName, dti.DynamicTableName}}
+	err := csvWriter.WriteAll(dataIdentifiers)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// dynamicTableIDFromString() takes in a pipe-delimited string: DatabaseName|SchemaName|DynamicTableName
+// and returns a dynamicTableID object
+func dynamicTableIDFromString(stringID string) (*dynamicTableID, error) {
+	reader := csv.NewReader(strings.NewReader(stringID))
+	reader.Comma = pipeIDDelimiter
+	lines, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("Not CSV compatible")
+	}
+
+	if len(lines) != 1 {
+		return nil, fmt.Errorf("1 line per dynamic table")
+	}
+	if len(lines[0]) != 3 {
+		return nil, fmt.Errorf("3 fields allowed")
+	}
+
+	names, err := objectNames(lines[0][:3])
+	if err != nil {
+		return nil, err
+	}
+
+	return &dynamicTableID{
+		DatabaseName:     names[0].Name(),
+		SchemaName:       names[1].Name(),
+		DynamicTableName: names[2].Name(),
+	}, nil
+}
+
+func (dti *dynamicTableID) builder() *snowflake.DynamicTableBuilder {
+	return snowflake.DynamicTable(dti.DynamicTableName, dti.DatabaseName, dti.SchemaName)
+}
+
+// DynamicTable returns a pointer to the resource representing a dynamic table
+func DynamicTable() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateDynamicTable,
+		ReadContext:   ReadDynamicTable,
+		UpdateContext: UpdateDynamicTable,
+		DeleteContext: DeleteDynamicTable,
+
+		Schema:        dynamicTableSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(dynamicTableSchema, upgradeUnchangedV0),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+	}
+}
+
+// CreateDynamicTable implements schema.CreateContextFunc
+func CreateDynamicTable(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	dynamicTableID := &dynamicTableID{
+		DatabaseName:     d.Get("database").(string),
+		SchemaName:       d.Get("schema").(string),
+		DynamicTableName: d.Get("name").(string),
+	}
+
+	builder := dynamicTableID.builder().
+		WithQuery(d.Get("query").(string)).
+		WithWarehouse(d.Get("warehouse").(string)).
+		WithTargetLag(d.Get("target_lag").(string)).
+		WithRefreshMode(d.Get("refresh_mode").(string)).
+		WithInitialize(d.Get("initialize").(string))
+
+	if v, ok := d.GetOk("cluster_by"); ok {
+		builder.WithClustering(expandStringList(v.([]interface{})))
+	}
+
+	if v, ok := d.GetOk("comment"); ok {
+		builder.WithComment(v.(string))
+	}
+
+	err := snowflake.ExecContext(ctx, db, builder.Create())
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error creating dynamic table %v", builder.QualifiedName()))
+	}
+
+	if d.Get("suspended").(bool) {
+		err = snowflake.ExecContext(ctx, db, builder.Suspend())
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error suspending dynamic table %v", builder.QualifiedName()))
+		}
+	}
+
+	dataIDInput, err := dynamicTableID.String()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(dataIDInput)
+
+	return ReadDynamicTable(ctx, d, meta)
+}
+
+// ReadDynamicTable implements schema.ReadContextFunc
+func ReadDynamicTable(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	dynamicTableID, err := dynamicTableIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	row := snowflake.QueryRowContext(ctx, db, dynamicTableID.builder().Show())
+	dt, err := snowflake.ScanDynamicTable(row)
+	if err == sql.ErrNoRows {
+		// If not found, mark resource to be removed from statefile during apply or refresh
+		log.Printf("[DEBUG] dynamic table (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Want to only capture the query because before that is the Create part of the dynamic table which we no longer care about
+	ddl, err := snowflake.ParseDDL(dt.Text.String)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	toSet := map[string]interface{}{
+		"name":         dt.Name,
+		"database":     dt.DatabaseName,
+		"schema":       dt.SchemaName,
+		"query":        ddl.Body,
+		"warehouse":    dt.Warehouse.String,
+		"target_lag":   dt.TargetLag.String,
+		"refresh_mode": dt.RefreshMode.String,
+		"cluster_by":   snowflake.ClusterStatementToList(dt.ClusterBy.String),
+		"suspended":    dt.IsSuspended(),
+		"comment":      dt.Comment.String,
+		"rows":         dt.Rows.Int64,
+		"owner":        dt.Owner,
+	}
+	// the initialization is not listed, e.g. on import
+	if d.Get("initialize").(string) == "" {
+		toSet["initialize"] = dynamicTableInitializeCreate
+	}
+	for key, val := range toSet {
+		err = d.Set(key, val) //lintignore:R001
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+// UpdateDynamicTable implements schema.UpdateContextFunc
+func UpdateDynamicTable(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	dynamicTableID, err := dynamicTableIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	builder := dynamicTableID.builder()
+
+	if d.HasChange("warehouse") {
+		err = snowflake.ExecContext(ctx, db, builder.ChangeWarehouse(d.Get("warehouse").(string)))
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error updating warehouse on dynamic table %v", d.Id()))
+		}
+	}
+
+	if d.HasChange("target_lag") {
+		err = snowflake.ExecContext(ctx, db, builder.ChangeTargetLag(d.Get("target_lag").(string)))
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error updating target lag on dynamic table %v", d.Id()))
+		}
+	}
+
+	if d.HasChange("cluster_by") {
+		var q string
+		if cb := expandStringList(d.Get("cluster_by").([]interface{})); len(cb) > 0 {
+			q = builder.ChangeClusterBy(cb)
+		} else {
+			q = builder.DropClustering()
+		}
+		err = snowflake.ExecContext(ctx, db, q)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error updating clustering on dynamic table %v", d.Id()))
+		}
+	}
+
+	if d.HasChange("comment") {
+		var q string
+		if c := d.Get("comment").(string); c != "" {
+			q = builder.ChangeComment(c)
+		} else {
+			q = builder.RemoveComment()
+		}
+		err = snowflake.ExecContext(ctx, db, q)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error updating comment on dynamic table %v", d.Id()))
+		}
+	}
+
+	if d.HasChange("suspended") {
+		var q string
+		if d.Get("suspended").(bool) {
+			q = builder.Suspend()
+		} else {
+			q = builder.Resume()
+		}
+		err = snowflake.ExecContext(ctx, db, q)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error suspending or resuming dynamic table %v", d.Id()))
+		}
+	}
+
+	if d.HasChange("refresh_triggers") {
+		err = snowflake.ExecContext(ctx, db, builder.Refresh())
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error refreshing dynamic table %v", d.Id()))
+		}
+	}
+
+	return ReadDynamicTable(ctx, d, meta)
+}
+
+// DeleteDynamicTable implements schema.DeleteContextFunc
+func DeleteDynamicTable(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	dynamicTableID, err := dynamicTableIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = snowflake.ExecContext(ctx, db, dynamicTableID.builder().Drop())
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error deleting dynamic table %v", d.Id()))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,85 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_DynamicTable(t *testing.T) {
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: dynamicTableConfig(accName, "1 minute", false, "test comment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_dynamic_table.test", "name", accName),
+					resource.TestCheckResourceAttr("snowflake_dynamic_table.test", "target_lag", "1 minute"),
+					resource.TestCheckResourceAttr("snowflake_dynamic_table.test", "suspended", "false"),
+					resource.TestCheckResourceAttr("snowflake_dynamic_table.test", "comment", "test comment"),
+				),
+			},
+			{
+				Config: dynamicTableConfig(accName, "DOWNSTREAM", true, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_dynamic_table.test", "target_lag", "DOWNSTREAM"),
+					resource.TestCheckResourceAttr("snowflake_dynamic_table.test", "suspended", "true"),
+					resource.TestCheckResourceAttr("snowflake_dynamic_table.test", "comment", ""),
+				),
+			},
+			{
+				ResourceName:            "snowflake_dynamic_table.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"refresh_mode"},
+			},
+		},
+	})
+}
+
+func dynamicTableConfig(n string, lag string, suspended bool, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_database" "test" {
+	name = "%[1]v"
+	comment = "Terraform acceptance test"
+}
+
+resource "snowflake_schema" "test" {
+	name = "%[1]v"
+	database = snowflake_database.test.name
+	comment = "Terraform acceptance test"
+}
+
+resource "snowflake_warehouse" "test" {
+	name = "%[1]v"
+}
+
+resource "snowflake_table" "test" {
+	database = snowflake_database.test.name
+	schema   = snowflake_schema.test.name
+	name     = "%[1]v_SOURCE"
+	change_tracking = true
+
+	column {
+		name = "ID"
+		type = "NUMBER(38,0)"
+	}
+}
+
+resource "snowflake_dynamic_table" "test" {
+	name = "%[1]v"
+	database = snowflake_database.test.name
+	schema = snowflake_schema.test.name
+	warehouse = snowflake_warehouse.test.name
+	query = "SELECT ID FROM \"${snowflake_database.test.name}\".\"${snowflake_schema.test.name}\".\"${snowflake_table.test.name}\""
+	target_lag = "%[2]v"
+	suspended = %[3]v
+	comment = "%[4]v"
+}
+`, n, lag, suspended, comment)
+}
@@ -0,0 +1,128 @@
+package resources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/provider"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/resources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDynamicTable(t *testing.T) {
+	r := require.New(t)
+	err := resources.DynamicTable().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestDynamicTableCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":       "test_dt",
+		"database":   "test_db",
+		"schema":     "test_schema",
+		"query":      "select id from t",
+		"warehouse":  "much_warehouse",
+		"target_lag": "1 minute",
+		"cluster_by": []interface{}{"id"},
+		"suspended":  true,
+		"comment":    "wow comment",
+	}
+
+	d := schema.TestResourceDataRaw(t, resources.DynamicTable().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`^CREATE DYNAMIC TABLE "test_db"."test_schema"."test_dt" TARGET_LAG = '1 minute' WAREHOUSE = "much_warehouse" REFRESH_MODE = AUTO INITIALIZE = ON_CREATE CLUSTER BY \(id\) COMMENT = 'wow comment' AS select id from t$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(
+			`^ALTER DYNAMIC TABLE "test_db"."test_schema"."test_dt" SUSPEND$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		expectReadDynamicTable(mock, "SUSPENDED")
+		diags := resources.CreateDynamicTable(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("test_db|test_schema|test_dt", d.Id())
+	})
+}
+
+func TestDynamicTableRead(t *testing.T) {
+	r := require.New(t)
+
+	d := dynamicTable(t, "test_db|test_schema|test_dt", map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadDynamicTable(mock, "RUNNING")
+		diags := resources.ReadDynamicTable(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("test_dt", d.Get("name").(string))
+		r.Equal("select id from t", d.Get("query").(string))
+		r.Equal("1 minute", d.Get("target_lag").(string))
+		r.Equal("INCREMENTAL", d.Get("refresh_mode").(string))
+		r.Equal("ON_CREATE", d.Get("initialize").(string))
+		r.Equal([]interface{}{"id"}, d.Get("cluster_by").([]interface{}))
+		r.Equal("wow comment", d.Get("comment").(string))
+		r.Equal(42, d.Get("rows").(int))
+		r.False(d.Get("suspended").(bool))
+	})
+}
+
+func TestDynamicTableReadNotFound(t *testing.T) {
+	r := require.New(t)
+
+	d := dynamicTable(t, "test_db|test_schema|test_dt", map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"created_on", "name", "database_name", "schema_name", "text"})
+		mock.ExpectQuery(`^SHOW DYNAMIC TABLES LIKE 'test_dt' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+		diags := resources.ReadDynamicTable(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("", d.Id())
+	})
+}
+
+func TestDynamicTableUpdate(t *testing.T) {
+	r := require.New(t)
+
+	d := dynamicTable(t, "test_db|test_schema|test_dt", map[string]interface{}{
+		"target_lag":       "DOWNSTREAM",
+		"refresh_triggers": map[string]interface{}{"run": "1"},
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER DYNAMIC TABLE "test_db"."test_schema"."test_dt" SET TARGET_LAG = DOWNSTREAM$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER DYNAMIC TABLE "test_db"."test_schema"."test_dt" REFRESH$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadDynamicTable(mock, "RUNNING")
+		diags := resources.UpdateDynamicTable(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
+
+func TestDynamicTableDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := dynamicTable(t, "test_db|test_schema|test_dt", map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^DROP DYNAMIC TABLE "test_db"."test_schema"."test_dt"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		diags := resources.DeleteDynamicTable(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("", d.Id())
+	})
+}
+
+func expectReadDynamicTable(mock sqlmock.Sqlmock, state string) {
+	rows := sqlmock.NewRows([]string{
+		"created_on", "name", "database_name", "schema_name", "cluster_by", "rows", "bytes", "owner", "target_lag", "refresh_mode", "warehouse", "comment", "text", "scheduling_state",
+	}).AddRow(
+		"2023-01-01 00:00:00", "test_dt", "test_db", "test_schema", "LINEAR(id)", 42, 1024, "SYSADMIN", "1 minute", "INCREMENTAL", "much_warehouse", "wow comment",
+		`create or replace dynamic table "test_db"."test_schema"."test_dt" lag = '1 minute' warehouse = much_warehouse cluster by (id) comment = 'wow comment' as select id from t`, state,
+	)
+	mock.ExpectQuery(`^SHOW DYNAMIC TABLES LIKE 'test_dt' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+}
@@ -0,0 +1,294 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"log"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+var externalAccessIntegrationSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the identifier for the external access integration; must be unique in the account.",
+		ForceNew:    true,
+	},
+	"allowed_network_rules": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Required:    true,
+		Description: "The fully qualified names of the egress network rules the integration allows, e.g. from `snowflake_network_rule.fully_qualified_name`.",
+	},
+	"allowed_api_authentication_integrations": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "The security integrations whose OAuth secrets the integration allows.",
+	},
+	"allowed_authentication_secrets": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "The fully qualified names of the secrets the integration allows, e.g. from `snowflake_secret.fully_qualified_name`.",
+	},
+	"enabled": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Specifies whether the integration is enabled.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the external access integration.",
+	},
+	"created_on": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Date and time when the external access integration was created.",
+	},
+}
+
+// ExternalAccessIntegration returns a pointer to the resource representing an external access integration
+func ExternalAccessIntegration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateExternalAccessIntegration,
+		ReadContext:   ReadExternalAccessIntegration,
+		UpdateContext: UpdateExternalAccessIntegration,
+		DeleteContext: DeleteExternalAccessIntegration,
+
+		Schema:        externalAccessIntegrationSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(externalAccessIntegrationSchema, upgradeUnchangedV0),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+	}
+}
+
+// matchQualifiedNames returns the names read back from Snowflake, written as
+// configured when a configured name resolves to the same object
+func matchQualifiedNames(names []string, configured []string) []string {
+	matched := make([]string, len(names))
+	for i, name := range names {
+		matched[i] = name
+		ids, err := snowflake.ParseQualifiedIdentifier(name)
+		if err != nil {
+			continue
+		}
+		parts := make([]string, len(ids))
+		for j, id := range ids {
+			parts[j] = id.Name()
+		}
+		for _, c := range configured {
+			if snowflake.IsQualifiedName(c, parts...) {
+				matched[i] = c
+				break
+			}
+		}
+	}
+	return matched
+}
+
+// CreateExternalAccessIntegration implements schema.CreateContextFunc
+func CreateExternalAccessIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	name := d.Get("name").(string)
+	builder := snowflake.ExternalAccessIntegration(name).
+		WithAllowedNetworkRules(expandStringList(d.Get("allowed_network_rules").(*schema.Set).List())).
+		WithAllowedAPIAuthenticationIntegrations(expandStringList(d.Get("allowed_api_authentication_integrations").(*schema.Set).List())).
+		WithAllowedAuthenticationSecrets(expandStringList(d.Get("allowed_authentication_secrets").(*schema.Set).List())).
+		WithEnabled(d.Get("enabled").(bool)).
+		WithComment(d.Get("comment").(string))
+
+	err := snowflake.ExecContext(ctx, db, builder.Create())
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error creating external access integration %v", name))
+	}
+
+	d.SetId(name)
+
+	return ReadExternalAccessIntegration(ctx, d, meta)
+}
+
+// ReadExternalAccessIntegration implements schema.ReadContextFunc
+func ReadExternalAccessIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	name := d.Id()
+	builder := snowflake.ExternalAccessIntegration(name)
+
+	eai, err := snowflake.ScanExternalAccessIntegration(snowflake.QueryRowContext(ctx, db, builder.Show()))
+	if err == sql.ErrNoRows {
+		// If not found, mark resource to be removed from statefile during apply or refresh
+		log.Printf("[DEBUG] external access integration (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rows, err := snowflake.QueryContext(ctx, db, builder.Describe())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer rows.Close()
+	properties, err := snowflake.ScanIntegrationProperties(rows)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "unable to describe external access integration %v", name))
+	}
+
+	toSet := map[string]interface{}{
+		"name":    eai.Name,
+		"enabled": eai.Enabled.Bool,
+		"comment": eai.Comment.String,
+	}
+	if p, ok := properties["CREATED_ON"]; ok {
+		toSet["created_on"] = p.PropertyValue.String
+	}
+
+	lists := map[string]string{
+		"allowed_network_rules":                   "ALLOWED_NETWORK_RULES",
+		"allowed_api_authentication_integrations": "ALLOWED_API_AUTHENTICATION_INTEGRATIONS",
+		"allowed_authentication_secrets":          "ALLOWED_AUTHENTICATION_SECRETS",
+	}
+	for key, property := range lists {
+		var values []string
+		if p, ok := properties[property]; ok {
+			values = p.ListValues()
+		}
+		toSet[key] = matchQualifiedNames(values, expandStringList(d.Get(key).(*schema.Set).List()))
+	}
+
+	for key, val := range toSet {
+		err = d.Set(key, val) //lintignore:R001
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+// UpdateExternalAccessIntegration implements schema.UpdateContextFunc
+func UpdateExternalAccessIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	builder := snowflake.ExternalAccessIntegration(d.Id())
+
+	if d.HasChange("allowed_network_rules") {
+		err := snowflake.ExecContext(ctx, db, builder.ChangeAllowedNetworkRules(expandStringList(d.Get("allowed_network_rules").(*schema.Set).List())))
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error updating allowed network rules on external access integration %v", d.Id()))
+		}
+	}
+
+	if d.HasChange("allowed_api_authentication_integrations") {
+		var q string
+		if integrations := expandStringList(d.Get("allowed_api_authentication_integrations").(*schema.Set).List()); len(integrations) > 0 {
+			q = builder.ChangeAllowedAPIAuthenticationIntegrations(integrations)
+		} else {
+			q = builder.RemoveAllowedAPIAuthenticationIntegrations()
+		}
+		err := snowflake.ExecContext(ctx, db, q)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error updating allowed api authentication integrations on external access integration %v", d.Id()))
+		}
+	}
+
+	if d.HasChange("allowed_authentication_secrets") {
+		var q string
+		if secrets := expandStringList(d.Get("allowed_authentication_secrets").(*schema.Set).List()); len(secrets) > 0 {
+			q = builder.ChangeAllowedAuthenticationSecrets(secrets)
+		} else {
+			q = builder.RemoveAllowedAuthenticationSecrets()
+		}
+		err := snowflake.ExecContext(ctx, db, q)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error updating allowed authentication secrets on external access integration %v", d.Id()))
+		}
+	}
+
+	if d.HasChange("enabled") {
+		err := snowflake.ExecContext(ctx, db, builder.ChangeEnabled(d.Get("enabled").(bool)))
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error updating enabled on external access integration %v", d.Id()))
+		}
+	}
+
+	if d.HasChange("comment") {
+		var q string
+		if c := d.Get("comment").(string); c != "" {
+			q = builder.ChangeComment(c)
+		} else {
+			q = builder.RemoveComment()
+		}
+		err := snowflake.ExecContext(ctx, db, q)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error updating comment on external access integration %v", d.Id()))
+		}
+	}
+
+	return ReadExternalAccessIntegration(ctx, d, meta)
+}
+
+// DeleteExternalAccessIntegration implements schema.DeleteContextFunc
+func DeleteExternalAccessIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+
+	err := snowflake.ExecContext(ctx, db, snowflake.ExternalAccessIntegration(d.Id()).Drop())
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error deleting external access integration %v", d.Id()))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// expandSecrets returns the secrets of a function or procedure by variable name
+func expandSecrets(d *schema.ResourceData) map[string]string {
+	secrets := map[string]string{}
+	for variable, name := range d.Get("secrets").(map[string]interface{}) {
+		secrets[variable] = name.(string)
+	}
+	return secrets
+}
+
+// readExternalAccessIntegrations sets the integrations of a function or
+// procedure from the value described by Snowflake, written as [A, B]
+func readExternalAccessIntegrations(d *schema.ResourceData, value string) error {
+	names := snowflake.SplitDescribedList(value)
+	configured := []string{}
+	for _, c := range d.Get("external_access_integrations").([]interface{}) {
+		configured = append(configured, c.(string))
+	}
+	return d.Set("external_access_integrations", matchQualifiedNames(names, configured))
+}
+
+// readSecrets sets the secrets of a function or procedure from the value
+// described by Snowflake, a JSON object of the secrets by variable name
+func readSecrets(d *schema.ResourceData, value string) error {
+	secrets := map[string]string{}
+	if value != "" {
+		if err := json.Unmarshal([]byte(value), &secrets); err != nil {
+			return errors.Wrapf(err, "unable to parse secrets %v", value)
+		}
+	}
+	configured := expandSecrets(d)
+	for variable, name := range secrets {
+		secrets[variable] = matchQualifiedNames([]string{name}, []string{configured[variable]})[0]
+	}
+	return d.Set("secrets", secrets)
+}
@@ -0,0 +1,93 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_ExternalAccessIntegration(t *testing.T) {
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: externalAccessIntegrationConfig(accName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_external_access_integration.test", "name", accName),
+					resource.TestCheckResourceAttr("snowflake_external_access_integration.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("snowflake_external_access_integration.test", "allowed_network_rules.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_external_access_integration.test", "allowed_authentication_secrets.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_procedure.test", "external_access_integrations.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_procedure.test", "secrets.cred", fmt.Sprintf(`"%[1]v"."%[1]v"."%[1]v"`, accName)),
+				),
+			},
+			{
+				Config: externalAccessIntegrationConfig(accName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_external_access_integration.test", "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      "snowflake_external_access_integration.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func externalAccessIntegrationConfig(n string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "snowflake_database" "test" {
+	name = "%[1]v"
+	comment = "Terraform acceptance test"
+}
+
+resource "snowflake_schema" "test" {
+	name = "%[1]v"
+	database = snowflake_database.test.name
+	comment = "Terraform acceptance test"
+}
+
+resource "snowflake_network_rule" "test" {
+	name = "%[1]v"
+	database = snowflake_database.test.name
+	schema = snowflake_schema.test.name
+	type = "HOST_PORT"
+	mode = "EGRESS"
+	value_list = ["example.com:443"]
+}
+
+resource "snowflake_secret" "test" {
+	name = "%[1]v"
+	database = snowflake_database.test.name
+	schema = snowflake_schema.test.name
+	type = "GENERIC_STRING"
+	secret_string = "s3cr3t"
+}
+
+resource "snowflake_external_access_integration" "test" {
+	name = "%[1]v"
+	allowed_network_rules = [snowflake_network_rule.test.fully_qualified_name]
+	allowed_authentication_secrets = [snowflake_secret.test.fully_qualified_name]
+	enabled = %[2]v
+}
+
+resource "snowflake_procedure" "test" {
+	name = "%[1]v"
+	database = snowflake_database.test.name
+	schema = snowflake_schema.test.name
+	return_type = "VARCHAR"
+	statement = "return 'ok';"
+	external_access_integrations = [snowflake_external_access_integration.test.name]
+	secrets = {
+		cred = snowflake_secret.test.fully_qualified_name
+	}
+}
+`, n, enabled)
+}
@@ -0,0 +1,124 @@
+package resources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/provider"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/resources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExternalAccessIntegration(t *testing.T) {
+	r := require.New(t)
+	err := resources.ExternalAccessIntegration().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestExternalAccessIntegrationCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":                           "test_integration",
+		"allowed_network_rules":          []interface{}{"test_db.test_schema.test_rule"},
+		"allowed_authentication_secrets": []interface{}{`"TEST_DB"."TEST_SCHEMA"."TEST_SECRET"`},
+		"comment":                        "wow comment",
+	}
+
+	d := schema.TestResourceDataRaw(t, resources.ExternalAccessIntegration().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`^CREATE EXTERNAL ACCESS INTEGRATION "test_integration" ALLOWED_NETWORK_RULES = \(test_db.test_schema.test_rule\) ALLOWED_AUTHENTICATION_SECRETS = \("TEST_DB"."TEST_SCHEMA"."TEST_SECRET"\) ENABLED = true COMMENT = 'wow comment'$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		expectReadExternalAccessIntegration(mock)
+		diags := resources.CreateExternalAccessIntegration(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("test_integration", d.Id())
+		// the names read back resolve to the configured ones, which are kept
+		r.ElementsMatch([]interface{}{"test_db.test_schema.test_rule"}, d.Get("allowed_network_rules").(*schema.Set).List())
+		r.ElementsMatch([]interface{}{`"TEST_DB"."TEST_SCHEMA"."TEST_SECRET"`}, d.Get("allowed_authentication_secrets").(*schema.Set).List())
+	})
+}
+
+func TestExternalAccessIntegrationRead(t *testing.T) {
+	r := require.New(t)
+
+	d := externalAccessIntegration(t, "test_integration", map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadExternalAccessIntegration(mock)
+		diags := resources.ReadExternalAccessIntegration(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("test_integration", d.Get("name").(string))
+		r.True(d.Get("enabled").(bool))
+		r.Equal("wow comment", d.Get("comment").(string))
+		r.ElementsMatch([]interface{}{"TEST_DB.TEST_SCHEMA.TEST_RULE"}, d.Get("allowed_network_rules").(*schema.Set).List())
+		r.Empty(d.Get("allowed_api_authentication_integrations").(*schema.Set).List())
+	})
+}
+
+func TestExternalAccessIntegrationReadNotFound(t *testing.T) {
+	r := require.New(t)
+
+	d := externalAccessIntegration(t, "test_integration", map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"name", "type", "category", "enabled", "comment", "created_on"})
+		mock.ExpectQuery(`^SHOW EXTERNAL ACCESS INTEGRATIONS LIKE 'test_integration'$`).WillReturnRows(rows)
+		diags := resources.ReadExternalAccessIntegration(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("", d.Id())
+	})
+}
+
+func TestExternalAccessIntegrationUpdate(t *testing.T) {
+	r := require.New(t)
+
+	d := externalAccessIntegration(t, "test_integration", map[string]interface{}{
+		"comment": "new comment",
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER EXTERNAL ACCESS INTEGRATION "test_integration" SET ENABLED = true$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER EXTERNAL ACCESS INTEGRATION "test_integration" SET COMMENT = 'new comment'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadExternalAccessIntegration(mock)
+		diags := resources.UpdateExternalAccessIntegration(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
+
+func TestExternalAccessIntegrationDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := externalAccessIntegration(t, "test_integration", map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^DROP INTEGRATION "test_integration"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		diags := resources.DeleteExternalAccessIntegration(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("", d.Id())
+	})
+}
+
+func expectReadExternalAccessIntegration(mock sqlmock.Sqlmock) {
+	showRows := sqlmock.NewRows([]string{
+		"name", "type", "category", "enabled", "comment", "created_on",
+	}).AddRow(
+		"test_integration", "EXTERNAL_ACCESS", "SECURITY", true, "wow comment", "2022-01-01 00:00:00",
+	)
+	mock.ExpectQuery(`^SHOW EXTERNAL ACCESS INTEGRATIONS LIKE 'test_integration'$`).WillReturnRows(showRows)
+
+	describeRows := sqlmock.NewRows([]string{"property", "property_type", "property_value", "property_default"}).
+		AddRow("ENABLED", "Boolean", "true", "false").
+		AddRow("ALLOWED_NETWORK_RULES", "List", "[TEST_DB.TEST_SCHEMA.TEST_RULE]", "[]").
+		AddRow("ALLOWED_AUTHENTICATION_SECRETS", "List", "[TEST_DB.TEST_SCHEMA.TEST_SECRET]", "[]").
+		AddRow("COMMENT", "String", "wow comment", "")
+	mock.ExpectQuery(`^DESCRIBE INTEGRATION "test_integration"$`).WillReturnRows(describeRows)
+}
@@ -2,6 +2,7 @@ package resources
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/csv"
 	"fmt"
@@ -11,6 +12,7 @@ import (
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
@@ -174,14 +176,23 @@ var externalFunctionSchema = map[string]*schema.Schema{
 // ExternalFunction returns a pointer to the resource representing an external function
 func ExternalFunction() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateExternalFunction,
-		Read:   ReadExternalFunction,
-		Delete: DeleteExternalFunction,
-
-		Schema: externalFunctionSchema,
+		CreateContext: CreateExternalFunction,
+		ReadContext:   ReadExternalFunction,
+		DeleteContext: DeleteExternalFunction,
+
+		Schema:        externalFunctionSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(externalFunctionSchema, upgradeQuotedNamesV0("database", "schema", "name")),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 	}
 }
 
@@ -219,16 +230,21 @@ func externalFunctionIDFromString(stringID string) (*externalFunctionID, error)
 		return nil, fmt.Errorf("4 fields allowed")
 	}
 
+	names, err := objectNames(lines[0][:3])
+	if err != nil {
+		return nil, err
+	}
+
 	return &externalFunctionID{
-		DatabaseName:             lines[0][0],
-		SchemaName:               lines[0][1],
-		ExternalFunctionName:     lines[0][2],
+		DatabaseName:             names[0].Name(),
+		SchemaName:               names[1].Name(),
+		ExternalFunctionName:     names[2].Name(),
 		ExternalFunctionArgTypes: lines[0][3],
 	}, nil
 }
 
-// CreateExternalFunction implements schema.CreateFunc
-func CreateExternalFunction(d *schema.ResourceData, meta interface{}) error {
+// CreateExternalFunction implements schema.CreateContextFunc
+func CreateExternalFunction(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	database := d.Get("database").(string)
 	dbSchema := d.Get("schema").(string)
@@ -304,9 +320,9 @@ func CreateExternalFunction(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	stmt := builder.Create()
-	err := snowflake.Exec(db, stmt)
+	err := snowflake.ExecContext(ctx, db, stmt)
 	if err != nil {
-		return errors.Wrapf(err, "error creating external function %v", name)
+		return diag.FromErr(errors.Wrapf(err, "error creating external function %v", name))
 	}
 
 	externalFunctionID := &externalFunctionID{
@@ -317,19 +333,19 @@ func CreateExternalFunction(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := externalFunctionID.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadExternalFunction(d, meta)
+	return ReadExternalFunction(ctx, d, meta)
 }
 
-// ReadExternalFunction implements schema.ReadFunc
-func ReadExternalFunction(d *schema.ResourceData, meta interface{}) error {
+// ReadExternalFunction implements schema.ReadContextFunc
+func ReadExternalFunction(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	externalFunctionID, err := externalFunctionIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := externalFunctionID.DatabaseName
@@ -339,47 +355,47 @@ func ReadExternalFunction(d *schema.ResourceData, meta interface{}) error {
 
 	// Some properties can come from the SHOW EXTERNAL FUNCTION call
 	stmt := snowflake.ExternalFunction(name, dbName, dbSchema).Show()
-	row := snowflake.QueryRow(db, stmt)
+	row := snowflake.QueryRowContext(ctx, db, stmt)
 	externalFunction, err := snowflake.ScanExternalFunction(row)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Note: 'language' must be EXTERNAL and 'is_external_function' set to Y
 	if externalFunction.Language.String != "EXTERNAL" || externalFunction.IsExternalFunction.String != "Y" {
-		return fmt.Errorf("Expected %v to be an external function, got 'language=%v' and 'is_external_function=%v'", d.Id(), externalFunction.Language.String, externalFunction.IsExternalFunction.String)
+		return diag.FromErr(fmt.Errorf("Expected %v to be an external function, got 'language=%v' and 'is_external_function=%v'", d.Id(), externalFunction.Language.String, externalFunction.IsExternalFunction.String))
 	}
 
 	if err := d.Set("name", externalFunction.ExternalFunctionName.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("schema", externalFunction.SchemaName.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("database", externalFunction.DatabaseName.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("comment", externalFunction.Comment.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("created_on", externalFunction.CreatedOn.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Some properties come from the DESCRIBE FUNCTION call
 	stmt = snowflake.ExternalFunction(name, dbName, dbSchema).WithArgTypes(argtypes).Describe()
-	externalFunctionDescriptionRows, err := snowflake.Query(db, stmt)
+	externalFunctionDescriptionRows, err := snowflake.QueryContext(ctx, db, stmt)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	externalFunctionDescription, err := snowflake.ScanExternalFunctionDescription(externalFunctionDescriptionRows)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	for _, desc := range externalFunctionDescription {
@@ -402,7 +418,7 @@ func ReadExternalFunction(d *schema.ResourceData, meta interface{}) error {
 				}
 
 				if err = d.Set("arg", args); err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		case "returns":
@@ -410,7 +426,7 @@ func ReadExternalFunction(d *schema.ResourceData, meta interface{}) error {
 			// We first check for VARIANT
 			if returnType == "VARIANT" {
 				if err = d.Set("return_type", returnType); err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 				break
 			}
@@ -419,19 +435,19 @@ func ReadExternalFunction(d *schema.ResourceData, meta interface{}) error {
 			re := regexp.MustCompile(`^(\w+)\([0-9]*\)$`)
 			match := re.FindStringSubmatch(desc.Value.String)
 			if len(match) < 2 {
-				return errors.Errorf("return_type %s not recognized", returnType)
+				return diag.FromErr(errors.Errorf("return_type %s not recognized", returnType))
 			}
 			if err = d.Set("return_type", match[1]); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 		case "null handling":
 			if err = d.Set("null_input_behavior", desc.Value.String); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "volatility":
 			if err = d.Set("return_behavior", desc.Value.String); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "headers":
 			if desc.Value.Valid && desc.Value.String != "null" {
@@ -449,7 +465,7 @@ func ReadExternalFunction(d *schema.ResourceData, meta interface{}) error {
 				}
 
 				if err = d.Set("header", headers); err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		case "context_headers":
@@ -458,27 +474,27 @@ func ReadExternalFunction(d *schema.ResourceData, meta interface{}) error {
 				contextHeaders := strings.Split(strings.ReplaceAll(strings.ReplaceAll(strings.ReplaceAll(desc.Value.String, "[", ""), "]", ""), "\"", ""), ",")
 
 				if err = d.Set("context_headers", contextHeaders); err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		case "max_batch_rows":
 			if desc.Value.String != "not set" {
 				i, err := strconv.ParseInt(desc.Value.String, 10, 64)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 
 				if err = d.Set("max_batch_rows", i); err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		case "compression":
 			if err = d.Set("compression", desc.Value.String); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "body":
 			if err = d.Set("url_of_proxy_and_resource", desc.Value.String); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "language":
 			// To ignore
@@ -490,12 +506,12 @@ func ReadExternalFunction(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-// DeleteExternalFunction implements schema.DeleteFunc
-func DeleteExternalFunction(d *schema.ResourceData, meta interface{}) error {
+// DeleteExternalFunction implements schema.DeleteContextFunc
+func DeleteExternalFunction(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	externalFunctionID, err := externalFunctionIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := externalFunctionID.DatabaseName
@@ -505,9 +521,9 @@ func DeleteExternalFunction(d *schema.ResourceData, meta interface{}) error {
 
 	q := snowflake.ExternalFunction(name, dbName, dbSchema).WithArgTypes(argtypes).Drop()
 
-	err = snowflake.Exec(db, q)
+	err = snowflake.ExecContext(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error deleting external function %v", d.Id())
+		return diag.FromErr(errors.Wrapf(err, "error deleting external function %v", d.Id()))
 	}
 
 	d.SetId("")
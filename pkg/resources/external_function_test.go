@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -39,8 +40,8 @@ func TestExternalFunctionCreate(t *testing.T) {
 		mock.ExpectExec(`CREATE EXTERNAL FUNCTION "database_name"."schema_name"."my_test_function" \(data varchar\) RETURNS varchar NULL CALLED ON NULL INPUT IMMUTABLE COMMENT = 'user-defined function' API_INTEGRATION = 'test_api_integration_01' HEADERS = \('x-custom-header' = 'snowflake'\) CONTEXT_HEADERS = \(current_timestamp\) COMPRESSION = 'AUTO' AS 'https://123456.execute-api.us-west-2.amazonaws.com/prod/my_test_function'`).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		expectExternalFunctionRead(mock)
-		err := resources.CreateExternalFunction(d, db)
-		r.NoError(err)
+		diags := resources.CreateExternalFunction(context.Background(), d, db)
+		r.Empty(diags)
 		r.Equal("my_test_function", d.Get("name").(string))
 	})
 }
@@ -87,8 +88,8 @@ func TestExternalFunctionRead(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectExternalFunctionRead(mock)
 
-		err := resources.ReadExternalFunction(d, db)
-		r.NoError(err)
+		diags := resources.ReadExternalFunction(context.Background(), d, db)
+		r.Empty(diags)
 		r.Equal("my_test_function", d.Get("name").(string))
 		r.Equal("mock comment", d.Get("comment").(string))
 		r.Equal("VARCHAR", d.Get("return_type").(string))
@@ -122,8 +123,8 @@ func TestExternalFunctionReadReturnTypeVariant(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectExternalFunctionReadVariant(mock)
 
-		err := resources.ReadExternalFunction(d, db)
-		r.NoError(err)
+		diags := resources.ReadExternalFunction(context.Background(), d, db)
+		r.Empty(diags)
 		r.Equal("my_test_function", d.Get("name").(string))
 		r.Equal("mock comment", d.Get("comment").(string))
 		r.Equal("VARIANT", d.Get("return_type").(string))
@@ -157,8 +158,8 @@ func TestExternalFunctionDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP FUNCTION "database_name"."schema_name"."drop_it" ()`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteExternalFunction(d, db)
-		r.NoError(err)
+		diags := resources.DeleteExternalFunction(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -1,12 +1,14 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
@@ -133,20 +135,30 @@ var oauthExternalIntegrationSchema = map[string]*schema.Schema{
 // ExternalOauthIntegration returns a pointer to the resource representing a network policy
 func ExternalOauthIntegration() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateExternalOauthIntegration,
-		Read:   ReadExternalOauthIntegration,
-		Update: UpdateExternalOauthIntegration,
-		Delete: DeleteExternalOauthIntegration,
-
-		Schema: oauthExternalIntegrationSchema,
+		CreateContext: CreateExternalOauthIntegration,
+		ReadContext:   ReadExternalOauthIntegration,
+		UpdateContext: UpdateExternalOauthIntegration,
+		DeleteContext: DeleteExternalOauthIntegration,
+
+		Schema:        oauthExternalIntegrationSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(oauthExternalIntegrationSchema, upgradeUnchangedV0),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 	}
 }
 
-// CreateExternalOauthIntegration implements schema.CreateFunc
-func CreateExternalOauthIntegration(d *schema.ResourceData, meta interface{}) error {
+// CreateExternalOauthIntegration implements schema.CreateContextFunc
+func CreateExternalOauthIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 
@@ -189,54 +201,54 @@ func CreateExternalOauthIntegration(d *schema.ResourceData, meta interface{}) er
 		stmt.SetString(`COMMENT`, d.Get("comment").(string))
 	}
 
-	err := snowflake.Exec(db, stmt.Statement())
+	err := snowflake.ExecContext(ctx, db, stmt.Statement())
 	if err != nil {
-		return errors.Wrap(err, "error creating security integration"+stmt.Statement())
+		return diag.FromErr(errors.Wrap(err, "error creating security integration"+stmt.Statement()))
 	}
 
 	d.SetId(name)
 
-	return ReadExternalOauthIntegration(d, meta)
+	return ReadExternalOauthIntegration(ctx, d, meta)
 }
 
-// ReadExternalOauthIntegration implements schema.ReadFunc
-func ReadExternalOauthIntegration(d *schema.ResourceData, meta interface{}) error {
+// ReadExternalOauthIntegration implements schema.ReadContextFunc
+func ReadExternalOauthIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	id := d.Id()
 
 	stmt := snowflake.ExternalOauthIntegration(id).Show()
-	row := snowflake.QueryRow(db, stmt)
+	row := snowflake.QueryRowContext(ctx, db, stmt)
 
 	// Some properties can come from the SHOW INTEGRATION call
 
 	s, err := snowflake.ScanExternalOauthIntegration(row)
 	if err != nil {
-		return errors.Wrap(err, "could not show security integration")
+		return diag.FromErr(errors.Wrap(err, "could not show security integration"))
 	}
 
 	// Note: category must be Security or something is broken
 	if c := s.Category.String; c != "SECURITY" {
-		return fmt.Errorf("expected %v to be an Security integration, got %v", id, c)
+		return diag.FromErr(fmt.Errorf("expected %v to be an Security integration, got %v", id, c))
 	}
 
 	if err := d.Set("type", strings.TrimPrefix(s.IntegrationType.String, "EXTERNAL_OAUTH - ")); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("name", s.Name.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("enabled", s.Enabled.Bool); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("comment", s.Comment.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("created_on", s.CreatedOn.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Some properties come from the DESCRIBE INTEGRATION call
@@ -244,14 +256,14 @@ func ReadExternalOauthIntegration(d *schema.ResourceData, meta interface{}) erro
 	var k, pType string
 	var v, unused interface{}
 	stmt = snowflake.ExternalOauthIntegration(id).Describe()
-	rows, err := db.Query(stmt)
+	rows, err := db.QueryContext(ctx, stmt)
 	if err != nil {
-		return errors.Wrap(err, "could not describe security integration")
+		return diag.FromErr(errors.Wrap(err, "could not describe security integration"))
 	}
 	defer rows.Close()
 	for rows.Next() {
 		if err := rows.Scan(&k, &pType, &v, &unused); err != nil {
-			return errors.Wrap(err, "unable to parse security integration rows")
+			return diag.FromErr(errors.Wrap(err, "unable to parse security integration rows"))
 		}
 		switch k {
 		case "ENABLED":
@@ -260,25 +272,25 @@ func ReadExternalOauthIntegration(d *schema.ResourceData, meta interface{}) erro
 			// We set this using the SHOW INTEGRATION call so let's ignore it here
 		case "EXTERNAL_OAUTH_ISSUER":
 			if err = d.Set("issuer", v.(string)); err != nil {
-				return errors.Wrap(err, "unable to set issuer for security integration")
+				return diag.FromErr(errors.Wrap(err, "unable to set issuer for security integration"))
 			}
 		case "EXTERNAL_OAUTH_JWS_KEYS_URL":
 			list := []string{}
 			list = append(list, strings.Split(v.(string), ",")...)
 			if err = d.Set("jws_keys_urls", list); err != nil {
-				return errors.Wrap(err, "unable to set jws keys urls for security integration")
+				return diag.FromErr(errors.Wrap(err, "unable to set jws keys urls for security integration"))
 			}
 		case "EXTERNAL_OAUTH_ANY_ROLE_MODE":
 			if err = d.Set("any_role_mode", v.(string)); err != nil {
-				return errors.Wrap(err, "unable to set any role mode for security integration")
+				return diag.FromErr(errors.Wrap(err, "unable to set any role mode for security integration"))
 			}
 		case "EXTERNAL_OAUTH_RSA_PUBLIC_KEY":
 			if err = d.Set("rsa_public_key", v.(string)); err != nil {
-				return errors.Wrap(err, "unable to set rsa public key for security integration")
+				return diag.FromErr(errors.Wrap(err, "unable to set rsa public key for security integration"))
 			}
 		case "EXTERNAL_OAUTH_RSA_PUBLIC_KEY_2":
 			if err = d.Set("rsa_public_key_2", v.(string)); err != nil {
-				return errors.Wrap(err, "unable to set rsa public key 2 for security integration")
+				return diag.FromErr(errors.Wrap(err, "unable to set rsa public key 2 for security integration"))
 			}
 		case "EXTERNAL_OAUTH_BLOCKED_ROLES_LIST":
 			blockedRolesAll := strings.Split(v.(string), ",")
@@ -292,7 +304,7 @@ func ReadExternalOauthIntegration(d *schema.ResourceData, meta interface{}) erro
 			}
 
 			if err = d.Set("blocked_roles", blockedRolesCustom); err != nil {
-				return errors.Wrap(err, "unable to set blocked roles for security integration")
+				return diag.FromErr(errors.Wrap(err, "unable to set blocked roles for security integration"))
 			}
 		case "EXTERNAL_OAUTH_ALLOWED_ROLES_LIST":
 			list := []string{}
@@ -302,7 +314,7 @@ func ReadExternalOauthIntegration(d *schema.ResourceData, meta interface{}) erro
 				}
 			}
 			if err = d.Set("allowed_roles", list); err != nil {
-				return errors.Wrap(err, "unable to set allowed roles for security integration")
+				return diag.FromErr(errors.Wrap(err, "unable to set allowed roles for security integration"))
 			}
 		case "EXTERNAL_OAUTH_AUDIENCE_LIST":
 			list := []string{}
@@ -312,7 +324,7 @@ func ReadExternalOauthIntegration(d *schema.ResourceData, meta interface{}) erro
 				}
 			}
 			if err = d.Set("audience_urls", list); err != nil {
-				return errors.Wrap(err, "unable to set audience urls for security integration")
+				return diag.FromErr(errors.Wrap(err, "unable to set audience urls for security integration"))
 			}
 		case "EXTERNAL_OAUTH_TOKEN_USER_MAPPING_CLAIM":
 			list := []string{}
@@ -322,22 +334,22 @@ func ReadExternalOauthIntegration(d *schema.ResourceData, meta interface{}) erro
 				}
 			}
 			if err = d.Set("token_user_mapping_claims", list); err != nil {
-				return errors.Wrap(err, "unable to set token user mapping claims for security integration")
+				return diag.FromErr(errors.Wrap(err, "unable to set token user mapping claims for security integration"))
 			}
 		case "EXTERNAL_OAUTH_SNOWFLAKE_USER_MAPPING_ATTRIBUTE":
 			if err = d.Set("snowflake_user_mapping_attribute", v.(string)); err != nil {
-				return errors.Wrap(err, "unable to set snowflake mapping attribute for security integration")
+				return diag.FromErr(errors.Wrap(err, "unable to set snowflake mapping attribute for security integration"))
 			}
 		default:
 			log.Printf("[WARN] unexpected security integration property %v returned from Snowflake", k)
 		}
 	}
 
-	return err
+	return diag.FromErr(err)
 }
 
-// UpdateExternalOauthIntegration implements schema.UpdateFunc
-func UpdateExternalOauthIntegration(d *schema.ResourceData, meta interface{}) error {
+// UpdateExternalOauthIntegration implements schema.UpdateContextFunc
+func UpdateExternalOauthIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	id := d.Id()
 
@@ -403,15 +415,15 @@ func UpdateExternalOauthIntegration(d *schema.ResourceData, meta interface{}) er
 	}
 
 	if runSetStatement {
-		if err := snowflake.Exec(db, stmt.Statement()); err != nil {
-			return errors.Wrap(err, "error updating security integration")
+		if err := snowflake.ExecContext(ctx, db, stmt.Statement()); err != nil {
+			return diag.FromErr(errors.Wrap(err, "error updating security integration"))
 		}
 	}
 
-	return ReadExternalOauthIntegration(d, meta)
+	return ReadExternalOauthIntegration(ctx, d, meta)
 }
 
-// DeleteExternalOauthIntegration implements schema.DeleteFunc
-func DeleteExternalOauthIntegration(d *schema.ResourceData, meta interface{}) error {
-	return DeleteResource("", snowflake.ExternalOauthIntegration)(d, meta)
+// DeleteExternalOauthIntegration implements schema.DeleteContextFunc
+func DeleteExternalOauthIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return DeleteResource("", snowflake.ExternalOauthIntegration)(ctx, d, meta)
 }
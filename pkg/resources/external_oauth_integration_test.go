@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -38,8 +39,8 @@ func TestExternalOauthIntegrationCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadExternalOauthIntegration(mock)
 
-		err := resources.CreateExternalOauthIntegration(d, db)
-		r.NoError(err)
+		diags := resources.CreateExternalOauthIntegration(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -51,8 +52,8 @@ func TestExternalOauthIntegrationRead(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadExternalOauthIntegration(mock)
 
-		err := resources.ReadExternalOauthIntegration(d, db)
-		r.NoError(err)
+		diags := resources.ReadExternalOauthIntegration(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -63,8 +64,8 @@ func TestExternalOauthIntegrationDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP SECURITY INTEGRATION "drop_it"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteExternalOauthIntegration(d, db)
-		r.NoError(err)
+		diags := resources.DeleteExternalOauthIntegration(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
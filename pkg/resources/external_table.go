@@ -2,12 +2,14 @@ package resources
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/csv"
 	"fmt"
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -133,15 +135,25 @@ var externalTableSchema = map[string]*schema.Schema{
 
 func ExternalTable() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateExternalTable,
-		Read:   ReadExternalTable,
-		Update: UpdateExternalTable,
-		Delete: DeleteExternalTable,
-
-		Schema: externalTableSchema,
+		CreateContext: CreateExternalTable,
+		ReadContext:   ReadExternalTable,
+		UpdateContext: UpdateExternalTable,
+		DeleteContext: DeleteExternalTable,
+
+		Schema:        externalTableSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(externalTableSchema, upgradeQuotedNamesV0("database", "schema", "name")),
+		},
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 	}
 }
 
@@ -183,16 +195,21 @@ func externalTableIDFromString(stringID string) (*externalTableID, error) {
 		return nil, fmt.Errorf("3 fields allowed")
 	}
 
+	names, err := objectNames(lines[0][:3])
+	if err != nil {
+		return nil, err
+	}
+
 	externalTableResult := &externalTableID{
-		DatabaseName:      lines[0][0],
-		SchemaName:        lines[0][1],
-		ExternalTableName: lines[0][2],
+		DatabaseName:      names[0].Name(),
+		SchemaName:        names[1].Name(),
+		ExternalTableName: names[2].Name(),
 	}
 	return externalTableResult, nil
 }
 
-// CreateExternalTable implements schema.CreateFunc
-func CreateExternalTable(data *schema.ResourceData, meta interface{}) error {
+// CreateExternalTable implements schema.CreateContextFunc
+func CreateExternalTable(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	database := data.Get("database").(string)
 	dbSchema := data.Get("schema").(string)
@@ -241,9 +258,9 @@ func CreateExternalTable(data *schema.ResourceData, meta interface{}) error {
 	}
 
 	stmt := builder.Create()
-	err := snowflake.Exec(db, stmt)
+	err := snowflake.ExecContext(ctx, db, stmt)
 	if err != nil {
-		return errors.Wrapf(err, "error creating externalTable %v", name)
+		return diag.FromErr(errors.Wrapf(err, "error creating externalTable %v", name))
 	}
 
 	externalTableID := &externalTableID{
@@ -253,19 +270,19 @@ func CreateExternalTable(data *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := externalTableID.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	data.SetId(dataIDInput)
 
-	return ReadExternalTable(data, meta)
+	return ReadExternalTable(ctx, data, meta)
 }
 
-// ReadExternalTable implements schema.ReadFunc
-func ReadExternalTable(data *schema.ResourceData, meta interface{}) error {
+// ReadExternalTable implements schema.ReadContextFunc
+func ReadExternalTable(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	externalTableID, err := externalTableIDFromString(data.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := externalTableID.DatabaseName
@@ -273,27 +290,27 @@ func ReadExternalTable(data *schema.ResourceData, meta interface{}) error {
 	name := externalTableID.ExternalTableName
 
 	stmt := snowflake.ExternalTable(name, dbName, schema).Show()
-	row := snowflake.QueryRow(db, stmt)
+	row := snowflake.QueryRowContext(ctx, db, stmt)
 	externalTable, err := snowflake.ScanExternalTable(row)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("name", externalTable.ExternalTableName.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("owner", externalTable.Owner.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-// UpdateExternalTable implements schema.UpdateFunc
-func UpdateExternalTable(data *schema.ResourceData, meta interface{}) error {
+// UpdateExternalTable implements schema.UpdateContextFunc
+func UpdateExternalTable(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	database := data.Get("database").(string)
 	dbSchema := data.Get("schema").(string)
@@ -308,9 +325,9 @@ func UpdateExternalTable(data *schema.ResourceData, meta interface{}) error {
 	}
 
 	stmt := builder.Update()
-	err := snowflake.Exec(db, stmt)
+	err := snowflake.ExecContext(ctx, db, stmt)
 	if err != nil {
-		return errors.Wrapf(err, "error updating externalTable %v", name)
+		return diag.FromErr(errors.Wrapf(err, "error updating externalTable %v", name))
 	}
 
 	externalTableID := &externalTableID{
@@ -320,19 +337,19 @@ func UpdateExternalTable(data *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := externalTableID.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	data.SetId(dataIDInput)
 
-	return ReadExternalTable(data, meta)
+	return ReadExternalTable(ctx, data, meta)
 }
 
-// DeleteExternalTable implements schema.DeleteFunc
-func DeleteExternalTable(data *schema.ResourceData, meta interface{}) error {
+// DeleteExternalTable implements schema.DeleteContextFunc
+func DeleteExternalTable(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	externalTableID, err := externalTableIDFromString(data.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := externalTableID.DatabaseName
@@ -341,9 +358,9 @@ func DeleteExternalTable(data *schema.ResourceData, meta interface{}) error {
 
 	q := snowflake.ExternalTable(externalTableName, dbName, schema).Drop()
 
-	err = snowflake.Exec(db, q)
+	err = snowflake.ExecContext(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error deleting pipe %v", data.Id())
+		return diag.FromErr(errors.Wrapf(err, "error deleting pipe %v", data.Id()))
 	}
 
 	data.SetId("")
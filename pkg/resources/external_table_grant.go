@@ -1,8 +1,11 @@
 package resources
 
 import (
+	"context"
+
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -74,21 +77,30 @@ var externalTableGrantSchema = map[string]*schema.Schema{
 func ExternalTableGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create: CreateExternalTableGrant,
-			Read:   ReadExternalTableGrant,
-			Delete: DeleteExternalTableGrant,
-
-			Schema: externalTableGrantSchema,
+			CreateContext: CreateExternalTableGrant,
+			ReadContext:   ReadExternalTableGrant,
+			DeleteContext: DeleteExternalTableGrant,
+
+			Schema:        externalTableGrantSchema,
+			SchemaVersion: 1,
+			StateUpgraders: []schema.StateUpgrader{
+				stateUpgraderV0(externalTableGrantSchema, upgradeGrantIDV0),
+			},
 			Importer: &schema.ResourceImporter{
 				StateContext: schema.ImportStatePassthroughContext,
 			},
+			Timeouts: &schema.ResourceTimeout{
+				Create: schema.DefaultTimeout(defaultTimeout),
+				Read:   schema.DefaultTimeout(defaultTimeout),
+				Delete: schema.DefaultTimeout(defaultTimeout),
+			},
 		},
 		ValidPrivs: validExternalTablePrivileges,
 	}
 }
 
-// CreateExternalTableGrant implements schema.CreateFunc
-func CreateExternalTableGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateExternalTableGrant implements schema.CreateContextFunc
+func CreateExternalTableGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var externalTableName string
 	if name, ok := d.GetOk("external_table_name"); ok {
 		externalTableName = name.(string)
@@ -101,10 +113,10 @@ func CreateExternalTableGrant(d *schema.ResourceData, meta interface{}) error {
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 
 	if (externalTableName == "") && !futureExternalTables {
-		return errors.New("external_table_name must be set unless on_future is true.")
+		return diag.FromErr(errors.New("external_table_name must be set unless on_future is true."))
 	}
 	if (externalTableName != "") && futureExternalTables {
-		return errors.New("external_table_name must be empty if on_future is true.")
+		return diag.FromErr(errors.New("external_table_name must be empty if on_future is true."))
 	}
 
 	var builder snowflake.GrantBuilder
@@ -114,9 +126,9 @@ func CreateExternalTableGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.ExternalTableGrant(dbName, schemaName, externalTableName)
 	}
 
-	err := createGenericGrant(d, meta, builder)
+	err := createGenericGrant(ctx, d, meta, builder)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grant := &grantID{
@@ -129,18 +141,18 @@ func CreateExternalTableGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := grant.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadExternalTableGrant(d, meta)
+	return ReadExternalTableGrant(ctx, d, meta)
 }
 
-// ReadExternalTableGrant implements schema.ReadFunc
-func ReadExternalTableGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadExternalTableGrant implements schema.ReadContextFunc
+func ReadExternalTableGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -149,11 +161,11 @@ func ReadExternalTableGrant(d *schema.ResourceData, meta interface{}) error {
 
 	err = d.Set("database_name", dbName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("schema_name", schemaName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	futureExternalTablesEnabled := false
 	if externalTableName == "" {
@@ -161,19 +173,19 @@ func ReadExternalTableGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	err = d.Set("external_table_name", externalTableName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("on_future", futureExternalTablesEnabled)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("privilege", priv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("with_grant_option", grantID.GrantOption)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var builder snowflake.GrantBuilder
@@ -183,14 +195,14 @@ func ReadExternalTableGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.ExternalTableGrant(dbName, schemaName, externalTableName)
 	}
 
-	return readGenericGrant(d, meta, externalTableGrantSchema, builder, futureExternalTablesEnabled, validExternalTablePrivileges)
+	return diag.FromErr(readGenericGrant(ctx, d, meta, externalTableGrantSchema, builder, futureExternalTablesEnabled, validExternalTablePrivileges))
 }
 
-// DeleteExternalTableGrant implements schema.DeleteFunc
-func DeleteExternalTableGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteExternalTableGrant implements schema.DeleteContextFunc
+func DeleteExternalTableGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -204,5 +216,5 @@ func DeleteExternalTableGrant(d *schema.ResourceData, meta interface{}) error {
 	} else {
 		builder = snowflake.ExternalTableGrant(dbName, schemaName, externalTableName)
 	}
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
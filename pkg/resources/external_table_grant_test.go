@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 	"time"
@@ -40,8 +41,8 @@ func TestExternalTableGrantCreate(t *testing.T) {
 		mock.ExpectExec(`^GRANT SELECT ON EXTERNAL TABLE "test-db"."PUBLIC"."test-external-table" TO SHARE "test-share-1" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT SELECT ON EXTERNAL TABLE "test-db"."PUBLIC"."test-external-table" TO SHARE "test-share-2" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadExternalTableGrant(mock)
-		err := resources.CreateExternalTableGrant(d, db)
-		r.NoError(err)
+		diags := resources.CreateExternalTableGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -62,8 +63,8 @@ func TestExternalTableGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadExternalTableGrant(mock)
-		err := resources.ReadExternalTableGrant(d, db)
-		r.NoError(err)
+		diags := resources.ReadExternalTableGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 
 	roles := d.Get("roles").(*schema.Set)
@@ -114,8 +115,8 @@ func TestFutureExternalTableGrantCreate(t *testing.T) {
 			`^GRANT SELECT ON FUTURE EXTERNAL TABLES IN SCHEMA "test-db"."PUBLIC" TO ROLE "test-role-2" WITH GRANT OPTION$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFutureExternalTableGrant(mock)
-		err := resources.CreateExternalTableGrant(d, db)
-		r.NoError(err)
+		diags := resources.CreateExternalTableGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 
 	b := require.New(t)
@@ -138,8 +139,8 @@ func TestFutureExternalTableGrantCreate(t *testing.T) {
 			`^GRANT SELECT ON FUTURE EXTERNAL TABLES IN DATABASE "test-db" TO ROLE "test-role-2"$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFutureExternalTableDatabaseGrant(mock)
-		err := resources.CreateExternalTableGrant(d, db)
-		b.NoError(err)
+		diags := resources.CreateExternalTableGrant(context.Background(), d, db)
+		b.Empty(diags)
 	})
 }
 
@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -36,8 +37,8 @@ func TestExternalTableCreate(t *testing.T) {
 		mock.ExpectExec(`CREATE EXTERNAL TABLE "database_name"."schema_name"."good_name" \("column1" OBJECT AS a, "column2" VARCHAR AS b\) WITH LOCATION = location REFRESH_ON_CREATE = true AUTO_REFRESH = true PATTERN = 'pattern' FILE_FORMAT = \( format \) COMMENT = 'great comment'`).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		expectExternalTableRead(mock)
-		err := resources.CreateExternalTable(d, db)
-		r.NoError(err)
+		diags := resources.CreateExternalTable(context.Background(), d, db)
+		r.Empty(diags)
 		r.Equal("good_name", d.Get("name").(string))
 	})
 }
@@ -55,8 +56,8 @@ func TestExternalTableRead(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectExternalTableRead(mock)
 
-		err := resources.ReadExternalTable(d, db)
-		r.NoError(err)
+		diags := resources.ReadExternalTable(context.Background(), d, db)
+		r.Empty(diags)
 		r.Equal("good_name", d.Get("name").(string))
 		r.Equal("mock comment", d.Get("comment").(string))
 	})
@@ -69,7 +70,7 @@ func TestExternalTableDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP EXTERNAL TABLE "database_name"."schema_name"."drop_it"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteExternalTable(d, db)
-		r.NoError(err)
+		diags := resources.DeleteExternalTable(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
@@ -0,0 +1,89 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+var failoverGroupSchema = func() map[string]*schema.Schema {
+	s := newReplicationGroupSchema(snowflake.FailoverGroupKind)
+	s["promote_to_primary"] = &schema.Schema{
+		Type:         schema.TypeBool,
+		Optional:     true,
+		Default:      false,
+		Description:  "Promotes the secondary failover group to primary, e.g. for failover drills. The primary failover group becomes a secondary one. Unsetting it does not demote the group back. Once promoted, allowed_databases, allowed_accounts and replication_schedule are read and can be set like those of a primary group.",
+		RequiredWith: []string{"as_replica_of"},
+	}
+	// read from a promoted group like from a primary one, see promotedOnly
+	for _, key := range promotedGroupKeys {
+		s[key].ConflictsWith = nil
+	}
+	return s
+}()
+
+// promotedGroupKeys are the settings of a primary group that are read once a
+// secondary failover group is promoted.
+var promotedGroupKeys = []string{"allowed_databases", "allowed_accounts", "replication_schedule"}
+
+// FailoverGroup returns a pointer to the resource representing a failover group
+func FailoverGroup() *schema.Resource {
+	res := replicationGroupResource(failoverGroupSchema, CreateFailoverGroup, ReadFailoverGroup, UpdateFailoverGroup, DeleteFailoverGroup)
+	res.CustomizeDiff = customdiff.All(res.CustomizeDiff, promotedOnly)
+	return res
+}
+
+// promotedOnly rejects plans setting the settings of a primary group on a
+// secondary failover group that is not promoted to primary.
+func promotedOnly(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !valuesKnown(d, "as_replica_of", "promote_to_primary") || d.Get("as_replica_of").(string) == "" || d.Get("promote_to_primary").(bool) {
+		return nil
+	}
+	for _, key := range promotedGroupKeys {
+		if ok, known := isSet(d, key); ok && known {
+			return fmt.Errorf("%s can only be set when promote_to_primary is true or as_replica_of is not set", key)
+		}
+	}
+	return nil
+}
+
+// CreateFailoverGroup implements schema.CreateContextFunc
+func CreateFailoverGroup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return createReplicationGroup(snowflake.FailoverGroup, promoteFailoverGroup)(ctx, d, meta)
+}
+
+// ReadFailoverGroup implements schema.ReadContextFunc
+func ReadFailoverGroup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return readReplicationGroup(snowflake.FailoverGroup)(ctx, d, meta)
+}
+
+// UpdateFailoverGroup implements schema.UpdateContextFunc
+func UpdateFailoverGroup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return updateReplicationGroup(snowflake.FailoverGroup, promoteFailoverGroup)(ctx, d, meta)
+}
+
+// DeleteFailoverGroup implements schema.DeleteContextFunc
+func DeleteFailoverGroup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return deleteReplicationGroup(snowflake.FailoverGroup)(ctx, d, meta)
+}
+
+// promoteFailoverGroup promotes the secondary failover group to primary when
+// promote_to_primary is newly set, then reads it
+func promoteFailoverGroup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+
+	if d.HasChange("promote_to_primary") && d.Get("promote_to_primary").(bool) {
+		err := snowflake.ExecContext(ctx, db, snowflake.FailoverGroup(d.Id()).Primary())
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error promoting failover group %v to primary", d.Id()))
+		}
+	}
+
+	return ReadFailoverGroup(ctx, d, meta)
+}
@@ -0,0 +1,157 @@
+package resources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/provider"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/resources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFailoverGroup(t *testing.T) {
+	r := require.New(t)
+	err := resources.FailoverGroup().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func expectReadFailoverGroup(mock sqlmock.Sqlmock, isPrimary bool) {
+	mock.ExpectQuery(`^SELECT CURRENT_ACCOUNT\(\) AS "account", CURRENT_REGION\(\) AS "region";$`).WillReturnRows(
+		sqlmock.NewRows([]string{"account", "region"}).AddRow("AB12345", "AWS_US_WEST_2"),
+	)
+	rows := sqlmock.NewRows([]string{"account_name", "name", "is_primary", "object_types", "allowed_integration_types", "allowed_accounts", "organization_name", "account_locator", "replication_schedule"}).
+		AddRow("ACCOUNT1", "fg", isPrimary, "DATABASES, ROLES", "", "MYORG.ACCOUNT1, MYORG.ACCOUNT2", "MYORG", "AB12345", "10 MINUTE")
+	mock.ExpectQuery(`^SHOW FAILOVER GROUPS$`).WillReturnRows(rows)
+}
+
+func TestFailoverGroupCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":                 "fg",
+		"object_types":         []interface{}{"DATABASES"},
+		"allowed_databases":    []interface{}{"db1"},
+		"allowed_accounts":     []interface{}{"myorg.account2"},
+		"replication_schedule": "10 MINUTE",
+	}
+	d := failoverGroup(t, "", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`^CREATE FAILOVER GROUP "fg" OBJECT_TYPES = DATABASES ALLOWED_DATABASES = "db1" ALLOWED_ACCOUNTS = myorg.account2 REPLICATION_SCHEDULE = '10 MINUTE'$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadFailoverGroup(mock, true)
+		mock.ExpectQuery(`^SHOW DATABASES IN FAILOVER GROUP "fg"$`).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("db1"))
+		mock.ExpectQuery(`^SHOW SHARES IN FAILOVER GROUP "fg"$`).WillReturnRows(sqlmock.NewRows([]string{"name"}))
+
+		diags := resources.CreateFailoverGroup(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("fg", d.Id())
+		r.True(d.Get("is_primary").(bool))
+		r.ElementsMatch([]interface{}{"DATABASES", "ROLES"}, d.Get("object_types").(*schema.Set).List())
+		r.Equal([]interface{}{"myorg.account2"}, d.Get("allowed_accounts").(*schema.Set).List())
+		r.Equal([]interface{}{"db1"}, d.Get("allowed_databases").(*schema.Set).List())
+	})
+}
+
+func TestFailoverGroupCreateReplica(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":               "fg",
+		"as_replica_of":      "myorg.account2.fg",
+		"promote_to_primary": true,
+	}
+	d := failoverGroup(t, "", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^CREATE FAILOVER GROUP "fg" AS REPLICA OF myorg.account2.fg$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER FAILOVER GROUP "fg" PRIMARY$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadFailoverGroup(mock, true)
+		mock.ExpectQuery(`^SHOW DATABASES IN FAILOVER GROUP "fg"$`).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("db1"))
+
+		diags := resources.CreateFailoverGroup(context.Background(), d, db)
+		r.Empty(diags)
+		r.True(d.Get("is_primary").(bool))
+		// the objects replicated stay those of the primary group
+		r.Empty(d.Get("object_types").(*schema.Set).List())
+		// but the group now replicates them
+		r.Equal([]interface{}{"db1"}, d.Get("allowed_databases").(*schema.Set).List())
+		r.Equal([]interface{}{"MYORG.ACCOUNT2"}, d.Get("allowed_accounts").(*schema.Set).List())
+		r.Equal("10 MINUTE", d.Get("replication_schedule"))
+	})
+}
+
+func TestFailoverGroupCustomizeDiff(t *testing.T) {
+	r := require.New(t)
+
+	err := planDiff(resources.FailoverGroup(), map[string]interface{}{
+		"name":              "fg",
+		"as_replica_of":     "myorg.account2.fg",
+		"allowed_databases": []interface{}{"db1"},
+	})
+	r.Error(err)
+	r.Contains(err.Error(), "allowed_databases can only be set when promote_to_primary is true or as_replica_of is not set")
+
+	err = planDiff(resources.FailoverGroup(), map[string]interface{}{
+		"name":                 "fg",
+		"as_replica_of":        "myorg.account2.fg",
+		"promote_to_primary":   true,
+		"allowed_databases":    []interface{}{"db1"},
+		"allowed_accounts":     []interface{}{"myorg.account2"},
+		"replication_schedule": "10 MINUTE",
+	})
+	r.NoError(err)
+}
+
+func TestFailoverGroupReadPromotedDrift(t *testing.T) {
+	r := require.New(t)
+
+	d := failoverGroup(t, "fg", map[string]interface{}{
+		"name":               "fg",
+		"as_replica_of":      "myorg.account2.fg",
+		"promote_to_primary": true,
+		"allowed_databases":  []interface{}{"db1", "db2"},
+		"allowed_accounts":   []interface{}{"myorg.account2"},
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadFailoverGroup(mock, true)
+		mock.ExpectQuery(`^SHOW DATABASES IN FAILOVER GROUP "fg"$`).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("db1"))
+
+		diags := resources.ReadFailoverGroup(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal([]interface{}{"db1"}, d.Get("allowed_databases").(*schema.Set).List())
+		r.Equal([]interface{}{"myorg.account2"}, d.Get("allowed_accounts").(*schema.Set).List())
+		r.Equal("10 MINUTE", d.Get("replication_schedule"))
+	})
+}
+
+func TestFailoverGroupReadNotFound(t *testing.T) {
+	r := require.New(t)
+
+	d := failoverGroup(t, "other", map[string]interface{}{"name": "other", "as_replica_of": "myorg.account2.other"})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadFailoverGroup(mock, false)
+		diags := resources.ReadFailoverGroup(context.Background(), d, db)
+		r.Empty(diags)
+		r.Empty(d.Id())
+	})
+}
+
+func TestFailoverGroupDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := failoverGroup(t, "fg", map[string]interface{}{"name": "fg", "object_types": []interface{}{"ROLES"}})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^DROP FAILOVER GROUP "fg"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		diags := resources.DeleteFailoverGroup(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
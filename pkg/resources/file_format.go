@@ -2,11 +2,15 @@ package resources
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/csv"
 	"fmt"
+	"sort"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
@@ -297,21 +301,34 @@ func (ffi *fileFormatID) String() (string, error) {
 // FileFormat returns a pointer to the resource representing a file format
 func FileFormat() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateFileFormat,
-		Read:   ReadFileFormat,
-		Update: UpdateFileFormat,
-		Delete: DeleteFileFormat,
-		Exists: FileFormatExists,
-
-		Schema: fileFormatSchema,
+		CreateContext: CreateFileFormat,
+		ReadContext:   ReadFileFormat,
+		UpdateContext: UpdateFileFormat,
+		DeleteContext: DeleteFileFormat,
+		Exists:        FileFormatExists,
+
+		Schema:        fileFormatSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(fileFormatSchema, upgradeQuotedNamesV0("database", "schema", "name")),
+		},
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customdiff.All(
+			validateFileFormatOptions,
+		),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 	}
 }
 
-// CreateFileFormat implements schema.CreateFunc
-func CreateFileFormat(data *schema.ResourceData, meta interface{}) error {
+// CreateFileFormat implements schema.CreateContextFunc
+func CreateFileFormat(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 
 	dbName := data.Get("database").(string)
@@ -327,187 +344,187 @@ func CreateFileFormat(data *schema.ResourceData, meta interface{}) error {
 	if v, ok, err := getFormatTypeOption(data, formatType, "compression"); ok && err == nil {
 		builder.WithCompression(v.(string))
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok, err := getFormatTypeOption(data, formatType, "record_delimiter"); ok && err == nil {
 		builder.WithRecordDelimiter(v.(string))
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok, err := getFormatTypeOption(data, formatType, "field_delimiter"); ok && err == nil {
 		builder.WithFieldDelimiter(v.(string))
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok, err := getFormatTypeOption(data, formatType, "file_extension"); ok && err == nil {
 		builder.WithFileExtension(v.(string))
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok, err := getFormatTypeOption(data, formatType, "skip_header"); ok && err == nil {
 		builder.WithSkipHeader(v.(int))
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok, err := getFormatTypeOption(data, formatType, "skip_blank_lines"); ok && err == nil {
 		builder.WithSkipBlankLines(v.(bool))
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok, err := getFormatTypeOption(data, formatType, "date_format"); ok && err == nil {
 		builder.WithDateFormat(v.(string))
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok, err := getFormatTypeOption(data, formatType, "time_format"); ok && err == nil {
 		builder.WithTimeFormat(v.(string))
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok, err := getFormatTypeOption(data, formatType, "timestamp_format"); ok && err == nil {
 		builder.WithTimestampFormat(v.(string))
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok, err := getFormatTypeOption(data, formatType, "binary_format"); ok && err == nil {
 		builder.WithBinaryFormat(v.(string))
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok, err := getFormatTypeOption(data, formatType, "escape"); ok && err == nil {
 		builder.WithEscape(v.(string))
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok, err := getFormatTypeOption(data, formatType, "escape_unenclosed_field"); ok && err == nil {
 		builder.WithEscapeUnenclosedField(snowflake.EscapeString(v.(string)))
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok, err := getFormatTypeOption(data, formatType, "trim_space"); ok && err == nil {
 		builder.WithTrimSpace(v.(bool))
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok, err := getFormatTypeOption(data, formatType, "field_optionally_enclosed_by"); ok && err == nil {
 		builder.WithFieldOptionallyEnclosedBy(v.(string))
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok, err := getFormatTypeOption(data, formatType, "null_if"); ok && err == nil {
 		builder.WithNullIf(expandStringList(v.([]interface{})))
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok, err := getFormatTypeOption(data, formatType, "error_on_column_count_mismatch"); ok && err == nil {
 		builder.WithErrorOnColumnCountMismatch(v.(bool))
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok, err := getFormatTypeOption(data, formatType, "replace_invalid_characters"); ok && err == nil {
 		builder.WithReplaceInvalidCharacters(v.(bool))
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok, err := getFormatTypeOption(data, formatType, "validate_utf8"); ok && err == nil {
 		builder.WithValidateUTF8(v.(bool))
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok, err := getFormatTypeOption(data, formatType, "empty_field_as_null"); ok && err == nil {
 		builder.WithEmptyFieldAsNull(v.(bool))
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok, err := getFormatTypeOption(data, formatType, "skip_byte_order_mark"); ok && err == nil {
 		builder.WithSkipByteOrderMark(v.(bool))
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok, err := getFormatTypeOption(data, formatType, "encoding"); ok && err == nil {
 		builder.WithEncoding(v.(string))
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok, err := getFormatTypeOption(data, formatType, "enable_octal"); ok && err == nil {
 		builder.WithEnableOctal(v.(bool))
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok, err := getFormatTypeOption(data, formatType, "allow_duplicate"); ok && err == nil {
 		builder.WithAllowDuplicate(v.(bool))
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok, err := getFormatTypeOption(data, formatType, "strip_outer_array"); ok && err == nil {
 		builder.WithStripOuterArray(v.(bool))
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok, err := getFormatTypeOption(data, formatType, "strip_null_values"); ok && err == nil {
 		builder.WithStripNullValues(v.(bool))
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok, err := getFormatTypeOption(data, formatType, "ignore_utf8_errors"); ok && err == nil {
 		builder.WithIgnoreUTF8Errors(v.(bool))
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok, err := getFormatTypeOption(data, formatType, "binary_as_text"); ok && err == nil {
 		builder.WithBinaryAsText(v.(bool))
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok, err := getFormatTypeOption(data, formatType, "preserve_space"); ok && err == nil {
 		builder.WithPreserveSpace(v.(bool))
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok, err := getFormatTypeOption(data, formatType, "strip_outer_element"); ok && err == nil {
 		builder.WithStripOuterElement(v.(bool))
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok, err := getFormatTypeOption(data, formatType, "disable_snowflake_data"); ok && err == nil {
 		builder.WithDisableSnowflakeData(v.(bool))
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok, err := getFormatTypeOption(data, formatType, "disable_auto_convert"); ok && err == nil {
 		builder.WithDisableAutoConvert(v.(bool))
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok := data.GetOk("comment"); ok {
@@ -516,9 +533,9 @@ func CreateFileFormat(data *schema.ResourceData, meta interface{}) error {
 
 	q := builder.Create()
 
-	err := snowflake.Exec(db, q)
+	err := snowflake.ExecContext(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error creating file format %v", fileFormatName)
+		return diag.FromErr(errors.Wrapf(err, "error creating file format %v", fileFormatName))
 	}
 
 	fileFormatID := &fileFormatID{
@@ -528,19 +545,19 @@ func CreateFileFormat(data *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := fileFormatID.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	data.SetId(dataIDInput)
 
-	return ReadFileFormat(data, meta)
+	return ReadFileFormat(ctx, data, meta)
 }
 
-// ReadFileFormat implements schema.ReadFunc
-func ReadFileFormat(data *schema.ResourceData, meta interface{}) error {
+// ReadFileFormat implements schema.ReadContextFunc
+func ReadFileFormat(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	fileFormatID, err := fileFormatIDFromString(data.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := fileFormatID.DatabaseName
@@ -548,206 +565,206 @@ func ReadFileFormat(data *schema.ResourceData, meta interface{}) error {
 	fileFormatName := fileFormatID.FileFormatName
 
 	ff := snowflake.FileFormat(fileFormatName, dbName, schemaName).Show()
-	row := snowflake.QueryRow(db, ff)
+	row := snowflake.QueryRowContext(ctx, db, ff)
 
 	f, err := snowflake.ScanFileFormatShow(row)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	opts, err := snowflake.ParseFormatOptions(f.FormatOptions.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("name", f.FileFormatName.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("database", f.DatabaseName.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("schema", f.SchemaName.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("format_type", opts.Type)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("compression", opts.Compression)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("record_delimiter", opts.RecordDelimiter)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("field_delimiter", opts.FieldDelimiter)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("file_extension", opts.FileExtension)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("skip_header", opts.SkipHeader)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("skip_blank_lines", opts.SkipBlankLines)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("date_format", opts.DateFormat)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("time_format", opts.TimeFormat)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("timestamp_format", opts.TimestampFormat)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("binary_format", opts.BinaryFormat)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("escape", opts.Escape)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("escape_unenclosed_field", opts.EscapeUnenclosedField)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("trim_space", opts.TrimSpace)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("field_optionally_enclosed_by", opts.FieldOptionallyEnclosedBy)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("null_if", opts.NullIf)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("error_on_column_count_mismatch", opts.ErrorOnColumnCountMismatch)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("replace_invalid_characters", opts.ReplaceInvalidCharacters)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("validate_utf8", opts.ValidateUTF8)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("empty_field_as_null", opts.EmptyFieldAsNull)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("skip_byte_order_mark", opts.SkipByteOrderMark)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("encoding", opts.Encoding)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("enable_octal", opts.EnabelOctal)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("allow_duplicate", opts.AllowDuplicate)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("strip_outer_array", opts.StripOuterArray)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("strip_null_values", opts.StripNullValues)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("ignore_utf8_errors", opts.IgnoreUTF8Errors)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("binary_as_text", opts.BinaryAsText)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("preserve_space", opts.PreserveSpace)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("strip_outer_element", opts.StripOuterElement)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("disable_snowflake_data", opts.DisableSnowflakeData)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("disable_auto_convert", opts.DisableAutoConvert)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("comment", f.Comment.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-// UpdateFileFormat implements schema.UpdateFunc
-func UpdateFileFormat(data *schema.ResourceData, meta interface{}) error {
+// UpdateFileFormat implements schema.UpdateContextFunc
+func UpdateFileFormat(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	fileFormatID, err := fileFormatIDFromString(data.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := fileFormatID.DatabaseName
@@ -761,36 +778,36 @@ func UpdateFileFormat(data *schema.ResourceData, meta interface{}) error {
 	if data.HasChange("compression") {
 		change := data.Get("compression")
 		q := builder.ChangeCompression(change.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating file format compression on %v", data.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating file format compression on %v", data.Id()))
 		}
 	}
 
 	if data.HasChange("record_delimiter") {
 		change := data.Get("record_delimiter")
 		q := builder.ChangeRecordDelimiter(change.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating file format record delimiter on %v", data.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating file format record delimiter on %v", data.Id()))
 		}
 	}
 
 	if data.HasChange("field_delimiter") {
 		change := data.Get("field_delimiter")
 		q := builder.ChangeFieldDelimiter(change.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating file format field delimiter on %v", data.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating file format field delimiter on %v", data.Id()))
 		}
 	}
 
 	if data.HasChange("file_extension") {
 		change := data.Get("file_extension")
 		q := builder.ChangeFileExtension(change.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating file format file extension on %v", data.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating file format file extension on %v", data.Id()))
 		}
 
 	}
@@ -798,264 +815,264 @@ func UpdateFileFormat(data *schema.ResourceData, meta interface{}) error {
 	if data.HasChange("skip_header") {
 		change := data.Get("skip_header")
 		q := builder.ChangeSkipHeader(change.(int))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating file format skip header on %v", data.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating file format skip header on %v", data.Id()))
 		}
 	}
 
 	if data.HasChange("skip_blank_lines") {
 		change := data.Get("skip_blank_lines")
 		q := builder.ChangeSkipBlankLines(change.(bool))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating file format skip blank lines on %v", data.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating file format skip blank lines on %v", data.Id()))
 		}
 	}
 
 	if data.HasChange("date_format") {
 		change := data.Get("date_format")
 		q := builder.ChangeDateFormat(change.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating file format date format on %v", data.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating file format date format on %v", data.Id()))
 		}
 	}
 
 	if data.HasChange("time_format") {
 		change := data.Get("time_format")
 		q := builder.ChangeTimeFormat(change.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating file format time format on %v", data.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating file format time format on %v", data.Id()))
 		}
 	}
 
 	if data.HasChange("timestamp_format") {
 		change := data.Get("timestamp_format")
 		q := builder.ChangeTimestampFormat(change.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating file format timstamp format on %v", data.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating file format timstamp format on %v", data.Id()))
 		}
 	}
 
 	if data.HasChange("binary_format") {
 		change := data.Get("binary_format")
 		q := builder.ChangeBinaryFormat(change.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating file format binary format on %v", data.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating file format binary format on %v", data.Id()))
 		}
 	}
 
 	if data.HasChange("escape") {
 		change := data.Get("escape")
 		q := builder.ChangeEscape(change.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating file format escape on %v", data.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating file format escape on %v", data.Id()))
 		}
 	}
 
 	if data.HasChange("escape_unenclosed_field") {
 		change := data.Get("escape_unenclosed_field")
 		q := builder.ChangeEscapeUnenclosedField(change.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating file format escape_unenclosed_field on %v", data.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating file format escape_unenclosed_field on %v", data.Id()))
 		}
 	}
 
 	if data.HasChange("field_optionally_enclosed_by") {
 		change := data.Get("field_optionally_enclosed_by")
 		q := builder.ChangeFieldOptionallyEnclosedBy(change.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating file format field_optionally_enclosed_by on %v", data.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating file format field_optionally_enclosed_by on %v", data.Id()))
 		}
 	}
 
 	if data.HasChange("encoding") {
 		change := data.Get("encoding")
 		q := builder.ChangeEncoding(change.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating file format encoding on %v", data.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating file format encoding on %v", data.Id()))
 		}
 	}
 
 	if data.HasChange("comment") {
 		change := data.Get("comment")
 		q := builder.ChangeComment(change.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating file format comment on %v", data.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating file format comment on %v", data.Id()))
 		}
 	}
 
 	if data.HasChange("trim_space") {
 		change := data.Get("trim_space")
 		q := builder.ChangeTrimSpace(change.(bool))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating file format trim_space on %v", data.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating file format trim_space on %v", data.Id()))
 		}
 	}
 
 	if data.HasChange("error_on_column_count_mismatch") {
 		change := data.Get("error_on_column_count_mismatch")
 		q := builder.ChangeErrorOnColumnCountMismatch(change.(bool))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating file format error_on_column_count_mismatch on %v", data.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating file format error_on_column_count_mismatch on %v", data.Id()))
 		}
 	}
 
 	if data.HasChange("replace_invalid_characters") {
 		change := data.Get("replace_invalid_characters")
 		q := builder.ChangeReplaceInvalidCharacters(change.(bool))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating file format replace_invalid_characters on %v", data.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating file format replace_invalid_characters on %v", data.Id()))
 		}
 	}
 
 	if data.HasChange("validate_utf8") {
 		change := data.Get("validate_utf8")
 		q := builder.ChangeValidateUTF8(change.(bool))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating file format validate_utf8 on %v", data.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating file format validate_utf8 on %v", data.Id()))
 		}
 	}
 
 	if data.HasChange("empty_field_as_null") {
 		change := data.Get("empty_field_as_null")
 		q := builder.ChangeEmptyFieldAsNull(change.(bool))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating file format empty_field_as_null on %v", data.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating file format empty_field_as_null on %v", data.Id()))
 		}
 	}
 
 	if data.HasChange("skip_byte_order_mark") {
 		change := data.Get("skip_byte_order_mark")
 		q := builder.ChangeSkipByteOrderMark(change.(bool))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating file format skip_byte_order_mark on %v", data.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating file format skip_byte_order_mark on %v", data.Id()))
 		}
 	}
 
 	if data.HasChange("enable_octal") {
 		change := data.Get("enable_octal")
 		q := builder.ChangeEnableOctal(change.(bool))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating file format enable_octal on %v", data.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating file format enable_octal on %v", data.Id()))
 		}
 	}
 
 	if data.HasChange("allow_duplicate") {
 		change := data.Get("allow_duplicate")
 		q := builder.ChangeAllowDuplicate(change.(bool))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating file format allow_duplicate on %v", data.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating file format allow_duplicate on %v", data.Id()))
 		}
 	}
 
 	if data.HasChange("strip_outer_array") {
 		change := data.Get("strip_outer_array")
 		q := builder.ChangeStripOuterArray(change.(bool))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating file format strip_outer_array on %v", data.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating file format strip_outer_array on %v", data.Id()))
 		}
 	}
 
 	if data.HasChange("strip_null_values") {
 		change := data.Get("strip_null_values")
 		q := builder.ChangeStripNullValues(change.(bool))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating file format strip_null_values on %v", data.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating file format strip_null_values on %v", data.Id()))
 		}
 	}
 
 	if data.HasChange("ignore_utf8_errors") {
 		change := data.Get("ignore_utf8_errors")
 		q := builder.ChangeIgnoreUTF8Errors(change.(bool))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating file format ignore_utf8_errors on %v", data.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating file format ignore_utf8_errors on %v", data.Id()))
 		}
 	}
 
 	if data.HasChange("binary_as_text") {
 		change := data.Get("binary_as_text")
 		q := builder.ChangeBinaryAsText(change.(bool))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating file format binary_as_text on %v", data.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating file format binary_as_text on %v", data.Id()))
 		}
 	}
 
 	if data.HasChange("preserve_space") {
 		change := data.Get("preserve_space")
 		q := builder.ChangePreserveSpace(change.(bool))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating file format preserve_space on %v", data.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating file format preserve_space on %v", data.Id()))
 		}
 	}
 
 	if data.HasChange("strip_outer_element") {
 		change := data.Get("strip_outer_element")
 		q := builder.ChangeStripOuterElement(change.(bool))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating file format strip_outer_element on %v", data.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating file format strip_outer_element on %v", data.Id()))
 		}
 	}
 
 	if data.HasChange("disable_snowflake_data") {
 		change := data.Get("disable_snowflake_data")
 		q := builder.ChangeDisableSnowflakeData(change.(bool))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating file format disable_snowflake_data on %v", data.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating file format disable_snowflake_data on %v", data.Id()))
 		}
 	}
 
 	if data.HasChange("disable_auto_convert") {
 		change := data.Get("disable_auto_convert")
 		q := builder.ChangeDisableAutoConvert(change.(bool))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating file format disable_auto_convert on %v", data.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating file format disable_auto_convert on %v", data.Id()))
 		}
 	}
 
 	if data.HasChange("null_if") {
 		change := data.Get("null_if")
 		q := builder.ChangeNullIf(expandStringList(change.([]interface{})))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating file format null_if on %v", data.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating file format null_if on %v", data.Id()))
 		}
 	}
 
-	return ReadFileFormat(data, meta)
+	return ReadFileFormat(ctx, data, meta)
 }
 
-// DeleteFileFormat implements schema.DeleteFunc
-func DeleteFileFormat(data *schema.ResourceData, meta interface{}) error {
+// DeleteFileFormat implements schema.DeleteContextFunc
+func DeleteFileFormat(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	fileFormatID, err := fileFormatIDFromString(data.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := fileFormatID.DatabaseName
@@ -1064,9 +1081,9 @@ func DeleteFileFormat(data *schema.ResourceData, meta interface{}) error {
 
 	q := snowflake.FileFormat(fileFormatName, dbName, schemaName).Drop()
 
-	err = snowflake.Exec(db, q)
+	err = snowflake.ExecContext(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error deleting file format %v", data.Id())
+		return diag.FromErr(errors.Wrapf(err, "error deleting file format %v", data.Id()))
 	}
 
 	data.SetId("")
@@ -1115,10 +1132,15 @@ func fileFormatIDFromString(stringID string) (*fileFormatID, error) {
 		return nil, fmt.Errorf("4 fields allowed")
 	}
 
+	names, err := objectNames(lines[0][:3])
+	if err != nil {
+		return nil, err
+	}
+
 	return &fileFormatID{
-		DatabaseName:   lines[0][0],
-		SchemaName:     lines[0][1],
-		FileFormatName: lines[0][2],
+		DatabaseName:   names[0].Name(),
+		SchemaName:     names[1].Name(),
+		FileFormatName: names[2].Name(),
 	}, nil
 }
 
@@ -1133,6 +1155,38 @@ func getFormatTypeOption(d *schema.ResourceData, formatType, formatTypeOption st
 	return nil, false, nil
 }
 
+// validateFileFormatOptions rejects options that do not apply to the chosen
+// format_type at plan time.
+func validateFileFormatOptions(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("format_type") {
+		return nil
+	}
+	formatType := d.Get("format_type").(string)
+
+	options := map[string]bool{}
+	for _, typeOptions := range formatTypeOptions {
+		for _, option := range typeOptions {
+			options[option] = true
+		}
+	}
+	keys := make([]string, 0, len(options))
+	for option := range options {
+		keys = append(keys, option)
+	}
+	sort.Strings(keys)
+
+	for _, option := range keys {
+		// mirror getFormatTypeOption, which ignores zero values at apply time
+		if _, ok := d.GetOk(option); !ok || !d.NewValueKnown(option) {
+			continue
+		}
+		if err := validateFormatTypeOptions(formatType, option, formatTypeOptions[formatType]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func validateFormatTypeOptions(formatType, formatTypeOption string, validFormatTypeOptions []string) error {
 	for _, f := range validFormatTypeOptions {
 		if f == formatTypeOption {
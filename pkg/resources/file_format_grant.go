@@ -1,8 +1,11 @@
 package resources
 
 import (
+	"context"
+
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -66,21 +69,30 @@ var fileFormatGrantSchema = map[string]*schema.Schema{
 func FileFormatGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create: CreateFileFormatGrant,
-			Read:   ReadFileFormatGrant,
-			Delete: DeleteFileFormatGrant,
-
-			Schema: fileFormatGrantSchema,
+			CreateContext: CreateFileFormatGrant,
+			ReadContext:   ReadFileFormatGrant,
+			DeleteContext: DeleteFileFormatGrant,
+
+			Schema:        fileFormatGrantSchema,
+			SchemaVersion: 1,
+			StateUpgraders: []schema.StateUpgrader{
+				stateUpgraderV0(fileFormatGrantSchema, upgradeGrantIDV0),
+			},
 			Importer: &schema.ResourceImporter{
 				StateContext: schema.ImportStatePassthroughContext,
 			},
+			Timeouts: &schema.ResourceTimeout{
+				Create: schema.DefaultTimeout(defaultTimeout),
+				Read:   schema.DefaultTimeout(defaultTimeout),
+				Delete: schema.DefaultTimeout(defaultTimeout),
+			},
 		},
 		ValidPrivs: validFileFormatPrivileges,
 	}
 }
 
-// CreateFileFormatGrant implements schema.CreateFunc
-func CreateFileFormatGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateFileFormatGrant implements schema.CreateContextFunc
+func CreateFileFormatGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var fileFormatName string
 	if name, ok := d.GetOk("file_format_name"); ok {
 		fileFormatName = name.(string)
@@ -93,10 +105,10 @@ func CreateFileFormatGrant(d *schema.ResourceData, meta interface{}) error {
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 
 	if (fileFormatName == "") && !futureFileFormats {
-		return errors.New("file_format_name must be set unless on_future is true.")
+		return diag.FromErr(errors.New("file_format_name must be set unless on_future is true."))
 	}
 	if (fileFormatName != "") && futureFileFormats {
-		return errors.New("file_format_name must be empty if on_future is true.")
+		return diag.FromErr(errors.New("file_format_name must be empty if on_future is true."))
 	}
 
 	var builder snowflake.GrantBuilder
@@ -106,9 +118,9 @@ func CreateFileFormatGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.FileFormatGrant(dbName, schemaName, fileFormatName)
 	}
 
-	err := createGenericGrant(d, meta, builder)
+	err := createGenericGrant(ctx, d, meta, builder)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grant := &grantID{
@@ -121,18 +133,18 @@ func CreateFileFormatGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := grant.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadFileFormatGrant(d, meta)
+	return ReadFileFormatGrant(ctx, d, meta)
 }
 
-// ReadFileFormatGrant implements schema.ReadFunc
-func ReadFileFormatGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadFileFormatGrant implements schema.ReadContextFunc
+func ReadFileFormatGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -141,11 +153,11 @@ func ReadFileFormatGrant(d *schema.ResourceData, meta interface{}) error {
 
 	err = d.Set("database_name", dbName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("schema_name", schemaName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	futureFileFormatsEnabled := false
 	if fileFormatName == "" {
@@ -153,19 +165,19 @@ func ReadFileFormatGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	err = d.Set("file_format_name", fileFormatName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("on_future", futureFileFormatsEnabled)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("privilege", priv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("with_grant_option", grantID.GrantOption)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var builder snowflake.GrantBuilder
@@ -175,14 +187,14 @@ func ReadFileFormatGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.FileFormatGrant(dbName, schemaName, fileFormatName)
 	}
 
-	return readGenericGrant(d, meta, fileFormatGrantSchema, builder, futureFileFormatsEnabled, validFileFormatPrivileges)
+	return diag.FromErr(readGenericGrant(ctx, d, meta, fileFormatGrantSchema, builder, futureFileFormatsEnabled, validFileFormatPrivileges))
 }
 
-// DeleteFileFormatGrant implements schema.DeleteFunc
-func DeleteFileFormatGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteFileFormatGrant implements schema.DeleteContextFunc
+func DeleteFileFormatGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -196,5 +208,5 @@ func DeleteFileFormatGrant(d *schema.ResourceData, meta interface{}) error {
 	} else {
 		builder = snowflake.FileFormatGrant(dbName, schemaName, fileFormatName)
 	}
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -39,8 +40,8 @@ func TestFileFormatCreate(t *testing.T) {
 			`^CREATE FILE FORMAT "test_db"."test_schema"."test_file_format" TYPE = 'CSV' NULL_IF = \('NULL'\) SKIP_BLANK_LINES = false TRIM_SPACE = false ERROR_ON_COLUMN_COUNT_MISMATCH = true REPLACE_INVALID_CHARACTERS = false VALIDATE_UTF8 = true EMPTY_FIELD_AS_NULL = false SKIP_BYTE_ORDER_MARK = false COMMENT = 'great comment'$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFileFormat(mock)
-		err := resources.CreateFileFormat(d, db)
-		r.NoError(err)
+		diags := resources.CreateFileFormat(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -60,8 +61,9 @@ func TestFileFormatCreateInvalidOptions(t *testing.T) {
 	r.NotNil(d)
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
-		err := resources.CreateFileFormat(d, db)
-		r.EqualError(err, "validate_utf8 is an invalid format type option for format type JSON")
+		diags := resources.CreateFileFormat(context.Background(), d, db)
+		r.Len(diags, 1)
+		r.Equal("validate_utf8 is an invalid format type option for format type JSON", diags[0].Summary)
 	})
 }
 
@@ -71,3 +73,26 @@ func expectReadFileFormat(mock sqlmock.Sqlmock) {
 	).AddRow("2019-12-23 17:20:50.088 +0000", "test_file_format", "test_db", "test_schema", "CSV", "test", "great comment", `{"TYPE":"CSV","RECORD_DELIMITER":"\n","FIELD_DELIMITER":",","FILE_EXTENSION":null,"SKIP_HEADER":0,"DATE_FORMAT":"AUTO","TIME_FORMAT":"AUTO","TIMESTAMP_FORMAT":"AUTO","BINARY_FORMAT":"HEX","ESCAPE":"NONE","ESCAPE_UNENCLOSED_FIELD":"\\","TRIM_SPACE":false,"FIELD_OPTIONALLY_ENCLOSED_BY":"NONE","NULL_IF":["\\N"],"COMPRESSION":"AUTO","ERROR_ON_COLUMN_COUNT_MISMATCH":false,"VALIDATE_UTF8":false,"SKIP_BLANK_LINES":false,"REPLACE_INVALID_CHARACTERS":false,"EMPTY_FIELD_AS_NULL":false,"SKIP_BYTE_ORDER_MARK":false,"ENCODING":"UTF8"}`)
 	mock.ExpectQuery(`^SHOW FILE FORMATS LIKE 'test_file_format' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
 }
+
+func TestFileFormatCustomizeDiff(t *testing.T) {
+	r := require.New(t)
+
+	err := planDiff(resources.FileFormat(), map[string]interface{}{
+		"name":          "test_file_format",
+		"database":      "test_db",
+		"schema":        "test_schema",
+		"format_type":   "JSON",
+		"validate_utf8": true,
+	})
+	r.Error(err)
+	r.Contains(err.Error(), "validate_utf8 is an invalid format type option for format type JSON")
+
+	err = planDiff(resources.FileFormat(), map[string]interface{}{
+		"name":              "test_file_format",
+		"database":          "test_db",
+		"schema":            "test_schema",
+		"format_type":       "JSON",
+		"strip_outer_array": true,
+	})
+	r.NoError(err)
+}
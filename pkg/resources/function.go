@@ -1,13 +1,14 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
-	"fmt"
 	"log"
 	"regexp"
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
@@ -123,25 +124,49 @@ var functionSchema = map[string]*schema.Schema{
 		ForceNew:    true,
 		Description: "the target path for compiled jar file for Java function.",
 	},
+	"external_access_integrations": {
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		ForceNew:    true,
+		Description: "The external access integrations the function can reach external APIs through.",
+	},
+	"secrets": {
+		Type:        schema.TypeMap,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		ForceNew:    true,
+		Description: "The fully qualified names of the secrets the function can use, by the variable name the handler retrieves them with.",
+	},
 }
 
 // Function returns a pointer to the resource representing a stored function
 func Function() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateFunction,
-		Read:   ReadFunction,
-		Update: UpdateFunction,
-		Delete: DeleteFunction,
-
-		Schema: functionSchema,
+		CreateContext: CreateFunction,
+		ReadContext:   ReadFunction,
+		UpdateContext: UpdateFunction,
+		DeleteContext: DeleteFunction,
+
+		Schema:        functionSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(functionSchema, upgradeDelimitedIDV0),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 	}
 }
 
-// CreateFunction implements schema.CreateFunc
-func CreateFunction(d *schema.ResourceData, meta interface{}) error {
+// CreateFunction implements schema.CreateContextFunc
+func CreateFunction(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 	schema := d.Get("schema").(string)
@@ -202,13 +227,19 @@ func CreateFunction(d *schema.ResourceData, meta interface{}) error {
 		builder.WithTargetPath(v.(string))
 	}
 
+	// external access, the integrations and the secrets they allow
+	if v, ok := d.GetOk("external_access_integrations"); ok {
+		builder.WithExternalAccessIntegrations(expandStringList(v.([]interface{})))
+	}
+	builder.WithSecrets(expandSecrets(d))
+
 	q, err := builder.Create()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	err = snowflake.Exec(db, q)
+	err = snowflake.ExecContext(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error creating function %v", name)
+		return diag.FromErr(errors.Wrapf(err, "error creating function %v", name))
 	}
 
 	functionID := &functionID{
@@ -220,15 +251,15 @@ func CreateFunction(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(functionID.String())
 
-	return ReadFunction(d, meta)
+	return ReadFunction(ctx, d, meta)
 }
 
-// ReadFunction implements schema.ReadFunc
-func ReadFunction(d *schema.ResourceData, meta interface{}) error {
+// ReadFunction implements schema.ReadContextFunc
+func ReadFunction(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	functionID, err := splitFunctionID(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	funct := snowflake.Function(
 		functionID.DatabaseName,
@@ -240,51 +271,48 @@ func ReadFunction(d *schema.ResourceData, meta interface{}) error {
 	// some atributes can be retrieved only by Describe and some only by Show
 	stmt, err := funct.Describe()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	rows, err := snowflake.Query(db, stmt)
+	rows, err := snowflake.QueryContext(ctx, db, stmt)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	defer rows.Close()
 	descPropValues, err := snowflake.ScanFunctionDescription(rows)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	for _, desc := range descPropValues {
 		switch desc.Property.String {
 		case "signature":
 			// Format in Snowflake DB is: (argName argType, argName argType, ...)
-			args := strings.ReplaceAll(strings.ReplaceAll(desc.Value.String, "(", ""), ")", "")
-
-			if args != "" { // Do nothing for functions without arguments
-				argPairs := strings.Split(args, ", ")
+			columns, err := snowflake.ParseSignature(desc.Value.String)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if len(columns) > 0 { // Do nothing for functions without arguments
 				args := []interface{}{}
-
-				for _, argPair := range argPairs {
-					argItem := strings.Split(argPair, " ")
-
-					arg := map[string]interface{}{}
-					arg["name"] = argItem[0]
-					arg["type"] = argItem[1]
-					args = append(args, arg)
+				for _, c := range columns {
+					args = append(args, map[string]interface{}{
+						"name": c.Name,
+						"type": c.Type,
+					})
 				}
-
 				if err = d.Set("arguments", args); err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		case "null handling":
 			if err = d.Set("null_input_behavior", desc.Value.String); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "volatility":
 			if err = d.Set("return_behavior", desc.Value.String); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "body":
 			if err = d.Set("statement", desc.Value.String); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "returns":
 			// Format in Snowflake DB is returnType(<some number>)
@@ -295,12 +323,12 @@ func ReadFunction(d *schema.ResourceData, meta interface{}) error {
 				rt = match[1]
 			}
 			if err = d.Set("return_type", rt); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "language":
 			if snowflake.Contains(languages, desc.Value.String) {
 				if err = d.Set("language", desc.Value.String); err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		case "imports":
@@ -308,16 +336,24 @@ func ReadFunction(d *schema.ResourceData, meta interface{}) error {
 			if importsString != "" { // Do nothing for Java functions without imports
 				imports := strings.Split(importsString, ", ")
 				if err = d.Set("imports", imports); err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		case "handler":
 			if err = d.Set("handler", desc.Value.String); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "target_path":
 			if err = d.Set("target_path", desc.Value.String); err != nil {
-				return err
+				return diag.FromErr(err)
+			}
+		case "external_access_integrations":
+			if err = readExternalAccessIntegrations(d, desc.Value.String); err != nil {
+				return diag.FromErr(err)
+			}
+		case "secrets":
+			if err = readSecrets(d, desc.Value.String); err != nil {
+				return diag.FromErr(err)
 			}
 		case "runtime_version":
 			// runtime version for Java function. currently not used.
@@ -327,7 +363,7 @@ func ReadFunction(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	q := funct.Show()
-	showRows, err := snowflake.Query(db, q)
+	showRows, err := snowflake.QueryContext(ctx, db, q)
 	if err == sql.ErrNoRows {
 		// If not found, mark resource to be removed from statefile during apply or refresh
 		log.Printf("[DEBUG] function (%s) not found", d.Id())
@@ -335,13 +371,13 @@ func ReadFunction(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	defer showRows.Close()
 
 	foundFunctions, err := snowflake.ScanFunctions(showRows)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// function names can be overloaded with different argument types so we
 	// iterate over and find the correct one
@@ -351,7 +387,7 @@ func ReadFunction(d *schema.ResourceData, meta interface{}) error {
 		if v.Arguments.String == argSig {
 			err = d.Set("comment", v.Comment.String)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -359,11 +395,11 @@ func ReadFunction(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-// UpdateFunction implements schema.UpdateFunction
-func UpdateFunction(d *schema.ResourceData, meta interface{}) error {
+// UpdateFunction implements schema.UpdateContextFunction
+func UpdateFunction(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	pID, err := splitFunctionID(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	builder := snowflake.Function(
 		pID.DatabaseName,
@@ -377,11 +413,11 @@ func UpdateFunction(d *schema.ResourceData, meta interface{}) error {
 		name := d.Get("name")
 		q, err := builder.Rename(name.(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
-		err = snowflake.Exec(db, q)
+		err = snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error renaming function %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error renaming function %v", d.Id()))
 		}
 		newID := &functionID{
 			DatabaseName: pID.DatabaseName,
@@ -398,33 +434,33 @@ func UpdateFunction(d *schema.ResourceData, meta interface{}) error {
 		if c := comment.(string); c == "" {
 			q, err := builder.RemoveComment()
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
-			err = snowflake.Exec(db, q)
+			err = snowflake.ExecContext(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error unsetting comment for function %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error unsetting comment for function %v", d.Id()))
 			}
 		} else {
 			q, err := builder.ChangeComment(c)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
-			err = snowflake.Exec(db, q)
+			err = snowflake.ExecContext(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error updating comment for function %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error updating comment for function %v", d.Id()))
 			}
 		}
 	}
 
-	return ReadFunction(d, meta)
+	return ReadFunction(ctx, d, meta)
 }
 
-// DeleteFunction implements schema.DeleteFunc
-func DeleteFunction(d *schema.ResourceData, meta interface{}) error {
+// DeleteFunction implements schema.DeleteContextFunc
+func DeleteFunction(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	pID, err := splitFunctionID(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	builder := snowflake.Function(
 		pID.DatabaseName,
@@ -435,12 +471,12 @@ func DeleteFunction(d *schema.ResourceData, meta interface{}) error {
 
 	q, err := builder.Drop()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = snowflake.Exec(db, q)
+	err = snowflake.ExecContext(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error deleting function %v", d.Id())
+		return diag.FromErr(errors.Wrapf(err, "error deleting function %v", d.Id()))
 	}
 
 	d.SetId("")
@@ -489,22 +525,26 @@ type functionID struct {
 //         FunctionName: FUNC1
 //         ArgTypes: [VARCHAR, DATE, VARCHAR]
 func splitFunctionID(v string) (*functionID, error) {
-	arr := strings.Split(v, "|")
-	if len(arr) != 4 {
-		return nil, fmt.Errorf("ID %v is invalid", v)
+	arr, err := splitObjectID(v, 4)
+	if err != nil {
+		return nil, err
+	}
+	names, err := objectNames(arr[:3])
+	if err != nil {
+		return nil, err
 	}
 
 	return &functionID{
-		DatabaseName: arr[0],
-		SchemaName:   arr[1],
-		FunctionName: arr[2],
+		DatabaseName: names[0].Name(),
+		SchemaName:   names[1].Name(),
+		FunctionName: names[2].Name(),
 		ArgTypes:     strings.Split(arr[3], "-"),
 	}, nil
 }
 
 // the opposite of splitFunctionID
 func (pi *functionID) String() string {
-	return fmt.Sprintf("%v|%v|%v|%v",
+	return formatObjectID(
 		pi.DatabaseName,
 		pi.SchemaName,
 		pi.FunctionName,
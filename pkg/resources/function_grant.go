@@ -1,10 +1,12 @@
 package resources
 
 import (
+	"context"
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -101,21 +103,30 @@ var functionGrantSchema = map[string]*schema.Schema{
 func FunctionGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create: CreateFunctionGrant,
-			Read:   ReadFunctionGrant,
-			Delete: DeleteFunctionGrant,
+			CreateContext: CreateFunctionGrant,
+			ReadContext:   ReadFunctionGrant,
+			DeleteContext: DeleteFunctionGrant,
 
-			Schema: functionGrantSchema,
+			Schema:        functionGrantSchema,
+			SchemaVersion: 1,
+			StateUpgraders: []schema.StateUpgrader{
+				stateUpgraderV0(functionGrantSchema, upgradeGrantIDV0),
+			},
 			Importer: &schema.ResourceImporter{
 				StateContext: schema.ImportStatePassthroughContext,
 			},
+			Timeouts: &schema.ResourceTimeout{
+				Create: schema.DefaultTimeout(defaultTimeout),
+				Read:   schema.DefaultTimeout(defaultTimeout),
+				Delete: schema.DefaultTimeout(defaultTimeout),
+			},
 		},
 		ValidPrivs: validFunctionPrivileges,
 	}
 }
 
-// CreateFunctionGrant implements schema.CreateFunc
-func CreateFunctionGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateFunctionGrant implements schema.CreateContextFunc
+func CreateFunctionGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var (
 		functionName      string
 		arguments         []interface{}
@@ -128,7 +139,7 @@ func CreateFunctionGrant(d *schema.ResourceData, meta interface{}) error {
 		if ret, ok := d.GetOk("return_type"); ok {
 			returnType = strings.ToUpper(ret.(string))
 		} else {
-			return errors.New("return_type must be set when specifying function_name.")
+			return diag.FromErr(errors.New("return_type must be set when specifying function_name."))
 		}
 	}
 	dbName := d.Get("database_name").(string)
@@ -140,10 +151,10 @@ func CreateFunctionGrant(d *schema.ResourceData, meta interface{}) error {
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 
 	if (functionName == "") && !futureFunctions {
-		return errors.New("function_name must be set unless on_future is true.")
+		return diag.FromErr(errors.New("function_name must be set unless on_future is true."))
 	}
 	if (functionName != "") && futureFunctions {
-		return errors.New("function_name must be empty if on_future is true.")
+		return diag.FromErr(errors.New("function_name must be empty if on_future is true."))
 	}
 
 	if functionName != "" {
@@ -159,9 +170,9 @@ func CreateFunctionGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.FunctionGrant(dbName, schemaName, functionName, argumentTypes)
 	}
 
-	err := createGenericGrant(d, meta, builder)
+	err := createGenericGrant(ctx, d, meta, builder)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grant := &grantID{
@@ -174,15 +185,15 @@ func CreateFunctionGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := grant.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadFunctionGrant(d, meta)
+	return ReadFunctionGrant(ctx, d, meta)
 }
 
-// ReadFunctionGrant implements schema.ReadFunc
-func ReadFunctionGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadFunctionGrant implements schema.ReadContextFunc
+func ReadFunctionGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var (
 		functionName  string
 		returnType    string
@@ -191,7 +202,7 @@ func ReadFunctionGrant(d *schema.ResourceData, meta interface{}) error {
 	)
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -200,11 +211,11 @@ func ReadFunctionGrant(d *schema.ResourceData, meta interface{}) error {
 
 	err = d.Set("database_name", dbName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("schema_name", schemaName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	futureFunctionsEnabled := false
 	if functionSignature == "" {
@@ -212,7 +223,7 @@ func ReadFunctionGrant(d *schema.ResourceData, meta interface{}) error {
 	} else {
 		functionSignatureMap, err := parseCallableObjectName(functionSignature)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		functionName = functionSignatureMap["callableName"].(string)
 		returnType = functionSignatureMap["returnType"].(string)
@@ -221,27 +232,27 @@ func ReadFunctionGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	err = d.Set("function_name", functionName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("arguments", arguments)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("return_type", returnType)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("on_future", futureFunctionsEnabled)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("privilege", priv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("with_grant_option", grantID.GrantOption)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var builder snowflake.GrantBuilder
@@ -251,14 +262,14 @@ func ReadFunctionGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.FunctionGrant(dbName, schemaName, functionName, argumentTypes)
 	}
 
-	return readGenericGrant(d, meta, functionGrantSchema, builder, futureFunctionsEnabled, validFunctionPrivileges)
+	return diag.FromErr(readGenericGrant(ctx, d, meta, functionGrantSchema, builder, futureFunctionsEnabled, validFunctionPrivileges))
 }
 
-// DeleteFunctionGrant implements schema.DeleteFunc
-func DeleteFunctionGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteFunctionGrant implements schema.DeleteContextFunc
+func DeleteFunctionGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -271,11 +282,11 @@ func DeleteFunctionGrant(d *schema.ResourceData, meta interface{}) error {
 	} else {
 		functionSignatureMap, err := parseCallableObjectName(grantID.ObjectName)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		functionName := functionSignatureMap["callableName"].(string)
 		argumentTypes := functionSignatureMap["argumentTypes"].([]string)
 		builder = snowflake.FunctionGrant(dbName, schemaName, functionName, argumentTypes)
 	}
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
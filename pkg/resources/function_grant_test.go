@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 	"time"
@@ -48,8 +49,8 @@ func TestFunctionGrantCreate(t *testing.T) {
 		mock.ExpectExec(`^GRANT USAGE ON FUNCTION "test-db"."PUBLIC"."test-function"\(ARRAY, STRING\) TO SHARE "test-share-1" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT USAGE ON FUNCTION "test-db"."PUBLIC"."test-function"\(ARRAY, STRING\) TO SHARE "test-share-2" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFunctionGrant(mock)
-		err := resources.CreateFunctionGrant(d, db)
-		r.NoError(err)
+		diags := resources.CreateFunctionGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -78,8 +79,8 @@ func TestFunctionGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadFunctionGrant(mock)
-		err := resources.ReadFunctionGrant(d, db)
-		r.NoError(err)
+		diags := resources.ReadFunctionGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 
 	roles := d.Get("roles").(*schema.Set)
@@ -130,8 +131,8 @@ func TestFutureFunctionGrantCreate(t *testing.T) {
 			`^GRANT USAGE ON FUTURE FUNCTIONS IN SCHEMA "test-db"."PUBLIC" TO ROLE "test-role-2" WITH GRANT OPTION$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFutureFunctionGrant(mock)
-		err := resources.CreateFunctionGrant(d, db)
-		r.NoError(err)
+		diags := resources.CreateFunctionGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 
 	b := require.New(t)
@@ -154,8 +155,8 @@ func TestFutureFunctionGrantCreate(t *testing.T) {
 			`^GRANT USAGE ON FUTURE FUNCTIONS IN DATABASE "test-db" TO ROLE "test-role-2"$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFutureFunctionDatabaseGrant(mock)
-		err := resources.CreateFunctionGrant(d, db)
-		b.NoError(err)
+		diags := resources.CreateFunctionGrant(context.Background(), d, db)
+		b.Empty(diags)
 	})
 }
 
@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -44,8 +45,8 @@ func TestFunctionCreate(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`CREATE OR REPLACE FUNCTION "my_db"."my_schema"."my_funct"\(data VARCHAR, event_dt DATE\) RETURNS VARCHAR CALLED ON NULL INPUT IMMUTABLE COMMENT = 'user-defined function' AS \$\$hi\$\$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectFunctionRead(mock)
-		err := resources.CreateFunction(d, db)
-		r.NoError(err)
+		diags := resources.CreateFunction(context.Background(), d, db)
+		r.Empty(diags)
 		r.Equal("my_funct", d.Get("name").(string))
 		r.Equal("VARCHAR", d.Get("return_type").(string))
 		r.Equal("user-defined function", d.Get("comment").(string))
@@ -76,8 +77,8 @@ func TestFunctionRead(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectFunctionRead(mock)
 
-		err := resources.ReadFunction(d, db)
-		r.NoError(err)
+		diags := resources.ReadFunction(context.Background(), d, db)
+		r.Empty(diags)
 		r.Equal("my_funct", d.Get("name").(string))
 		r.Equal("user-defined function", d.Get("comment").(string))
 		r.Equal("VARCHAR", d.Get("return_type").(string))
@@ -103,7 +104,42 @@ func TestFunctionDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP FUNCTION "my_db"."my_schema"."my_funct"\(VARCHAR, DATE\)`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteFunction(d, db)
-		r.NoError(err)
+		diags := resources.DeleteFunction(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
+
+func TestFunctionReadExternalAccess(t *testing.T) {
+	r := require.New(t)
+
+	d := function(t, "my_db|my_schema|my_funct|", map[string]interface{}{
+		"name":                         "my_funct",
+		"database":                     "my_db",
+		"schema":                       "my_schema",
+		"return_type":                  "varchar",
+		"statement":                    functionBody,
+		"external_access_integrations": []interface{}{"api_integration"},
+		"secrets":                      map[string]interface{}{"cred": "my_db.my_schema.api_secret"},
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		describeRows := sqlmock.NewRows([]string{"property", "value"}).
+			AddRow("signature", "()").
+			AddRow("returns", "VARCHAR(123456789)").
+			AddRow("language", "JAVA").
+			AddRow("body", functionBody).
+			AddRow("external_access_integrations", "[API_INTEGRATION]").
+			AddRow("secrets", `{"cred":"MY_DB.MY_SCHEMA.API_SECRET"}`)
+		mock.ExpectQuery(`DESCRIBE FUNCTION "my_db"."my_schema"."my_funct"\(\)`).WillReturnRows(describeRows)
+
+		rows := sqlmock.NewRows([]string{"created_on", "name", "schema_name", "is_builtin", "is_aggregate", "is_ansi", "min_num_arguments", "max_num_arguments", "arguments", "description", "catalog_name", "is_table_function", "valid_for_clustering", "is_secure"}).
+			AddRow("now", "my_funct", "my_schema", "N", "N", "N", "0", "0", "MY_FUNCT() RETURN VARCHAR", "mock comment", "my_db", "N", "N", "N")
+		mock.ExpectQuery(`SHOW USER FUNCTIONS LIKE 'my_funct' IN SCHEMA "my_db"."my_schema"`).WillReturnRows(rows)
+
+		diags := resources.ReadFunction(context.Background(), d, db)
+		r.Empty(diags)
+		// the names read back resolve to the configured ones, which are kept
+		r.Equal([]interface{}{"api_integration"}, d.Get("external_access_integrations").([]interface{}))
+		r.Equal(map[string]interface{}{"cred": "my_db.my_schema.api_secret"}, d.Get("secrets").(map[string]interface{}))
 	})
 }
@@ -2,6 +2,7 @@ package resources
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/csv"
 	"fmt"
@@ -102,8 +103,10 @@ func (gi *grantID) String() (string, error) {
 	return strGrantID, nil
 }
 
-// grantIDFromString() takes in a pipe-delimited string: resourceName|schemaName|ObjectName|Privilege|Roles
-// and returns a grantID object
+// grantIDFromString() takes in a pipe-delimited string: resourceName|schemaName|ObjectName|Privilege|Roles|GrantOption
+// and returns a grantID object. The grant option can be left out when
+// importing, in which case it is false; IDs stored without it are upgraded by
+// upgradeGrantIDV0.
 func grantIDFromString(stringID string) (*grantID, error) {
 	reader := csv.NewReader(strings.NewReader(stringID))
 	reader.Comma = grantIDDelimiter
@@ -119,10 +122,7 @@ func grantIDFromString(stringID string) (*grantID, error) {
 		return nil, fmt.Errorf("5 or 6 fields allowed")
 	}
 
-	grantOption := false
-	if len(lines[0]) == 6 && lines[0][5] == "true" {
-		grantOption = true
-	}
+	grantOption := len(lines[0]) == 6 && lines[0][5] == "true"
 
 	grantResult := &grantID{
 		ResourceName: lines[0][0],
@@ -136,7 +136,7 @@ func grantIDFromString(stringID string) (*grantID, error) {
 }
 
 // createGenericGrantRolesAndShares will create generic grants for a set of roles and shares
-func createGenericGrantRolesAndShares(
+func createGenericGrantRolesAndShares(ctx context.Context,
 	meta interface{},
 	builder snowflake.GrantBuilder,
 	priv string,
@@ -146,14 +146,14 @@ func createGenericGrantRolesAndShares(
 ) error {
 	db := meta.(*sql.DB)
 	for _, role := range roles {
-		err := snowflake.Exec(db, builder.Role(role).Grant(priv, grantOption))
+		err := snowflake.ExecContext(ctx, db, builder.Role(role).Grant(priv, grantOption))
 		if err != nil {
 			return err
 		}
 	}
 
 	for _, share := range shares {
-		err := snowflake.Exec(db, builder.Share(share).Grant(priv, grantOption))
+		err := snowflake.ExecContext(ctx, db, builder.Share(share).Grant(priv, grantOption))
 		if err != nil {
 			return err
 		}
@@ -161,12 +161,12 @@ func createGenericGrantRolesAndShares(
 	return nil
 }
 
-func createGenericGrant(d *schema.ResourceData, meta interface{}, builder snowflake.GrantBuilder) error {
+func createGenericGrant(ctx context.Context, d *schema.ResourceData, meta interface{}, builder snowflake.GrantBuilder) error {
 	priv := d.Get("privilege").(string)
 	grantOption := d.Get("with_grant_option").(bool)
 	roles, shares := expandRolesAndShares(d)
 
-	return createGenericGrantRolesAndShares(
+	return createGenericGrantRolesAndShares(ctx,
 		meta,
 		builder,
 		priv,
@@ -176,7 +176,7 @@ func createGenericGrant(d *schema.ResourceData, meta interface{}, builder snowfl
 	)
 }
 
-func readGenericGrant(
+func readGenericGrant(ctx context.Context,
 	d *schema.ResourceData,
 	meta interface{},
 	grantSchema map[string]*schema.Schema,
@@ -187,9 +187,9 @@ func readGenericGrant(
 	var grants []*grant
 	var err error
 	if futureObjects {
-		grants, err = readGenericFutureGrants(db, builder)
+		grants, err = readGenericFutureGrants(ctx, db, builder)
 	} else {
-		grants, err = readGenericCurrentGrants(db, builder)
+		grants, err = readGenericCurrentGrants(ctx, db, builder)
 	}
 	if err != nil {
 		// HACK HACK: If the object doesn't exist or not authorized then we can assume someone deleted it
@@ -241,6 +241,11 @@ func readGenericGrant(
 			privileges.addString(grant.Privilege)
 			// Reassign set back
 			sharePrivileges[granteeNameStrippedAccount] = privileges
+		case "DATABASE_ROLE":
+			// Privileges granted to database roles are managed by
+			// snowflake_object_grants, not by the single privilege grant
+			// resources.
+			continue
 		default:
 			return fmt.Errorf("unknown grantee type %s", grant.GranteeType)
 		}
@@ -288,9 +293,9 @@ func readGenericGrant(
 	return nil
 }
 
-func readGenericCurrentGrants(db *sql.DB, builder snowflake.GrantBuilder) ([]*grant, error) {
+func readGenericCurrentGrants(ctx context.Context, db *sql.DB, builder snowflake.GrantBuilder) ([]*grant, error) {
 	stmt := builder.Show()
-	rows, err := snowflake.Query(db, stmt)
+	rows, err := snowflake.QueryContext(ctx, db, stmt)
 	if err != nil {
 		return nil, err
 	}
@@ -325,11 +330,11 @@ func readGenericCurrentGrants(db *sql.DB, builder snowflake.GrantBuilder) ([]*gr
 	return grants, nil
 }
 
-func readGenericFutureGrants(db *sql.DB, builder snowflake.GrantBuilder) ([]*grant, error) {
+func readGenericFutureGrants(ctx context.Context, db *sql.DB, builder snowflake.GrantBuilder) ([]*grant, error) {
 	conn := sqlx.NewDb(db, "snowflake")
 
 	stmt := builder.Show()
-	rows, err := conn.Queryx(stmt)
+	rows, err := conn.QueryxContext(ctx, stmt)
 	if err != nil {
 		return nil, err
 	}
@@ -359,7 +364,7 @@ func readGenericFutureGrants(db *sql.DB, builder snowflake.GrantBuilder) ([]*gra
 
 // Deletes specific roles and shares from a grant
 // Does not modify TF remote state
-func deleteGenericGrantRolesAndShares(
+func deleteGenericGrantRolesAndShares(ctx context.Context,
 	meta interface{},
 	builder snowflake.GrantBuilder,
 	priv string,
@@ -369,14 +374,14 @@ func deleteGenericGrantRolesAndShares(
 	db := meta.(*sql.DB)
 
 	for _, role := range roles {
-		err := snowflake.ExecMulti(db, builder.Role(role).Revoke(priv))
+		err := snowflake.ExecMultiContext(ctx, db, builder.Role(role).Revoke(priv))
 		if err != nil {
 			return err
 		}
 	}
 
 	for _, share := range shares {
-		err := snowflake.ExecMulti(db, builder.Share(share).Revoke(priv))
+		err := snowflake.ExecMultiContext(ctx, db, builder.Share(share).Revoke(priv))
 		if err != nil {
 			return err
 		}
@@ -384,10 +389,10 @@ func deleteGenericGrantRolesAndShares(
 	return nil
 }
 
-func deleteGenericGrant(d *schema.ResourceData, meta interface{}, builder snowflake.GrantBuilder) error {
+func deleteGenericGrant(ctx context.Context, d *schema.ResourceData, meta interface{}, builder snowflake.GrantBuilder) error {
 	priv := d.Get("privilege").(string)
 	roles, shares := expandRolesAndShares(d)
-	err := deleteGenericGrantRolesAndShares(meta, builder, priv, roles, shares)
+	err := deleteGenericGrantRolesAndShares(ctx, meta, builder, priv, roles, shares)
 	if err != nil {
 		return err
 	}
@@ -1,14 +1,48 @@
 package resources_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/provider"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/resources"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/require"
 )
 
+func account(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, resources.Account().Schema, params)
+	r.NotNil(d)
+	d.SetId(id)
+	return d
+}
+
+func accountParameter(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, resources.AccountParameter().Schema, params)
+	r.NotNil(d)
+	d.SetId(id)
+	return d
+}
+
+func alert(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, resources.Alert().Schema, params)
+	r.NotNil(d)
+	d.SetId(id)
+	return d
+}
+
+func authenticationPolicyAttachment(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, resources.AuthenticationPolicyAttachment().Schema, params)
+	r.NotNil(d)
+	d.SetId(id)
+	return d
+}
+
 func database(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
 	r := require.New(t)
 	d := schema.TestResourceDataRaw(t, resources.Database().Schema, params)
@@ -17,6 +51,30 @@ func database(t *testing.T, id string, params map[string]interface{}) *schema.Re
 	return d
 }
 
+func databaseRefresh(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, resources.DatabaseRefresh().Schema, params)
+	r.NotNil(d)
+	d.SetId(id)
+	return d
+}
+
+func databaseRole(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, resources.DatabaseRole().Schema, params)
+	r.NotNil(d)
+	d.SetId(id)
+	return d
+}
+
+func databaseRoleGrants(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, resources.DatabaseRoleGrants().Schema, params)
+	r.NotNil(d)
+	d.SetId(id)
+	return d
+}
+
 func databaseGrant(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
 	r := require.New(t)
 	d := schema.TestResourceDataRaw(t, resources.DatabaseGrant().Resource.Schema, params)
@@ -89,6 +147,14 @@ func accountGrant(t *testing.T, id string, params map[string]interface{}) *schem
 	return d
 }
 
+func failoverGroup(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, resources.FailoverGroup().Schema, params)
+	r.NotNil(d)
+	d.SetId(id)
+	return d
+}
+
 func managedAccount(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
 	r := require.New(t)
 	d := schema.TestResourceDataRaw(t, resources.ManagedAccount().Schema, params)
@@ -105,6 +171,14 @@ func maskingPolicy(t *testing.T, id string, params map[string]interface{}) *sche
 	return d
 }
 
+func maskingPolicyAttachment(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, resources.MaskingPolicyAttachment().Schema, params)
+	r.NotNil(d)
+	d.SetId(id)
+	return d
+}
+
 func networkPolicy(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
 	r := require.New(t)
 	d := schema.TestResourceDataRaw(t, resources.NetworkPolicy().Schema, params)
@@ -113,6 +187,46 @@ func networkPolicy(t *testing.T, id string, params map[string]interface{}) *sche
 	return d
 }
 
+func networkPolicyAttachment(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, resources.NetworkPolicyAttachment().Schema, params)
+	r.NotNil(d)
+	d.SetId(id)
+	return d
+}
+
+func networkRule(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, resources.NetworkRule().Schema, params)
+	r.NotNil(d)
+	d.SetId(id)
+	return d
+}
+
+func objectParameter(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, resources.ObjectParameter().Schema, params)
+	r.NotNil(d)
+	d.SetId(id)
+	return d
+}
+
+func passwordPolicy(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, resources.PasswordPolicy().Schema, params)
+	r.NotNil(d)
+	d.SetId(id)
+	return d
+}
+
+func passwordPolicyAttachment(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, resources.PasswordPolicyAttachment().Schema, params)
+	r.NotNil(d)
+	d.SetId(id)
+	return d
+}
+
 func pipe(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
 	r := require.New(t)
 	d := schema.TestResourceDataRaw(t, resources.Pipe().Schema, params)
@@ -121,6 +235,14 @@ func pipe(t *testing.T, id string, params map[string]interface{}) *schema.Resour
 	return d
 }
 
+func replicationGroup(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, resources.ReplicationGroup().Schema, params)
+	r.NotNil(d)
+	d.SetId(id)
+	return d
+}
+
 func resourceMonitor(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
 	r := require.New(t)
 	d := schema.TestResourceDataRaw(t, resources.ResourceMonitor().Schema, params)
@@ -129,6 +251,14 @@ func resourceMonitor(t *testing.T, id string, params map[string]interface{}) *sc
 	return d
 }
 
+func secret(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, resources.Secret().Schema, params)
+	r.NotNil(d)
+	d.SetId(id)
+	return d
+}
+
 func sequence(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
 	r := require.New(t)
 	d := schema.TestResourceDataRaw(t, resources.Sequence().Schema, params)
@@ -137,6 +267,22 @@ func sequence(t *testing.T, id string, params map[string]interface{}) *schema.Re
 	return d
 }
 
+func sessionPolicy(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, resources.SessionPolicy().Schema, params)
+	r.NotNil(d)
+	d.SetId(id)
+	return d
+}
+
+func sessionPolicyAttachment(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, resources.SessionPolicyAttachment().Schema, params)
+	r.NotNil(d)
+	d.SetId(id)
+	return d
+}
+
 func share(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
 	r := require.New(t)
 	d := schema.TestResourceDataRaw(t, resources.Share().Schema, params)
@@ -169,6 +315,22 @@ func tag(t *testing.T, id string, params map[string]interface{}) *schema.Resourc
 	return d
 }
 
+func tagAssociation(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, resources.TagAssociation().Schema, params)
+	r.NotNil(d)
+	d.SetId(id)
+	return d
+}
+
+func tagMaskingPolicyAssociation(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, resources.TagMaskingPolicyAssociation().Schema, params)
+	r.NotNil(d)
+	d.SetId(id)
+	return d
+}
+
 func providers() map[string]*schema.Provider {
 	p := provider.Provider()
 	return map[string]*schema.Provider{
@@ -232,6 +394,22 @@ func externalOauthIntegration(t *testing.T, id string, params map[string]interfa
 	return d
 }
 
+func dynamicTable(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, resources.DynamicTable().Schema, params)
+	r.NotNil(d)
+	d.SetId(id)
+	return d
+}
+
+func externalAccessIntegration(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, resources.ExternalAccessIntegration().Schema, params)
+	r.NotNil(d)
+	d.SetId(id)
+	return d
+}
+
 func externalFunction(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
 	r := require.New(t)
 	d := schema.TestResourceDataRaw(t, resources.ExternalFunction().Schema, params)
@@ -399,6 +577,14 @@ func rowAccessPolicy(t *testing.T, id string, params map[string]interface{}) *sc
 	return d
 }
 
+func rowAccessPolicyAttachment(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, resources.RowAccessPolicyAttachment().Schema, params)
+	r.NotNil(d)
+	d.SetId(id)
+	return d
+}
+
 func rowAccessPolicyGrant(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
 	r := require.New(t)
 	d := schema.TestResourceDataRaw(t, resources.RowAccessPolicyGrant().Resource.Schema, params)
@@ -414,3 +600,48 @@ func function(t *testing.T, id string, params map[string]interface{}) *schema.Re
 	d.SetId(id)
 	return d
 }
+
+// planDiff runs the plan-time diff of res against config for a new resource,
+// including its CustomizeDiff.
+func planDiff(res *schema.Resource, config map[string]interface{}) error {
+	_, err := res.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil)
+	return err
+}
+
+// planUpdateDiff runs the plan-time diff of res against config for the
+// resource id, whose state was written from params.
+func planUpdateDiff(t *testing.T, res *schema.Resource, id string, params, config map[string]interface{}) (*terraform.InstanceState, *terraform.InstanceDiff) {
+	r := require.New(t)
+	old := schema.TestResourceDataRaw(t, res.Schema, params)
+	old.SetId(id)
+	state := old.State()
+	diff, err := res.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+	r.NoError(err)
+	return state, diff
+}
+
+// updateData returns the data of res when updating the resource id, whose
+// state was written from params, to config.
+func updateData(t *testing.T, res *schema.Resource, id string, params, config map[string]interface{}) *schema.ResourceData {
+	r := require.New(t)
+	state, diff := planUpdateDiff(t, res, id, params, config)
+	d, err := schema.InternalMap(res.Schema).Data(state, diff)
+	r.NoError(err)
+	return d
+}
+
+func objectGrants(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, resources.ObjectGrants().Schema, params)
+	r.NotNil(d)
+	d.SetId(id)
+	return d
+}
+
+func ownership(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, resources.Ownership().Schema, params)
+	r.NotNil(d)
+	d.SetId(id)
+	return d
+}
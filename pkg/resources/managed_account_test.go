@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -35,8 +36,8 @@ func TestManagedAccountCreate(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`^CREATE MANAGED ACCOUNT "test-account" ADMIN_NAME='bob' ADMIN_PASSWORD='abc123ABC' COMMENT='great comment' TYPE='READER'$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadManagedAccount(mock)
-		err := resources.CreateManagedAccount(d, db)
-		r.NoError(err)
+		diags := resources.CreateManagedAccount(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -49,10 +50,10 @@ func TestManagedAccountRead(t *testing.T) {
 		r.NotEmpty(d.State())
 		q := snowflake.ManagedAccount(d.Id()).Show()
 		mock.ExpectQuery(q).WillReturnError(sql.ErrNoRows)
-		err := resources.ReadManagedAccount(d, db)
+		diags := resources.ReadManagedAccount(context.Background(), d, db)
 
 		r.Empty(d.State())
-		r.Nil(err)
+		r.Empty(diags)
 	})
 }
 
@@ -2,12 +2,14 @@ package resources
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/csv"
 	"fmt"
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -57,6 +59,11 @@ var maskingPolicySchema = map[string]*schema.Schema{
 		Optional:    true,
 		Description: "Specifies a comment for the masking policy.",
 	},
+	"fully_qualified_name": {
+		Type:        schema.TypeString,
+		Description: "The fully qualified name of the masking policy, to attach it to tables and views.",
+		Computed:    true,
+	},
 }
 
 type maskingPolicyID struct {
@@ -97,10 +104,15 @@ func maskingPolicyIDFromString(stringID string) (*maskingPolicyID, error) {
 		return nil, fmt.Errorf("3 fields allowed")
 	}
 
+	names, err := objectNames(lines[0][:3])
+	if err != nil {
+		return nil, err
+	}
+
 	maskingPolicyResult := &maskingPolicyID{
-		DatabaseName:      lines[0][0],
-		SchemaName:        lines[0][1],
-		MaskingPolicyName: lines[0][2],
+		DatabaseName:      names[0].Name(),
+		SchemaName:        names[1].Name(),
+		MaskingPolicyName: names[2].Name(),
 	}
 	return maskingPolicyResult, nil
 }
@@ -108,20 +120,30 @@ func maskingPolicyIDFromString(stringID string) (*maskingPolicyID, error) {
 // MaskingPolicy returns a pointer to the resource representing a masking policy
 func MaskingPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateMaskingPolicy,
-		Read:   ReadMaskingPolicy,
-		Update: UpdateMaskingPolicy,
-		Delete: DeleteMaskingPolicy,
-
-		Schema: maskingPolicySchema,
+		CreateContext: CreateMaskingPolicy,
+		ReadContext:   ReadMaskingPolicy,
+		UpdateContext: UpdateMaskingPolicy,
+		DeleteContext: DeleteMaskingPolicy,
+
+		Schema:        maskingPolicySchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(maskingPolicySchema, upgradeQuotedNamesV0("database", "schema", "name")),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 	}
 }
 
-// CreateMaskingPolicy implements schema.CreateFunc
-func CreateMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
+// CreateMaskingPolicy implements schema.CreateContextFunc
+func CreateMaskingPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 	database := d.Get("database").(string)
@@ -142,9 +164,9 @@ func CreateMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	stmt := builder.Create()
-	err := snowflake.Exec(db, stmt)
+	err := snowflake.ExecContext(ctx, db, stmt)
 	if err != nil {
-		return errors.Wrapf(err, "error creating masking policy %v", name)
+		return diag.FromErr(errors.Wrapf(err, "error creating masking policy %v", name))
 	}
 
 	maskingPolicyID := &maskingPolicyID{
@@ -154,19 +176,19 @@ func CreateMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := maskingPolicyID.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadMaskingPolicy(d, meta)
+	return ReadMaskingPolicy(ctx, d, meta)
 }
 
-// ReadMaskingPolicy implements schema.ReadFunc
-func ReadMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
+// ReadMaskingPolicy implements schema.ReadContextFunc
+func ReadMaskingPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	maskingPolicyID, err := maskingPolicyIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := maskingPolicyID.DatabaseName
@@ -177,37 +199,42 @@ func ReadMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
 
 	showSQL := builder.Show()
 
-	row := snowflake.QueryRow(db, showSQL)
+	row := snowflake.QueryRowContext(ctx, db, showSQL)
 
 	s, err := snowflake.ScanMaskingPolicies(row)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("name", s.Name.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("database", s.DatabaseName.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("schema", s.SchemaName.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("comment", s.Comment.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("fully_qualified_name", snowflake.AddressEscape(s.DatabaseName.String, s.SchemaName.String, s.Name.String))
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	descSQL := builder.Describe()
-	rows, err := snowflake.Query(db, descSQL)
+	rows, err := snowflake.QueryContext(ctx, db, descSQL)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var (
@@ -219,37 +246,37 @@ func ReadMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
 	for rows.Next() {
 		err := rows.Scan(&name, &signature, &returnType, &body)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		err = d.Set("masking_expression", body)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		err = d.Set("return_data_type", returnType)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		// format in database is `(VAL <data_type>)`
 		valueDataType := strings.TrimSuffix(strings.Split(signature, " ")[1], ")")
 		err = d.Set("value_data_type", valueDataType)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return err
+	return diag.FromErr(err)
 }
 
-// UpdateMaskingPolicy implements schema.UpdateFunc
-func UpdateMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
+// UpdateMaskingPolicy implements schema.UpdateContextFunc
+func UpdateMaskingPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 
 	maskingPolicyID, err := maskingPolicyIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := maskingPolicyID.DatabaseName
@@ -262,15 +289,15 @@ func UpdateMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
 		comment := d.Get("comment")
 		if c := comment.(string); c == "" {
 			q := builder.RemoveComment()
-			err := snowflake.Exec(db, q)
+			err := snowflake.ExecContext(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error unsetting comment for masking policy on %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error unsetting comment for masking policy on %v", d.Id()))
 			}
 		} else {
 			q := builder.ChangeComment(c)
-			err := snowflake.Exec(db, q)
+			err := snowflake.ExecContext(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error updating comment for masking policy on %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error updating comment for masking policy on %v", d.Id()))
 			}
 		}
 	}
@@ -278,21 +305,21 @@ func UpdateMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("masking_expression") {
 		maskingExpression := d.Get("masking_expression")
 		q := builder.ChangeMaskingExpression(maskingExpression.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating masking policy expression on %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating masking policy expression on %v", d.Id()))
 		}
 	}
 
-	return ReadMaskingPolicy(d, meta)
+	return ReadMaskingPolicy(ctx, d, meta)
 }
 
-// DeleteMaskingPolicy implements schema.DeleteFunc
-func DeleteMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
+// DeleteMaskingPolicy implements schema.DeleteContextFunc
+func DeleteMaskingPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	maskingPolicyID, err := maskingPolicyIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := maskingPolicyID.DatabaseName
@@ -301,9 +328,9 @@ func DeleteMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
 
 	q := snowflake.MaskingPolicy(policyName, dbName, schema).Drop()
 
-	err = snowflake.Exec(db, q)
+	err = snowflake.ExecContext(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error deleting masking policy %v", d.Id())
+		return diag.FromErr(errors.Wrapf(err, "error deleting masking policy %v", d.Id()))
 	}
 
 	d.SetId("")
@@ -0,0 +1,319 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+)
+
+const (
+	policyAttachmentIDDelimiter = '|'
+)
+
+// policyAttachmentObjectTypes are the objects policies can be attached to
+var policyAttachmentObjectTypes = []string{"TABLE", "VIEW"}
+
+var maskingPolicyAttachmentSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database of the table or view.",
+		ForceNew:    true,
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema of the table or view.",
+		ForceNew:    true,
+	},
+	"object_type": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "TABLE",
+		Description:  "The type of the object the column belongs to, one of TABLE or VIEW.",
+		ForceNew:     true,
+		ValidateFunc: validation.StringInSlice(policyAttachmentObjectTypes, false),
+	},
+	"object_name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The name of the table or view.",
+		ForceNew:    true,
+	},
+	"column": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The column to mask.",
+		ForceNew:    true,
+	},
+	"masking_policy": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The fully qualified name of the masking policy, e.g. `snowflake_masking_policy.policy.fully_qualified_name`.",
+		ValidateFunc: func(val interface{}, key string) ([]string, []error) {
+			return snowflake.ValidateQualifiedName(val)
+		},
+	},
+	"using": {
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "The columns passed to a conditional masking policy: the masked column first, followed by the columns the policy conditions on. The masked column alone is the same as no `using`.",
+	},
+	"force": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Replaces a masking policy already set on the column when creating the attachment. Without it, creating the attachment fails if the column is masked already.",
+	},
+}
+
+type maskingPolicyAttachmentID struct {
+	DatabaseName string
+	SchemaName   string
+	ObjectType   string
+	ObjectName   string
+	ColumnName   string
+}
+
+// String() takes in a maskingPolicyAttachmentID object and returns a pipe-delimited string:
+// DatabaseName|SchemaName|ObjectType|ObjectName|ColumnName
+func (mpai *maskingPolicyAttachmentID) String() (string, error) {
+	var buf bytes.Buffer
+	csvWriter := csv.NewWriter(&buf)
+	csvWriter.Comma = policyAttachmentIDDelimiter
+	dataIdentifiers := [][]string{{mpai.DatabaseName, mpai.SchemaName, mpai.ObjectType, mpai.ObjectName, mpai.ColumnName}}
+	err := csvWriter.WriteAll(dataIdentifiers)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// maskingPolicyAttachmentIDFromString() takes in a pipe-delimited string: DatabaseName|SchemaName|ObjectType|ObjectName|ColumnName
+// and returns a maskingPolicyAttachmentID object
+func maskingPolicyAttachmentIDFromString(stringID string) (*maskingPolicyAttachmentID, error) {
+	reader := csv.NewReader(strings.NewReader(stringID))
+	reader.Comma = policyAttachmentIDDelimiter
+	lines, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("Not CSV compatible")
+	}
+
+	if len(lines) != 1 {
+		return nil, fmt.Errorf("1 line per masking policy attachment")
+	}
+	if len(lines[0]) != 5 {
+		return nil, fmt.Errorf("5 fields allowed")
+	}
+
+	return &maskingPolicyAttachmentID{
+		DatabaseName: lines[0][0],
+		SchemaName:   lines[0][1],
+		ObjectType:   lines[0][2],
+		ObjectName:   lines[0][3],
+		ColumnName:   lines[0][4],
+	}, nil
+}
+
+func (mpai *maskingPolicyAttachmentID) builder() *snowflake.PolicyAttachmentBuilder {
+	return snowflake.PolicyAttachment(mpai.ObjectType, mpai.ObjectName, mpai.DatabaseName, mpai.SchemaName)
+}
+
+// MaskingPolicyAttachment returns a pointer to the resource representing a masking policy set on a column
+func MaskingPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateMaskingPolicyAttachment,
+		ReadContext:   ReadMaskingPolicyAttachment,
+		UpdateContext: UpdateMaskingPolicyAttachment,
+		DeleteContext: DeleteMaskingPolicyAttachment,
+
+		Schema:        maskingPolicyAttachmentSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(maskingPolicyAttachmentSchema, upgradeUnchangedV0),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+	}
+}
+
+// CreateMaskingPolicyAttachment implements schema.CreateContextFunc
+func CreateMaskingPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	attachmentID := &maskingPolicyAttachmentID{
+		DatabaseName: d.Get("database").(string),
+		SchemaName:   d.Get("schema").(string),
+		ObjectType:   d.Get("object_type").(string),
+		ObjectName:   d.Get("object_name").(string),
+		ColumnName:   d.Get("column").(string),
+	}
+	policy := d.Get("masking_policy").(string)
+	using := maskingPolicyUsing(attachmentID.ColumnName, expandStringList(d.Get("using").([]interface{})))
+
+	stmt := attachmentID.builder().SetMaskingPolicy(attachmentID.ColumnName, policy, using, d.Get("force").(bool))
+	err := snowflake.ExecContext(ctx, db, stmt)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error setting masking policy %v on column %v", policy, attachmentID.ColumnName))
+	}
+
+	dataIDInput, err := attachmentID.String()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(dataIDInput)
+
+	return ReadMaskingPolicyAttachment(ctx, d, meta)
+}
+
+// ReadMaskingPolicyAttachment implements schema.ReadContextFunc
+func ReadMaskingPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	attachmentID, err := maskingPolicyAttachmentIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ref, err := readColumnMaskingPolicy(ctx, db, attachmentID)
+	if isNotFoundError(err) {
+		log.Printf("[DEBUG] %v (%s) not found", strings.ToLower(attachmentID.ObjectType), d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if ref == nil {
+		// If not found, mark resource to be removed from statefile during apply or refresh
+		log.Printf("[DEBUG] masking policy attachment (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	policy := d.Get("masking_policy").(string)
+	if !ref.IsPolicy(policy) {
+		policy = ref.Policy()
+	}
+	args, err := ref.ArgColumns()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	using := []string{}
+	if len(args) > 0 {
+		using = append([]string{attachmentID.ColumnName}, args...)
+	} else if configured := expandStringList(d.Get("using").([]interface{})); maskingPolicyUsing(attachmentID.ColumnName, configured) == nil {
+		// the masked column alone is listed as no arguments, keep it as configured
+		using = configured
+	}
+
+	toSet := map[string]interface{}{
+		"database":       attachmentID.DatabaseName,
+		"schema":         attachmentID.SchemaName,
+		"object_type":    attachmentID.ObjectType,
+		"object_name":    attachmentID.ObjectName,
+		"column":         attachmentID.ColumnName,
+		"masking_policy": policy,
+		"using":          using,
+	}
+	for key, val := range toSet {
+		err = d.Set(key, val) //lintignore:R001
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+// UpdateMaskingPolicyAttachment implements schema.UpdateContextFunc
+func UpdateMaskingPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	attachmentID, err := maskingPolicyAttachmentIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("masking_policy", "using") {
+		policy := d.Get("masking_policy").(string)
+		using := maskingPolicyUsing(attachmentID.ColumnName, expandStringList(d.Get("using").([]interface{})))
+
+		// FORCE replaces the policy without leaving the column unmasked
+		stmt := attachmentID.builder().SetMaskingPolicy(attachmentID.ColumnName, policy, using, true)
+		err := snowflake.ExecContext(ctx, db, stmt)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error setting masking policy %v on column %v", policy, attachmentID.ColumnName))
+		}
+	}
+
+	return ReadMaskingPolicyAttachment(ctx, d, meta)
+}
+
+// DeleteMaskingPolicyAttachment implements schema.DeleteContextFunc
+func DeleteMaskingPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	attachmentID, err := maskingPolicyAttachmentIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// leave the column alone if another policy was set on it since
+	ref, err := readColumnMaskingPolicy(ctx, db, attachmentID)
+	if err != nil && !isNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+	if ref == nil || !ref.IsPolicy(d.Get("masking_policy").(string)) {
+		log.Printf("[DEBUG] column %v is not masked by %v, not unsetting it", attachmentID.ColumnName, d.Get("masking_policy"))
+		d.SetId("")
+		return nil
+	}
+
+	stmt := attachmentID.builder().UnsetMaskingPolicy(attachmentID.ColumnName)
+	err = snowflake.ExecContext(ctx, db, stmt)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error unsetting masking policy on column %v", attachmentID.ColumnName))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// readColumnMaskingPolicy returns the reference of the masking policy set on
+// the column, nil if none is set
+// maskingPolicyUsing returns the columns of the USING clause of a masking
+// policy on column, nil when the policy is only passed the masked column, the
+// same as setting it without USING.
+func maskingPolicyUsing(column string, using []string) []string {
+	if len(using) == 0 || (len(using) == 1 && using[0] == column) {
+		return nil
+	}
+	return using
+}
+
+func readColumnMaskingPolicy(ctx context.Context, db *sql.DB, attachmentID *maskingPolicyAttachmentID) (*snowflake.PolicyReference, error) {
+	refs, err := snowflake.ListPolicyReferences(ctx, attachmentID.builder().ShowPolicyReferences(), db)
+	if err != nil {
+		return nil, err
+	}
+	for _, ref := range refs {
+		if ref.PolicyKind.String == snowflake.MaskingPolicyKind && ref.RefColumnName.String == attachmentID.ColumnName {
+			return &ref, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,97 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_MaskingPolicyAttachment(t *testing.T) {
+	if _, ok := os.LookupEnv("SKIP_MASKING_POLICY_TESTS"); ok {
+		t.Skip("Skipping TestAccMaskingPolicyAttachment")
+	}
+
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: maskingPolicyAttachmentConfig(accName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("snowflake_masking_policy_attachment.test", "masking_policy", "snowflake_masking_policy.first", "fully_qualified_name"),
+					resource.TestCheckResourceAttr("snowflake_masking_policy_attachment.test", "using.#", "0"),
+				),
+			},
+			// replaced with FORCE
+			{
+				Config: maskingPolicyAttachmentConfig(accName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("snowflake_masking_policy_attachment.test", "masking_policy", "snowflake_masking_policy.second", "fully_qualified_name"),
+				),
+			},
+			{
+				ResourceName:            "snowflake_masking_policy_attachment.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force"},
+			},
+		},
+	})
+}
+
+func maskingPolicyAttachmentConfig(n string, policy string) string {
+	return fmt.Sprintf(`
+resource "snowflake_database" "test" {
+	name = "%[1]v"
+	comment = "Terraform acceptance test"
+}
+
+resource "snowflake_schema" "test" {
+	name = "%[1]v"
+	database = snowflake_database.test.name
+	comment = "Terraform acceptance test"
+}
+
+resource "snowflake_table" "test" {
+	name = "%[1]v"
+	database = snowflake_database.test.name
+	schema = snowflake_schema.test.name
+
+	column {
+		name = "EMAIL"
+		type = "VARCHAR(16777216)"
+	}
+}
+
+resource "snowflake_masking_policy" "first" {
+	name = "%[1]v_FIRST"
+	database = snowflake_database.test.name
+	schema = snowflake_schema.test.name
+	value_data_type = "VARCHAR"
+	masking_expression = "case when current_role() in ('ANALYST') then val else '***' end"
+	return_data_type = "VARCHAR(16777216)"
+}
+
+resource "snowflake_masking_policy" "second" {
+	name = "%[1]v_SECOND"
+	database = snowflake_database.test.name
+	schema = snowflake_schema.test.name
+	value_data_type = "VARCHAR"
+	masking_expression = "case when current_role() in ('ANALYST') then val else sha2(val, 512) end"
+	return_data_type = "VARCHAR(16777216)"
+}
+
+resource "snowflake_masking_policy_attachment" "test" {
+	database = snowflake_database.test.name
+	schema = snowflake_schema.test.name
+	object_name = snowflake_table.test.name
+	column = "EMAIL"
+	masking_policy = snowflake_masking_policy.%[2]v.fully_qualified_name
+}
+`, n, policy)
+}
@@ -0,0 +1,145 @@
+package resources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/provider"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/resources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/stretchr/testify/require"
+)
+
+const policyReferencesQuery = `^SELECT \* FROM TABLE\("database_name".INFORMATION_SCHEMA.POLICY_REFERENCES\(REF_ENTITY_NAME => '"database_name"."schema_name"."table_name"', REF_ENTITY_DOMAIN => 'table'\)\)$`
+
+func TestMaskingPolicyAttachment(t *testing.T) {
+	r := require.New(t)
+	err := resources.MaskingPolicyAttachment().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func expectReadPolicyReferences(mock sqlmock.Sqlmock, refs ...[]interface{}) {
+	rows := sqlmock.NewRows([]string{"POLICY_DB", "POLICY_SCHEMA", "POLICY_NAME", "POLICY_KIND", "REF_DATABASE_NAME", "REF_COLUMN_NAME", "REF_ARG_COLUMN_NAMES"})
+	for _, ref := range refs {
+		rows.AddRow(ref[0], ref[1], ref[2], ref[3], "database_name", ref[4], ref[5])
+	}
+	mock.ExpectQuery(policyReferencesQuery).WillReturnRows(rows)
+}
+
+func TestMaskingPolicyAttachmentCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"database":       "database_name",
+		"schema":         "schema_name",
+		"object_name":    "table_name",
+		"column":         "email",
+		"masking_policy": `DATABASE_NAME.SCHEMA_NAME."email_mask"`,
+		"using":          []interface{}{"email", "visibility"},
+		"force":          true,
+	}
+	d := maskingPolicyAttachment(t, "", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`^ALTER TABLE "database_name"."schema_name"."table_name" MODIFY COLUMN "email" SET MASKING POLICY DATABASE_NAME.SCHEMA_NAME."email_mask" USING \("email", "visibility"\) FORCE$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadPolicyReferences(mock, []interface{}{"DATABASE_NAME", "SCHEMA_NAME", "email_mask", "MASKING_POLICY", "email", `[ "visibility" ]`})
+
+		diags := resources.CreateMaskingPolicyAttachment(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("database_name|schema_name|TABLE|table_name|email", d.Id())
+		r.Equal(`DATABASE_NAME.SCHEMA_NAME."email_mask"`, d.Get("masking_policy"))
+		r.Equal([]interface{}{"email", "visibility"}, d.Get("using"))
+	})
+}
+
+func TestMaskingPolicyAttachmentRead(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"masking_policy": `DATABASE_NAME.SCHEMA_NAME."email_mask"`,
+		"using":          []interface{}{"email", "visibility"},
+	}
+	d := maskingPolicyAttachment(t, "database_name|schema_name|TABLE|table_name|email", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		// the column was masked with another policy since
+		expectReadPolicyReferences(mock,
+			[]interface{}{"DATABASE_NAME", "SCHEMA_NAME", "other_mask", "MASKING_POLICY", "email", nil},
+			[]interface{}{"DATABASE_NAME", "SCHEMA_NAME", "email_mask", "MASKING_POLICY", "name", nil},
+		)
+		diags := resources.ReadMaskingPolicyAttachment(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal(`DATABASE_NAME.SCHEMA_NAME."other_mask"`, d.Get("masking_policy"))
+		r.Empty(d.Get("using"))
+		r.Equal("table_name", d.Get("object_name"))
+		r.Equal("email", d.Get("column"))
+
+		// and unset
+		expectReadPolicyReferences(mock)
+		diags = resources.ReadMaskingPolicyAttachment(context.Background(), d, db)
+		r.Empty(diags)
+		r.Empty(d.Id())
+	})
+}
+
+func TestMaskingPolicyAttachmentMaskedColumnOnly(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"database":       "database_name",
+		"schema":         "schema_name",
+		"object_name":    "table_name",
+		"column":         "email",
+		"masking_policy": `DATABASE_NAME.SCHEMA_NAME."email_mask"`,
+		"using":          []interface{}{"email"},
+	}
+	d := maskingPolicyAttachment(t, "", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`^ALTER TABLE "database_name"."schema_name"."table_name" MODIFY COLUMN "email" SET MASKING POLICY DATABASE_NAME.SCHEMA_NAME."email_mask"$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadPolicyReferences(mock, []interface{}{"DATABASE_NAME", "SCHEMA_NAME", "email_mask", "MASKING_POLICY", "email", nil})
+
+		diags := resources.CreateMaskingPolicyAttachment(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal([]interface{}{"email"}, d.Get("using"))
+	})
+}
+
+func TestMaskingPolicyAttachmentDelete(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"object_type":    "VIEW",
+		"masking_policy": `DATABASE_NAME.SCHEMA_NAME."email_mask"`,
+	}
+	d := maskingPolicyAttachment(t, "database_name|schema_name|VIEW|table_name|email", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadPolicyReferences(mock, []interface{}{"DATABASE_NAME", "SCHEMA_NAME", "email_mask", "MASKING_POLICY", "email", nil})
+		mock.ExpectExec(`^ALTER VIEW "database_name"."schema_name"."table_name" MODIFY COLUMN "email" UNSET MASKING POLICY$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		diags := resources.DeleteMaskingPolicyAttachment(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
+
+func TestMaskingPolicyAttachmentDeleteReplaced(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"masking_policy": `DATABASE_NAME.SCHEMA_NAME."email_mask"`,
+	}
+	d := maskingPolicyAttachment(t, "database_name|schema_name|TABLE|table_name|email", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		// no UNSET, the column is masked by another policy
+		expectReadPolicyReferences(mock, []interface{}{"DATABASE_NAME", "SCHEMA_NAME", "other_mask", "MASKING_POLICY", "email", nil})
+		diags := resources.DeleteMaskingPolicyAttachment(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
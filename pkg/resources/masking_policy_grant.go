@@ -1,8 +1,11 @@
 package resources
 
 import (
+	"context"
+
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -58,21 +61,30 @@ var maskingPolicyGrantSchema = map[string]*schema.Schema{
 func MaskingPolicyGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create: CreateMaskingPolicyGrant,
-			Read:   ReadMaskingPolicyGrant,
-			Delete: DeleteMaskingPolicyGrant,
-
-			Schema: maskingPolicyGrantSchema,
+			CreateContext: CreateMaskingPolicyGrant,
+			ReadContext:   ReadMaskingPolicyGrant,
+			DeleteContext: DeleteMaskingPolicyGrant,
+
+			Schema:        maskingPolicyGrantSchema,
+			SchemaVersion: 1,
+			StateUpgraders: []schema.StateUpgrader{
+				stateUpgraderV0(maskingPolicyGrantSchema, upgradeGrantIDV0),
+			},
 			Importer: &schema.ResourceImporter{
 				StateContext: schema.ImportStatePassthroughContext,
 			},
+			Timeouts: &schema.ResourceTimeout{
+				Create: schema.DefaultTimeout(defaultTimeout),
+				Read:   schema.DefaultTimeout(defaultTimeout),
+				Delete: schema.DefaultTimeout(defaultTimeout),
+			},
 		},
 		ValidPrivs: validMaskingPoilcyPrivileges,
 	}
 }
 
-// CreateMaskingPolicyGrant implements schema.CreateFunc
-func CreateMaskingPolicyGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateMaskingPolicyGrant implements schema.CreateContextFunc
+func CreateMaskingPolicyGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var maskingPolicyName string
 	if name, ok := d.GetOk("masking_policy_name"); ok {
 		maskingPolicyName = name.(string)
@@ -85,9 +97,9 @@ func CreateMaskingPolicyGrant(d *schema.ResourceData, meta interface{}) error {
 
 	builder := snowflake.MaskingPolicyGrant(dbName, schemaName, maskingPolicyName)
 
-	err := createGenericGrant(d, meta, builder)
+	err := createGenericGrant(ctx, d, meta, builder)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grant := &grantID{
@@ -100,18 +112,18 @@ func CreateMaskingPolicyGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := grant.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadMaskingPolicyGrant(d, meta)
+	return ReadMaskingPolicyGrant(ctx, d, meta)
 }
 
-// ReadMaskingPolicyGrant implements schema.ReadFunc
-func ReadMaskingPolicyGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadMaskingPolicyGrant implements schema.ReadContextFunc
+func ReadMaskingPolicyGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -120,35 +132,35 @@ func ReadMaskingPolicyGrant(d *schema.ResourceData, meta interface{}) error {
 
 	err = d.Set("database_name", dbName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("schema_name", schemaName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("masking_policy_name", maskingPolicyName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("privilege", priv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("with_grant_option", grantID.GrantOption)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	builder := snowflake.MaskingPolicyGrant(dbName, schemaName, maskingPolicyName)
 
-	return readGenericGrant(d, meta, maskingPolicyGrantSchema, builder, false, validMaskingPoilcyPrivileges)
+	return diag.FromErr(readGenericGrant(ctx, d, meta, maskingPolicyGrantSchema, builder, false, validMaskingPoilcyPrivileges))
 }
 
-// DeleteMaskingPolicyGrant implements schema.DeleteFunc
-func DeleteMaskingPolicyGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteMaskingPolicyGrant implements schema.DeleteContextFunc
+func DeleteMaskingPolicyGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -156,5 +168,5 @@ func DeleteMaskingPolicyGrant(d *schema.ResourceData, meta interface{}) error {
 
 	builder := snowflake.MaskingPolicyGrant(dbName, schemaName, maskingPolicyName)
 
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
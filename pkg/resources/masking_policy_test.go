@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 	"time"
@@ -38,8 +39,8 @@ func TestMaskingPolicyCreate(t *testing.T) {
 			`^CREATE MASKING POLICY "database_name"."schema_name"."policy_name" AS \(VAL string\) RETURNS string -> case when current_role\(\) in \('ANALYST'\) then val else sha2\(val, 512\) end COMMENT = \'great comment\'$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadMaskingPolicy(mock)
-		err := resources.CreateMaskingPolicy(d, db)
-		r.NoError(err)
+		diags := resources.CreateMaskingPolicy(context.Background(), d, db)
+		r.Empty(diags)
 		r.Equal("policy_name", d.Get("name").(string))
 	})
 }
@@ -78,7 +79,7 @@ func TestMaskingPolicyDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`^DROP MASKING POLICY "database_name"."schema_name"."policy_name"$`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteMaskingPolicy(d, db)
-		r.NoError(err)
+		diags := resources.DeleteMaskingPolicy(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
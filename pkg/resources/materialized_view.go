@@ -2,6 +2,7 @@ package resources
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/csv"
 	"fmt"
@@ -9,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -67,15 +69,25 @@ var materializedViewSchema = map[string]*schema.Schema{
 // View returns a pointer to the resource representing a view
 func MaterializedView() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateMaterializedView,
-		Read:   ReadMaterializedView,
-		Update: UpdateMaterializedView,
-		Delete: DeleteMaterializedView,
-
-		Schema: materializedViewSchema,
+		CreateContext: CreateMaterializedView,
+		ReadContext:   ReadMaterializedView,
+		UpdateContext: UpdateMaterializedView,
+		DeleteContext: DeleteMaterializedView,
+
+		Schema:        materializedViewSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(materializedViewSchema, upgradeQuotedNamesV0("database", "schema", "name")),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 	}
 }
 
@@ -121,16 +133,21 @@ func materializedViewIDFromString(stringID string) (*materializedViewID, error)
 		return nil, fmt.Errorf("3 fields allowed")
 	}
 
+	names, err := objectNames(lines[0][:3])
+	if err != nil {
+		return nil, err
+	}
+
 	materializedViewResult := &materializedViewID{
-		DatabaseName: lines[0][0],
-		SchemaName:   lines[0][1],
-		ViewName:     lines[0][2],
+		DatabaseName: names[0].Name(),
+		SchemaName:   names[1].Name(),
+		ViewName:     names[2].Name(),
 	}
 	return materializedViewResult, nil
 }
 
-// CreateMaterializedView implements schema.CreateFunc
-func CreateMaterializedView(d *schema.ResourceData, meta interface{}) error {
+// CreateMaterializedView implements schema.CreateContextFunc
+func CreateMaterializedView(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 	schema := d.Get("schema").(string)
@@ -160,9 +177,9 @@ func CreateMaterializedView(d *schema.ResourceData, meta interface{}) error {
 
 	q := builder.Create()
 	log.Print("[DEBUG] xxx ", q)
-	err := snowflake.ExecMulti(db, q)
+	err := snowflake.ExecMultiContext(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error creating view %v", name)
+		return diag.FromErr(errors.Wrapf(err, "error creating view %v", name))
 	}
 
 	materializedViewID := &materializedViewID{
@@ -172,19 +189,19 @@ func CreateMaterializedView(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := materializedViewID.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadMaterializedView(d, meta)
+	return ReadMaterializedView(ctx, d, meta)
 }
 
-// ReadMaterializedView implements schema.ReadFunc
-func ReadMaterializedView(d *schema.ResourceData, meta interface{}) error {
+// ReadMaterializedView implements schema.ReadContextFunc
+func ReadMaterializedView(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	materializedViewID, err := materializedViewIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := materializedViewID.DatabaseName
@@ -192,7 +209,7 @@ func ReadMaterializedView(d *schema.ResourceData, meta interface{}) error {
 	view := materializedViewID.ViewName
 
 	q := snowflake.MaterializedView(view).WithDB(dbName).WithSchema(schema).Show()
-	row := snowflake.QueryRow(db, q)
+	row := snowflake.QueryRowContext(ctx, db, q)
 	v, err := snowflake.ScanMaterializedView(row)
 	if err == sql.ErrNoRows {
 		// If not found, mark resource to be removed from statefile during apply or refresh
@@ -201,50 +218,48 @@ func ReadMaterializedView(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("name", v.Name.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("is_secure", v.IsSecure)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("comment", v.Comment.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("schema", v.SchemaName.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Want to only capture the Select part of the query because before that is the Create part of the view which we no longer care about
-
-	extractor := snowflake.NewViewSelectStatementExtractor(v.Text.String)
-	substringOfQuery, err := extractor.ExtractMaterializedView()
+	ddl, err := snowflake.ParseDDL(v.Text.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = d.Set("statement", substringOfQuery)
+	err = d.Set("statement", ddl.Body)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return d.Set("database", v.DatabaseName.String)
+	return diag.FromErr(d.Set("database", v.DatabaseName.String))
 }
 
-// UpdateMaterializedView implements schema.UpdateFunc
-func UpdateMaterializedView(d *schema.ResourceData, meta interface{}) error {
+// UpdateMaterializedView implements schema.UpdateContextFunc
+func UpdateMaterializedView(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	materializedViewID, err := materializedViewIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := materializedViewID.DatabaseName
@@ -258,9 +273,9 @@ func UpdateMaterializedView(d *schema.ResourceData, meta interface{}) error {
 		name := d.Get("name")
 
 		q := builder.Rename(name.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error renaming view %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error renaming view %v", d.Id()))
 		}
 
 		d.SetId(fmt.Sprintf("%v|%v|%v", dbName, schema, name.(string)))
@@ -271,15 +286,15 @@ func UpdateMaterializedView(d *schema.ResourceData, meta interface{}) error {
 
 		if c := comment.(string); c == "" {
 			q := builder.RemoveComment()
-			err := snowflake.Exec(db, q)
+			err := snowflake.ExecContext(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error unsetting comment for view %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error unsetting comment for view %v", d.Id()))
 			}
 		} else {
 			q := builder.ChangeComment(c)
-			err := snowflake.Exec(db, q)
+			err := snowflake.ExecContext(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error updating comment for view %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error updating comment for view %v", d.Id()))
 			}
 		}
 	}
@@ -288,30 +303,30 @@ func UpdateMaterializedView(d *schema.ResourceData, meta interface{}) error {
 
 		if secure.(bool) {
 			q := builder.Secure()
-			err := snowflake.Exec(db, q)
+			err := snowflake.ExecContext(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error setting secure for view %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error setting secure for view %v", d.Id()))
 			}
 		} else {
 			q := builder.Unsecure()
-			err := snowflake.Exec(db, q)
+			err := snowflake.ExecContext(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error unsetting secure for materialized view %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error unsetting secure for materialized view %v", d.Id()))
 			}
 		}
 	}
 
-	handleTagChanges(db, d, builder)
+	handleTagChanges(ctx, db, d, builder)
 
-	return ReadMaterializedView(d, meta)
+	return ReadMaterializedView(ctx, d, meta)
 }
 
-// DeleteMaterializedView implements schema.DeleteFunc
-func DeleteMaterializedView(d *schema.ResourceData, meta interface{}) error {
+// DeleteMaterializedView implements schema.DeleteContextFunc
+func DeleteMaterializedView(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	materializedViewID, err := materializedViewIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := materializedViewID.DatabaseName
@@ -320,9 +335,9 @@ func DeleteMaterializedView(d *schema.ResourceData, meta interface{}) error {
 
 	q := snowflake.MaterializedView(view).WithDB(dbName).WithSchema(schema).Drop()
 
-	err = snowflake.Exec(db, q)
+	err = snowflake.ExecContext(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error deleting materialized view %v", d.Id())
+		return diag.FromErr(errors.Wrapf(err, "error deleting materialized view %v", d.Id()))
 	}
 
 	d.SetId("")
@@ -1,8 +1,11 @@
 package resources
 
 import (
+	"context"
+
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -80,21 +83,30 @@ var materializedViewGrantSchema = map[string]*schema.Schema{
 func MaterializedViewGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create: CreateMaterializedViewGrant,
-			Read:   ReadMaterializedViewGrant,
-			Delete: DeleteMaterializedViewGrant,
-
-			Schema: materializedViewGrantSchema,
+			CreateContext: CreateMaterializedViewGrant,
+			ReadContext:   ReadMaterializedViewGrant,
+			DeleteContext: DeleteMaterializedViewGrant,
+
+			Schema:        materializedViewGrantSchema,
+			SchemaVersion: 1,
+			StateUpgraders: []schema.StateUpgrader{
+				stateUpgraderV0(materializedViewGrantSchema, upgradeGrantIDV0),
+			},
 			Importer: &schema.ResourceImporter{
 				StateContext: schema.ImportStatePassthroughContext,
 			},
+			Timeouts: &schema.ResourceTimeout{
+				Create: schema.DefaultTimeout(defaultTimeout),
+				Read:   schema.DefaultTimeout(defaultTimeout),
+				Delete: schema.DefaultTimeout(defaultTimeout),
+			},
 		},
 		ValidPrivs: validMaterializedViewPrivileges,
 	}
 }
 
-// CreateViewGrant implements schema.CreateFunc
-func CreateMaterializedViewGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateViewGrant implements schema.CreateContextFunc
+func CreateMaterializedViewGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var materializedViewName string
 	if name, ok := d.GetOk("materialized_view_name"); ok {
 		materializedViewName = name.(string)
@@ -107,14 +119,14 @@ func CreateMaterializedViewGrant(d *schema.ResourceData, meta interface{}) error
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 
 	if (schemaName == "") && !futureMaterializedViews {
-		return errors.New("schema_name must be set unless on_future is true.")
+		return diag.FromErr(errors.New("schema_name must be set unless on_future is true."))
 	}
 
 	if (materializedViewName == "") && !futureMaterializedViews {
-		return errors.New("materialized_view_name must be set unless on_future is true.")
+		return diag.FromErr(errors.New("materialized_view_name must be set unless on_future is true."))
 	}
 	if (materializedViewName != "") && futureMaterializedViews {
-		return errors.New("materialized_view_name must be empty if on_future is true.")
+		return diag.FromErr(errors.New("materialized_view_name must be empty if on_future is true."))
 	}
 
 	var builder snowflake.GrantBuilder
@@ -124,9 +136,9 @@ func CreateMaterializedViewGrant(d *schema.ResourceData, meta interface{}) error
 		builder = snowflake.MaterializedViewGrant(dbName, schemaName, materializedViewName)
 	}
 
-	err := createGenericGrant(d, meta, builder)
+	err := createGenericGrant(ctx, d, meta, builder)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grant := &grantID{
@@ -139,18 +151,18 @@ func CreateMaterializedViewGrant(d *schema.ResourceData, meta interface{}) error
 	}
 	dataIDInput, err := grant.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadMaterializedViewGrant(d, meta)
+	return ReadMaterializedViewGrant(ctx, d, meta)
 }
 
-// ReadViewGrant implements schema.ReadFunc
-func ReadMaterializedViewGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadViewGrant implements schema.ReadContextFunc
+func ReadMaterializedViewGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -159,11 +171,11 @@ func ReadMaterializedViewGrant(d *schema.ResourceData, meta interface{}) error {
 
 	err = d.Set("database_name", dbName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("schema_name", schemaName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	futureMaterializedViewsEnabled := false
 	if materializedViewName == "" {
@@ -171,19 +183,19 @@ func ReadMaterializedViewGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	err = d.Set("materialized_view_name", materializedViewName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("on_future", futureMaterializedViewsEnabled)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("privilege", priv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("with_grant_option", grantID.GrantOption)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var builder snowflake.GrantBuilder
@@ -193,14 +205,14 @@ func ReadMaterializedViewGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.MaterializedViewGrant(dbName, schemaName, materializedViewName)
 	}
 
-	return readGenericGrant(d, meta, materializedViewGrantSchema, builder, futureMaterializedViewsEnabled, validMaterializedViewPrivileges)
+	return diag.FromErr(readGenericGrant(ctx, d, meta, materializedViewGrantSchema, builder, futureMaterializedViewsEnabled, validMaterializedViewPrivileges))
 }
 
-// DeleteViewGrant implements schema.DeleteFunc
-func DeleteMaterializedViewGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteViewGrant implements schema.DeleteContextFunc
+func DeleteMaterializedViewGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -214,5 +226,5 @@ func DeleteMaterializedViewGrant(d *schema.ResourceData, meta interface{}) error
 	} else {
 		builder = snowflake.MaterializedViewGrant(dbName, schemaName, materializedViewName)
 	}
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
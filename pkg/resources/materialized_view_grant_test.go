@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 	"time"
@@ -40,8 +41,8 @@ func TestMaterializedViewGrantCreate(t *testing.T) {
 		mock.ExpectExec(`^GRANT SELECT ON VIEW "test-db"."PUBLIC"."test-materialized-view" TO SHARE "test-share-1" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT SELECT ON VIEW "test-db"."PUBLIC"."test-materialized-view" TO SHARE "test-share-2" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadMaterializedViewGrant(mock)
-		err := resources.CreateMaterializedViewGrant(d, db)
-		r.NoError(err)
+		diags := resources.CreateMaterializedViewGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -62,8 +63,8 @@ func TestMaterializedViewGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadMaterializedViewGrant(mock)
-		err := resources.ReadMaterializedViewGrant(d, db)
-		r.NoError(err)
+		diags := resources.ReadMaterializedViewGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 
 	roles := d.Get("roles").(*schema.Set)
@@ -114,8 +115,8 @@ func TestFutureMaterializedViewGrantCreate(t *testing.T) {
 			`^GRANT SELECT ON FUTURE MATERIALIZED VIEWS IN SCHEMA "test-db"."PUBLIC" TO ROLE "test-role-2" WITH GRANT OPTION$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFutureMaterializedViewGrant(mock)
-		err := resources.CreateMaterializedViewGrant(d, db)
-		r.NoError(err)
+		diags := resources.CreateMaterializedViewGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 
 	b := require.New(t)
@@ -138,8 +139,8 @@ func TestFutureMaterializedViewGrantCreate(t *testing.T) {
 			`^GRANT SELECT ON FUTURE MATERIALIZED VIEWS IN DATABASE "test-db" TO ROLE "test-role-2"$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFutureMaterializedViewDatabaseGrant(mock)
-		err := resources.CreateMaterializedViewGrant(d, db)
-		b.NoError(err)
+		diags := resources.CreateMaterializedViewGrant(context.Background(), d, db)
+		b.Empty(diags)
 	})
 
 	// Validate specifying on_future=false and schema_name="" generates an error
@@ -156,8 +157,8 @@ func TestFutureMaterializedViewGrantCreate(t *testing.T) {
 	m.NotNil(d)
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
-		err := resources.CreateMaterializedViewGrant(d, db)
-		m.Error(err)
+		diags := resources.CreateMaterializedViewGrant(context.Background(), d, db)
+		m.True(diags.HasError())
 	})
 }
 
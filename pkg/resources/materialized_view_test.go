@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -45,8 +46,8 @@ func TestMaterializedViewCreate(t *testing.T) {
 		mock.ExpectCommit()
 
 		expectReadMaterializedView(mock)
-		err := resources.CreateMaterializedView(d, db)
-		r.NoError(err)
+		diags := resources.CreateMaterializedView(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 func TestMaterializedViewCreateOrReplace(t *testing.T) {
@@ -76,8 +77,8 @@ func TestMaterializedViewCreateOrReplace(t *testing.T) {
 		mock.ExpectCommit()
 
 		expectReadMaterializedView(mock)
-		err := resources.CreateMaterializedView(d, db)
-		r.NoError(err)
+		diags := resources.CreateMaterializedView(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 func TestMaterializedViewCreateAmpersand(t *testing.T) {
@@ -106,8 +107,8 @@ func TestMaterializedViewCreateAmpersand(t *testing.T) {
 		mock.ExpectCommit()
 
 		expectReadMaterializedView(mock)
-		err := resources.CreateMaterializedView(d, db)
-		r.NoError(err)
+		diags := resources.CreateMaterializedView(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -134,8 +135,8 @@ func TestMaterializedViewRead(t *testing.T) {
 		r.NotEmpty(d.State())
 		q := snowflake.MaterializedView("good_name").WithDB("test_db").WithSchema("test_schema").Show()
 		mock.ExpectQuery(q).WillReturnError(sql.ErrNoRows)
-		err := resources.ReadMaterializedView(d, db)
+		diags := resources.ReadMaterializedView(context.Background(), d, db)
 		r.Empty(d.State())
-		r.Nil(err)
+		r.Empty(diags)
 	})
 }
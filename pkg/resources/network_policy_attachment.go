@@ -1,13 +1,20 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
+	"log"
+	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
+	"github.com/snowflakedb/gosnowflake"
 )
 
+const networkPolicyAttachmentIDSuffix = "_attachment"
+
 var networkPolicyAttachmentSchema = map[string]*schema.Schema{
 	"network_policy_name": {
 		Type:        schema.TypeString,
@@ -18,7 +25,7 @@ var networkPolicyAttachmentSchema = map[string]*schema.Schema{
 	"set_for_account": {
 		Type:        schema.TypeBool,
 		Optional:    true,
-		Description: "Specifies whether the network policy should be applied globally to your Snowflake account<br><br>**Note:** The Snowflake user running `terraform apply` must be on an IP address allowed by the network policy to set that policy globally on the Snowflake account.<br><br>Additionally, a Snowflake account can only have one network policy set globally at any given time. This resource does not enforce one-policy-per-account, it is the user's responsibility to enforce this. If multiple network policy resources have `set_for_account: true`, the final policy set on the account will be non-deterministic.",
+		Description: "Specifies whether the network policy should be applied globally to your Snowflake account<br><br>**Note:** The Snowflake user running `terraform apply` must be on an IP address allowed by the network policy to set that policy globally on the Snowflake account.<br><br>Additionally, a Snowflake account can only have one network policy set globally at any given time. Setting this fails if another network policy is already set on the account, e.g. by another attachment.",
 		Default:     false,
 	},
 	"users": {
@@ -32,65 +39,132 @@ var networkPolicyAttachmentSchema = map[string]*schema.Schema{
 // NetworkPolicyAttachment returns a pointer to the resource representing a network policy attachment
 func NetworkPolicyAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateNetworkPolicyAttachment,
-		Read:   ReadNetworkPolicyAttachment,
-		Update: UpdateNetworkPolicyAttachment,
-		Delete: DeleteNetworkPolicyAttachment,
-
-		Schema: networkPolicyAttachmentSchema,
+		CreateContext: CreateNetworkPolicyAttachment,
+		ReadContext:   ReadNetworkPolicyAttachment,
+		UpdateContext: UpdateNetworkPolicyAttachment,
+		DeleteContext: DeleteNetworkPolicyAttachment,
+
+		Schema:        networkPolicyAttachmentSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(networkPolicyAttachmentSchema, upgradeUnchangedV0),
+		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportNetworkPolicyAttachment,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
 		},
 	}
 }
 
-// CreateNetworkPolicyAttachment implements schema.CreateFunc
-func CreateNetworkPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+// CreateNetworkPolicyAttachment implements schema.CreateContextFunc
+func CreateNetworkPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	policyName := d.Get("network_policy_name").(string)
-	d.SetId(policyName + "_attachment")
+	d.SetId(policyName + networkPolicyAttachmentIDSuffix)
 
 	if d.Get("set_for_account").(bool) {
-		err := setOnAccount(d, meta)
+		err := setOnAccount(ctx, d, meta)
 		if err != nil {
-			return errors.Wrapf(err, "error creating attachment for network policy %v", policyName)
+			return diag.FromErr(errors.Wrapf(err, "error creating attachment for network policy %v", policyName))
 		}
 	}
 
 	if u, ok := d.GetOk("users"); ok {
 		users := expandStringList(u.(*schema.Set).List())
 
-		err := ensureUserAlterPrivileges(users, meta)
+		err := ensureUserAlterPrivileges(ctx, users, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		err = setOnUsers(users, d, meta)
+		err = setOnUsers(ctx, users, d, meta)
 		if err != nil {
-			return errors.Wrapf(err, "error creating attachment for network policy %v", policyName)
+			return diag.FromErr(errors.Wrapf(err, "error creating attachment for network policy %v", policyName))
 		}
 	}
 
-	return nil
+	return ReadNetworkPolicyAttachment(ctx, d, meta)
 }
 
-// ReadNetworkPolicyAttachment implements schema.ReadFunc
-func ReadNetworkPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
-	// HACK: InternalValidate requires Read to be implemented
-	// There is no way of using SHOW/DESC on Network Policies/Users to pull attachment information, so we can't actually Read
-	return nil
+// ImportNetworkPolicyAttachment implements schema.StateContextFunc. Every user
+// of the account is checked for the policy.
+func ImportNetworkPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	db := meta.(*sql.DB)
+	policyName := strings.TrimSuffix(d.Id(), networkPolicyAttachmentIDSuffix)
+	if err := d.Set("network_policy_name", policyName); err != nil {
+		return nil, err
+	}
+
+	users, err := snowflake.ListUsers(ctx, db)
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing users")
+	}
+	attached := []string{}
+	for _, u := range users {
+		policy, err := readUserNetworkPolicy(ctx, db, u.Name.String)
+		if err != nil {
+			return nil, err
+		}
+		if policy == policyName {
+			attached = append(attached, u.Name.String)
+		}
+	}
+	if err := d.Set("users", attached); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// ReadNetworkPolicyAttachment implements schema.ReadContextFunc
+func ReadNetworkPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	policyName := strings.TrimSuffix(d.Id(), networkPolicyAttachmentIDSuffix)
+	if err := d.Set("network_policy_name", policyName); err != nil {
+		return diag.FromErr(err)
+	}
+
+	accountPolicy, err := readAccountNetworkPolicy(ctx, db)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("set_for_account", accountPolicy == policyName); err != nil {
+		return diag.FromErr(err)
+	}
+
+	attached := []string{}
+	for _, user := range expandStringList(d.Get("users").(*schema.Set).List()) {
+		policy, err := readUserNetworkPolicy(ctx, db, user)
+		if isNotFoundError(err) {
+			log.Printf("[DEBUG] user (%s) not found, removing from network policy attachment %s", user, d.Id())
+			continue
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if policy != policyName {
+			log.Printf("[DEBUG] user (%s) has network policy %q instead of %s", user, policy, policyName)
+			continue
+		}
+		attached = append(attached, user)
+	}
+	return diag.FromErr(d.Set("users", attached))
 }
 
-// UpdateNetworkPolicyAttachment implements schema.UpdateFunc
-func UpdateNetworkPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+// UpdateNetworkPolicyAttachment implements schema.UpdateContextFunc
+func UpdateNetworkPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	if d.HasChange("set_for_account") {
 		oldAcctFlag, newAcctFlag := d.GetChange("set_for_account")
 		if newAcctFlag.(bool) {
-			if err := setOnAccount(d, meta); err != nil {
-				return err
+			if err := setOnAccount(ctx, d, meta); err != nil {
+				return diag.FromErr(err)
 			}
 		} else if !newAcctFlag.(bool) && oldAcctFlag == true {
-			if err := unsetOnAccount(d, meta); err != nil {
-				return err
+			if err := unsetOnAccount(ctx, d, meta); err != nil {
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -103,55 +177,55 @@ func UpdateNetworkPolicyAttachment(d *schema.ResourceData, meta interface{}) err
 		removedUsers := expandStringList(oldUsersSet.Difference(newUsersSet).List())
 		addedUsers := expandStringList(newUsersSet.Difference(oldUsersSet).List())
 
-		err := ensureUserAlterPrivileges(removedUsers, meta)
+		err := ensureUserAlterPrivileges(ctx, removedUsers, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		err = ensureUserAlterPrivileges(addedUsers, meta)
+		err = ensureUserAlterPrivileges(ctx, addedUsers, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		for _, user := range removedUsers {
-			err := unsetOnUser(user, d, meta)
+			err := unsetOnUser(ctx, user, d, meta)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
 		for _, user := range addedUsers {
-			err := setOnUser(user, d, meta)
+			err := setOnUser(ctx, user, d, meta)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 
-	return nil
+	return ReadNetworkPolicyAttachment(ctx, d, meta)
 }
 
-// DeleteNetworkPolicyAttachment implements schema.DeleteFunc
-func DeleteNetworkPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+// DeleteNetworkPolicyAttachment implements schema.DeleteContextFunc
+func DeleteNetworkPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	policyName := d.Get("network_policy_name").(string)
-	d.SetId(policyName + "_attachment")
 
-	err := unsetOnAccount(d, meta)
-	if err != nil {
-		return errors.Wrapf(err, "error deleting attachment for network policy %v", policyName)
+	if d.Get("set_for_account").(bool) {
+		if err := unsetOnAccount(ctx, d, meta); err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error deleting attachment for network policy %v", policyName))
+		}
 	}
 
 	if u, ok := d.GetOk("users"); ok {
 		users := expandStringList(u.(*schema.Set).List())
 
-		err := ensureUserAlterPrivileges(users, meta)
+		err := ensureUserAlterPrivileges(ctx, users, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		err = unsetOnUsers(users, d, meta)
+		err = unsetOnUsers(ctx, users, d, meta)
 		if err != nil {
-			return errors.Wrapf(err, "error deleting attachment for network policy %v", policyName)
+			return diag.FromErr(errors.Wrapf(err, "error deleting attachment for network policy %v", policyName))
 		}
 	}
 
@@ -160,13 +234,21 @@ func DeleteNetworkPolicyAttachment(d *schema.ResourceData, meta interface{}) err
 
 // setOnAccount sets the network policy globally for the Snowflake account
 // Note: the ip address of the session executing this SQL must be allowed by the network policy being set
-func setOnAccount(d *schema.ResourceData, meta interface{}) error {
+func setOnAccount(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
 	policyName := d.Get("network_policy_name").(string)
 
+	accountPolicy, err := readAccountNetworkPolicy(ctx, db)
+	if err != nil {
+		return err
+	}
+	if accountPolicy != "" && accountPolicy != policyName {
+		return errors.Errorf("network policy %v is already set on the account, unset it or remove set_for_account from its attachment before setting %v", accountPolicy, policyName)
+	}
+
 	acctSql := snowflake.NetworkPolicy(policyName).SetOnAccount()
 
-	err := snowflake.Exec(db, acctSql)
+	err = snowflake.ExecContext(ctx, db, acctSql)
 	if err != nil {
 		return errors.Wrapf(err, "error setting network policy %v on account", policyName)
 	}
@@ -174,14 +256,24 @@ func setOnAccount(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-// setOnAccount unsets the network policy globally for the Snowflake account
-func unsetOnAccount(d *schema.ResourceData, meta interface{}) error {
+// unsetOnAccount unsets the network policy globally for the Snowflake account,
+// unless another policy was set on the account since
+func unsetOnAccount(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
 	policyName := d.Get("network_policy_name").(string)
 
+	accountPolicy, err := readAccountNetworkPolicy(ctx, db)
+	if err != nil {
+		return err
+	}
+	if accountPolicy != policyName {
+		log.Printf("[DEBUG] account has network policy %q instead of %s, not unsetting it", accountPolicy, policyName)
+		return nil
+	}
+
 	acctSql := snowflake.NetworkPolicy(policyName).UnsetOnAccount()
 
-	err := snowflake.Exec(db, acctSql)
+	err = snowflake.ExecContext(ctx, db, acctSql)
 	if err != nil {
 		return errors.Wrapf(err, "error unsetting network policy %v on account", policyName)
 	}
@@ -190,10 +282,10 @@ func unsetOnAccount(d *schema.ResourceData, meta interface{}) error {
 }
 
 // setOnUsers sets the network policy for list of users
-func setOnUsers(users []string, data *schema.ResourceData, meta interface{}) error {
+func setOnUsers(ctx context.Context, users []string, data *schema.ResourceData, meta interface{}) error {
 	policyName := data.Get("network_policy_name").(string)
 	for _, user := range users {
-		err := setOnUser(user, data, meta)
+		err := setOnUser(ctx, user, data, meta)
 		if err != nil {
 			return errors.Wrapf(err, "error setting network policy %v on user %v", policyName, user)
 		}
@@ -203,11 +295,11 @@ func setOnUsers(users []string, data *schema.ResourceData, meta interface{}) err
 }
 
 // setOnUser sets the network policy for a given user
-func setOnUser(user string, data *schema.ResourceData, meta interface{}) error {
+func setOnUser(ctx context.Context, user string, data *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
 	policyName := data.Get("network_policy_name").(string)
 	userSql := snowflake.NetworkPolicy(policyName).SetOnUser(user)
-	err := snowflake.Exec(db, userSql)
+	err := snowflake.ExecContext(ctx, db, userSql)
 	if err != nil {
 		return errors.Wrapf(err, "error setting network policy %v on user %v", policyName, user)
 	}
@@ -216,10 +308,10 @@ func setOnUser(user string, data *schema.ResourceData, meta interface{}) error {
 }
 
 // unsetOnUsers unsets the network policy for list of users
-func unsetOnUsers(users []string, data *schema.ResourceData, meta interface{}) error {
+func unsetOnUsers(ctx context.Context, users []string, data *schema.ResourceData, meta interface{}) error {
 	policyName := data.Get("network_policy_name").(string)
 	for _, user := range users {
-		err := unsetOnUser(user, data, meta)
+		err := unsetOnUser(ctx, user, data, meta)
 		if err != nil {
 			return errors.Wrapf(err, "error unsetting network policy %v on user %v", policyName, user)
 		}
@@ -229,11 +321,11 @@ func unsetOnUsers(users []string, data *schema.ResourceData, meta interface{}) e
 }
 
 // unsetOnUser sets the network policy for a given user
-func unsetOnUser(user string, data *schema.ResourceData, meta interface{}) error {
+func unsetOnUser(ctx context.Context, user string, data *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
 	policyName := data.Get("network_policy_name").(string)
 	userSql := snowflake.NetworkPolicy(policyName).UnsetOnUser(user)
-	err := snowflake.Exec(db, userSql)
+	err := snowflake.ExecContext(ctx, db, userSql)
 	if err != nil {
 		return errors.Wrapf(err, "error unsetting network policy %v on user %v", policyName, user)
 	}
@@ -242,11 +334,11 @@ func unsetOnUser(user string, data *schema.ResourceData, meta interface{}) error
 }
 
 // ensureUserAlterPrivileges ensures the executing Snowflake user can alter each user in the set of users
-func ensureUserAlterPrivileges(users []string, meta interface{}) error {
+func ensureUserAlterPrivileges(ctx context.Context, users []string, meta interface{}) error {
 	db := meta.(*sql.DB)
 	for _, user := range users {
 		userDescSql := snowflake.User(user).Describe()
-		err := snowflake.Exec(db, userDescSql)
+		err := snowflake.ExecContext(ctx, db, userDescSql)
 		if err != nil {
 			return errors.Wrapf(err, "error altering network policy of user %v", user)
 		}
@@ -254,3 +346,33 @@ func ensureUserAlterPrivileges(users []string, meta interface{}) error {
 
 	return nil
 }
+
+// readAccountNetworkPolicy returns the network policy set globally on the
+// account, empty if none is set
+func readAccountNetworkPolicy(ctx context.Context, db *sql.DB) (string, error) {
+	stmt := snowflake.NetworkPolicy("").ShowOnAccount()
+	policy, err := snowflake.ScanNetworkPolicyParameter(snowflake.QueryRowContext(ctx, db, stmt), "ACCOUNT")
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	return policy, errors.Wrap(err, "error reading network policy of account")
+}
+
+// readUserNetworkPolicy returns the network policy set on a user, empty if
+// none is set on the user itself
+func readUserNetworkPolicy(ctx context.Context, db *sql.DB, user string) (string, error) {
+	stmt := snowflake.NetworkPolicy("").ShowOnUser(user)
+	policy, err := snowflake.ScanNetworkPolicyParameter(snowflake.QueryRowContext(ctx, db, stmt), "USER")
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	return policy, errors.Wrapf(err, "error reading network policy of user %v", user)
+}
+
+// isNotFoundError reports whether err is Snowflake reporting a missing object
+func isNotFoundError(err error) bool {
+	var snowflakeErr *gosnowflake.SnowflakeError
+	return errors.As(err, &snowflakeErr) &&
+		snowflakeErr.Number == 2003 &&
+		strings.Contains(snowflakeErr.Error(), "does not exist or not authorized")
+}
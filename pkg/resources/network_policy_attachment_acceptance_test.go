@@ -34,7 +34,7 @@ func TestAcc_NetworkPolicyAttachment(t *testing.T) {
 			{
 				ResourceName:      "snowflake_network_policy_attachment.test",
 				ImportState:       true,
-				ImportStateVerify: false,
+				ImportStateVerify: true,
 			},
 		},
 	})
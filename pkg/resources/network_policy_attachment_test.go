@@ -1,7 +1,9 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
+	"fmt"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -9,6 +11,7 @@ import (
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/resources"
 	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/snowflakedb/gosnowflake"
 	"github.com/stretchr/testify/require"
 )
 
@@ -30,12 +33,87 @@ func TestNetworkPolicyAttachmentCreate(t *testing.T) {
 	r.NotNil(d)
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadAccountNetworkPolicy(mock, "")
 		mock.ExpectExec(`^ALTER ACCOUNT SET NETWORK_POLICY = "test-network-policy"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^DESCRIBE USER "test-user"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^ALTER USER "test-user" SET NETWORK_POLICY = "test-network-policy"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadAccountNetworkPolicy(mock, "test-network-policy")
+		expectReadUserNetworkPolicy(mock, "test-user", "test-network-policy")
 
-		err := resources.CreateNetworkPolicyAttachment(d, db)
+		diags := resources.CreateNetworkPolicyAttachment(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("test-network-policy_attachment", d.Id())
+		r.True(d.Get("set_for_account").(bool))
+		r.Equal([]interface{}{"test-user"}, d.Get("users").(*schema.Set).List())
+	})
+}
+
+func TestNetworkPolicyAttachmentCreateAccountTaken(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"network_policy_name": "test-network-policy",
+		"set_for_account":     true,
+	}
+	d := schema.TestResourceDataRaw(t, resources.NetworkPolicyAttachment().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadAccountNetworkPolicy(mock, "other-policy")
+
+		diags := resources.CreateNetworkPolicyAttachment(context.Background(), d, db)
+		r.Len(diags, 1)
+		r.Contains(diags[0].Summary, "network policy other-policy is already set on the account")
+	})
+}
+
+func TestNetworkPolicyAttachmentRead(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"network_policy_name": "test-network-policy",
+		"set_for_account":     true,
+		"users":               []interface{}{"attached", "changed", "dropped", "inherited"},
+	}
+	d := networkPolicyAttachment(t, "test-network-policy_attachment", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadAccountNetworkPolicy(mock, "other-policy")
+		expectReadUserNetworkPolicy(mock, "attached", `"test-network-policy"`)
+		expectReadUserNetworkPolicy(mock, "changed", "")
+		mock.ExpectQuery(`^SHOW PARAMETERS LIKE 'NETWORK_POLICY' IN USER "dropped"$`).WillReturnError(&gosnowflake.SnowflakeError{
+			Number:  2003,
+			Message: "User 'dropped' does not exist or not authorized.",
+		})
+		expectReadInheritedNetworkPolicy(mock, "inherited", "test-network-policy")
+
+		diags := resources.ReadNetworkPolicyAttachment(context.Background(), d, db)
+		r.Empty(diags)
+		r.False(d.Get("set_for_account").(bool))
+		r.Equal([]interface{}{"attached"}, d.Get("users").(*schema.Set).List())
+	})
+}
+
+func TestNetworkPolicyAttachmentImport(t *testing.T) {
+	r := require.New(t)
+
+	d := networkPolicyAttachment(t, "test-network-policy_attachment", map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"name", "login_name"}).
+			AddRow("attached", "attached").
+			AddRow("other", "other").
+			AddRow("inherited", "inherited")
+		mock.ExpectQuery(`^SHOW USERS$`).WillReturnRows(rows)
+		expectReadUserNetworkPolicy(mock, "attached", "test-network-policy")
+		expectReadUserNetworkPolicy(mock, "other", "")
+		expectReadInheritedNetworkPolicy(mock, "inherited", "test-network-policy")
+
+		ds, err := resources.ImportNetworkPolicyAttachment(context.Background(), d, db)
 		r.NoError(err)
+		r.Len(ds, 1)
+		r.Equal("test-network-policy", d.Get("network_policy_name"))
+		r.Equal([]interface{}{"attached"}, d.Get("users").(*schema.Set).List())
 	})
 }
 
@@ -51,11 +129,48 @@ func TestNetworkPolicyAttachmentDelete(t *testing.T) {
 	r.NotNil(d)
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadAccountNetworkPolicy(mock, "test-network-policy")
 		mock.ExpectExec(`^ALTER ACCOUNT UNSET NETWORK_POLICY$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^DESCRIBE USER "test-user"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^ALTER USER "test-user" UNSET NETWORK_POLICY$`).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		err := resources.DeleteNetworkPolicyAttachment(d, db)
-		r.NoError(err)
+		diags := resources.DeleteNetworkPolicyAttachment(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
+
+func TestNetworkPolicyAttachmentDeleteAccountChanged(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"network_policy_name": "test-network-policy",
+		"set_for_account":     true,
+	}
+	d := schema.TestResourceDataRaw(t, resources.NetworkPolicyAttachment().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadAccountNetworkPolicy(mock, "other-policy")
+
+		diags := resources.DeleteNetworkPolicyAttachment(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
+
+func expectReadAccountNetworkPolicy(mock sqlmock.Sqlmock, policy string) {
+	rows := sqlmock.NewRows([]string{"key", "value", "default", "level", "description", "type"}).
+		AddRow("NETWORK_POLICY", policy, "", "ACCOUNT", "Network policy assigned for the given target.", "STRING")
+	mock.ExpectQuery(`^SHOW PARAMETERS LIKE 'NETWORK_POLICY' IN ACCOUNT$`).WillReturnRows(rows)
+}
+
+func expectReadUserNetworkPolicy(mock sqlmock.Sqlmock, user, policy string) {
+	rows := sqlmock.NewRows([]string{"key", "value", "default", "level", "description", "type"}).
+		AddRow("NETWORK_POLICY", policy, "", "USER", "Network policy assigned for the given target.", "STRING")
+	mock.ExpectQuery(fmt.Sprintf(`^SHOW PARAMETERS LIKE 'NETWORK_POLICY' IN USER "%s"$`, user)).WillReturnRows(rows)
+}
+
+func expectReadInheritedNetworkPolicy(mock sqlmock.Sqlmock, user, policy string) {
+	rows := sqlmock.NewRows([]string{"key", "value", "default", "level", "description", "type"}).
+		AddRow("NETWORK_POLICY", policy, "", "ACCOUNT", "Network policy assigned for the given target.", "STRING")
+	mock.ExpectQuery(fmt.Sprintf(`^SHOW PARAMETERS LIKE 'NETWORK_POLICY' IN USER "%s"$`, user)).WillReturnRows(rows)
+}
@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 	"time"
@@ -35,8 +36,8 @@ func TestNetworkPolicyCreate(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`^CREATE NETWORK POLICY "test-network-policy" ALLOWED_IP_LIST=\('192\.168\.1\.0/24'\) BLOCKED_IP_LIST=\('155\.548\.2\.98'\) COMMENT="great comment"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadNetworkPolicy(mock)
-		err := resources.CreateNetworkPolicy(d, db)
-		r.NoError(err)
+		diags := resources.CreateNetworkPolicy(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -73,8 +74,8 @@ func TestNetworkPolicyDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`^DROP NETWORK POLICY "test-network-policy"$`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteNetworkPolicy(d, db)
-		r.NoError(err)
+		diags := resources.DeleteNetworkPolicy(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -103,7 +104,7 @@ func TestNetworkPolicyRead(t *testing.T) {
 		r.NotEmpty(d.State())
 		q := snowflake.NetworkPolicy(d.Id()).ShowAllNetworkPolicies()
 		mock.ExpectQuery(q).WillReturnError(sql.ErrNoRows)
-		err1 := resources.ReadNetworkPolicy(d, db)
+		diags1 := resources.ReadNetworkPolicy(context.Background(), d, db)
 		r.Empty(d.State())
 
 		rows := sqlmock.NewRows([]string{
@@ -112,9 +113,9 @@ func TestNetworkPolicyRead(t *testing.T) {
 			time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "bad-network-policy", "this is a comment", 2, 1,
 		)
 		mock.ExpectQuery(q).WillReturnRows(rows)
-		err2 := resources.ReadNetworkPolicy(d, db)
+		diags2 := resources.ReadNetworkPolicy(context.Background(), d, db)
 
-		r.Nil(err1)
-		r.Nil(err2)
+		r.Empty(diags1)
+		r.Empty(diags2)
 	})
 }
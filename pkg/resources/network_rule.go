@@ -0,0 +1,273 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+)
+
+var (
+	networkRuleTypes = []string{"IPV4", "HOST_PORT", "AWSVPCEID", "AZURELINKID"}
+	networkRuleModes = []string{"INGRESS", "EGRESS", "INTERNAL_STAGE"}
+)
+
+var networkRuleSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the identifier for the network rule; must be unique for the database and schema in which the network rule is created.",
+		ForceNew:    true,
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database in which to create the network rule.",
+		ForceNew:    true,
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema in which to create the network rule.",
+		ForceNew:    true,
+	},
+	"type": {
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  fmt.Sprintf("The type of the identifiers in the value list, one of %v.", strings.Join(networkRuleTypes, ", ")),
+		ForceNew:     true,
+		ValidateFunc: validation.StringInSlice(networkRuleTypes, false),
+	},
+	"mode": {
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  fmt.Sprintf("The direction of the traffic the rule restricts, one of %v.", strings.Join(networkRuleModes, ", ")),
+		ForceNew:     true,
+		ValidateFunc: validation.StringInSlice(networkRuleModes, false),
+	},
+	"value_list": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Required:    true,
+		Description: "The network identifiers the rule allows, e.g. `example.com:443` host ports or `10.0.0.0/8` IPv4 ranges.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the network rule.",
+	},
+	"fully_qualified_name": {
+		Type:        schema.TypeString,
+		Description: "The fully qualified name of the network rule, to allow it in external access integrations.",
+		Computed:    true,
+	},
+}
+
+type networkRuleID struct {
+	DatabaseName    string
+	SchemaName      string
+	NetworkRuleName string
+}
+
+// String() takes in a networkRuleID object and returns a pipe-delimited string:
+// DatabaseName|SchemaName|NetworkRuleName
+func (nri *networkRuleID) String() (string, error) {
+	var buf bytes.Buffer
+	csvWriter := csv.NewWriter(&buf)
+	csvWriter.Comma = pipeIDDelimiter
+	dataIdentifiers := [][]string{{nri.DatabaseName, nri.SchemaName, nri.NetworkRuleName}}
+	err := csvWriter.WriteAll(dataIdentifiers)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// networkRuleIDFromString() takes in a pipe-delimited string: DatabaseName|SchemaName|NetworkRuleName
+// and returns a networkRuleID object
+func networkRuleIDFromString(stringID string) (*networkRuleID, error) {
+	reader := csv.NewReader(strings.NewReader(stringID))
+	reader.Comma = pipeIDDelimiter
+	lines, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("Not CSV compatible")
+	}
+
+	if len(lines) != 1 {
+		return nil, fmt.Errorf("1 line per network rule")
+	}
+	if len(lines[0]) != 3 {
+		return nil, fmt.Errorf("3 fields allowed")
+	}
+
+	names, err := objectNames(lines[0][:3])
+	if err != nil {
+		return nil, err
+	}
+
+	return &networkRuleID{
+		DatabaseName:    names[0].Name(),
+		SchemaName:      names[1].Name(),
+		NetworkRuleName: names[2].Name(),
+	}, nil
+}
+
+func (nri *networkRuleID) builder() *snowflake.NetworkRuleBuilder {
+	return snowflake.NetworkRule(nri.NetworkRuleName, nri.DatabaseName, nri.SchemaName)
+}
+
+// NetworkRule returns a pointer to the resource representing a network rule
+func NetworkRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateNetworkRule,
+		ReadContext:   ReadNetworkRule,
+		UpdateContext: UpdateNetworkRule,
+		DeleteContext: DeleteNetworkRule,
+
+		Schema:        networkRuleSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(networkRuleSchema, upgradeUnchangedV0),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+	}
+}
+
+// CreateNetworkRule implements schema.CreateContextFunc
+func CreateNetworkRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	networkRuleID := &networkRuleID{
+		DatabaseName:    d.Get("database").(string),
+		SchemaName:      d.Get("schema").(string),
+		NetworkRuleName: d.Get("name").(string),
+	}
+	builder := networkRuleID.builder().
+		WithType(d.Get("type").(string)).
+		WithMode(d.Get("mode").(string)).
+		WithValueList(expandStringList(d.Get("value_list").(*schema.Set).List())).
+		WithComment(d.Get("comment").(string))
+
+	err := snowflake.ExecContext(ctx, db, builder.Create())
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error creating network rule %v", builder.QualifiedName()))
+	}
+
+	dataIDInput, err := networkRuleID.String()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(dataIDInput)
+
+	return ReadNetworkRule(ctx, d, meta)
+}
+
+// ReadNetworkRule implements schema.ReadContextFunc
+func ReadNetworkRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	networkRuleID, err := networkRuleIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	builder := networkRuleID.builder()
+
+	_, err = snowflake.ScanNetworkRule(snowflake.QueryRowContext(ctx, db, builder.Show()))
+	if err == sql.ErrNoRows {
+		// If not found, mark resource to be removed from statefile during apply or refresh
+		log.Printf("[DEBUG] network rule (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the value list is only described
+	nr, err := snowflake.ScanNetworkRule(snowflake.QueryRowContext(ctx, db, builder.Describe()))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	toSet := map[string]interface{}{
+		"name":                 nr.Name,
+		"database":             nr.DatabaseName,
+		"schema":               nr.SchemaName,
+		"type":                 nr.Type,
+		"mode":                 nr.Mode,
+		"value_list":           nr.ListValues(),
+		"comment":              nr.Comment.String,
+		"fully_qualified_name": snowflake.AddressEscape(nr.DatabaseName, nr.SchemaName, nr.Name),
+	}
+	for key, val := range toSet {
+		err = d.Set(key, val) //lintignore:R001
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+// UpdateNetworkRule implements schema.UpdateContextFunc
+func UpdateNetworkRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	networkRuleID, err := networkRuleIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	builder := networkRuleID.builder()
+
+	if d.HasChange("value_list") {
+		err = snowflake.ExecContext(ctx, db, builder.ChangeValueList(expandStringList(d.Get("value_list").(*schema.Set).List())))
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error updating value list on network rule %v", d.Id()))
+		}
+	}
+
+	if d.HasChange("comment") {
+		var q string
+		if c := d.Get("comment").(string); c != "" {
+			q = builder.ChangeComment(c)
+		} else {
+			q = builder.RemoveComment()
+		}
+		err = snowflake.ExecContext(ctx, db, q)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error updating comment on network rule %v", d.Id()))
+		}
+	}
+
+	return ReadNetworkRule(ctx, d, meta)
+}
+
+// DeleteNetworkRule implements schema.DeleteContextFunc
+func DeleteNetworkRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	networkRuleID, err := networkRuleIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = snowflake.ExecContext(ctx, db, networkRuleID.builder().Drop())
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error deleting network rule %v", d.Id()))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,67 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_NetworkRule(t *testing.T) {
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: networkRuleConfig(accName, `"example.com:443"`, "test comment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_network_rule.test", "name", accName),
+					resource.TestCheckResourceAttr("snowflake_network_rule.test", "type", "HOST_PORT"),
+					resource.TestCheckResourceAttr("snowflake_network_rule.test", "mode", "EGRESS"),
+					resource.TestCheckResourceAttr("snowflake_network_rule.test", "value_list.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_network_rule.test", "comment", "test comment"),
+				),
+			},
+			{
+				Config: networkRuleConfig(accName, `"example.com:443", "api.example.com"`, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_network_rule.test", "value_list.#", "2"),
+					resource.TestCheckResourceAttr("snowflake_network_rule.test", "comment", ""),
+				),
+			},
+			{
+				ResourceName:      "snowflake_network_rule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func networkRuleConfig(n string, values string, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_database" "test" {
+	name = "%[1]v"
+	comment = "Terraform acceptance test"
+}
+
+resource "snowflake_schema" "test" {
+	name = "%[1]v"
+	database = snowflake_database.test.name
+	comment = "Terraform acceptance test"
+}
+
+resource "snowflake_network_rule" "test" {
+	name = "%[1]v"
+	database = snowflake_database.test.name
+	schema = snowflake_schema.test.name
+	type = "HOST_PORT"
+	mode = "EGRESS"
+	value_list = [%[2]v]
+	comment = "%[3]v"
+}
+`, n, values, comment)
+}
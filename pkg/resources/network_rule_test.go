@@ -0,0 +1,124 @@
+package resources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/provider"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/resources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkRule(t *testing.T) {
+	r := require.New(t)
+	err := resources.NetworkRule().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestNetworkRuleCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":       "test_rule",
+		"database":   "test_db",
+		"schema":     "test_schema",
+		"type":       "HOST_PORT",
+		"mode":       "EGRESS",
+		"value_list": []interface{}{"example.com:443"},
+		"comment":    "wow comment",
+	}
+
+	d := schema.TestResourceDataRaw(t, resources.NetworkRule().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`^CREATE NETWORK RULE "test_db"."test_schema"."test_rule" TYPE = HOST_PORT MODE = EGRESS VALUE_LIST = \('example.com:443'\) COMMENT = 'wow comment'$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		expectReadNetworkRule(mock)
+		diags := resources.CreateNetworkRule(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("test_db|test_schema|test_rule", d.Id())
+		r.Equal(`"test_db"."test_schema"."test_rule"`, d.Get("fully_qualified_name").(string))
+	})
+}
+
+func TestNetworkRuleRead(t *testing.T) {
+	r := require.New(t)
+
+	d := networkRule(t, "test_db|test_schema|test_rule", map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadNetworkRule(mock)
+		diags := resources.ReadNetworkRule(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("test_rule", d.Get("name").(string))
+		r.Equal("HOST_PORT", d.Get("type").(string))
+		r.Equal("EGRESS", d.Get("mode").(string))
+		r.Equal("wow comment", d.Get("comment").(string))
+		r.ElementsMatch([]interface{}{"example.com:443"}, d.Get("value_list").(*schema.Set).List())
+	})
+}
+
+func TestNetworkRuleReadNotFound(t *testing.T) {
+	r := require.New(t)
+
+	d := networkRule(t, "test_db|test_schema|test_rule", map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"created_on", "name", "database_name", "schema_name", "owner", "comment", "type", "mode"})
+		mock.ExpectQuery(`^SHOW NETWORK RULES LIKE 'test_rule' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+		diags := resources.ReadNetworkRule(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("", d.Id())
+	})
+}
+
+func TestNetworkRuleUpdate(t *testing.T) {
+	r := require.New(t)
+
+	d := networkRule(t, "test_db|test_schema|test_rule", map[string]interface{}{
+		"value_list": []interface{}{"example.com:443"},
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER NETWORK RULE "test_db"."test_schema"."test_rule" SET VALUE_LIST = \('example.com:443'\)$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadNetworkRule(mock)
+		diags := resources.UpdateNetworkRule(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
+
+func TestNetworkRuleDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := networkRule(t, "test_db|test_schema|test_rule", map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^DROP NETWORK RULE "test_db"."test_schema"."test_rule"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		diags := resources.DeleteNetworkRule(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("", d.Id())
+	})
+}
+
+func expectReadNetworkRule(mock sqlmock.Sqlmock) {
+	showRows := sqlmock.NewRows([]string{
+		"created_on", "name", "database_name", "schema_name", "owner", "comment", "type", "mode",
+	}).AddRow(
+		"2022-01-01 00:00:00", "test_rule", "test_db", "test_schema", "ACCOUNTADMIN", "wow comment", "HOST_PORT", "EGRESS",
+	)
+	mock.ExpectQuery(`^SHOW NETWORK RULES LIKE 'test_rule' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(showRows)
+
+	describeRows := sqlmock.NewRows([]string{
+		"created_on", "name", "database_name", "schema_name", "owner", "comment", "type", "mode", "value_list",
+	}).AddRow(
+		"2022-01-01 00:00:00", "test_rule", "test_db", "test_schema", "ACCOUNTADMIN", "wow comment", "HOST_PORT", "EGRESS", "example.com:443",
+	)
+	mock.ExpectQuery(`^DESCRIBE NETWORK RULE "test_db"."test_schema"."test_rule"$`).WillReturnRows(describeRows)
+}
@@ -1,12 +1,14 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -121,21 +123,31 @@ var notificationIntegrationSchema = map[string]*schema.Schema{
 // NotificationIntegration returns a pointer to the resource representing a notification integration
 func NotificationIntegration() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateNotificationIntegration,
-		Read:   ReadNotificationIntegration,
-		Update: UpdateNotificationIntegration,
-		Delete: DeleteNotificationIntegration,
-		Exists: NotificationIntegrationExists,
-
-		Schema: notificationIntegrationSchema,
+		CreateContext: CreateNotificationIntegration,
+		ReadContext:   ReadNotificationIntegration,
+		UpdateContext: UpdateNotificationIntegration,
+		DeleteContext: DeleteNotificationIntegration,
+		Exists:        NotificationIntegrationExists,
+
+		Schema:        notificationIntegrationSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(notificationIntegrationSchema, upgradeUnchangedV0),
+		},
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 	}
 }
 
-// CreateNotificationIntegration implements schema.CreateFunc
-func CreateNotificationIntegration(data *schema.ResourceData, meta interface{}) error {
+// CreateNotificationIntegration implements schema.CreateContextFunc
+func CreateNotificationIntegration(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := data.Get("name").(string)
 
@@ -180,38 +192,38 @@ func CreateNotificationIntegration(data *schema.ResourceData, meta interface{})
 		stmt.SetString(`GCP_PUBSUB_SUBSCRIPTION_NAME`, v.(string))
 	}
 
-	err := snowflake.Exec(db, stmt.Statement())
+	err := snowflake.ExecContext(ctx, db, stmt.Statement())
 	if err != nil {
-		return fmt.Errorf("error creating notification integration: %w", err)
+		return diag.FromErr(fmt.Errorf("error creating notification integration: %w", err))
 	}
 
 	data.SetId(name)
 
-	return ReadNotificationIntegration(data, meta)
+	return ReadNotificationIntegration(ctx, data, meta)
 }
 
-// ReadNotificationIntegration implements schema.ReadFunc
-func ReadNotificationIntegration(data *schema.ResourceData, meta interface{}) error {
+// ReadNotificationIntegration implements schema.ReadContextFunc
+func ReadNotificationIntegration(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	id := data.Id()
 
 	stmt := snowflake.NotificationIntegration(data.Id()).Show()
-	row := snowflake.QueryRow(db, stmt)
+	row := snowflake.QueryRowContext(ctx, db, stmt)
 
 	// Some properties can come from the SHOW INTEGRATION call
 
 	s, err := snowflake.ScanNotificationIntegration(row)
 	if err != nil {
-		return fmt.Errorf("Could not show notification integration: %w", err)
+		return diag.FromErr(fmt.Errorf("Could not show notification integration: %w", err))
 	}
 
 	// Note: category must be NOTIFICATION or something is broken
 	if c := s.Category.String; c != "NOTIFICATION" {
-		return fmt.Errorf("Expected %v to be a NOTIFICATION integration, got %v", id, c)
+		return diag.FromErr(fmt.Errorf("Expected %v to be a NOTIFICATION integration, got %v", id, c))
 	}
 
 	if err := data.Set("name", s.Name.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Snowflake returns "QUEUE - AZURE_STORAGE_QUEUE" instead of simple "QUEUE" as a type
@@ -219,15 +231,15 @@ func ReadNotificationIntegration(data *schema.ResourceData, meta interface{}) er
 	typeParts := strings.Split(s.Type.String, "-")
 	parsedType := strings.TrimSpace(typeParts[0])
 	if err = data.Set("type", parsedType); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := data.Set("created_on", s.CreatedOn.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := data.Set("enabled", s.Enabled.Bool); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Some properties come from the DESCRIBE INTEGRATION call
@@ -235,84 +247,84 @@ func ReadNotificationIntegration(data *schema.ResourceData, meta interface{}) er
 	var k, pType string
 	var v, d interface{}
 	stmt = snowflake.NotificationIntegration(data.Id()).Describe()
-	rows, err := db.Query(stmt)
+	rows, err := db.QueryContext(ctx, stmt)
 	if err != nil {
-		return fmt.Errorf("Could not describe notification integration: %w", err)
+		return diag.FromErr(fmt.Errorf("Could not describe notification integration: %w", err))
 	}
 	defer rows.Close()
 	for rows.Next() {
 		if err := rows.Scan(&k, &pType, &v, &d); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		switch k {
 		case "ENABLED":
 			// We set this using the SHOW INTEGRATION call so let's ignore it here
 		case "DIRECTION":
 			if err = data.Set("direction", v.(string)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "NOTIFICATION_PROVIDER":
 			if err = data.Set("notification_provider", v.(string)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "AZURE_STORAGE_QUEUE_PRIMARY_URI":
 			if err = data.Set("azure_storage_queue_primary_uri", v.(string)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "AZURE_TENANT_ID":
 			if err = data.Set("azure_tenant_id", v.(string)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "AWS_SQS_ARN":
 			if err = data.Set("aws_sqs_arn", v.(string)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "AWS_SQS_ROLE_ARN":
 			if err = data.Set("aws_sqs_role_arn", v.(string)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "AWS_SQS_EXTERNAL_ID":
 			if err = data.Set("aws_sqs_external_id", v.(string)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "AWS_SQS_IAM_USER_ARN":
 			if err = data.Set("aws_sqs_iam_user_arn", v.(string)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "AWS_SNS_TOPIC_ARN":
 			if err = data.Set("aws_sns_topic_arn", v.(string)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "AWS_SNS_ROLE_ARN":
 			if err = data.Set("aws_sns_role_arn", v.(string)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "SF_AWS_EXTERNAL_ID":
 			if err = data.Set("aws_sns_external_id", v.(string)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "SF_AWS_IAM_USER_ARN":
 			if err = data.Set("aws_sns_iam_user_arn", v.(string)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "GCP_PUBSUB_SUBSCRIPTION_NAME":
 			if err = data.Set("gcp_pubsub_subscription_name", v.(string)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "GCP_PUBSUB_SERVICE_ACCOUNT":
 			if err = data.Set("gcp_pubsub_service_account", v.(string)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		default:
 			log.Printf("[WARN] unexpected property %v returned from Snowflake", k)
 		}
 	}
 
-	return err
+	return diag.FromErr(err)
 }
 
-// UpdateNotificationIntegration implements schema.UpdateFunc
-func UpdateNotificationIntegration(data *schema.ResourceData, meta interface{}) error {
+// UpdateNotificationIntegration implements schema.UpdateContextFunc
+func UpdateNotificationIntegration(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	id := data.Id()
 
@@ -383,17 +395,17 @@ func UpdateNotificationIntegration(data *schema.ResourceData, meta interface{})
 	}
 
 	if runSetStatement {
-		if err := snowflake.Exec(db, stmt.Statement()); err != nil {
-			return fmt.Errorf("error updating notification integration: %w", err)
+		if err := snowflake.ExecContext(ctx, db, stmt.Statement()); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating notification integration: %w", err))
 		}
 	}
 
-	return ReadNotificationIntegration(data, meta)
+	return ReadNotificationIntegration(ctx, data, meta)
 }
 
-// DeleteNotificationIntegration implements schema.DeleteFunc
-func DeleteNotificationIntegration(data *schema.ResourceData, meta interface{}) error {
-	return DeleteResource("", snowflake.NotificationIntegration)(data, meta)
+// DeleteNotificationIntegration implements schema.DeleteContextFunc
+func DeleteNotificationIntegration(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return DeleteResource("", snowflake.NotificationIntegration)(ctx, data, meta)
 }
 
 // NotificationIntegrationExists implements schema.ExistsFunc
@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -79,8 +80,8 @@ func TestNotificationIntegrationCreate(t *testing.T) {
 			mock.ExpectExec(testCase.expectSQL).WillReturnResult(sqlmock.NewResult(1, 1))
 			expectReadNotificationIntegration(mock, testCase.notificationProvider)
 
-			err := resources.CreateNotificationIntegration(d, db)
-			r.NoError(err)
+			diags := resources.CreateNotificationIntegration(context.Background(), d, db)
+			r.Empty(diags)
 		})
 	}
 }
@@ -110,8 +111,8 @@ func TestNotificationIntegrationRead(t *testing.T) {
 		WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 			expectReadNotificationIntegration(mock, testCase.notificationProvider)
 
-			err := resources.ReadNotificationIntegration(d, db)
-			r.NoError(err)
+			diags := resources.ReadNotificationIntegration(context.Background(), d, db)
+			r.Empty(diags)
 		})
 	}
 }
@@ -123,8 +124,8 @@ func TestNotificationIntegrationDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP NOTIFICATION INTEGRATION "drop_it"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteNotificationIntegration(d, db)
-		r.NoError(err)
+		diags := resources.DeleteNotificationIntegration(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -1,6 +1,7 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
@@ -8,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
@@ -73,20 +75,30 @@ var oauthIntegrationSchema = map[string]*schema.Schema{
 // OAuthIntegration returns a pointer to the resource representing an OAuth integration
 func OAuthIntegration() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateOAuthIntegration,
-		Read:   ReadOAuthIntegration,
-		Update: UpdateOAuthIntegration,
-		Delete: DeleteOAuthIntegration,
-
-		Schema: oauthIntegrationSchema,
+		CreateContext: CreateOAuthIntegration,
+		ReadContext:   ReadOAuthIntegration,
+		UpdateContext: UpdateOAuthIntegration,
+		DeleteContext: DeleteOAuthIntegration,
+
+		Schema:        oauthIntegrationSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(oauthIntegrationSchema, upgradeUnchangedV0),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 	}
 }
 
-// CreateOAuthIntegration implements schema.CreateFunc
-func CreateOAuthIntegration(d *schema.ResourceData, meta interface{}) error {
+// CreateOAuthIntegration implements schema.CreateContextFunc
+func CreateOAuthIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 
@@ -116,54 +128,54 @@ func CreateOAuthIntegration(d *schema.ResourceData, meta interface{}) error {
 		stmt.SetString(`COMMENT`, d.Get("comment").(string))
 	}
 
-	err := snowflake.Exec(db, stmt.Statement())
+	err := snowflake.ExecContext(ctx, db, stmt.Statement())
 	if err != nil {
-		return errors.Wrap(err, "error creating security integration")
+		return diag.FromErr(errors.Wrap(err, "error creating security integration"))
 	}
 
 	d.SetId(name)
 
-	return ReadOAuthIntegration(d, meta)
+	return ReadOAuthIntegration(ctx, d, meta)
 }
 
-// ReadOAuthIntegration implements schema.ReadFunc
-func ReadOAuthIntegration(d *schema.ResourceData, meta interface{}) error {
+// ReadOAuthIntegration implements schema.ReadContextFunc
+func ReadOAuthIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	id := d.Id()
 
 	stmt := snowflake.OAuthIntegration(id).Show()
-	row := snowflake.QueryRow(db, stmt)
+	row := snowflake.QueryRowContext(ctx, db, stmt)
 
 	// Some properties can come from the SHOW INTEGRATION call
 
 	s, err := snowflake.ScanOAuthIntegration(row)
 	if err != nil {
-		return errors.Wrap(err, "could not show security integration")
+		return diag.FromErr(errors.Wrap(err, "could not show security integration"))
 	}
 
 	// Note: category must be Security or something is broken
 	if c := s.Category.String; c != "SECURITY" {
-		return fmt.Errorf("expected %v to be an Security integration, got %v", id, c)
+		return diag.FromErr(fmt.Errorf("expected %v to be an Security integration, got %v", id, c))
 	}
 
 	if err := d.Set("oauth_client", strings.TrimPrefix(s.IntegrationType.String, "OAUTH - ")); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("name", s.Name.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("enabled", s.Enabled.Bool); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("comment", s.Comment.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("created_on", s.CreatedOn.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Some properties come from the DESCRIBE INTEGRATION call
@@ -171,14 +183,14 @@ func ReadOAuthIntegration(d *schema.ResourceData, meta interface{}) error {
 	var k, pType string
 	var v, unused interface{}
 	stmt = snowflake.OAuthIntegration(id).Describe()
-	rows, err := db.Query(stmt)
+	rows, err := db.QueryContext(ctx, stmt)
 	if err != nil {
-		return errors.Wrap(err, "could not describe security integration")
+		return diag.FromErr(errors.Wrap(err, "could not describe security integration"))
 	}
 	defer rows.Close()
 	for rows.Next() {
 		if err := rows.Scan(&k, &pType, &v, &unused); err != nil {
-			return errors.Wrap(err, "unable to parse security integration rows")
+			return diag.FromErr(errors.Wrap(err, "unable to parse security integration rows"))
 		}
 		switch k {
 		case "ENABLED":
@@ -188,22 +200,22 @@ func ReadOAuthIntegration(d *schema.ResourceData, meta interface{}) error {
 		case "OAUTH_ISSUE_REFRESH_TOKENS":
 			b, err := strconv.ParseBool(v.(string))
 			if err != nil {
-				return errors.Wrap(err, "returned OAuth issue refresh tokens that is not boolean")
+				return diag.FromErr(errors.Wrap(err, "returned OAuth issue refresh tokens that is not boolean"))
 			}
 			if err = d.Set("oauth_issue_refresh_tokens", b); err != nil {
-				return errors.Wrap(err, "unable to set OAuth issue refresh tokens for security integration")
+				return diag.FromErr(errors.Wrap(err, "unable to set OAuth issue refresh tokens for security integration"))
 			}
 		case "OAUTH_REFRESH_TOKEN_VALIDITY":
 			i, err := strconv.Atoi(v.(string))
 			if err != nil {
-				return errors.Wrap(err, "returned OAuth refresh token validity that is not integer")
+				return diag.FromErr(errors.Wrap(err, "returned OAuth refresh token validity that is not integer"))
 			}
 			if err = d.Set("oauth_refresh_token_validity", i); err != nil {
-				return errors.Wrap(err, "unable to set OAuth refresh token validity for security integration")
+				return diag.FromErr(errors.Wrap(err, "unable to set OAuth refresh token validity for security integration"))
 			}
 		case "OAUTH_USE_SECONDARY_ROLES":
 			if err = d.Set("oauth_use_secondary_roles", v.(string)); err != nil {
-				return errors.Wrap(err, "unable to set OAuth use secondary roles for security integration")
+				return diag.FromErr(errors.Wrap(err, "unable to set OAuth use secondary roles for security integration"))
 			}
 		case "BLOCKED_ROLES_LIST":
 			blockedRolesAll := strings.Split(v.(string), ",")
@@ -218,7 +230,7 @@ func ReadOAuthIntegration(d *schema.ResourceData, meta interface{}) error {
 			}
 
 			if err = d.Set("blocked_roles_list", blockedRolesCustom); err != nil {
-				return errors.Wrap(err, "unable to set blocked roles list for security integration")
+				return diag.FromErr(errors.Wrap(err, "unable to set blocked roles list for security integration"))
 			}
 		case "OAUTH_CLIENT_TYPE":
 			// Only used for custom OAuth clients (not supported yet)
@@ -240,11 +252,11 @@ func ReadOAuthIntegration(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return err
+	return diag.FromErr(err)
 }
 
-// UpdateOAuthIntegration implements schema.UpdateFunc
-func UpdateOAuthIntegration(d *schema.ResourceData, meta interface{}) error {
+// UpdateOAuthIntegration implements schema.UpdateContextFunc
+func UpdateOAuthIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	id := d.Id()
 
@@ -288,15 +300,15 @@ func UpdateOAuthIntegration(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if runSetStatement {
-		if err := snowflake.Exec(db, stmt.Statement()); err != nil {
-			return errors.Wrap(err, "error updating security integration")
+		if err := snowflake.ExecContext(ctx, db, stmt.Statement()); err != nil {
+			return diag.FromErr(errors.Wrap(err, "error updating security integration"))
 		}
 	}
 
-	return ReadOAuthIntegration(d, meta)
+	return ReadOAuthIntegration(ctx, d, meta)
 }
 
-// DeleteOAuthIntegration implements schema.DeleteFunc
-func DeleteOAuthIntegration(d *schema.ResourceData, meta interface{}) error {
-	return DeleteResource("", snowflake.OAuthIntegration)(d, meta)
+// DeleteOAuthIntegration implements schema.DeleteContextFunc
+func DeleteOAuthIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return DeleteResource("", snowflake.OAuthIntegration)(ctx, d, meta)
 }
@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -34,8 +35,8 @@ func TestOAuthIntegrationCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadOAuthIntegration(mock)
 
-		err := resources.CreateOAuthIntegration(d, db)
-		r.NoError(err)
+		diags := resources.CreateOAuthIntegration(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -47,8 +48,8 @@ func TestOAuthIntegrationRead(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadOAuthIntegration(mock)
 
-		err := resources.ReadOAuthIntegration(d, db)
-		r.NoError(err)
+		diags := resources.ReadOAuthIntegration(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -59,8 +60,8 @@ func TestOAuthIntegrationDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP SECURITY INTEGRATION "drop_it"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteOAuthIntegration(d, db)
-		r.NoError(err)
+		diags := resources.DeleteOAuthIntegration(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -0,0 +1,593 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+	"github.com/snowflakedb/gosnowflake"
+)
+
+const (
+	objectGrantsIDDelimiter = '|'
+)
+
+// objectGrantsTarget describes an object type supported by
+// snowflake_object_grants.
+type objectGrantsTarget struct {
+	// scope is the number of parents of the object: 0 for account level
+	// objects, 1 for objects in a database and 2 for objects in a schema.
+	scope      int
+	validPrivs PrivilegeSet
+	builder    func(db, schema, name string) snowflake.GrantBuilder
+}
+
+var objectGrantsTargets = map[string]objectGrantsTarget{
+	"DATABASE": {0, validDatabasePrivileges, func(_, _, name string) snowflake.GrantBuilder {
+		return snowflake.DatabaseGrant(name)
+	}},
+	"INTEGRATION": {0, validIntegrationPrivileges, func(_, _, name string) snowflake.GrantBuilder {
+		return snowflake.IntegrationGrant(name)
+	}},
+	"RESOURCE MONITOR": {0, validResourceMonitorPrivileges, func(_, _, name string) snowflake.GrantBuilder {
+		return snowflake.ResourceMonitorGrant(name)
+	}},
+	"WAREHOUSE": {0, validWarehousePrivileges, func(_, _, name string) snowflake.GrantBuilder {
+		return snowflake.WarehouseGrant(name)
+	}},
+	"SCHEMA": {1, validSchemaPrivileges, func(db, _, name string) snowflake.GrantBuilder {
+		return snowflake.SchemaGrant(db, name)
+	}},
+	"EXTERNAL TABLE":    {2, validExternalTablePrivileges, snowflake.ExternalTableGrant},
+	"FILE FORMAT":       {2, validFileFormatPrivileges, snowflake.FileFormatGrant},
+	"MASKING POLICY":    {2, validMaskingPoilcyPrivileges, snowflake.MaskingPolicyGrant},
+	"MATERIALIZED VIEW": {2, validMaterializedViewPrivileges, snowflake.MaterializedViewGrant},
+	"PIPE":              {2, validPipePrivileges, snowflake.PipeGrant},
+	"ROW ACCESS POLICY": {2, validRowAccessPoilcyPrivileges, snowflake.RowAccessPolicyGrant},
+	"SEQUENCE":          {2, validSequencePrivileges, snowflake.SequenceGrant},
+	"STAGE":             {2, validStagePrivileges, snowflake.StageGrant},
+	"STREAM":            {2, validStreamPrivileges, snowflake.StreamGrant},
+	"TABLE":             {2, validTablePrivileges, snowflake.TableGrant},
+	"TASK":              {2, validTaskPrivileges, snowflake.TaskGrant},
+	"VIEW":              {2, validViewPrivileges, snowflake.ViewGrant},
+}
+
+func objectGrantsTypes() []string {
+	types := make([]string, 0, len(objectGrantsTargets))
+	for t := range objectGrantsTargets {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+var objectGrantsSchema = map[string]*schema.Schema{
+	"object_type": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		Description:  fmt.Sprintf("The type of the object on which to manage privileges, one of %s.", strings.Join(objectGrantsTypes(), ", ")),
+		ValidateFunc: validation.StringInSlice(objectGrantsTypes(), false),
+	},
+	"object_name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The name of the object on which to manage privileges.",
+	},
+	"database_name": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "The database containing the object. Required for schemas and schema objects.",
+	},
+	"schema_name": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "The schema containing the object. Required for schema objects.",
+	},
+	"privilege": {
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "A privilege along with the roles it is granted to. Grants of privileges on the object to roles and database roles that are not listed here are revoked; OWNERSHIP and grants to shares are left untouched.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The privilege to grant on the object.",
+					ValidateFunc: validation.StringNotInSlice([]string{privilegeOwnership.String()}, false),
+				},
+				"roles": {
+					Type:        schema.TypeSet,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Optional:    true,
+					Description: "Grants the privilege to these roles.",
+				},
+				"database_roles": {
+					Type:        schema.TypeSet,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Optional:    true,
+					Description: "Grants the privilege to these database roles. Database roles belong to the database of the object, or to the database itself for object_type DATABASE.",
+				},
+				"with_grant_option": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "When this is set to true, allows the recipient roles to grant the privilege to other roles.",
+				},
+			},
+		},
+	},
+}
+
+// ObjectGrants returns a pointer to the resource representing all the
+// privileges granted to roles on a single object
+func ObjectGrants() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateObjectGrants,
+		ReadContext:   ReadObjectGrants,
+		UpdateContext: UpdateObjectGrants,
+		DeleteContext: DeleteObjectGrants,
+
+		Schema:        objectGrantsSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(objectGrantsSchema, upgradeUnchangedV0),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: customdiff.All(validateObjectGrants),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+	}
+}
+
+type objectGrantsID struct {
+	ObjectType   string
+	DatabaseName string
+	SchemaName   string
+	ObjectName   string
+}
+
+// String() takes in an objectGrantsID object and returns a pipe-delimited string:
+// ObjectType|DatabaseName|SchemaName|ObjectName
+func (oi *objectGrantsID) String() (string, error) {
+	var buf bytes.Buffer
+	csvWriter := csv.NewWriter(&buf)
+	csvWriter.Comma = objectGrantsIDDelimiter
+	dataIdentifiers := [][]string{{oi.ObjectType, oi.DatabaseName, oi.SchemaName, oi.ObjectName}}
+	err := csvWriter.WriteAll(dataIdentifiers)
+	if err != nil {
+		return "", err
+	}
+	strObjectGrantsID := strings.TrimSpace(buf.String())
+	return strObjectGrantsID, nil
+}
+
+// objectGrantsIDFromString() takes in a pipe-delimited string: ObjectType|DatabaseName|SchemaName|ObjectName
+// and returns an objectGrantsID object
+func objectGrantsIDFromString(stringID string) (*objectGrantsID, error) {
+	reader := csv.NewReader(strings.NewReader(stringID))
+	reader.Comma = objectGrantsIDDelimiter
+	lines, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("not CSV compatible")
+	}
+
+	if len(lines) != 1 {
+		return nil, fmt.Errorf("1 line per object")
+	}
+	if len(lines[0]) != 4 {
+		return nil, fmt.Errorf("4 fields allowed")
+	}
+
+	return &objectGrantsID{
+		ObjectType:   lines[0][0],
+		DatabaseName: lines[0][1],
+		SchemaName:   lines[0][2],
+		ObjectName:   lines[0][3],
+	}, nil
+}
+
+// database returns the database the object belongs to, or an empty string
+// for account level objects other than databases.
+func (oi *objectGrantsID) database() string {
+	if oi.ObjectType == "DATABASE" {
+		return oi.ObjectName
+	}
+	return oi.DatabaseName
+}
+
+// builder returns the GrantBuilder of the object identified by oi.
+func (oi *objectGrantsID) builder() (snowflake.GrantBuilder, error) {
+	target, ok := objectGrantsTargets[oi.ObjectType]
+	if !ok {
+		return nil, fmt.Errorf("unsupported object type %v", oi.ObjectType)
+	}
+	return target.builder(oi.DatabaseName, oi.SchemaName, oi.ObjectName), nil
+}
+
+// objectGrant is a single privilege granted to a role, or to a database role
+// of the database of the object when DatabaseRole is set.
+type objectGrant struct {
+	Privilege    string
+	Role         string
+	DatabaseRole bool
+	GrantOption  bool
+}
+
+// executable returns the GrantExecutable granting g on the object of builder.
+func (g objectGrant) executable(builder snowflake.GrantBuilder, database string) snowflake.GrantExecutable {
+	if g.DatabaseRole {
+		return builder.DatabaseRole(database, g.Role)
+	}
+	return builder.Role(g.Role)
+}
+
+func (g objectGrant) grantee() string {
+	if g.DatabaseRole {
+		return fmt.Sprintf("database role %v", g.Role)
+	}
+	return fmt.Sprintf("role %v", g.Role)
+}
+
+// expandObjectGrants flattens the privilege blocks into the set of grants they
+// declare.
+func expandObjectGrants(privileges *schema.Set) map[objectGrant]bool {
+	grants := map[objectGrant]bool{}
+	for _, p := range privileges.List() {
+		privilege := p.(map[string]interface{})
+		for _, role := range expandStringList(privilege["roles"].(*schema.Set).List()) {
+			grants[objectGrant{
+				Privilege:   privilege["name"].(string),
+				Role:        role,
+				GrantOption: privilege["with_grant_option"].(bool),
+			}] = true
+		}
+		for _, role := range expandStringList(privilege["database_roles"].(*schema.Set).List()) {
+			grants[objectGrant{
+				Privilege:    privilege["name"].(string),
+				Role:         role,
+				DatabaseRole: true,
+				GrantOption:  privilege["with_grant_option"].(bool),
+			}] = true
+		}
+	}
+	return grants
+}
+
+// flattenObjectGrants groups grants into privilege blocks, one per privilege
+// and grant option.
+func flattenObjectGrants(grants map[objectGrant]bool) []interface{} {
+	type key struct {
+		privilege   string
+		grantOption bool
+	}
+	roles := map[key][]string{}
+	databaseRoles := map[key][]string{}
+	keys := []key{}
+	for g := range grants {
+		k := key{g.Privilege, g.GrantOption}
+		_, hasRoles := roles[k]
+		_, hasDatabaseRoles := databaseRoles[k]
+		if !hasRoles && !hasDatabaseRoles {
+			keys = append(keys, k)
+		}
+		if g.DatabaseRole {
+			databaseRoles[k] = append(databaseRoles[k], g.Role)
+		} else {
+			roles[k] = append(roles[k], g.Role)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].privilege != keys[j].privilege {
+			return keys[i].privilege < keys[j].privilege
+		}
+		return !keys[i].grantOption && keys[j].grantOption
+	})
+
+	privileges := make([]interface{}, 0, len(keys))
+	for _, k := range keys {
+		sort.Strings(roles[k])
+		sort.Strings(databaseRoles[k])
+		privileges = append(privileges, map[string]interface{}{
+			"name":              k.privilege,
+			"roles":             roles[k],
+			"database_roles":    databaseRoles[k],
+			"with_grant_option": k.grantOption,
+		})
+	}
+	return privileges
+}
+
+// sortedObjectGrants returns grants in a stable order so that statements are
+// always issued in the same sequence.
+func sortedObjectGrants(grants map[objectGrant]bool) []objectGrant {
+	sorted := make([]objectGrant, 0, len(grants))
+	for g := range grants {
+		sorted = append(sorted, g)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Privilege != sorted[j].Privilege {
+			return sorted[i].Privilege < sorted[j].Privilege
+		}
+		if sorted[i].DatabaseRole != sorted[j].DatabaseRole {
+			return !sorted[i].DatabaseRole
+		}
+		if sorted[i].Role != sorted[j].Role {
+			return sorted[i].Role < sorted[j].Role
+		}
+		return !sorted[i].GrantOption && sorted[j].GrantOption
+	})
+	return sorted
+}
+
+// readObjectGrants returns the privileges currently granted to roles and
+// database roles on the object, leaving out OWNERSHIP. Database roles are
+// reported by Snowflake as DATABASE.ROLE and returned without their database.
+func readObjectGrants(ctx context.Context, db *sql.DB, builder snowflake.GrantBuilder, database string) (map[objectGrant]bool, error) {
+	grants, err := readGenericCurrentGrants(ctx, db, builder)
+	if err != nil {
+		return nil, err
+	}
+
+	current := map[objectGrant]bool{}
+	for _, g := range grants {
+		if g.Privilege == privilegeOwnership.String() {
+			continue
+		}
+		switch g.GranteeType {
+		case "ROLE":
+			current[objectGrant{
+				Privilege:   g.Privilege,
+				Role:        g.GranteeName,
+				GrantOption: g.GrantOption,
+			}] = true
+		case "DATABASE_ROLE":
+			current[objectGrant{
+				Privilege:    g.Privilege,
+				Role:         strings.TrimPrefix(g.GranteeName, database+"."),
+				DatabaseRole: true,
+				GrantOption:  g.GrantOption,
+			}] = true
+		}
+	}
+	return current, nil
+}
+
+// applyObjectGrants revokes every privilege on the object that is not in
+// desired and grants the missing ones.
+func applyObjectGrants(ctx context.Context, db *sql.DB, builder snowflake.GrantBuilder, database string, desired map[objectGrant]bool) error {
+	current, err := readObjectGrants(ctx, db, builder, database)
+	if err != nil {
+		return err
+	}
+
+	for _, g := range sortedObjectGrants(current) {
+		if desired[g] {
+			continue
+		}
+		for _, stmt := range g.executable(builder, database).Revoke(g.Privilege) {
+			if err := snowflake.ExecContext(ctx, db, stmt); err != nil {
+				return errors.Wrapf(err, "error revoking %v on %v %v from %v", g.Privilege, builder.GrantType(), builder.Name(), g.grantee())
+			}
+		}
+	}
+
+	for _, g := range sortedObjectGrants(desired) {
+		if current[g] {
+			continue
+		}
+		stmt := g.executable(builder, database).Grant(g.Privilege, g.GrantOption)
+		if err := snowflake.ExecContext(ctx, db, stmt); err != nil {
+			return errors.Wrapf(err, "error granting %v on %v %v to %v", g.Privilege, builder.GrantType(), builder.Name(), g.grantee())
+		}
+	}
+	return nil
+}
+
+// CreateObjectGrants implements schema.CreateContextFunc
+func CreateObjectGrants(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	objectGrants := &objectGrantsID{
+		ObjectType:   d.Get("object_type").(string),
+		DatabaseName: d.Get("database_name").(string),
+		SchemaName:   d.Get("schema_name").(string),
+		ObjectName:   d.Get("object_name").(string),
+	}
+	builder, err := objectGrants.builder()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = applyObjectGrants(ctx, db, builder, objectGrants.database(), expandObjectGrants(d.Get("privilege").(*schema.Set)))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dataIDInput, err := objectGrants.String()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(dataIDInput)
+
+	return ReadObjectGrants(ctx, d, meta)
+}
+
+// ReadObjectGrants implements schema.ReadContextFunc
+func ReadObjectGrants(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	objectGrants, err := objectGrantsIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	builder, err := objectGrants.builder()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	current, err := readObjectGrants(ctx, db, builder, objectGrants.database())
+	if err != nil {
+		if snowflakeErr, ok := err.(*gosnowflake.SnowflakeError); ok &&
+			snowflakeErr.Number == 2003 &&
+			strings.Contains(err.Error(), "does not exist or not authorized") {
+			log.Printf("[WARN] object grants (%s) not found, removing from state file", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("object_type", objectGrants.ObjectType); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("database_name", objectGrants.DatabaseName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("schema_name", objectGrants.SchemaName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("object_name", objectGrants.ObjectName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("privilege", flattenObjectGrants(current)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// UpdateObjectGrants implements schema.UpdateContextFunc
+func UpdateObjectGrants(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	objectGrants, err := objectGrantsIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	builder, err := objectGrants.builder()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("privilege") {
+		err = applyObjectGrants(ctx, db, builder, objectGrants.database(), expandObjectGrants(d.Get("privilege").(*schema.Set)))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return ReadObjectGrants(ctx, d, meta)
+}
+
+// DeleteObjectGrants implements schema.DeleteContextFunc
+func DeleteObjectGrants(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	objectGrants, err := objectGrantsIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	builder, err := objectGrants.builder()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, g := range sortedObjectGrants(expandObjectGrants(d.Get("privilege").(*schema.Set))) {
+		for _, stmt := range g.executable(builder, objectGrants.database()).Revoke(g.Privilege) {
+			if err := snowflake.ExecContext(ctx, db, stmt); err != nil {
+				return diag.FromErr(errors.Wrapf(err, "error revoking %v on %v %v from %v", g.Privilege, builder.GrantType(), builder.Name(), g.grantee()))
+			}
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// validateObjectGrants checks at plan time that the parents of the object are
+// given, that each privilege is valid on the object type, that database roles
+// are only used on objects of a database and that a privilege is not declared
+// twice with the same grant option.
+func validateObjectGrants(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !valuesKnown(d, "object_type", "database_name", "schema_name", "privilege") {
+		return nil
+	}
+	objectType := d.Get("object_type").(string)
+	target, ok := objectGrantsTargets[objectType]
+	if !ok {
+		return nil
+	}
+
+	if err := validateObjectParents(d, objectType, target); err != nil {
+		return err
+	}
+
+	seen := map[string]bool{}
+	// the grant option of a privilege granted to a role is either set or not
+	grantOptions := map[string]bool{}
+	for _, p := range d.Get("privilege").(*schema.Set).List() {
+		privilege := p.(map[string]interface{})
+		name := privilege["name"].(string)
+		if !target.validPrivs.hasString(name) {
+			return fmt.Errorf("privilege %v is not valid on object type %v", name, objectType)
+		}
+		if target.scope == 0 && objectType != "DATABASE" && privilege["database_roles"].(*schema.Set).Len() > 0 {
+			return fmt.Errorf("database_roles cannot be set for object type %v", objectType)
+		}
+		key := fmt.Sprintf("%v|%v", name, privilege["with_grant_option"].(bool))
+		if seen[key] {
+			return fmt.Errorf("privilege %v is declared more than once with with_grant_option = %v", name, privilege["with_grant_option"].(bool))
+		}
+		seen[key] = true
+
+		withGrantOption := privilege["with_grant_option"].(bool)
+		grantees := []struct {
+			kind  string
+			names *schema.Set
+		}{
+			{"role", privilege["roles"].(*schema.Set)},
+			{"database role", privilege["database_roles"].(*schema.Set)},
+		}
+		for _, g := range grantees {
+			for _, grantee := range expandStringList(g.names.List()) {
+				granteeKey := fmt.Sprintf("%v|%v|%v", name, g.kind, grantee)
+				if option, ok := grantOptions[granteeKey]; ok && option != withGrantOption {
+					return fmt.Errorf("privilege %v is granted to %v %v both with and without with_grant_option", name, g.kind, grantee)
+				}
+				grantOptions[granteeKey] = withGrantOption
+			}
+		}
+	}
+	return nil
+}
+
+// validateObjectParents checks that database_name and schema_name are set
+// exactly when objects of objectType live in a database or a schema.
+func validateObjectParents(d *schema.ResourceDiff, objectType string, target objectGrantsTarget) error {
+	hasDatabase := d.Get("database_name").(string) != ""
+	hasSchema := d.Get("schema_name").(string) != ""
+	switch {
+	case target.scope == 0 && (hasDatabase || hasSchema):
+		return fmt.Errorf("database_name and schema_name cannot be set for object type %v", objectType)
+	case target.scope == 1 && (!hasDatabase || hasSchema):
+		return fmt.Errorf("database_name is required and schema_name cannot be set for object type %v", objectType)
+	case target.scope == 2 && (!hasDatabase || !hasSchema):
+		return fmt.Errorf("database_name and schema_name are required for object type %v", objectType)
+	}
+	return nil
+}
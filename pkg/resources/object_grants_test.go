@@ -0,0 +1,290 @@
+package resources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/provider"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/resources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObjectGrants(t *testing.T) {
+	r := require.New(t)
+	err := resources.ObjectGrants().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestObjectGrantsCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"object_type":   "SCHEMA",
+		"database_name": "test-db",
+		"object_name":   "test-schema",
+		"privilege": []interface{}{
+			map[string]interface{}{
+				"name":  "USAGE",
+				"roles": []interface{}{"test-role-1", "test-role-2"},
+			},
+			map[string]interface{}{
+				"name":              "CREATE TABLE",
+				"roles":             []interface{}{"test-role-1"},
+				"with_grant_option": true,
+			},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, resources.ObjectGrants().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{
+			"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by",
+		}).AddRow(
+			time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "OWNERSHIP", "SCHEMA", "test-schema", "ROLE", "owner", false, "owner",
+		).AddRow(
+			time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "USAGE", "SCHEMA", "test-schema", "ROLE", "test-role-1", false, "owner",
+		).AddRow(
+			time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "USAGE", "SCHEMA", "test-schema", "ROLE", "out-of-band", false, "owner",
+		).AddRow(
+			time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "USAGE", "SCHEMA", "test-schema", "SHARE", "test-share", false, "owner",
+		)
+		mock.ExpectQuery(`^SHOW GRANTS ON SCHEMA "test-db"."test-schema"$`).WillReturnRows(rows)
+		mock.ExpectExec(`^REVOKE USAGE ON SCHEMA "test-db"."test-schema" FROM ROLE "out-of-band"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^GRANT CREATE TABLE ON SCHEMA "test-db"."test-schema" TO ROLE "test-role-1" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^GRANT USAGE ON SCHEMA "test-db"."test-schema" TO ROLE "test-role-2"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadObjectGrants(mock)
+		diags := resources.CreateObjectGrants(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("SCHEMA|test-db||test-schema", d.Id())
+	})
+}
+
+func TestObjectGrantsRead(t *testing.T) {
+	r := require.New(t)
+
+	d := objectGrants(t, "SCHEMA|test-db||test-schema", map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadObjectGrants(mock)
+		diags := resources.ReadObjectGrants(context.Background(), d, db)
+		r.Empty(diags)
+	})
+
+	r.Equal("SCHEMA", d.Get("object_type"))
+	r.Equal("test-db", d.Get("database_name"))
+	r.Equal("test-schema", d.Get("object_name"))
+
+	privileges := d.Get("privilege").(*schema.Set).List()
+	r.Len(privileges, 2)
+	for _, p := range privileges {
+		privilege := p.(map[string]interface{})
+		switch privilege["name"] {
+		case "USAGE":
+			r.ElementsMatch([]interface{}{"test-role-1", "test-role-2"}, privilege["roles"].(*schema.Set).List())
+			r.False(privilege["with_grant_option"].(bool))
+		case "CREATE TABLE":
+			r.ElementsMatch([]interface{}{"test-role-1"}, privilege["roles"].(*schema.Set).List())
+			r.True(privilege["with_grant_option"].(bool))
+		default:
+			r.Failf("unexpected privilege", "%v", privilege["name"])
+		}
+	}
+}
+
+func TestObjectGrantsDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := objectGrants(t, "WAREHOUSE|||test-warehouse", map[string]interface{}{
+		"object_type": "WAREHOUSE",
+		"object_name": "test-warehouse",
+		"privilege": []interface{}{
+			map[string]interface{}{
+				"name":  "USAGE",
+				"roles": []interface{}{"test-role-1"},
+			},
+		},
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^REVOKE USAGE ON WAREHOUSE "test-warehouse" FROM ROLE "test-role-1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		diags := resources.DeleteObjectGrants(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
+
+func TestObjectGrantsDatabaseRoles(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"object_type": "DATABASE",
+		"object_name": "test-db",
+		"privilege": []interface{}{
+			map[string]interface{}{
+				"name":           "USAGE",
+				"roles":          []interface{}{"test-role-1"},
+				"database_roles": []interface{}{"reader"},
+			},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, resources.ObjectGrants().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{
+			"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by",
+		}).AddRow(
+			time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "USAGE", "DATABASE", "test-db", "ROLE", "test-role-1", false, "owner",
+		).AddRow(
+			time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "USAGE", "DATABASE", "test-db", "DATABASE_ROLE", "test-db.stale", false, "owner",
+		)
+		mock.ExpectQuery(`^SHOW GRANTS ON DATABASE "test-db"$`).WillReturnRows(rows)
+		mock.ExpectExec(`^REVOKE USAGE ON DATABASE "test-db" FROM DATABASE ROLE "test-db"."stale"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^GRANT USAGE ON DATABASE "test-db" TO DATABASE ROLE "test-db"."reader"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		rows = sqlmock.NewRows([]string{
+			"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by",
+		}).AddRow(
+			time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "USAGE", "DATABASE", "test-db", "ROLE", "test-role-1", false, "owner",
+		).AddRow(
+			time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "USAGE", "DATABASE", "test-db", "DATABASE_ROLE", "test-db.reader", false, "owner",
+		)
+		mock.ExpectQuery(`^SHOW GRANTS ON DATABASE "test-db"$`).WillReturnRows(rows)
+		diags := resources.CreateObjectGrants(context.Background(), d, db)
+		r.Empty(diags)
+	})
+
+	privileges := d.Get("privilege").(*schema.Set).List()
+	r.Len(privileges, 1)
+	privilege := privileges[0].(map[string]interface{})
+	r.ElementsMatch([]interface{}{"test-role-1"}, privilege["roles"].(*schema.Set).List())
+	r.ElementsMatch([]interface{}{"reader"}, privilege["database_roles"].(*schema.Set).List())
+}
+
+func TestObjectGrantsCustomizeDiff(t *testing.T) {
+	r := require.New(t)
+
+	err := planDiff(resources.ObjectGrants(), map[string]interface{}{
+		"object_type": "TABLE",
+		"object_name": "test-table",
+	})
+	r.Error(err)
+	r.Contains(err.Error(), "database_name and schema_name are required for object type TABLE")
+
+	err = planDiff(resources.ObjectGrants(), map[string]interface{}{
+		"object_type": "WAREHOUSE",
+		"object_name": "test-warehouse",
+		"privilege": []interface{}{
+			map[string]interface{}{
+				"name":  "SELECT",
+				"roles": []interface{}{"test-role-1"},
+			},
+		},
+	})
+	r.Error(err)
+	r.Contains(err.Error(), "privilege SELECT is not valid on object type WAREHOUSE")
+
+	err = planDiff(resources.ObjectGrants(), map[string]interface{}{
+		"object_type": "WAREHOUSE",
+		"object_name": "test-warehouse",
+		"privilege": []interface{}{
+			map[string]interface{}{
+				"name":  "USAGE",
+				"roles": []interface{}{"test-role-1"},
+			},
+			map[string]interface{}{
+				"name":  "USAGE",
+				"roles": []interface{}{"test-role-2"},
+			},
+		},
+	})
+	r.Error(err)
+	r.Contains(err.Error(), "privilege USAGE is declared more than once")
+
+	err = planDiff(resources.ObjectGrants(), map[string]interface{}{
+		"object_type": "WAREHOUSE",
+		"object_name": "test-warehouse",
+		"privilege": []interface{}{
+			map[string]interface{}{
+				"name":  "USAGE",
+				"roles": []interface{}{"test-role-1", "test-role-2"},
+			},
+			map[string]interface{}{
+				"name":              "USAGE",
+				"roles":             []interface{}{"test-role-2"},
+				"with_grant_option": true,
+			},
+		},
+	})
+	r.Error(err)
+	r.Contains(err.Error(), "privilege USAGE is granted to role test-role-2 both with and without with_grant_option")
+
+	err = planDiff(resources.ObjectGrants(), map[string]interface{}{
+		"object_type": "WAREHOUSE",
+		"object_name": "test-warehouse",
+		"privilege": []interface{}{
+			map[string]interface{}{
+				"name":  "USAGE",
+				"roles": []interface{}{"test-role-1"},
+			},
+			map[string]interface{}{
+				"name":              "USAGE",
+				"roles":             []interface{}{"test-role-2"},
+				"with_grant_option": true,
+			},
+			map[string]interface{}{
+				"name":  "MONITOR",
+				"roles": []interface{}{"test-role-2"},
+			},
+		},
+	})
+	r.NoError(err)
+
+	err = planDiff(resources.ObjectGrants(), map[string]interface{}{
+		"object_type": "WAREHOUSE",
+		"object_name": "test-warehouse",
+		"privilege": []interface{}{
+			map[string]interface{}{
+				"name":           "USAGE",
+				"database_roles": []interface{}{"reader"},
+			},
+		},
+	})
+	r.Error(err)
+	r.Contains(err.Error(), "database_roles")
+
+	err = planDiff(resources.ObjectGrants(), map[string]interface{}{
+		"object_type":   "SCHEMA",
+		"database_name": "test-db",
+		"object_name":   "test-schema",
+		"privilege": []interface{}{
+			map[string]interface{}{
+				"name":  "USAGE",
+				"roles": []interface{}{"test-role-1"},
+			},
+		},
+	})
+	r.NoError(err)
+}
+
+func expectReadObjectGrants(mock sqlmock.Sqlmock) {
+	rows := sqlmock.NewRows([]string{
+		"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by",
+	}).AddRow(
+		time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "OWNERSHIP", "SCHEMA", "test-schema", "ROLE", "owner", false, "owner",
+	).AddRow(
+		time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "USAGE", "SCHEMA", "test-schema", "ROLE", "test-role-1", false, "owner",
+	).AddRow(
+		time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "USAGE", "SCHEMA", "test-schema", "ROLE", "test-role-2", false, "owner",
+	).AddRow(
+		time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "CREATE TABLE", "SCHEMA", "test-schema", "ROLE", "test-role-1", true, "owner",
+	).AddRow(
+		time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "USAGE", "SCHEMA", "test-schema", "SHARE", "test-share", false, "owner",
+	)
+	mock.ExpectQuery(`^SHOW GRANTS ON SCHEMA "test-db"."test-schema"$`).WillReturnRows(rows)
+}
@@ -0,0 +1,54 @@
+package resources
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strings"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+)
+
+// The IDs of databases, schemas and schema objects hold the names of the
+// object and of its parents as listed by Snowflake, pipe-delimited with CSV
+// quoting, e.g. MY_DB|PUBLIC|"My ""quoted"" table". Each name is read back as
+// a case-sensitive identifier, the way builders quote names from
+// configuration, and validated the same way. Parents are empty where optional.
+
+// objectIDDelimiter separates the names in the ID of an object.
+const objectIDDelimiter = '|'
+
+// objectNames reads the names of an object ID as identifiers.
+func objectNames(fields []string) ([]snowflake.Identifier, error) {
+	ids := make([]snowflake.Identifier, len(fields))
+	for i, field := range fields {
+		if field != "" {
+			if _, errs := snowflake.ValidateIdentifier(field); len(errs) > 0 {
+				return nil, fmt.Errorf("invalid name %v in ID: %v", field, errs[0])
+			}
+		}
+		ids[i] = snowflake.QuotedIdentifier(field)
+	}
+	return ids, nil
+}
+
+// formatObjectID writes the ID of an object from its fields.
+func formatObjectID(fields ...string) string {
+	var buf bytes.Buffer
+	csvWriter := csv.NewWriter(&buf)
+	csvWriter.Comma = objectIDDelimiter
+	// writing to a bytes.Buffer does not fail
+	_ = csvWriter.WriteAll([][]string{fields})
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// splitObjectID reads the n fields of an object ID written by formatObjectID.
+func splitObjectID(stringID string, n int) ([]string, error) {
+	reader := csv.NewReader(strings.NewReader(stringID))
+	reader.Comma = objectIDDelimiter
+	lines, err := reader.ReadAll()
+	if err != nil || len(lines) != 1 || len(lines[0]) != n {
+		return nil, fmt.Errorf("ID %v is invalid", stringID)
+	}
+	return lines[0], nil
+}
@@ -0,0 +1,37 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestObjectID(t *testing.T) {
+	r := require.New(t)
+
+	id := formatObjectID("database_name", "My Schema", `my "table"|1`)
+	r.Equal(`database_name|My Schema|"my ""table""|1"`, id)
+
+	fields, err := splitObjectID(id, 3)
+	r.NoError(err)
+	names, err := objectNames(fields)
+	r.NoError(err)
+	r.Equal("database_name", names[0].Name())
+	r.Equal("My Schema", names[1].Name())
+	r.Equal(`my "table"|1`, names[2].Name())
+	r.Equal(`"database_name"."My Schema"."my ""table""|1"`, snowflake.JoinIdentifiers(names...))
+
+	// empty parents are allowed
+	names, err = objectNames([]string{"database_name", "", ""})
+	r.NoError(err)
+	r.Equal("", names[1].Name())
+
+	// names are not quoted twice
+	_, err = objectNames([]string{"database_name", `"My Schema"`})
+	r.Error(err)
+
+	_, err = splitObjectID("database_name|schema_name", 3)
+	r.EqualError(err, "ID database_name|schema_name is invalid")
+}
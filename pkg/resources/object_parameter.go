@@ -0,0 +1,273 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+)
+
+// objectParameterTypes are the kinds of object parameters can be set on, by
+// the number of parts of their qualified name
+var objectParameterTypes = map[string]int{
+	"DATABASE":  1,
+	"USER":      1,
+	"WAREHOUSE": 1,
+	"SCHEMA":    2,
+	"TABLE":     3,
+	"TASK":      3,
+}
+
+var objectParameterSchema = map[string]*schema.Schema{
+	"key": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      "Name of the parameter, e.g. `DATA_RETENTION_TIME_IN_DAYS`.",
+		DiffSuppressFunc: diffCaseInsensitive,
+		ValidateFunc:     validation.StringMatch(parameterKeyPattern, "parameter names only contain letters, numbers and underscores"),
+	},
+	"value": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      "Value of the parameter, as a string. Numbers and booleans are set as such.",
+		DiffSuppressFunc: diffCaseInsensitive,
+	},
+	"object_type": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      "Type of the object the parameter is set on, one of DATABASE, SCHEMA, TABLE, TASK, USER or WAREHOUSE.",
+		ValidateFunc:     validation.StringInSlice([]string{"DATABASE", "SCHEMA", "TABLE", "TASK", "USER", "WAREHOUSE"}, true),
+		DiffSuppressFunc: diffCaseInsensitive,
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "The database of the schema, table or task the parameter is set on.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "The schema of the table or task the parameter is set on.",
+	},
+	"object_name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Name of the object the parameter is set on.",
+	},
+}
+
+// ObjectParameter returns a pointer to the resource representing a parameter set on an object
+func ObjectParameter() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateObjectParameter,
+		ReadContext:   ReadObjectParameter,
+		UpdateContext: UpdateObjectParameter,
+		DeleteContext: DeleteObjectParameter,
+
+		Schema:        objectParameterSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(objectParameterSchema, upgradeUnchangedV0),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+	}
+}
+
+type objectParameterID struct {
+	Key          string
+	ObjectType   string
+	DatabaseName string
+	SchemaName   string
+	ObjectName   string
+}
+
+// String() takes in an objectParameterID object and returns a pipe-delimited string:
+// Key|ObjectType|DatabaseName|SchemaName|ObjectName
+func (id *objectParameterID) String() (string, error) {
+	var buf bytes.Buffer
+	csvWriter := csv.NewWriter(&buf)
+	csvWriter.Comma = pipeIDDelimiter
+	dataIdentifiers := [][]string{{id.Key, id.ObjectType, id.DatabaseName, id.SchemaName, id.ObjectName}}
+	err := csvWriter.WriteAll(dataIdentifiers)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// objectParameterIDFromString() takes in a pipe-delimited string: Key|ObjectType|DatabaseName|SchemaName|ObjectName
+// and returns an objectParameterID object
+func objectParameterIDFromString(stringID string) (*objectParameterID, error) {
+	reader := csv.NewReader(strings.NewReader(stringID))
+	reader.Comma = pipeIDDelimiter
+	lines, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("Not CSV compatible")
+	}
+
+	if len(lines) != 1 {
+		return nil, fmt.Errorf("1 line per parameter")
+	}
+	if len(lines[0]) != 5 {
+		return nil, fmt.Errorf("5 fields allowed")
+	}
+
+	return &objectParameterID{
+		Key:          lines[0][0],
+		ObjectType:   lines[0][1],
+		DatabaseName: lines[0][2],
+		SchemaName:   lines[0][3],
+		ObjectName:   lines[0][4],
+	}, nil
+}
+
+// names returns the parts of the qualified name of the object the parameter is set on
+func (id *objectParameterID) names() []string {
+	names := []string{}
+	for _, n := range []string{id.DatabaseName, id.SchemaName, id.ObjectName} {
+		if n != "" {
+			names = append(names, n)
+		}
+	}
+	return names
+}
+
+func (id *objectParameterID) builder() *snowflake.ParameterBuilder {
+	return snowflake.ObjectParameter(id.Key, id.ObjectType, id.names()...)
+}
+
+// CreateObjectParameter implements schema.CreateContextFunc
+func CreateObjectParameter(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	id := &objectParameterID{
+		Key:          strings.ToUpper(d.Get("key").(string)),
+		ObjectType:   strings.ToUpper(d.Get("object_type").(string)),
+		DatabaseName: d.Get("database").(string),
+		SchemaName:   d.Get("schema").(string),
+		ObjectName:   d.Get("object_name").(string),
+	}
+
+	// the qualified name must match the kind of object, e.g. a schema is named in a database
+	if len(id.names()) != objectParameterTypes[id.ObjectType] {
+		return diag.Errorf("a %v is named by %v parts of database, schema and object_name, got %v", id.ObjectType, objectParameterTypes[id.ObjectType], strings.Join(id.names(), "."))
+	}
+
+	stmt := id.builder().Set(d.Get("value").(string))
+	err := snowflake.ExecContext(ctx, db, stmt)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error setting parameter %v on %v %v", id.Key, id.ObjectType, id.ObjectName))
+	}
+
+	dataIDInput, err := id.String()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(dataIDInput)
+
+	return ReadObjectParameter(ctx, d, meta)
+}
+
+// ReadObjectParameter implements schema.ReadContextFunc
+func ReadObjectParameter(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	id, err := objectParameterIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	stmt := id.builder().Show()
+	row := snowflake.QueryRowContext(ctx, db, stmt)
+	p, err := snowflake.ScanParameter(row)
+	if err == sql.ErrNoRows || isNotFoundError(err) {
+		log.Printf("[DEBUG] object parameter (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// a value inherited from the account or a parent object is not set on the object
+	if !p.IsSetOn(id.ObjectType) {
+		log.Printf("[DEBUG] object parameter (%s) not set on the object but at level %q", d.Id(), p.Level.String)
+		d.SetId("")
+		return nil
+	}
+
+	toSet := map[string]interface{}{
+		"key":         p.Key.String,
+		"value":       p.Value.String,
+		"object_type": id.ObjectType,
+		"database":    id.DatabaseName,
+		"schema":      id.SchemaName,
+		"object_name": id.ObjectName,
+	}
+	for key, val := range toSet {
+		err = d.Set(key, val) //lintignore:R001
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// UpdateObjectParameter implements schema.UpdateContextFunc
+func UpdateObjectParameter(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	id, err := objectParameterIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("value") {
+		stmt := id.builder().Set(d.Get("value").(string))
+		err := snowflake.ExecContext(ctx, db, stmt)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error updating object parameter %v", d.Id()))
+		}
+	}
+
+	return ReadObjectParameter(ctx, d, meta)
+}
+
+// DeleteObjectParameter implements schema.DeleteContextFunc
+func DeleteObjectParameter(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	id, err := objectParameterIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	stmt := id.builder().Unset()
+	err = snowflake.ExecContext(ctx, db, stmt)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error unsetting object parameter %v", d.Id()))
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_ObjectParameter(t *testing.T) {
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: objectParameterConfig(accName, "7"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_object_parameter.test", "key", "MAX_DATA_EXTENSION_TIME_IN_DAYS"),
+					resource.TestCheckResourceAttr("snowflake_object_parameter.test", "value", "7"),
+					resource.TestCheckResourceAttr("snowflake_object_parameter.test", "object_type", "SCHEMA"),
+				),
+			},
+			{
+				Config: objectParameterConfig(accName, "14"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_object_parameter.test", "value", "14"),
+				),
+			},
+			{
+				ResourceName:      "snowflake_object_parameter.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func objectParameterConfig(n string, value string) string {
+	return fmt.Sprintf(`
+resource "snowflake_database" "test" {
+	name    = "%[1]v"
+	comment = "Terraform acceptance test"
+}
+
+resource "snowflake_schema" "test" {
+	name     = "%[1]v"
+	database = snowflake_database.test.name
+	comment  = "Terraform acceptance test"
+}
+
+resource "snowflake_object_parameter" "test" {
+	key         = "MAX_DATA_EXTENSION_TIME_IN_DAYS"
+	value       = "%[2]v"
+	object_type = "SCHEMA"
+	database    = snowflake_database.test.name
+	object_name = snowflake_schema.test.name
+}
+`, n, value)
+}
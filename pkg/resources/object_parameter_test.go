@@ -0,0 +1,112 @@
+package resources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/provider"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/resources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObjectParameter(t *testing.T) {
+	r := require.New(t)
+	err := resources.ObjectParameter().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestObjectParameterCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"key":         "DATA_RETENTION_TIME_IN_DAYS",
+		"value":       "30",
+		"object_type": "schema",
+		"database":    "test_db",
+		"object_name": "test_schema",
+	}
+	d := objectParameter(t, "", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER SCHEMA "test_db"."test_schema" SET DATA_RETENTION_TIME_IN_DAYS = 30$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadObjectParameter(mock, "SCHEMA")
+		diags := resources.CreateObjectParameter(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("DATA_RETENTION_TIME_IN_DAYS|SCHEMA|test_db||test_schema", d.Id())
+		r.Equal("30", d.Get("value").(string))
+	})
+}
+
+func TestObjectParameterCreateWrongName(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"key":         "DATA_RETENTION_TIME_IN_DAYS",
+		"value":       "30",
+		"object_type": "TABLE",
+		"object_name": "test_table",
+	}
+	d := objectParameter(t, "", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		diags := resources.CreateObjectParameter(context.Background(), d, db)
+		r.NotEmpty(diags)
+	})
+}
+
+func expectReadObjectParameter(mock sqlmock.Sqlmock, level string) {
+	rows := sqlmock.NewRows([]string{"key", "value", "default", "level", "description", "type"}).
+		AddRow("DATA_RETENTION_TIME_IN_DAYS", "30", "1", level, "retention", "NUMBER")
+	mock.ExpectQuery(`^SHOW PARAMETERS LIKE 'DATA_RETENTION_TIME_IN_DAYS' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+}
+
+func TestObjectParameterRead(t *testing.T) {
+	r := require.New(t)
+
+	d := objectParameter(t, "DATA_RETENTION_TIME_IN_DAYS|SCHEMA|test_db||test_schema", map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadObjectParameter(mock, "SCHEMA")
+		diags := resources.ReadObjectParameter(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("DATA_RETENTION_TIME_IN_DAYS", d.Get("key").(string))
+		r.Equal("30", d.Get("value").(string))
+		r.Equal("SCHEMA", d.Get("object_type").(string))
+		r.Equal("test_db", d.Get("database").(string))
+		r.Equal("test_schema", d.Get("object_name").(string))
+
+		// a value inherited from the database is not set on the schema
+		expectReadObjectParameter(mock, "DATABASE")
+		diags = resources.ReadObjectParameter(context.Background(), d, db)
+		r.Empty(diags)
+		r.Empty(d.Id())
+	})
+}
+
+func TestObjectParameterUpdate(t *testing.T) {
+	r := require.New(t)
+
+	d := objectParameter(t, "DATA_RETENTION_TIME_IN_DAYS|SCHEMA|test_db||test_schema", map[string]interface{}{"value": "30"})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER SCHEMA "test_db"."test_schema" SET DATA_RETENTION_TIME_IN_DAYS = 30$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadObjectParameter(mock, "SCHEMA")
+		diags := resources.UpdateObjectParameter(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
+
+func TestObjectParameterDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := objectParameter(t, "DATA_RETENTION_TIME_IN_DAYS|SCHEMA|test_db||test_schema", map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER SCHEMA "test_db"."test_schema" UNSET DATA_RETENTION_TIME_IN_DAYS$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		diags := resources.DeleteObjectParameter(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
@@ -0,0 +1,279 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+	"github.com/snowflakedb/gosnowflake"
+)
+
+var ownershipSchema = map[string]*schema.Schema{
+	"object_type": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		Description:  fmt.Sprintf("The type of the object to transfer, one of %s.", strings.Join(objectGrantsTypes(), ", ")),
+		ValidateFunc: validation.StringInSlice(objectGrantsTypes(), false),
+	},
+	"object_name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The name of the object to transfer.",
+	},
+	"database_name": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "The database containing the object. Required for schemas and schema objects.",
+	},
+	"schema_name": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "The schema containing the object. Required for schema objects.",
+	},
+	"role": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The role that owns the object.",
+	},
+	"current_grants": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      string(snowflake.CopyCurrentGrants),
+		Description:  "Whether the grants already on the object are kept (COPY) or removed (REVOKE) when ownership is transferred.",
+		ValidateFunc: validation.StringInSlice([]string{string(snowflake.CopyCurrentGrants), string(snowflake.RevokeCurrentGrants)}, false),
+	},
+	"restore_on_destroy": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "When this is set to true, ownership is transferred back to previous_owner when the resource is destroyed. Otherwise the object stays owned by role.",
+	},
+	"previous_owner": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "The role that owned the object before it was transferred by this resource. It is not known after import, so set it for restore_on_destroy to transfer ownership back.",
+	},
+}
+
+// Ownership returns a pointer to the resource representing the owner of an
+// object
+func Ownership() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateOwnership,
+		ReadContext:   ReadOwnership,
+		UpdateContext: UpdateOwnership,
+		DeleteContext: DeleteOwnership,
+
+		Schema:        ownershipSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(ownershipSchema, upgradeUnchangedV0),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: customdiff.All(validateOwnership),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+	}
+}
+
+// readOwner returns the role owning the object, or an empty string when the
+// object has no owner.
+func readOwner(ctx context.Context, db *sql.DB, builder snowflake.GrantBuilder) (string, error) {
+	grants, err := readGenericCurrentGrants(ctx, db, builder)
+	if err != nil {
+		return "", err
+	}
+	for _, g := range grants {
+		if g.Privilege == privilegeOwnership.String() && g.GranteeType == "ROLE" {
+			return g.GranteeName, nil
+		}
+	}
+	return "", nil
+}
+
+// transferOwnership makes role the owner of the object.
+func transferOwnership(ctx context.Context, db *sql.DB, builder snowflake.GrantBuilder, role string, currentGrants snowflake.OwnershipCurrentGrants) error {
+	ge, ok := builder.Role(role).(snowflake.OwnershipGrantExecutable)
+	if !ok {
+		return fmt.Errorf("ownership of %v %v cannot be transferred", builder.GrantType(), builder.Name())
+	}
+	err := snowflake.ExecContext(ctx, db, ge.Ownership(currentGrants))
+	if err != nil {
+		return errors.Wrapf(err, "error transferring ownership of %v %v to role %v", builder.GrantType(), builder.Name(), role)
+	}
+	return nil
+}
+
+// CreateOwnership implements schema.CreateContextFunc
+func CreateOwnership(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	object := &objectGrantsID{
+		ObjectType:   d.Get("object_type").(string),
+		DatabaseName: d.Get("database_name").(string),
+		SchemaName:   d.Get("schema_name").(string),
+		ObjectName:   d.Get("object_name").(string),
+	}
+	builder, err := object.builder()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	owner, err := readOwner(ctx, db, builder)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error reading owner of %v %v", builder.GrantType(), builder.Name()))
+	}
+
+	role := d.Get("role").(string)
+	currentGrants := snowflake.OwnershipCurrentGrants(d.Get("current_grants").(string))
+	if owner != role {
+		err = transferOwnership(ctx, db, builder, role, currentGrants)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	dataIDInput, err := object.String()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(dataIDInput)
+	// a configured previous owner wins over the one read, which may already be role
+	previousOwner := owner
+	if v, ok := d.GetOk("previous_owner"); ok {
+		previousOwner = v.(string)
+	}
+	if err = d.Set("previous_owner", previousOwner); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadOwnership(ctx, d, meta)
+}
+
+// ReadOwnership implements schema.ReadContextFunc
+func ReadOwnership(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	object, err := objectGrantsIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	builder, err := object.builder()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	owner, err := readOwner(ctx, db, builder)
+	if err != nil {
+		if snowflakeErr, ok := err.(*gosnowflake.SnowflakeError); ok &&
+			snowflakeErr.Number == 2003 &&
+			strings.Contains(err.Error(), "does not exist or not authorized") {
+			log.Printf("[WARN] ownership (%s) not found, removing from state file", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("object_type", object.ObjectType); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("database_name", object.DatabaseName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("schema_name", object.SchemaName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("object_name", object.ObjectName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("role", owner); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// UpdateOwnership implements schema.UpdateContextFunc
+func UpdateOwnership(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	object, err := objectGrantsIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	builder, err := object.builder()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("role") {
+		currentGrants := snowflake.OwnershipCurrentGrants(d.Get("current_grants").(string))
+		err = transferOwnership(ctx, db, builder, d.Get("role").(string), currentGrants)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return ReadOwnership(ctx, d, meta)
+}
+
+// DeleteOwnership implements schema.DeleteContextFunc
+func DeleteOwnership(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	object, err := objectGrantsIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	builder, err := object.builder()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var diags diag.Diagnostics
+	previousOwner := d.Get("previous_owner").(string)
+	if d.Get("restore_on_destroy").(bool) && previousOwner == "" {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("ownership of %v %v not restored", builder.GrantType(), builder.Name()),
+			Detail:   "restore_on_destroy is set but previous_owner is unknown, e.g. after import, so the object stays owned by role.",
+		})
+	}
+	if d.Get("restore_on_destroy").(bool) && previousOwner != "" && previousOwner != d.Get("role").(string) {
+		err = transferOwnership(ctx, db, builder, previousOwner, snowflake.CopyCurrentGrants)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	return diags
+}
+
+// validateOwnership checks at plan time that the parents of the object are
+// given.
+func validateOwnership(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !valuesKnown(d, "object_type", "database_name", "schema_name") {
+		return nil
+	}
+	objectType := d.Get("object_type").(string)
+	target, ok := objectGrantsTargets[objectType]
+	if !ok {
+		return nil
+	}
+	return validateObjectParents(d, objectType, target)
+}
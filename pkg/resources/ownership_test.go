@@ -0,0 +1,152 @@
+package resources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/provider"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/resources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOwnership(t *testing.T) {
+	r := require.New(t)
+	err := resources.Ownership().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestOwnershipCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"object_type":    "TABLE",
+		"database_name":  "test-db",
+		"schema_name":    "PUBLIC",
+		"object_name":    "test-table",
+		"role":           "new-owner",
+		"current_grants": "REVOKE",
+	}
+	d := schema.TestResourceDataRaw(t, resources.Ownership().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadOwnership(mock, "old-owner")
+		mock.ExpectExec(`^GRANT OWNERSHIP ON TABLE "test-db"."PUBLIC"."test-table" TO ROLE "new-owner" REVOKE CURRENT GRANTS$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadOwnership(mock, "new-owner")
+		diags := resources.CreateOwnership(context.Background(), d, db)
+		r.Empty(diags)
+	})
+
+	r.Equal("TABLE|test-db|PUBLIC|test-table", d.Id())
+	r.Equal("old-owner", d.Get("previous_owner"))
+	r.Equal("new-owner", d.Get("role"))
+}
+
+func TestOwnershipRead(t *testing.T) {
+	r := require.New(t)
+
+	d := ownership(t, "TABLE|test-db|PUBLIC|test-table", map[string]interface{}{"role": "new-owner"})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadOwnership(mock, "someone-else")
+		diags := resources.ReadOwnership(context.Background(), d, db)
+		r.Empty(diags)
+	})
+
+	r.Equal("TABLE", d.Get("object_type"))
+	r.Equal("test-table", d.Get("object_name"))
+	r.Equal("someone-else", d.Get("role"))
+}
+
+func TestOwnershipDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := ownership(t, "TABLE|test-db|PUBLIC|test-table", map[string]interface{}{
+		"object_type":        "TABLE",
+		"database_name":      "test-db",
+		"schema_name":        "PUBLIC",
+		"object_name":        "test-table",
+		"role":               "new-owner",
+		"restore_on_destroy": true,
+		"previous_owner":     "old-owner",
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^GRANT OWNERSHIP ON TABLE "test-db"."PUBLIC"."test-table" TO ROLE "old-owner" COPY CURRENT GRANTS$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		diags := resources.DeleteOwnership(context.Background(), d, db)
+		r.Empty(diags)
+	})
+
+	// without restore_on_destroy the object is left alone
+	d = ownership(t, "TABLE|test-db|PUBLIC|test-table", map[string]interface{}{
+		"object_type":    "TABLE",
+		"database_name":  "test-db",
+		"schema_name":    "PUBLIC",
+		"object_name":    "test-table",
+		"role":           "new-owner",
+		"previous_owner": "old-owner",
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		diags := resources.DeleteOwnership(context.Background(), d, db)
+		r.Empty(diags)
+	})
+
+	// imported, the previous owner is unknown
+	d = ownership(t, "TABLE|test-db|PUBLIC|test-table", map[string]interface{}{
+		"object_type":        "TABLE",
+		"database_name":      "test-db",
+		"schema_name":        "PUBLIC",
+		"object_name":        "test-table",
+		"role":               "new-owner",
+		"restore_on_destroy": true,
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		diags := resources.DeleteOwnership(context.Background(), d, db)
+		r.Len(diags, 1)
+		r.Equal(diag.Warning, diags[0].Severity)
+		r.Contains(diags[0].Summary, "not restored")
+	})
+}
+
+func expectReadOwnership(mock sqlmock.Sqlmock, owner string) {
+	rows := sqlmock.NewRows([]string{
+		"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by",
+	}).AddRow(
+		time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "OWNERSHIP", "TABLE", "test-table", "ROLE", owner, true, owner,
+	).AddRow(
+		time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "SELECT", "TABLE", "test-table", "ROLE", "reader", false, owner,
+	)
+	mock.ExpectQuery(`^SHOW GRANTS ON TABLE "test-db"."PUBLIC"."test-table"$`).WillReturnRows(rows)
+}
+
+func TestOwnershipCreatePreviousOwner(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"object_type":    "TABLE",
+		"database_name":  "test-db",
+		"schema_name":    "PUBLIC",
+		"object_name":    "test-table",
+		"role":           "new-owner",
+		"previous_owner": "old-owner",
+	}
+	d := schema.TestResourceDataRaw(t, resources.Ownership().Schema, in)
+
+	// already transferred, e.g. by hand before the resource was written
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadOwnership(mock, "new-owner")
+		expectReadOwnership(mock, "new-owner")
+		diags := resources.CreateOwnership(context.Background(), d, db)
+		r.Empty(diags)
+	})
+
+	r.Equal("old-owner", d.Get("previous_owner"))
+}
@@ -0,0 +1,145 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// passwordPolicy describes password policies, their properties defaulting as in Snowflake
+var passwordPolicy = &userPolicyKind{
+	name:    "password policy",
+	builder: snowflake.PasswordPolicy,
+	prefix:  "PASSWORD_",
+	properties: map[string]*schema.Schema{
+		"min_length": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      8,
+			Description:  "Specifies the minimum number of characters the password must contain, from 8 to 256.",
+			ValidateFunc: validation.IntBetween(8, 256),
+		},
+		"max_length": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      256,
+			Description:  "Specifies the maximum number of characters the password must contain, from 8 to 256 and at least min_length.",
+			ValidateFunc: validation.IntBetween(8, 256),
+		},
+		"min_upper_case_chars": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1,
+			Description:  "Specifies the minimum number of uppercase characters the password must contain.",
+			ValidateFunc: validation.IntBetween(0, 256),
+		},
+		"min_lower_case_chars": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1,
+			Description:  "Specifies the minimum number of lowercase characters the password must contain.",
+			ValidateFunc: validation.IntBetween(0, 256),
+		},
+		"min_numeric_chars": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1,
+			Description:  "Specifies the minimum number of numeric characters the password must contain.",
+			ValidateFunc: validation.IntBetween(0, 256),
+		},
+		"min_special_chars": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			Description:  "Specifies the minimum number of special characters the password must contain.",
+			ValidateFunc: validation.IntBetween(0, 256),
+		},
+		"min_age_days": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			Description:  "Specifies the number of days the user must wait before a recently changed password can be changed again, from 0 to 999.",
+			ValidateFunc: validation.IntBetween(0, 999),
+		},
+		"max_age_days": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      90,
+			Description:  "Specifies the maximum number of days before the password must be changed, from 0 to 999; 0 never expires the password.",
+			ValidateFunc: validation.IntBetween(0, 999),
+		},
+		"max_retries": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      5,
+			Description:  "Specifies the maximum number of attempts to enter a password before being locked out, from 1 to 10.",
+			ValidateFunc: validation.IntBetween(1, 10),
+		},
+		"lockout_time_mins": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      15,
+			Description:  "Specifies the number of minutes the user account is locked after exhausting max_retries, from 1 to 999.",
+			ValidateFunc: validation.IntBetween(1, 999),
+		},
+		"history": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			Description:  "Specifies the number of most recent passwords a new password must differ from, from 0 to 24.",
+			ValidateFunc: validation.IntBetween(0, 24),
+		},
+	},
+}
+
+var passwordPolicySchema = passwordPolicy.schema()
+
+// PasswordPolicy returns a pointer to the resource representing a password policy
+func PasswordPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreatePasswordPolicy,
+		ReadContext:   ReadPasswordPolicy,
+		UpdateContext: UpdatePasswordPolicy,
+		DeleteContext: DeletePasswordPolicy,
+
+		Schema:        passwordPolicySchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(passwordPolicySchema, upgradeUnchangedV0),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: customdiff.All(intAtMost("min_length", "max_length")),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+	}
+}
+
+// CreatePasswordPolicy implements schema.CreateContextFunc
+func CreatePasswordPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return passwordPolicy.create(ctx, d, meta)
+}
+
+// ReadPasswordPolicy implements schema.ReadContextFunc
+func ReadPasswordPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return passwordPolicy.read(ctx, d, meta)
+}
+
+// UpdatePasswordPolicy implements schema.UpdateContextFunc
+func UpdatePasswordPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return passwordPolicy.update(ctx, d, meta)
+}
+
+// DeletePasswordPolicy implements schema.DeleteContextFunc
+func DeletePasswordPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return passwordPolicy.delete(ctx, d, meta)
+}
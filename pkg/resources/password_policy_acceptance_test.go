@@ -0,0 +1,65 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_PasswordPolicy(t *testing.T) {
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: passwordPolicyConfig(accName, 12, "test comment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_password_policy.test", "name", accName),
+					resource.TestCheckResourceAttr("snowflake_password_policy.test", "min_length", "12"),
+					resource.TestCheckResourceAttr("snowflake_password_policy.test", "max_retries", "5"),
+					resource.TestCheckResourceAttr("snowflake_password_policy.test", "comment", "test comment"),
+					resource.TestCheckResourceAttr("snowflake_password_policy.test", "fully_qualified_name", fmt.Sprintf(`"%[1]v"."%[1]v"."%[1]v"`, accName)),
+				),
+			},
+			{
+				Config: passwordPolicyConfig(accName, 16, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_password_policy.test", "min_length", "16"),
+					resource.TestCheckResourceAttr("snowflake_password_policy.test", "comment", ""),
+				),
+			},
+			{
+				ResourceName:      "snowflake_password_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func passwordPolicyConfig(n string, minLength int, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_database" "test" {
+	name = "%[1]v"
+	comment = "Terraform acceptance test"
+}
+
+resource "snowflake_schema" "test" {
+	name = "%[1]v"
+	database = snowflake_database.test.name
+	comment = "Terraform acceptance test"
+}
+
+resource "snowflake_password_policy" "test" {
+	name = "%[1]v"
+	database = snowflake_database.test.name
+	schema = snowflake_schema.test.name
+	min_length = %[2]v
+	comment = "%[3]v"
+}
+`, n, minLength, comment)
+}
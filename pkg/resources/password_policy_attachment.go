@@ -0,0 +1,61 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var passwordPolicyAttachmentSchema = passwordPolicy.attachmentSchema()
+
+// PasswordPolicyAttachment returns a pointer to the resource representing a password policy attachment
+func PasswordPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreatePasswordPolicyAttachment,
+		ReadContext:   ReadPasswordPolicyAttachment,
+		UpdateContext: UpdatePasswordPolicyAttachment,
+		DeleteContext: DeletePasswordPolicyAttachment,
+
+		Schema:        passwordPolicyAttachmentSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(passwordPolicyAttachmentSchema, upgradeUnchangedV0),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportPasswordPolicyAttachment,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+	}
+}
+
+// CreatePasswordPolicyAttachment implements schema.CreateContextFunc
+func CreatePasswordPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return passwordPolicy.createAttachment(ctx, d, meta)
+}
+
+// ImportPasswordPolicyAttachment implements schema.StateContextFunc. Every user
+// the policy is set on is attached.
+func ImportPasswordPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	return passwordPolicy.importAttachment(ctx, d, meta)
+}
+
+// ReadPasswordPolicyAttachment implements schema.ReadContextFunc
+func ReadPasswordPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return passwordPolicy.readAttachment(ctx, d, meta)
+}
+
+// UpdatePasswordPolicyAttachment implements schema.UpdateContextFunc
+func UpdatePasswordPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return passwordPolicy.updateAttachment(ctx, d, meta)
+}
+
+// DeletePasswordPolicyAttachment implements schema.DeleteContextFunc
+func DeletePasswordPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return passwordPolicy.deleteAttachment(ctx, d, meta)
+}
@@ -0,0 +1,70 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_PasswordPolicyAttachment(t *testing.T) {
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	user1 := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	user2 := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: passwordPolicyAttachmentConfig(accName, user1, user2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_password_policy_attachment.test", "password_policy", fmt.Sprintf(`"%[1]v"."%[1]v"."%[1]v"`, accName)),
+					resource.TestCheckResourceAttr("snowflake_password_policy_attachment.test", "set_for_account", "false"),
+					resource.TestCheckResourceAttr("snowflake_password_policy_attachment.test", "users.#", "2"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:      "snowflake_password_policy_attachment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func passwordPolicyAttachmentConfig(n, user1, user2 string) string {
+	return fmt.Sprintf(`
+resource "snowflake_user" "test-user1" {
+	name = "%[2]v"
+}
+
+resource "snowflake_user" "test-user2" {
+	name = "%[3]v"
+}
+
+resource "snowflake_database" "test" {
+	name = "%[1]v"
+	comment = "Terraform acceptance test"
+}
+
+resource "snowflake_schema" "test" {
+	name = "%[1]v"
+	database = snowflake_database.test.name
+	comment = "Terraform acceptance test"
+}
+
+resource "snowflake_password_policy" "test" {
+	name = "%[1]v"
+	database = snowflake_database.test.name
+	schema = snowflake_schema.test.name
+}
+
+resource "snowflake_password_policy_attachment" "test" {
+	password_policy = snowflake_password_policy.test.fully_qualified_name
+	users           = [snowflake_user.test-user1.name, snowflake_user.test-user2.name]
+}
+`, n, user1, user2)
+}
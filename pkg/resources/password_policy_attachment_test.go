@@ -0,0 +1,135 @@
+package resources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/provider"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/resources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasswordPolicyAttachment(t *testing.T) {
+	r := require.New(t)
+	err := resources.PasswordPolicyAttachment().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestPasswordPolicyAttachmentCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"password_policy": `"test_db"."test_schema"."test_policy"`,
+		"set_for_account": true,
+		"users":           []interface{}{"test-user"},
+	}
+	d := schema.TestResourceDataRaw(t, resources.PasswordPolicyAttachment().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER ACCOUNT SET PASSWORD POLICY "test_db"."test_schema"."test_policy"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER USER "test-user" SET PASSWORD POLICY "test_db"."test_schema"."test_policy"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectPasswordPolicyReferences(mock, "ACCOUNT", "test-user")
+
+		diags := resources.CreatePasswordPolicyAttachment(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal(`"test_db"."test_schema"."test_policy"`, d.Id())
+		r.True(d.Get("set_for_account").(bool))
+		r.Equal([]interface{}{"test-user"}, d.Get("users").(*schema.Set).List())
+	})
+}
+
+func TestPasswordPolicyAttachmentRead(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"password_policy": "TEST_DB.TEST_SCHEMA.TEST_POLICY",
+		"set_for_account": true,
+		"users":           []interface{}{"attached", "detached"},
+	}
+	d := passwordPolicyAttachment(t, "TEST_DB.TEST_SCHEMA.TEST_POLICY", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"POLICY_DB", "POLICY_SCHEMA", "POLICY_NAME", "POLICY_KIND", "REF_ENTITY_NAME", "REF_ENTITY_DOMAIN"}).
+			AddRow("TEST_DB", "TEST_SCHEMA", "TEST_POLICY", "PASSWORD_POLICY", "attached", "USER").
+			AddRow("TEST_DB", "TEST_SCHEMA", "TEST_POLICY", "PASSWORD_POLICY", "other", "USER")
+		mock.ExpectQuery(`^SELECT \* FROM TABLE\("TEST_DB".INFORMATION_SCHEMA.POLICY_REFERENCES\(POLICY_NAME => '"TEST_DB"."TEST_SCHEMA"."TEST_POLICY"'\)\)$`).WillReturnRows(rows)
+
+		diags := resources.ReadPasswordPolicyAttachment(context.Background(), d, db)
+		r.Empty(diags)
+		r.False(d.Get("set_for_account").(bool))
+		r.Equal([]interface{}{"attached"}, d.Get("users").(*schema.Set).List())
+	})
+}
+
+func TestPasswordPolicyAttachmentReadUppercase(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"password_policy": `"test_db"."test_schema"."test_policy"`,
+		"users":           []interface{}{"jdoe"},
+	}
+	d := passwordPolicyAttachment(t, `"test_db"."test_schema"."test_policy"`, in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		// users created unquoted are listed in uppercase
+		expectPasswordPolicyReferences(mock, "JDOE")
+
+		diags := resources.ReadPasswordPolicyAttachment(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal([]interface{}{"jdoe"}, d.Get("users").(*schema.Set).List())
+	})
+}
+
+func TestPasswordPolicyAttachmentImport(t *testing.T) {
+	r := require.New(t)
+
+	d := passwordPolicyAttachment(t, `"test_db"."test_schema"."test_policy"`, map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectPasswordPolicyReferences(mock, "test-user")
+
+		_, err := resources.ImportPasswordPolicyAttachment(context.Background(), d, db)
+		r.NoError(err)
+		r.Equal([]interface{}{"test-user"}, d.Get("users").(*schema.Set).List())
+	})
+}
+
+func TestPasswordPolicyAttachmentDelete(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"password_policy": `"test_db"."test_schema"."test_policy"`,
+		"set_for_account": true,
+		"users":           []interface{}{"test-user", "changed"},
+	}
+	d := passwordPolicyAttachment(t, `"test_db"."test_schema"."test_policy"`, in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		// another policy was set on the account and on changed since
+		expectPasswordPolicyReferences(mock, "test-user")
+		mock.ExpectExec(`^ALTER USER "test-user" UNSET PASSWORD POLICY$`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		diags := resources.DeletePasswordPolicyAttachment(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("", d.Id())
+	})
+}
+
+// expectPasswordPolicyReferences expects the references of the policy, each
+// a user or ACCOUNT for the account
+func expectPasswordPolicyReferences(mock sqlmock.Sqlmock, refs ...string) {
+	rows := sqlmock.NewRows([]string{"POLICY_DB", "POLICY_SCHEMA", "POLICY_NAME", "POLICY_KIND", "REF_ENTITY_NAME", "REF_ENTITY_DOMAIN"})
+	for _, ref := range refs {
+		if ref == "ACCOUNT" {
+			rows.AddRow("test_db", "test_schema", "test_policy", "PASSWORD_POLICY", "TEST_ACCOUNT", "ACCOUNT")
+			continue
+		}
+		rows.AddRow("test_db", "test_schema", "test_policy", "PASSWORD_POLICY", ref, "USER")
+	}
+	mock.ExpectQuery(`^SELECT \* FROM TABLE\("test_db".INFORMATION_SCHEMA.POLICY_REFERENCES\(POLICY_NAME => '"test_db"."test_schema"."test_policy"'\)\)$`).WillReturnRows(rows)
+}
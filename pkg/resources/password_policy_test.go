@@ -0,0 +1,152 @@
+package resources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/provider"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/resources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasswordPolicy(t *testing.T) {
+	r := require.New(t)
+	err := resources.PasswordPolicy().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestPasswordPolicyCustomizeDiff(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":       "test_policy",
+		"database":   "test_db",
+		"schema":     "test_schema",
+		"min_length": 12,
+		"max_length": 12,
+	}
+	r.NoError(planDiff(resources.PasswordPolicy(), in))
+
+	in["max_length"] = 10
+	err := planDiff(resources.PasswordPolicy(), in)
+	r.Error(err)
+	r.Contains(err.Error(), "min_length (12) must be less than or equal to max_length (10)")
+}
+
+func TestPasswordPolicyCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":       "test_policy",
+		"database":   "test_db",
+		"schema":     "test_schema",
+		"min_length": 12,
+		"history":    5,
+		"comment":    "strict",
+	}
+
+	d := schema.TestResourceDataRaw(t, resources.PasswordPolicy().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`^CREATE PASSWORD POLICY "test_db"."test_schema"."test_policy" COMMENT = 'strict' PASSWORD_HISTORY = 5 PASSWORD_LOCKOUT_TIME_MINS = 15 PASSWORD_MAX_AGE_DAYS = 90 PASSWORD_MAX_LENGTH = 256 PASSWORD_MAX_RETRIES = 5 PASSWORD_MIN_AGE_DAYS = 0 PASSWORD_MIN_LENGTH = 12 PASSWORD_MIN_LOWER_CASE_CHARS = 1 PASSWORD_MIN_NUMERIC_CHARS = 1 PASSWORD_MIN_SPECIAL_CHARS = 0 PASSWORD_MIN_UPPER_CASE_CHARS = 1$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		expectReadPasswordPolicy(mock)
+		diags := resources.CreatePasswordPolicy(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("test_db|test_schema|test_policy", d.Id())
+		r.Equal(`"test_db"."test_schema"."test_policy"`, d.Get("fully_qualified_name").(string))
+	})
+}
+
+func TestPasswordPolicyRead(t *testing.T) {
+	r := require.New(t)
+
+	d := passwordPolicy(t, "test_db|test_schema|test_policy", map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadPasswordPolicy(mock)
+		diags := resources.ReadPasswordPolicy(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("test_policy", d.Get("name").(string))
+		r.Equal("strict", d.Get("comment").(string))
+		r.Equal(12, d.Get("min_length").(int))
+		r.Equal(5, d.Get("history").(int))
+		r.Equal(90, d.Get("max_age_days").(int))
+	})
+}
+
+func TestPasswordPolicyReadNotFound(t *testing.T) {
+	r := require.New(t)
+
+	d := passwordPolicy(t, "test_db|test_schema|test_policy", map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"created_on", "name", "database_name", "schema_name", "kind", "owner", "comment"})
+		mock.ExpectQuery(`^SHOW PASSWORD POLICIES LIKE 'test_policy' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+		diags := resources.ReadPasswordPolicy(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("", d.Id())
+	})
+}
+
+func TestPasswordPolicyUpdate(t *testing.T) {
+	r := require.New(t)
+
+	d := passwordPolicy(t, "test_db|test_schema|test_policy", map[string]interface{}{
+		"min_length": 12,
+		"history":    5,
+		"comment":    "strict",
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER PASSWORD POLICY "test_db"."test_schema"."test_policy" SET COMMENT = 'strict' PASSWORD_HISTORY = 5 .* PASSWORD_MIN_LENGTH = 12 .*$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadPasswordPolicy(mock)
+		diags := resources.UpdatePasswordPolicy(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
+
+func TestPasswordPolicyDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := passwordPolicy(t, "test_db|test_schema|test_policy", map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^DROP PASSWORD POLICY "test_db"."test_schema"."test_policy"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		diags := resources.DeletePasswordPolicy(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("", d.Id())
+	})
+}
+
+func expectReadPasswordPolicy(mock sqlmock.Sqlmock) {
+	showRows := sqlmock.NewRows([]string{
+		"created_on", "name", "database_name", "schema_name", "kind", "owner", "comment",
+	}).AddRow(
+		"2023-01-01 00:00:00", "test_policy", "test_db", "test_schema", "PASSWORD_POLICY", "ACCOUNTADMIN", "strict",
+	)
+	mock.ExpectQuery(`^SHOW PASSWORD POLICIES LIKE 'test_policy' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(showRows)
+
+	describeRows := sqlmock.NewRows([]string{"property", "value", "default", "description"}).
+		AddRow("NAME", "test_policy", "null", "Name of password policy.").
+		AddRow("COMMENT", "strict", "null", "Comment on password policy.").
+		AddRow("PASSWORD_MIN_LENGTH", "12", "8", "Minimum length of new password.").
+		AddRow("PASSWORD_MAX_LENGTH", "256", "256", "Maximum length of new password.").
+		AddRow("PASSWORD_MIN_UPPER_CASE_CHARS", "1", "1", "Minimum number of uppercase characters in new password.").
+		AddRow("PASSWORD_MIN_LOWER_CASE_CHARS", "1", "1", "Minimum number of lowercase characters in new password.").
+		AddRow("PASSWORD_MIN_NUMERIC_CHARS", "1", "1", "Minimum number of numeric characters in new password.").
+		AddRow("PASSWORD_MIN_SPECIAL_CHARS", "0", "0", "Minimum number of special characters in new password.").
+		AddRow("PASSWORD_MIN_AGE_DAYS", "0", "0", "Period after a password is changed during which a password cannot be changed again.").
+		AddRow("PASSWORD_MAX_AGE_DAYS", "90", "90", "Period after which password must be changed.").
+		AddRow("PASSWORD_MAX_RETRIES", "5", "5", "Number of attempts users have to enter the correct password before their account is locked.").
+		AddRow("PASSWORD_LOCKOUT_TIME_MINS", "15", "15", "Period of time for which users will be locked after entering their password incorrectly many times.").
+		AddRow("PASSWORD_HISTORY", "5", "0", "Number of most recent passwords that may not be repeated by the user.")
+	mock.ExpectQuery(`^DESCRIBE PASSWORD POLICY "test_db"."test_schema"."test_policy"$`).WillReturnRows(describeRows)
+}
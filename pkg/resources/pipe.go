@@ -2,6 +2,7 @@ package resources
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/csv"
 	"fmt"
@@ -9,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -85,15 +87,25 @@ var pipeSchema = map[string]*schema.Schema{
 
 func Pipe() *schema.Resource {
 	return &schema.Resource{
-		Create: CreatePipe,
-		Read:   ReadPipe,
-		Update: UpdatePipe,
-		Delete: DeletePipe,
-
-		Schema: pipeSchema,
+		CreateContext: CreatePipe,
+		ReadContext:   ReadPipe,
+		UpdateContext: UpdatePipe,
+		DeleteContext: DeletePipe,
+
+		Schema:        pipeSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(pipeSchema, upgradeQuotedNamesV0("database", "schema", "name")),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 	}
 }
 
@@ -144,16 +156,21 @@ func pipeIDFromString(stringID string) (*pipeID, error) {
 		return nil, fmt.Errorf("3 fields allowed")
 	}
 
+	names, err := objectNames(lines[0][:3])
+	if err != nil {
+		return nil, err
+	}
+
 	pipeResult := &pipeID{
-		DatabaseName: lines[0][0],
-		SchemaName:   lines[0][1],
-		PipeName:     lines[0][2],
+		DatabaseName: names[0].Name(),
+		SchemaName:   names[1].Name(),
+		PipeName:     names[2].Name(),
 	}
 	return pipeResult, nil
 }
 
-// CreatePipe implements schema.CreateFunc
-func CreatePipe(d *schema.ResourceData, meta interface{}) error {
+// CreatePipe implements schema.CreateContextFunc
+func CreatePipe(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	database := d.Get("database").(string)
 	schema := d.Get("schema").(string)
@@ -188,9 +205,9 @@ func CreatePipe(d *schema.ResourceData, meta interface{}) error {
 
 	q := builder.Create()
 
-	err := snowflake.Exec(db, q)
+	err := snowflake.ExecContext(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error creating pipe %v", name)
+		return diag.FromErr(errors.Wrapf(err, "error creating pipe %v", name))
 	}
 
 	pipeID := &pipeID{
@@ -200,19 +217,19 @@ func CreatePipe(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := pipeID.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadPipe(d, meta)
+	return ReadPipe(ctx, d, meta)
 }
 
-// ReadPipe implements schema.ReadFunc
-func ReadPipe(d *schema.ResourceData, meta interface{}) error {
+// ReadPipe implements schema.ReadContextFunc
+func ReadPipe(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	pipeID, err := pipeIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := pipeID.DatabaseName
@@ -220,7 +237,7 @@ func ReadPipe(d *schema.ResourceData, meta interface{}) error {
 	name := pipeID.PipeName
 
 	sq := snowflake.Pipe(name, dbName, schema).Show()
-	row := snowflake.QueryRow(db, sq)
+	row := snowflake.QueryRowContext(ctx, db, sq)
 	pipe, err := snowflake.ScanPipe(row)
 	if err == sql.ErrNoRows {
 		// If not found, mark resource to be removed from statefile during apply or refresh
@@ -229,52 +246,52 @@ func ReadPipe(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("name", pipe.Name)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("database", pipe.DatabaseName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("schema", pipe.SchemaName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("copy_statement", pipe.Definition)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("owner", pipe.Owner)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("comment", pipe.Comment)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("notification_channel", pipe.NotificationChannel)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("auto_ingest", pipe.NotificationChannel != nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if pipe.NotificationChannel != nil && strings.Contains(*pipe.NotificationChannel, "arn:aws:sns:") {
 		err = d.Set("aws_sns_topic_arn", pipe.NotificationChannel)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// The "DESCRIBE PIPE ..." command returns the string "null" for error_integration
@@ -284,17 +301,17 @@ func ReadPipe(d *schema.ResourceData, meta interface{}) error {
 	}
 	err = d.Set("error_integration", pipe.ErrorIntegration.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-// UpdatePipe implements schema.UpdateFunc
-func UpdatePipe(d *schema.ResourceData, meta interface{}) error {
+// UpdatePipe implements schema.UpdateContextFunc
+func UpdatePipe(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	pipeID, err := pipeIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := pipeID.DatabaseName
@@ -307,9 +324,9 @@ func UpdatePipe(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("comment") {
 		comment := d.Get("comment")
 		q := builder.ChangeComment(comment.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating pipe comment on %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating pipe comment on %v", d.Id()))
 		}
 	}
 
@@ -320,21 +337,21 @@ func UpdatePipe(d *schema.ResourceData, meta interface{}) error {
 		} else {
 			q = builder.RemoveErrorIntegration()
 		}
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating pipe error_integration on %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating pipe error_integration on %v", d.Id()))
 		}
 	}
 
-	return ReadPipe(d, meta)
+	return ReadPipe(ctx, d, meta)
 }
 
-// DeletePipe implements schema.DeleteFunc
-func DeletePipe(d *schema.ResourceData, meta interface{}) error {
+// DeletePipe implements schema.DeleteContextFunc
+func DeletePipe(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	pipeID, err := pipeIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := pipeID.DatabaseName
@@ -343,9 +360,9 @@ func DeletePipe(d *schema.ResourceData, meta interface{}) error {
 
 	q := snowflake.Pipe(pipe, dbName, schema).Drop()
 
-	err = snowflake.Exec(db, q)
+	err = snowflake.ExecContext(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error deleting pipe %v", d.Id())
+		return diag.FromErr(errors.Wrapf(err, "error deleting pipe %v", d.Id()))
 	}
 
 	d.SetId("")
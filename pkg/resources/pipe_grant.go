@@ -1,8 +1,11 @@
 package resources
 
 import (
+	"context"
+
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -67,21 +70,30 @@ var pipeGrantSchema = map[string]*schema.Schema{
 func PipeGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create: CreatePipeGrant,
-			Read:   ReadPipeGrant,
-			Delete: DeletePipeGrant,
-
-			Schema: pipeGrantSchema,
+			CreateContext: CreatePipeGrant,
+			ReadContext:   ReadPipeGrant,
+			DeleteContext: DeletePipeGrant,
+
+			Schema:        pipeGrantSchema,
+			SchemaVersion: 1,
+			StateUpgraders: []schema.StateUpgrader{
+				stateUpgraderV0(pipeGrantSchema, upgradeGrantIDV0),
+			},
 			Importer: &schema.ResourceImporter{
 				StateContext: schema.ImportStatePassthroughContext,
 			},
+			Timeouts: &schema.ResourceTimeout{
+				Create: schema.DefaultTimeout(defaultTimeout),
+				Read:   schema.DefaultTimeout(defaultTimeout),
+				Delete: schema.DefaultTimeout(defaultTimeout),
+			},
 		},
 		ValidPrivs: validPipePrivileges,
 	}
 }
 
-// CreatePipeGrant implements schema.CreateFunc
-func CreatePipeGrant(d *schema.ResourceData, meta interface{}) error {
+// CreatePipeGrant implements schema.CreateContextFunc
+func CreatePipeGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var pipeName string
 	if name, ok := d.GetOk("pipe_name"); ok {
 		pipeName = name.(string)
@@ -94,10 +106,10 @@ func CreatePipeGrant(d *schema.ResourceData, meta interface{}) error {
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 
 	if (pipeName == "") && !futurePipes {
-		return errors.New("pipe_name must be set unless on_future is true.")
+		return diag.FromErr(errors.New("pipe_name must be set unless on_future is true."))
 	}
 	if (pipeName != "") && futurePipes {
-		return errors.New("pipe_name must be empty if on_future is true.")
+		return diag.FromErr(errors.New("pipe_name must be empty if on_future is true."))
 	}
 
 	var builder snowflake.GrantBuilder
@@ -107,9 +119,9 @@ func CreatePipeGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.PipeGrant(dbName, schemaName, pipeName)
 	}
 
-	err := createGenericGrant(d, meta, builder)
+	err := createGenericGrant(ctx, d, meta, builder)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grant := &grantID{
@@ -122,18 +134,18 @@ func CreatePipeGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := grant.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadPipeGrant(d, meta)
+	return ReadPipeGrant(ctx, d, meta)
 }
 
-// ReadPipeGrant implements schema.ReadFunc
-func ReadPipeGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadPipeGrant implements schema.ReadContextFunc
+func ReadPipeGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -142,11 +154,11 @@ func ReadPipeGrant(d *schema.ResourceData, meta interface{}) error {
 
 	err = d.Set("database_name", dbName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("schema_name", schemaName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	futurePipesEnabled := false
 	if pipeName == "" {
@@ -154,19 +166,19 @@ func ReadPipeGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	err = d.Set("pipe_name", pipeName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("on_future", futurePipesEnabled)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("privilege", priv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("with_grant_option", grantID.GrantOption)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var builder snowflake.GrantBuilder
@@ -176,14 +188,14 @@ func ReadPipeGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.PipeGrant(dbName, schemaName, pipeName)
 	}
 
-	return readGenericGrant(d, meta, pipeGrantSchema, builder, futurePipesEnabled, validPipePrivileges)
+	return diag.FromErr(readGenericGrant(ctx, d, meta, pipeGrantSchema, builder, futurePipesEnabled, validPipePrivileges))
 }
 
-// DeletePipeGrant implements schema.DeleteFunc
-func DeletePipeGrant(d *schema.ResourceData, meta interface{}) error {
+// DeletePipeGrant implements schema.DeleteContextFunc
+func DeletePipeGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -197,5 +209,5 @@ func DeletePipeGrant(d *schema.ResourceData, meta interface{}) error {
 	} else {
 		builder = snowflake.PipeGrant(dbName, schemaName, pipeName)
 	}
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 	"time"
@@ -37,15 +38,15 @@ func TestPipeGrantCreate(t *testing.T) {
 		mock.ExpectExec(`^GRANT OPERATE ON PIPE "test-db"."PUBLIC"."test-pipe" TO ROLE "test-role-1" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT OPERATE ON PIPE "test-db"."PUBLIC"."test-pipe" TO ROLE "test-role-2" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadPipeGrant(mock)
-		err := resources.CreatePipeGrant(d, db)
-		r.NoError(err)
+		diags := resources.CreatePipeGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
 func TestPipeGrantRead(t *testing.T) {
 	r := require.New(t)
 
-	d := pipeGrant(t, "test-db|PUBLIC|test-pipe|OPERATE|false", map[string]interface{}{
+	d := pipeGrant(t, "test-db|PUBLIC|test-pipe|OPERATE||false", map[string]interface{}{
 		"pipe_name":         "test-pipe",
 		"schema_name":       "PUBLIC",
 		"database_name":     "test-db",
@@ -58,8 +59,8 @@ func TestPipeGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadPipeGrant(mock)
-		err := resources.ReadPipeGrant(d, db)
-		r.NoError(err)
+		diags := resources.ReadPipeGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 
 	roles := d.Get("roles").(*schema.Set)
@@ -101,8 +102,8 @@ func TestFuturePipeGrantCreate(t *testing.T) {
 			`^GRANT OPERATE ON FUTURE PIPES IN SCHEMA "test-db"."PUBLIC" TO ROLE "test-role-2" WITH GRANT OPTION$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFuturePipeGrant(mock)
-		err := resources.CreatePipeGrant(d, db)
-		r.NoError(err)
+		diags := resources.CreatePipeGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 
 	b := require.New(t)
@@ -125,8 +126,8 @@ func TestFuturePipeGrantCreate(t *testing.T) {
 			`^GRANT OPERATE ON FUTURE PIPES IN DATABASE "test-db" TO ROLE "test-role-2"$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFuturePipeDatabaseGrant(mock)
-		err := resources.CreatePipeGrant(d, db)
-		b.NoError(err)
+		diags := resources.CreatePipeGrant(context.Background(), d, db)
+		b.Empty(diags)
 	})
 }
 
@@ -1,18 +1,22 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
-	"fmt"
 	"log"
 	"regexp"
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
 )
 
+var procedureLanguages = []string{"javascript", "sql", "python", "java", "scala"}
+
 var procedureSchema = map[string]*schema.Schema{
 	"name": {
 		Type:        schema.TypeString,
@@ -66,9 +70,54 @@ var procedureSchema = map[string]*schema.Schema{
 	"statement": {
 		Type:             schema.TypeString,
 		Required:         true,
-		Description:      "Specifies the javascript code used to create the procedure.",
+		Description:      "Specifies the javascript / sql / python / java / scala code used to create the procedure.",
 		ForceNew:         true,
-		DiffSuppressFunc: DiffSuppressStatement,
+		DiffSuppressFunc: DiffSuppressBody,
+	},
+	"language": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          "javascript",
+		ForceNew:         true,
+		ValidateFunc:     validation.StringInSlice(procedureLanguages, true),
+		DiffSuppressFunc: diffCaseInsensitive,
+		Description:      "The language of the statement, one of javascript, sql, python, java or scala.",
+	},
+	"runtime_version": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "the runtime version for Python, Java or Scala procedure, e.g. `3.8` or `11`.",
+	},
+	"packages": {
+		Type: schema.TypeList,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Optional:    true,
+		ForceNew:    true,
+		Description: "packages for Python, Java or Scala procedure, e.g. `snowflake-snowpark-python`, compared in any order and ignoring the versions Snowflake pins.",
+	},
+	"imports": {
+		Type: schema.TypeList,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Optional:    true,
+		ForceNew:    true,
+		Description: "files to import for Python, Java or Scala procedure, compared in any order.",
+	},
+	"handler": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "the handler function or method for Python, Java or Scala procedure.",
+	},
+	"target_path": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "the target path for compiled jar file for Java or Scala procedure.",
 	},
 	"execute_as": {
 		Type:        schema.TypeString,
@@ -99,29 +148,102 @@ var procedureSchema = map[string]*schema.Schema{
 		Default:     "user-defined procedure",
 		Description: "Specifies a comment for the procedure.",
 	},
+	"external_access_integrations": {
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		ForceNew:    true,
+		Description: "The external access integrations the procedure can reach external APIs through.",
+	},
+	"secrets": {
+		Type:        schema.TypeMap,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		ForceNew:    true,
+		Description: "The fully qualified names of the secrets the procedure can use, by the variable name the handler retrieves them with.",
+	},
 }
 
 func DiffTypes(k, old, new string, d *schema.ResourceData) bool {
 	return strings.EqualFold(strings.ToUpper(old), strings.ToUpper(new))
 }
 
+// DiffSuppressBody suppresses the diff between bodies which only differ in
+// the $$ delimiters they are written with, see DiffSuppressStatement.
+func DiffSuppressBody(k, old, new string, d *schema.ResourceData) bool {
+	return DiffSuppressStatement(k, snowflake.TrimBodyDelimiters(old), snowflake.TrimBodyDelimiters(new), d)
+}
+
+// matchDescribedList returns the configured list when it holds the same
+// values as the described one in any order, each value compared by key.
+// Otherwise the described list is returned.
+func matchDescribedList(described []string, configured []interface{}, key func(string) string) []string {
+	count := map[string]int{}
+	for _, v := range described {
+		count[key(v)]++
+	}
+	matched := []string{}
+	for _, c := range configured {
+		k := key(c.(string))
+		if count[k] == 0 {
+			return described
+		}
+		count[k]--
+		matched = append(matched, c.(string))
+	}
+	if len(matched) != len(described) {
+		return described
+	}
+	return matched
+}
+
+// packageName returns the name of a package without its version, in lower
+// case: numpy for numpy==1.24.3, com.example:lib for com.example:lib:1.0.
+func packageName(pkg string) string {
+	name := strings.ToLower(strings.TrimSpace(pkg))
+	if i := strings.IndexAny(name, "=<>!~"); i >= 0 {
+		name = strings.TrimSpace(name[:i])
+	}
+	if strings.Count(name, ":") > 1 {
+		name = name[:strings.LastIndex(name, ":")]
+	}
+	return name
+}
+
 // Procedure returns a pointer to the resource representing a stored procedure
 func Procedure() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateProcedure,
-		Read:   ReadProcedure,
-		Update: UpdateProcedure,
-		Delete: DeleteProcedure,
-
-		Schema: procedureSchema,
+		CreateContext: CreateProcedure,
+		ReadContext:   ReadProcedure,
+		UpdateContext: UpdateProcedure,
+		DeleteContext: DeleteProcedure,
+
+		Schema:        procedureSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(procedureSchema, upgradeDelimitedIDV0),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customdiff.All(
+			requiredWhen("language", "python", "handler", "runtime_version"),
+			requiredWhen("language", "java", "handler", "runtime_version"),
+			requiredWhen("language", "scala", "handler", "runtime_version"),
+			setOnlyWhen("language", []string{"python", "java", "scala"}, "runtime_version", "handler", "packages", "imports", "target_path"),
+			setOnlyWhen("language", []string{"java", "scala"}, "target_path"),
+		),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 	}
 }
 
-// CreateProcedure implements schema.CreateFunc
-func CreateProcedure(d *schema.ResourceData, meta interface{}) error {
+// CreateProcedure implements schema.CreateContextFunc
+func CreateProcedure(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 	schema := d.Get("schema").(string)
@@ -163,13 +285,47 @@ func CreateProcedure(d *schema.ResourceData, meta interface{}) error {
 		builder.WithComment(v.(string))
 	}
 
+	// Set optionals, default is javascript
+	if v, ok := d.GetOk("language"); ok {
+		builder.WithLanguage(v.(string))
+	}
+
+	// runtime version, packages and imports for Python, Java and Scala
+	if v, ok := d.GetOk("runtime_version"); ok {
+		builder.WithRuntimeVersion(v.(string))
+	}
+
+	if v, ok := d.GetOk("packages"); ok {
+		builder.WithPackages(expandStringList(v.([]interface{})))
+	}
+
+	if v, ok := d.GetOk("imports"); ok {
+		builder.WithImports(expandStringList(v.([]interface{})))
+	}
+
+	// handler for Python, Java and Scala
+	if v, ok := d.GetOk("handler"); ok {
+		builder.WithHandler(v.(string))
+	}
+
+	// target path for Java and Scala
+	if v, ok := d.GetOk("target_path"); ok {
+		builder.WithTargetPath(v.(string))
+	}
+
+	// external access, the integrations and the secrets they allow
+	if v, ok := d.GetOk("external_access_integrations"); ok {
+		builder.WithExternalAccessIntegrations(expandStringList(v.([]interface{})))
+	}
+	builder.WithSecrets(expandSecrets(d))
+
 	q, err := builder.Create()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	err = snowflake.Exec(db, q)
+	err = snowflake.ExecContext(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error creating procedure %v", name)
+		return diag.FromErr(errors.Wrapf(err, "error creating procedure %v", name))
 	}
 
 	procedureID := &procedureID{
@@ -181,15 +337,15 @@ func CreateProcedure(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(procedureID.String())
 
-	return ReadProcedure(d, meta)
+	return ReadProcedure(ctx, d, meta)
 }
 
-// ReadProcedure implements schema.ReadFunc
-func ReadProcedure(d *schema.ResourceData, meta interface{}) error {
+// ReadProcedure implements schema.ReadContextFunc
+func ReadProcedure(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	procedureID, err := splitProcedureID(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	proc := snowflake.Procedure(
 		procedureID.DatabaseName,
@@ -201,72 +357,108 @@ func ReadProcedure(d *schema.ResourceData, meta interface{}) error {
 	// some atributes can be retrieved only by Describe and some only by Show
 	stmt, err := proc.Describe()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	rows, err := snowflake.Query(db, stmt)
+	rows, err := snowflake.QueryContext(ctx, db, stmt)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	defer rows.Close()
 	descPropValues, err := snowflake.ScanProcedureDescription(rows)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	for _, desc := range descPropValues {
 		switch desc.Property.String {
 		case "signature":
 			// Format in Snowflake DB is: (argName argType, argName argType, ...)
-			args := strings.ReplaceAll(strings.ReplaceAll(desc.Value.String, "(", ""), ")", "")
-
-			if args != "" { // Do nothing for functions without arguments
-				argPairs := strings.Split(args, ", ")
+			columns, err := snowflake.ParseSignature(desc.Value.String)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if len(columns) > 0 { // Do nothing for procedures without arguments
 				args := []interface{}{}
-
-				for _, argPair := range argPairs {
-					argItem := strings.Split(argPair, " ")
-
-					arg := map[string]interface{}{}
-					arg["name"] = argItem[0]
-					arg["type"] = argItem[1]
-					args = append(args, arg)
+				for _, c := range columns {
+					args = append(args, map[string]interface{}{
+						"name": c.Name,
+						"type": c.Type,
+					})
 				}
-
 				if err = d.Set("arguments", args); err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		case "null handling":
 			if err = d.Set("null_input_behavior", desc.Value.String); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "volatility":
 			if err = d.Set("return_behavior", desc.Value.String); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "body":
 			if err = d.Set("statement", desc.Value.String); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "execute as":
 			if err = d.Set("execute_as", desc.Value.String); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "returns":
 			// Format in Snowflake DB is RETURN_TYPE(<some number>) or RETURN_TYPE
 			re := regexp.MustCompile(`^([A-Z0-9_]+)(\([0-9]*\))?$`)
 			match := re.FindStringSubmatch(desc.Value.String)
-			if err = d.Set("return_type", match[1]); err != nil {
-				return err
+			rt := desc.Value.String
+			if match != nil {
+				rt = match[1]
+			}
+			if err = d.Set("return_type", rt); err != nil {
+				return diag.FromErr(err)
+			}
+		case "external_access_integrations":
+			if err = readExternalAccessIntegrations(d, desc.Value.String); err != nil {
+				return diag.FromErr(err)
+			}
+		case "secrets":
+			if err = readSecrets(d, desc.Value.String); err != nil {
+				return diag.FromErr(err)
 			}
 		case "language":
-			// To ignore
+			if err = d.Set("language", strings.ToLower(desc.Value.String)); err != nil {
+				return diag.FromErr(err)
+			}
+		case "runtime_version":
+			if err = d.Set("runtime_version", desc.Value.String); err != nil {
+				return diag.FromErr(err)
+			}
+		case "packages":
+			// Snowflake may reorder the packages and pin their versions
+			packages := matchDescribedList(snowflake.SplitDescribedList(desc.Value.String), d.Get("packages").([]interface{}), packageName)
+			if err = d.Set("packages", packages); err != nil {
+				return diag.FromErr(err)
+			}
+		case "imports":
+			imports := matchDescribedList(snowflake.SplitDescribedList(desc.Value.String), d.Get("imports").([]interface{}), strings.ToUpper)
+			if err = d.Set("imports", imports); err != nil {
+				return diag.FromErr(err)
+			}
+		case "handler":
+			if err = d.Set("handler", desc.Value.String); err != nil {
+				return diag.FromErr(err)
+			}
+		case "target_path":
+			if err = d.Set("target_path", desc.Value.String); err != nil {
+				return diag.FromErr(err)
+			}
+		case "installed_packages":
+			// the packages resolved by Snowflake, including the dependencies of the packages
 		default:
 			log.Printf("[WARN] unexpected procedure property %v returned from Snowflake", desc.Property.String)
 		}
 	}
 
 	q := proc.Show()
-	showRows, err := snowflake.Query(db, q)
+	showRows, err := snowflake.QueryContext(ctx, db, q)
 	if err == sql.ErrNoRows {
 		// If not found, mark resource to be removed from statefile during apply or refresh
 		log.Printf("[DEBUG] procedure (%s) not found", d.Id())
@@ -274,13 +466,13 @@ func ReadProcedure(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	defer showRows.Close()
 
 	foundProcedures, err := snowflake.ScanProcedures(showRows)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// procedure names can be overloaded with different argument types so we
 	// iterate over and find the correct one
@@ -291,19 +483,19 @@ func ReadProcedure(d *schema.ResourceData, meta interface{}) error {
 		if showArgs[0] == argSig {
 			err = d.Set("name", v.Name.String)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			err = d.Set("database", v.DatabaseName.String)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			err = d.Set("schema", v.SchemaName.String)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			err = d.Set("comment", v.Comment.String)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -312,10 +504,10 @@ func ReadProcedure(d *schema.ResourceData, meta interface{}) error {
 }
 
 // UpdateProcedure implements schema.UpdateProcedure
-func UpdateProcedure(d *schema.ResourceData, meta interface{}) error {
+func UpdateProcedure(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	pID, err := splitProcedureID(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	builder := snowflake.Procedure(
 		pID.DatabaseName,
@@ -329,11 +521,11 @@ func UpdateProcedure(d *schema.ResourceData, meta interface{}) error {
 		name := d.Get("name")
 		q, err := builder.Rename(name.(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
-		err = snowflake.Exec(db, q)
+		err = snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error renaming procedure %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error renaming procedure %v", d.Id()))
 		}
 		newID := &procedureID{
 			DatabaseName:  pID.DatabaseName,
@@ -350,20 +542,20 @@ func UpdateProcedure(d *schema.ResourceData, meta interface{}) error {
 		if c := comment.(string); c == "" {
 			q, err := builder.RemoveComment()
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
-			err = snowflake.Exec(db, q)
+			err = snowflake.ExecContext(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error unsetting comment for procedure %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error unsetting comment for procedure %v", d.Id()))
 			}
 		} else {
 			q, err := builder.ChangeComment(c)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
-			err = snowflake.Exec(db, q)
+			err = snowflake.ExecContext(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error updating comment for procedure %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error updating comment for procedure %v", d.Id()))
 			}
 		}
 	}
@@ -372,23 +564,23 @@ func UpdateProcedure(d *schema.ResourceData, meta interface{}) error {
 
 		q, err := builder.ChangeExecuteAs(executeAs.(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
-		err = snowflake.Exec(db, q)
+		err = snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error changing execute as for procedure %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error changing execute as for procedure %v", d.Id()))
 		}
 	}
 
-	return ReadProcedure(d, meta)
+	return ReadProcedure(ctx, d, meta)
 }
 
-// DeleteProcedure implements schema.DeleteFunc
-func DeleteProcedure(d *schema.ResourceData, meta interface{}) error {
+// DeleteProcedure implements schema.DeleteContextFunc
+func DeleteProcedure(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	pID, err := splitProcedureID(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	builder := snowflake.Procedure(
 		pID.DatabaseName,
@@ -399,12 +591,12 @@ func DeleteProcedure(d *schema.ResourceData, meta interface{}) error {
 
 	q, err := builder.Drop()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = snowflake.Exec(db, q)
+	err = snowflake.ExecContext(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error deleting procedure %v", d.Id())
+		return diag.FromErr(errors.Wrapf(err, "error deleting procedure %v", d.Id()))
 	}
 
 	d.SetId("")
@@ -453,22 +645,26 @@ type procedureID struct {
 //         ProcedureName: PROC1
 //         ArgTypes: [VARCHAR, DATE, VARCHAR]
 func splitProcedureID(v string) (*procedureID, error) {
-	arr := strings.Split(v, "|")
-	if len(arr) != 4 {
-		return nil, fmt.Errorf("ID %v is invalid", v)
+	arr, err := splitObjectID(v, 4)
+	if err != nil {
+		return nil, err
+	}
+	names, err := objectNames(arr[:3])
+	if err != nil {
+		return nil, err
 	}
 
 	return &procedureID{
-		DatabaseName:  arr[0],
-		SchemaName:    arr[1],
-		ProcedureName: arr[2],
+		DatabaseName:  names[0].Name(),
+		SchemaName:    names[1].Name(),
+		ProcedureName: names[2].Name(),
 		ArgTypes:      strings.Split(arr[3], "-"),
 	}, nil
 }
 
 // the opposite of splitProcedureID
 func (pi *procedureID) String() string {
-	return fmt.Sprintf("%v|%v|%v|%v",
+	return formatObjectID(
 		pi.DatabaseName,
 		pi.SchemaName,
 		pi.ProcedureName,
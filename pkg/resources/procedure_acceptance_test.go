@@ -49,6 +49,13 @@ func TestAcc_Procedure(t *testing.T) {
 					resource.TestCheckResourceAttr("snowflake_procedure.test_proc_complex", "arguments.1.type", "DATE"),
 					resource.TestCheckResourceAttr("snowflake_procedure.test_proc_complex", "return_behavior", "IMMUTABLE"),
 					resource.TestCheckResourceAttr("snowflake_procedure.test_proc_complex", "null_input_behavior", "RETURNS NULL ON NULL INPUT"),
+
+					resource.TestCheckResourceAttr("snowflake_procedure.test_proc_sql", "language", "sql"),
+
+					resource.TestCheckResourceAttr("snowflake_procedure.test_proc_python", "language", "python"),
+					resource.TestCheckResourceAttr("snowflake_procedure.test_proc_python", "runtime_version", "3.8"),
+					resource.TestCheckResourceAttr("snowflake_procedure.test_proc_python", "packages.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_procedure.test_proc_python", "handler", "run"),
 				),
 			},
 			{
@@ -116,5 +123,35 @@ var X=1
 return X
 EOT
 	}
-	`, db, schema, name, name, name)
+
+	resource "snowflake_procedure" "test_proc_sql" {
+		name = "%s_SQL"
+		database = snowflake_database.test_database.name
+		schema   = snowflake_schema.test_schema.name
+		language = "SQL"
+		return_type = "VARCHAR"
+		statement = <<EOT
+$$
+BEGIN
+  RETURN 'Hi';
+END;
+$$
+EOT
+	}
+
+	resource "snowflake_procedure" "test_proc_python" {
+		name = "%s_PYTHON"
+		database = snowflake_database.test_database.name
+		schema   = snowflake_schema.test_schema.name
+		language = "python"
+		runtime_version = "3.8"
+		packages = ["snowflake-snowpark-python"]
+		handler = "run"
+		return_type = "VARCHAR"
+		statement = <<EOT
+def run(session):
+  return 'Hi'
+EOT
+	}
+	`, db, schema, name, name, name, name, name)
 }
@@ -1,10 +1,12 @@
 package resources
 
 import (
+	"context"
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -101,21 +103,30 @@ var procedureGrantSchema = map[string]*schema.Schema{
 func ProcedureGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create: CreateProcedureGrant,
-			Read:   ReadProcedureGrant,
-			Delete: DeleteProcedureGrant,
+			CreateContext: CreateProcedureGrant,
+			ReadContext:   ReadProcedureGrant,
+			DeleteContext: DeleteProcedureGrant,
 
-			Schema: procedureGrantSchema,
+			Schema:        procedureGrantSchema,
+			SchemaVersion: 1,
+			StateUpgraders: []schema.StateUpgrader{
+				stateUpgraderV0(procedureGrantSchema, upgradeGrantIDV0),
+			},
 			Importer: &schema.ResourceImporter{
 				StateContext: schema.ImportStatePassthroughContext,
 			},
+			Timeouts: &schema.ResourceTimeout{
+				Create: schema.DefaultTimeout(defaultTimeout),
+				Read:   schema.DefaultTimeout(defaultTimeout),
+				Delete: schema.DefaultTimeout(defaultTimeout),
+			},
 		},
 		ValidPrivs: validProcedurePrivileges,
 	}
 }
 
-// CreateProcedureGrant implements schema.CreateFunc
-func CreateProcedureGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateProcedureGrant implements schema.CreateContextFunc
+func CreateProcedureGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var (
 		procedureName      string
 		arguments          []interface{}
@@ -128,7 +139,7 @@ func CreateProcedureGrant(d *schema.ResourceData, meta interface{}) error {
 		if ret, ok := d.GetOk("return_type"); ok {
 			returnType = strings.ToUpper(ret.(string))
 		} else {
-			return errors.New("return_type must be set when specifying procedure_name.")
+			return diag.FromErr(errors.New("return_type must be set when specifying procedure_name."))
 		}
 	}
 	dbName := d.Get("database_name").(string)
@@ -140,10 +151,10 @@ func CreateProcedureGrant(d *schema.ResourceData, meta interface{}) error {
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 
 	if (procedureName == "") && !futureProcedures {
-		return errors.New("procedure_name must be set unless on_future is true.")
+		return diag.FromErr(errors.New("procedure_name must be set unless on_future is true."))
 	}
 	if (procedureName != "") && futureProcedures {
-		return errors.New("procedure_name must be empty if on_future is true.")
+		return diag.FromErr(errors.New("procedure_name must be empty if on_future is true."))
 	}
 
 	if procedureName != "" {
@@ -159,9 +170,9 @@ func CreateProcedureGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.ProcedureGrant(dbName, schemaName, procedureName, argumentTypes)
 	}
 
-	err := createGenericGrant(d, meta, builder)
+	err := createGenericGrant(ctx, d, meta, builder)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grant := &grantID{
@@ -174,15 +185,15 @@ func CreateProcedureGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := grant.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadProcedureGrant(d, meta)
+	return ReadProcedureGrant(ctx, d, meta)
 }
 
-// ReadProcedureGrant implements schema.ReadFunc
-func ReadProcedureGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadProcedureGrant implements schema.ReadContextFunc
+func ReadProcedureGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var (
 		procedureName string
 		returnType    string
@@ -191,7 +202,7 @@ func ReadProcedureGrant(d *schema.ResourceData, meta interface{}) error {
 	)
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -200,11 +211,11 @@ func ReadProcedureGrant(d *schema.ResourceData, meta interface{}) error {
 
 	err = d.Set("database_name", dbName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("schema_name", schemaName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	futureProceduresEnabled := false
 	if procedureSignature == "" {
@@ -212,7 +223,7 @@ func ReadProcedureGrant(d *schema.ResourceData, meta interface{}) error {
 	} else {
 		procedureSignatureMap, err := parseCallableObjectName(procedureSignature)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		procedureName = procedureSignatureMap["callableName"].(string)
 		returnType = procedureSignatureMap["returnType"].(string)
@@ -221,27 +232,27 @@ func ReadProcedureGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	err = d.Set("procedure_name", procedureName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("arguments", arguments)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("return_type", returnType)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("on_future", futureProceduresEnabled)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("privilege", priv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("with_grant_option", grantID.GrantOption)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var builder snowflake.GrantBuilder
@@ -251,14 +262,14 @@ func ReadProcedureGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.ProcedureGrant(dbName, schemaName, procedureName, argumentTypes)
 	}
 
-	return readGenericGrant(d, meta, procedureGrantSchema, builder, futureProceduresEnabled, validProcedurePrivileges)
+	return diag.FromErr(readGenericGrant(ctx, d, meta, procedureGrantSchema, builder, futureProceduresEnabled, validProcedurePrivileges))
 }
 
-// DeleteProcedureGrant implements schema.DeleteFunc
-func DeleteProcedureGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteProcedureGrant implements schema.DeleteContextFunc
+func DeleteProcedureGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -271,11 +282,11 @@ func DeleteProcedureGrant(d *schema.ResourceData, meta interface{}) error {
 	} else {
 		procedureSignatureMap, err := parseCallableObjectName(grantID.ObjectName)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		procedureName := procedureSignatureMap["callableName"].(string)
 		argumentTypes := procedureSignatureMap["argumentTypes"].([]string)
 		builder = snowflake.ProcedureGrant(dbName, schemaName, procedureName, argumentTypes)
 	}
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
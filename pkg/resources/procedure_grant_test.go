@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 	"time"
@@ -48,8 +49,8 @@ func TestProcedureGrantCreate(t *testing.T) {
 		mock.ExpectExec(`^GRANT USAGE ON PROCEDURE "test-db"."PUBLIC"."test-procedure"\(ARRAY, STRING\) TO SHARE "test-share-1" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT USAGE ON PROCEDURE "test-db"."PUBLIC"."test-procedure"\(ARRAY, STRING\) TO SHARE "test-share-2" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadProcedureGrant(mock)
-		err := resources.CreateProcedureGrant(d, db)
-		r.NoError(err)
+		diags := resources.CreateProcedureGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -78,8 +79,8 @@ func TestProcedureGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadProcedureGrant(mock)
-		err := resources.ReadProcedureGrant(d, db)
-		r.NoError(err)
+		diags := resources.ReadProcedureGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 
 	roles := d.Get("roles").(*schema.Set)
@@ -130,8 +131,8 @@ func TestFutureProcedureGrantCreate(t *testing.T) {
 			`^GRANT USAGE ON FUTURE PROCEDURES IN SCHEMA "test-db"."PUBLIC" TO ROLE "test-role-2" WITH GRANT OPTION$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFutureProcedureGrant(mock)
-		err := resources.CreateProcedureGrant(d, db)
-		r.NoError(err)
+		diags := resources.CreateProcedureGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 
 	b := require.New(t)
@@ -154,8 +155,8 @@ func TestFutureProcedureGrantCreate(t *testing.T) {
 			`^GRANT USAGE ON FUTURE PROCEDURES IN DATABASE "test-db" TO ROLE "test-role-2"$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFutureProcedureDatabaseGrant(mock)
-		err := resources.CreateProcedureGrant(d, db)
-		b.NoError(err)
+		diags := resources.CreateProcedureGrant(context.Background(), d, db)
+		b.Empty(diags)
 	})
 }
 
@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -45,8 +46,8 @@ func TestProcedureCreate(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`CREATE OR REPLACE PROCEDURE "my_db"."my_schema"."my_proc"\(data VARCHAR, event_dt DATE\) RETURNS VARCHAR LANGUAGE javascript CALLED ON NULL INPUT IMMUTABLE COMMENT = 'mock comment' EXECUTE AS OWNER AS \$\$hi\$\$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectProcedureRead(mock)
-		err := resources.CreateProcedure(d, db)
-		r.NoError(err)
+		diags := resources.CreateProcedure(context.Background(), d, db)
+		r.Empty(diags)
 		r.Equal("MY_PROC", d.Get("name").(string))
 		r.Equal("VARCHAR", d.Get("return_type").(string))
 		r.Equal("mock comment", d.Get("comment").(string))
@@ -78,8 +79,8 @@ func TestProcedureRead(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectProcedureRead(mock)
 
-		err := resources.ReadProcedure(d, db)
-		r.NoError(err)
+		diags := resources.ReadProcedure(context.Background(), d, db)
+		r.Empty(diags)
 		r.Equal("MY_PROC", d.Get("name").(string))
 		r.Equal("MY_DB", d.Get("database").(string))
 		r.Equal("MY_SCHEMA", d.Get("schema").(string))
@@ -108,7 +109,169 @@ func TestProcedureDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP PROCEDURE "my_db"."my_schema"."my_proc"\(VARCHAR, DATE\)`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteProcedure(d, db)
-		r.NoError(err)
+		diags := resources.DeleteProcedure(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
+
+func TestProcedureCreatePython(t *testing.T) {
+	r := require.New(t)
+
+	d := procedure(t, "", map[string]interface{}{
+		"name":            "my_proc",
+		"database":        "my_db",
+		"schema":          "my_schema",
+		"return_type":     "varchar",
+		"language":        "python",
+		"runtime_version": "3.8",
+		"packages":        []interface{}{"snowflake-snowpark-python"},
+		"handler":         "run",
+		"statement":       "$$def run(session):\n  return 'hi'$$",
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`CREATE OR REPLACE PROCEDURE "my_db"."my_schema"."my_proc"\(\) RETURNS VARCHAR LANGUAGE python CALLED ON NULL INPUT VOLATILE COMMENT = 'user-defined procedure' RUNTIME_VERSION = '3.8' PACKAGES = \('snowflake-snowpark-python'\) HANDLER = 'run' EXECUTE AS OWNER AS \$\$def run\(session\):\s+return 'hi'\$\$`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		rows := sqlmock.NewRows([]string{"created_on", "name", "schema_name", "is_builtin", "is_aggregate", "is_ansi", "min_num_arguments", "max_num_arguments", "arguments", "description", "catalog_name", "is_table_function", "valid_for_clustering", "is_secure"}).
+			AddRow("now", "MY_PROC", "MY_SCHEMA", "N", "N", "N", "0", "0", "MY_PROC() RETURN VARCHAR", "user-defined procedure", "MY_DB", "N", "N", "N")
+		mock.ExpectQuery(`SHOW PROCEDURES LIKE 'my_proc' IN SCHEMA "my_db"."my_schema"`).WillReturnRows(rows)
+
+		describeRows := sqlmock.NewRows([]string{"property", "value"}).
+			AddRow("signature", "()").
+			AddRow("returns", "VARCHAR(16777216)").
+			AddRow("language", "PYTHON").
+			AddRow("null handling", "CALLED ON NULL INPUT").
+			AddRow("volatility", "VOLATILE").
+			AddRow("body", "def run(session):\n  return 'hi'").
+			AddRow("imports", "[]").
+			AddRow("handler", "run").
+			AddRow("runtime_version", "3.8").
+			AddRow("packages", "['snowflake-snowpark-python']").
+			AddRow("installed_packages", "['snowflake-snowpark-python==1.0.0','cloudpickle==2.0.0']").
+			AddRow("execute as", "OWNER")
+		mock.ExpectQuery(`DESCRIBE PROCEDURE "my_db"."my_schema"."my_proc"\(\)`).WillReturnRows(describeRows)
+
+		diags := resources.CreateProcedure(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("python", d.Get("language").(string))
+		r.Equal("3.8", d.Get("runtime_version").(string))
+		r.Equal([]interface{}{"snowflake-snowpark-python"}, d.Get("packages").([]interface{}))
+		r.Empty(d.Get("imports").([]interface{}))
+		r.Equal("run", d.Get("handler").(string))
+		// the body is described without the delimiters it was written with
+		r.True(resources.DiffSuppressBody("statement", "$$def run(session):\n  return 'hi'$$", d.Get("statement").(string), d))
+	})
+}
+
+func TestProcedureCustomizeDiff(t *testing.T) {
+	r := require.New(t)
+
+	config := func(extra map[string]interface{}) map[string]interface{} {
+		c := map[string]interface{}{
+			"name":        "my_proc",
+			"database":    "my_db",
+			"schema":      "my_schema",
+			"return_type": "varchar",
+			"statement":   "return 'hi'",
+		}
+		for k, v := range extra {
+			c[k] = v
+		}
+		return c
+	}
+
+	r.NoError(planDiff(resources.Procedure(), config(nil)))
+	r.NoError(planDiff(resources.Procedure(), config(map[string]interface{}{
+		"language":        "PYTHON",
+		"runtime_version": "3.8",
+		"handler":         "run",
+		"packages":        []interface{}{"snowflake-snowpark-python"},
+	})))
+
+	err := planDiff(resources.Procedure(), config(map[string]interface{}{
+		"language": "python",
+		"handler":  "run",
+	}))
+	r.Error(err)
+	r.Contains(err.Error(), "runtime_version must be set when language is python")
+
+	err = planDiff(resources.Procedure(), config(map[string]interface{}{
+		"language":        "Java",
+		"runtime_version": "11",
+	}))
+	r.Error(err)
+	r.Contains(err.Error(), "handler must be set when language is java")
+
+	err = planDiff(resources.Procedure(), config(map[string]interface{}{
+		"packages": []interface{}{"snowflake-snowpark-python"},
+	}))
+	r.Error(err)
+	r.Contains(err.Error(), "packages can only be set when language is python or java or scala")
+
+	err = planDiff(resources.Procedure(), config(map[string]interface{}{
+		"language": "sql",
+		"handler":  "run",
+	}))
+	r.Error(err)
+	r.Contains(err.Error(), "handler can only be set when language is python or java or scala")
+
+	err = planDiff(resources.Procedure(), config(map[string]interface{}{
+		"language":        "python",
+		"runtime_version": "3.8",
+		"handler":         "run",
+		"target_path":     "@stage/proc.jar",
+	}))
+	r.Error(err)
+	r.Contains(err.Error(), "target_path can only be set when language is java or scala")
+}
+
+func TestProcedureReadNormalizedPackages(t *testing.T) {
+	r := require.New(t)
+
+	d := procedure(t, "my_db|my_schema|my_proc|", map[string]interface{}{
+		"name":            "my_proc",
+		"database":        "my_db",
+		"schema":          "my_schema",
+		"return_type":     "varchar",
+		"language":        "python",
+		"runtime_version": "3.8",
+		"packages":        []interface{}{"snowflake-snowpark-python", "numpy"},
+		"imports":         []interface{}{"@my_stage/a.py", "@my_stage/b.py"},
+		"handler":         "run",
+		"statement":       "def run(session):\n  return 'hi'",
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		describeRows := sqlmock.NewRows([]string{"property", "value"}).
+			AddRow("signature", "()").
+			AddRow("returns", "VARCHAR(16777216)").
+			AddRow("language", "PYTHON").
+			AddRow("body", "def run(session):\n  return 'hi'").
+			AddRow("imports", "[@MY_STAGE/b.py, @MY_STAGE/a.py]").
+			AddRow("handler", "run").
+			AddRow("runtime_version", "3.8").
+			AddRow("packages", "['numpy==1.24.3', 'snowflake-snowpark-python']")
+		mock.ExpectQuery(`DESCRIBE PROCEDURE "my_db"."my_schema"."my_proc"\(\)`).WillReturnRows(describeRows)
+
+		rows := sqlmock.NewRows([]string{"created_on", "name", "schema_name", "is_builtin", "is_aggregate", "is_ansi", "min_num_arguments", "max_num_arguments", "arguments", "description", "catalog_name", "is_table_function", "valid_for_clustering", "is_secure"}).
+			AddRow("now", "MY_PROC", "MY_SCHEMA", "N", "N", "N", "0", "0", "MY_PROC() RETURN VARCHAR", "user-defined procedure", "MY_DB", "N", "N", "N")
+		mock.ExpectQuery(`SHOW PROCEDURES LIKE 'my_proc' IN SCHEMA "my_db"."my_schema"`).WillReturnRows(rows)
+
+		diags := resources.ReadProcedure(context.Background(), d, db)
+		r.Empty(diags)
+		// reordered and pinned by Snowflake, the configured values are kept
+		r.Equal([]interface{}{"snowflake-snowpark-python", "numpy"}, d.Get("packages").([]interface{}))
+		r.Equal([]interface{}{"@my_stage/a.py", "@my_stage/b.py"}, d.Get("imports").([]interface{}))
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		describeRows := sqlmock.NewRows([]string{"property", "value"}).
+			AddRow("packages", "['pandas==2.0.3', 'snowflake-snowpark-python']")
+		mock.ExpectQuery(`DESCRIBE PROCEDURE "my_db"."my_schema"."my_proc"\(\)`).WillReturnRows(describeRows)
+		mock.ExpectQuery(`SHOW PROCEDURES LIKE 'my_proc' IN SCHEMA "my_db"."my_schema"`).WillReturnRows(sqlmock.NewRows([]string{"name"}))
+
+		diags := resources.ReadProcedure(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal([]interface{}{"pandas==2.0.3", "snowflake-snowpark-python"}, d.Get("packages").([]interface{}))
 	})
 }
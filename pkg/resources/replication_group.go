@@ -0,0 +1,342 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"strings"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+)
+
+// replicationGroupObjectTypes are the types of the objects failover and
+// replication groups replicate
+var replicationGroupObjectTypes = []string{
+	"ACCOUNT PARAMETERS", "DATABASES", "INTEGRATIONS", "NETWORK POLICIES",
+	"RESOURCE MONITORS", "ROLES", "SHARES", "USERS", "WAREHOUSES",
+}
+
+// replicationGroupIntegrationTypes are the types of the integrations failover
+// and replication groups replicate
+var replicationGroupIntegrationTypes = []string{
+	"SECURITY INTEGRATIONS", "API INTEGRATIONS", "NOTIFICATION INTEGRATIONS", "STORAGE INTEGRATIONS",
+}
+
+// newReplicationGroupSchema returns the schema of failover and replication groups
+func newReplicationGroupSchema(kind string) map[string]*schema.Schema {
+	kind = strings.ToLower(kind)
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Specifies the identifier for the " + kind + ".",
+		},
+		"object_types": {
+			Type:         schema.TypeSet,
+			Elem:         &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringInSlice(replicationGroupObjectTypes, false)},
+			Optional:     true,
+			Description:  "The types of the objects replicated by the primary " + kind + ", among " + strings.Join(replicationGroupObjectTypes, ", ") + ".",
+			ExactlyOneOf: []string{"object_types", "as_replica_of"},
+		},
+		"allowed_databases": {
+			Type:          schema.TypeSet,
+			Elem:          &schema.Schema{Type: schema.TypeString},
+			Optional:      true,
+			Description:   "The databases replicated when DATABASES are.",
+			ConflictsWith: []string{"as_replica_of"},
+		},
+		"allowed_shares": {
+			Type:          schema.TypeSet,
+			Elem:          &schema.Schema{Type: schema.TypeString},
+			Optional:      true,
+			Description:   "The shares replicated when SHARES are.",
+			ConflictsWith: []string{"as_replica_of"},
+		},
+		"allowed_integration_types": {
+			Type:          schema.TypeSet,
+			Elem:          &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringInSlice(replicationGroupIntegrationTypes, false)},
+			Optional:      true,
+			Description:   "The types of the integrations replicated, among " + strings.Join(replicationGroupIntegrationTypes, ", ") + ". Required when object_types contains INTEGRATIONS, and only allowed then.",
+			ConflictsWith: []string{"as_replica_of"},
+		},
+		"allowed_accounts": {
+			Type:          schema.TypeSet,
+			Elem:          &schema.Schema{Type: schema.TypeString},
+			Optional:      true,
+			Description:   "The target accounts, as organization_name.account_name, the " + kind + " is replicated to.",
+			ConflictsWith: []string{"as_replica_of"},
+		},
+		"ignore_edition_check": {
+			Type:          schema.TypeBool,
+			Optional:      true,
+			Default:       false,
+			Description:   "Allows replicating to accounts on a lower edition than the account of the " + kind + ".",
+			ConflictsWith: []string{"as_replica_of"},
+		},
+		"replication_schedule": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Description:   "The schedule the secondary groups are refreshed on, e.g. `10 MINUTE` or `USING CRON 0 0 * * * UTC`.",
+			ConflictsWith: []string{"as_replica_of"},
+		},
+		"as_replica_of": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Creates a secondary " + kind + " replicating the primary one given as organization_name.account_name.group_name.",
+			ForceNew:    true,
+		},
+		"is_primary": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the " + kind + " is the primary one.",
+		},
+	}
+}
+
+// replicationGroupResource returns a pointer to the resource representing a
+// failover or replication group of the schema given
+func replicationGroupResource(s map[string]*schema.Schema, create schema.CreateContextFunc, read schema.ReadContextFunc, update schema.UpdateContextFunc, delete schema.DeleteContextFunc) *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   read,
+		UpdateContext: update,
+		DeleteContext: delete,
+
+		Schema:        s,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(s, upgradeUnchangedV0),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: customdiff.All(
+			requiredForValue("allowed_integration_types", "object_types", "INTEGRATIONS"),
+		),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+	}
+}
+
+var replicationGroupSchema = newReplicationGroupSchema(snowflake.ReplicationGroupKind)
+
+// ReplicationGroup returns a pointer to the resource representing a replication group
+func ReplicationGroup() *schema.Resource {
+	return replicationGroupResource(replicationGroupSchema, CreateReplicationGroup, ReadReplicationGroup, UpdateReplicationGroup, DeleteReplicationGroup)
+}
+
+// CreateReplicationGroup implements schema.CreateContextFunc
+func CreateReplicationGroup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return createReplicationGroup(snowflake.ReplicationGroup, ReadReplicationGroup)(ctx, d, meta)
+}
+
+// ReadReplicationGroup implements schema.ReadContextFunc
+func ReadReplicationGroup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return readReplicationGroup(snowflake.ReplicationGroup)(ctx, d, meta)
+}
+
+// UpdateReplicationGroup implements schema.UpdateContextFunc
+func UpdateReplicationGroup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return updateReplicationGroup(snowflake.ReplicationGroup, ReadReplicationGroup)(ctx, d, meta)
+}
+
+// DeleteReplicationGroup implements schema.DeleteContextFunc
+func DeleteReplicationGroup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return deleteReplicationGroup(snowflake.ReplicationGroup)(ctx, d, meta)
+}
+
+func createReplicationGroup(builder func(string) *snowflake.ReplicationGroupBuilder, read schema.ReadContextFunc) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		db := meta.(*sql.DB)
+		name := d.Get("name").(string)
+		b := builder(name)
+
+		var stmt string
+		if primary, ok := d.GetOk("as_replica_of"); ok {
+			stmt = b.CreateReplica(primary.(string))
+		} else {
+			b.WithObjectTypes(expandStringList(d.Get("object_types").(*schema.Set).List())).
+				WithAllowedDatabases(expandStringList(d.Get("allowed_databases").(*schema.Set).List())).
+				WithAllowedShares(expandStringList(d.Get("allowed_shares").(*schema.Set).List())).
+				WithAllowedIntegrationTypes(expandStringList(d.Get("allowed_integration_types").(*schema.Set).List())).
+				WithAllowedAccounts(expandStringList(d.Get("allowed_accounts").(*schema.Set).List())).
+				WithIgnoreEditionCheck(d.Get("ignore_edition_check").(bool)).
+				WithReplicationSchedule(d.Get("replication_schedule").(string))
+			stmt = b.Create()
+		}
+
+		err := snowflake.ExecContext(ctx, db, stmt)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error creating %v %v", strings.ToLower(b.Kind()), name))
+		}
+
+		d.SetId(name)
+
+		return read(ctx, d, meta)
+	}
+}
+
+func readReplicationGroup(builder func(string) *snowflake.ReplicationGroupBuilder) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		db := meta.(*sql.DB)
+		b := builder(d.Id())
+
+		group, err := snowflake.ReadReplicationGroup(ctx, db, b)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if group == nil {
+			// If not found, mark resource to be removed from statefile during apply or refresh
+			log.Printf("[DEBUG] %v (%s) not found", strings.ToLower(b.Kind()), d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		toSet := map[string]interface{}{
+			"name":       group.Name.String,
+			"is_primary": group.IsPrimary.Bool,
+		}
+		replicaOf := d.Get("as_replica_of").(string)
+		if !group.IsPrimary.Bool && replicaOf == "" {
+			// imported secondary groups replicate the primary listed
+			replicaOf = group.PrimaryGroup()
+			toSet["as_replica_of"] = replicaOf
+		}
+		// the settings of secondary groups are the primary's
+		if replicaOf == "" {
+			databases, err := snowflake.ListReplicationGroupObjects(ctx, b.ShowDatabases(), db)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			shares, err := snowflake.ListReplicationGroupObjects(ctx, b.ShowShares(), db)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			configured := expandStringList(d.Get("allowed_accounts").(*schema.Set).List())
+
+			toSet["object_types"] = group.ListObjectTypes()
+			toSet["allowed_integration_types"] = group.ListAllowedIntegrationTypes()
+			toSet["allowed_accounts"] = matchAccounts(group.ListAllowedAccounts(), configured)
+			toSet["allowed_databases"] = databases
+			toSet["allowed_shares"] = shares
+			toSet["replication_schedule"] = group.ReplicationSchedule.String
+		} else if group.IsPrimary.Bool {
+			// a promoted failover group, which keeps the objects replicated
+			// but is now the one replicating them
+			databases, err := snowflake.ListReplicationGroupObjects(ctx, b.ShowDatabases(), db)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			configured := expandStringList(d.Get("allowed_accounts").(*schema.Set).List())
+
+			toSet["allowed_accounts"] = matchAccounts(group.ListAllowedAccounts(), configured)
+			toSet["allowed_databases"] = databases
+			toSet["replication_schedule"] = group.ReplicationSchedule.String
+		}
+		for key, val := range toSet {
+			err = d.Set(key, val) //lintignore:R001
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		return nil
+	}
+}
+
+func updateReplicationGroup(builder func(string) *snowflake.ReplicationGroupBuilder, read schema.ReadContextFunc) schema.UpdateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		db := meta.(*sql.DB)
+		kind := strings.ToLower(builder(d.Id()).Kind())
+
+		if d.HasChange("name") {
+			oldName, newName := d.GetChange("name")
+			err := snowflake.ExecContext(ctx, db, builder(oldName.(string)).Rename(newName.(string)))
+			if err != nil {
+				return diag.FromErr(errors.Wrapf(err, "error renaming %v %v to %v", kind, oldName, newName))
+			}
+			d.SetId(newName.(string))
+		}
+
+		b := builder(d.Id())
+		stmts := []string{}
+		if d.HasChange("object_types") {
+			stmts = append(stmts, b.SetObjectTypes(expandStringList(d.Get("object_types").(*schema.Set).List())))
+		}
+		if d.HasChange("allowed_integration_types") {
+			// the types are only removed along with INTEGRATIONS, which the
+			// object types set above stop replicating
+			if types := expandStringList(d.Get("allowed_integration_types").(*schema.Set).List()); len(types) > 0 {
+				stmts = append(stmts, b.SetAllowedIntegrationTypes(types))
+			}
+		}
+		if d.HasChange("allowed_databases") {
+			added, removed := changeDiff(d, "allowed_databases")
+			if len(removed) > 0 {
+				stmts = append(stmts, b.RemoveAllowedDatabases(removed))
+			}
+			if len(added) > 0 {
+				stmts = append(stmts, b.AddAllowedDatabases(added))
+			}
+		}
+		if d.HasChange("allowed_shares") {
+			added, removed := changeDiff(d, "allowed_shares")
+			if len(removed) > 0 {
+				stmts = append(stmts, b.RemoveAllowedShares(removed))
+			}
+			if len(added) > 0 {
+				stmts = append(stmts, b.AddAllowedShares(added))
+			}
+		}
+		if d.HasChange("allowed_accounts") {
+			o, n := d.GetChange("allowed_accounts")
+			oldAccounts := expandStringList(o.(*schema.Set).List())
+			newAccounts := expandStringList(n.(*schema.Set).List())
+			if removed := diffAccounts(oldAccounts, newAccounts); len(removed) > 0 {
+				stmts = append(stmts, b.RemoveAllowedAccounts(removed))
+			}
+			if added := diffAccounts(newAccounts, oldAccounts); len(added) > 0 {
+				stmts = append(stmts, b.AddAllowedAccounts(added, d.Get("ignore_edition_check").(bool)))
+			}
+		}
+		if d.HasChange("replication_schedule") {
+			if schedule := d.Get("replication_schedule").(string); schedule != "" {
+				stmts = append(stmts, b.SetReplicationSchedule(schedule))
+			} else {
+				stmts = append(stmts, b.UnsetReplicationSchedule())
+			}
+		}
+
+		for _, stmt := range stmts {
+			err := snowflake.ExecContext(ctx, db, stmt)
+			if err != nil {
+				return diag.FromErr(errors.Wrapf(err, "error updating %v %v", kind, d.Id()))
+			}
+		}
+
+		return read(ctx, d, meta)
+	}
+}
+
+func deleteReplicationGroup(builder func(string) *snowflake.ReplicationGroupBuilder) schema.DeleteContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		db := meta.(*sql.DB)
+		b := builder(d.Id())
+
+		err := snowflake.ExecContext(ctx, db, b.Drop())
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error dropping %v %v", strings.ToLower(b.Kind()), d.Id()))
+		}
+
+		d.SetId("")
+		return nil
+	}
+}
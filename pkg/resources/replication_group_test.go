@@ -0,0 +1,125 @@
+package resources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/provider"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/resources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplicationGroup(t *testing.T) {
+	r := require.New(t)
+	err := resources.ReplicationGroup().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestReplicationGroupCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":                      "rg",
+		"object_types":              []interface{}{"INTEGRATIONS"},
+		"allowed_integration_types": []interface{}{"SECURITY INTEGRATIONS"},
+		"allowed_accounts":          []interface{}{"MYORG.ACCOUNT2"},
+		"ignore_edition_check":      true,
+	}
+	d := replicationGroup(t, "", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`^CREATE REPLICATION GROUP "rg" OBJECT_TYPES = INTEGRATIONS ALLOWED_INTEGRATION_TYPES = SECURITY INTEGRATIONS ALLOWED_ACCOUNTS = MYORG.ACCOUNT2 IGNORE EDITION CHECK$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectQuery(`^SELECT CURRENT_ACCOUNT`).WillReturnRows(
+			sqlmock.NewRows([]string{"account", "region"}).AddRow("AB12345", "AWS_US_WEST_2"),
+		)
+		rows := sqlmock.NewRows([]string{"account_name", "name", "is_primary", "object_types", "allowed_integration_types", "allowed_accounts", "organization_name", "account_locator", "replication_schedule"}).
+			AddRow("ACCOUNT1", "rg", true, "INTEGRATIONS", "SECURITY INTEGRATIONS", "MYORG.ACCOUNT1, MYORG.ACCOUNT2", "MYORG", "AB12345", nil)
+		mock.ExpectQuery(`^SHOW REPLICATION GROUPS$`).WillReturnRows(rows)
+		mock.ExpectQuery(`^SHOW DATABASES IN REPLICATION GROUP "rg"$`).WillReturnRows(sqlmock.NewRows([]string{"name"}))
+		mock.ExpectQuery(`^SHOW SHARES IN REPLICATION GROUP "rg"$`).WillReturnRows(sqlmock.NewRows([]string{"name"}))
+
+		diags := resources.CreateReplicationGroup(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("rg", d.Id())
+		r.Equal("", d.Get("replication_schedule"))
+	})
+}
+
+func TestReplicationGroupUpdate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"allowed_databases":    []interface{}{"db1"},
+		"allowed_accounts":     []interface{}{"myorg.account2"},
+		"replication_schedule": "USING CRON 0 0 * * * UTC",
+	}
+	d := replicationGroup(t, "rg", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER REPLICATION GROUP "rg" ADD "db1" TO ALLOWED_DATABASES$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER REPLICATION GROUP "rg" ADD myorg.account2 TO ALLOWED_ACCOUNTS$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER REPLICATION GROUP "rg" SET REPLICATION_SCHEDULE = 'USING CRON 0 0 \* \* \* UTC'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectQuery(`^SELECT CURRENT_ACCOUNT`).WillReturnRows(
+			sqlmock.NewRows([]string{"account", "region"}).AddRow("AB12345", "AWS_US_WEST_2"),
+		)
+		mock.ExpectQuery(`^SHOW REPLICATION GROUPS$`).WillReturnRows(sqlmock.NewRows([]string{"name", "account_locator"}))
+
+		diags := resources.UpdateReplicationGroup(context.Background(), d, db)
+		r.Empty(diags)
+		// dropped since
+		r.Empty(d.Id())
+	})
+}
+
+func TestReplicationGroupCustomizeDiff(t *testing.T) {
+	r := require.New(t)
+
+	err := planDiff(resources.ReplicationGroup(), map[string]interface{}{
+		"name":                      "rg",
+		"object_types":              []interface{}{"INTEGRATIONS"},
+		"allowed_integration_types": []interface{}{"SECURITY INTEGRATIONS"},
+	})
+	r.NoError(err)
+
+	err = planDiff(resources.ReplicationGroup(), map[string]interface{}{
+		"name":         "rg",
+		"object_types": []interface{}{"INTEGRATIONS"},
+	})
+	r.Error(err)
+	r.Contains(err.Error(), "allowed_integration_types must be set when object_types contains INTEGRATIONS")
+
+	err = planDiff(resources.ReplicationGroup(), map[string]interface{}{
+		"name":                      "rg",
+		"object_types":              []interface{}{"DATABASES"},
+		"allowed_integration_types": []interface{}{"SECURITY INTEGRATIONS"},
+	})
+	r.Error(err)
+	r.Contains(err.Error(), "allowed_integration_types can only be set when object_types contains INTEGRATIONS")
+}
+
+func TestReplicationGroupImportSecondary(t *testing.T) {
+	r := require.New(t)
+
+	d := replicationGroup(t, "rg", map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(`^SELECT CURRENT_ACCOUNT`).WillReturnRows(
+			sqlmock.NewRows([]string{"account", "region"}).AddRow("AB12345", "AWS_US_WEST_2"),
+		)
+		rows := sqlmock.NewRows([]string{"account_name", "name", "is_primary", "primary", "object_types", "allowed_accounts", "organization_name", "account_locator"}).
+			AddRow("ACCOUNT2", "rg", false, "AWS_US_WEST_2.MYORG.ACCOUNT1.RG", "DATABASES", "MYORG.ACCOUNT1, MYORG.ACCOUNT2", "MYORG", "AB12345")
+		mock.ExpectQuery(`^SHOW REPLICATION GROUPS$`).WillReturnRows(rows)
+
+		diags := resources.ReadReplicationGroup(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("MYORG.ACCOUNT1.RG", d.Get("as_replica_of"))
+		r.False(d.Get("is_primary").(bool))
+		r.Empty(d.Get("object_types").(*schema.Set).List())
+	})
+}
@@ -1,22 +1,29 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"log"
+	"time"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
 
+// defaultTimeout bounds every resource operation unless it is overridden in the
+// resource's `timeouts {}` block.
+const defaultTimeout = 20 * time.Minute
+
 func CreateResource(
 	t string,
 	properties []string,
 	s map[string]*schema.Schema,
 	builder func(string) *snowflake.Builder,
-	read func(*schema.ResourceData, interface{}) error,
-) func(*schema.ResourceData, interface{}) error {
-	return func(d *schema.ResourceData, meta interface{}) error {
+	read schema.ReadContextFunc,
+) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		db := meta.(*sql.DB)
 		name := d.Get("name").(string)
 
@@ -42,15 +49,15 @@ func CreateResource(
 			tags := getTags(v)
 			qb.SetTags(tags.toSnowflakeTagValues())
 		}
-		err := snowflake.Exec(db, qb.Statement())
+		err := snowflake.ExecContext(ctx, db, qb.Statement())
 
 		if err != nil {
-			return errors.Wrapf(err, "error creating %s", t)
+			return diag.FromErr(errors.Wrapf(err, "error creating %s", t))
 		}
 
 		d.SetId(name)
 
-		return read(d, meta)
+		return read(ctx, d, meta)
 	}
 }
 
@@ -59,9 +66,9 @@ func UpdateResource(
 	properties []string,
 	s map[string]*schema.Schema,
 	builder func(string) *snowflake.Builder,
-	read func(*schema.ResourceData, interface{}) error,
-) func(*schema.ResourceData, interface{}) error {
-	return func(d *schema.ResourceData, meta interface{}) error {
+	read schema.ReadContextFunc,
+) schema.UpdateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		db := meta.(*sql.DB)
 		if d.HasChange("name") {
 			// I wish this could be done on one line.
@@ -71,9 +78,9 @@ func UpdateResource(
 
 			stmt := builder(oldName).Rename(newName)
 
-			err := snowflake.Exec(db, stmt)
+			err := snowflake.ExecContext(ctx, db, stmt)
 			if err != nil {
-				return errors.Wrapf(err, "error renaming %s %s to %s", t, oldName, newName)
+				return diag.FromErr(errors.Wrapf(err, "error renaming %s %s to %s", t, oldName, newName))
 			}
 			d.SetId(newName)
 		}
@@ -109,26 +116,26 @@ func UpdateResource(
 				qb.SetTags(tags.toSnowflakeTagValues())
 			}
 
-			err := snowflake.Exec(db, qb.Statement())
+			err := snowflake.ExecContext(ctx, db, qb.Statement())
 			if err != nil {
-				return errors.Wrapf(err, "error altering %s", t)
+				return diag.FromErr(errors.Wrapf(err, "error altering %s", t))
 			}
 		}
 		log.Printf("[DEBUG] performing read")
-		return read(d, meta)
+		return read(ctx, d, meta)
 	}
 }
 
-func DeleteResource(t string, builder func(string) *snowflake.Builder) func(*schema.ResourceData, interface{}) error {
-	return func(d *schema.ResourceData, meta interface{}) error {
+func DeleteResource(t string, builder func(string) *snowflake.Builder) schema.DeleteContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		db := meta.(*sql.DB)
 		name := d.Get("name").(string)
 
 		stmt := builder(name).Drop()
 
-		err := snowflake.Exec(db, stmt)
+		err := snowflake.ExecContext(ctx, db, stmt)
 		if err != nil {
-			return errors.Wrapf(err, "error dropping %s %s", t, name)
+			return diag.FromErr(errors.Wrapf(err, "error dropping %s %s", t, name))
 		}
 
 		d.SetId("")
@@ -1,12 +1,15 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"log"
 	"strconv"
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
@@ -51,23 +54,23 @@ var resourceMonitorSchema = map[string]*schema.Schema{
 	},
 	"suspend_triggers": {
 		Type:        schema.TypeSet,
-		Elem:        &schema.Schema{Type: schema.TypeInt},
+		Elem:        &schema.Schema{Type: schema.TypeInt, ValidateFunc: validation.IntAtLeast(1)},
 		Optional:    true,
-		Description: "A list of percentage thresholds at which to suspend all warehouses.",
+		Description: "A list of percentage thresholds, at most 100, at which to suspend all warehouses.",
 		ForceNew:    true,
 	},
 	"suspend_immediate_triggers": {
 		Type:        schema.TypeSet,
-		Elem:        &schema.Schema{Type: schema.TypeInt},
+		Elem:        &schema.Schema{Type: schema.TypeInt, ValidateFunc: validation.IntAtLeast(1)},
 		Optional:    true,
-		Description: "A list of percentage thresholds at which to immediately suspend all warehouses.",
+		Description: "A list of percentage thresholds, at most 100, at which to immediately suspend all warehouses.",
 		ForceNew:    true,
 	},
 	"notify_triggers": {
 		Type:        schema.TypeSet,
-		Elem:        &schema.Schema{Type: schema.TypeInt},
+		Elem:        &schema.Schema{Type: schema.TypeInt, ValidateFunc: validation.IntAtLeast(1)},
 		Optional:    true,
-		Description: "A list of percentage thresholds at which to send an alert to subscribed users.",
+		Description: "A list of percentage thresholds, at most 100, at which to send an alert to subscribed users.",
 		ForceNew:    true,
 	},
 	"set_for_account": {
@@ -89,20 +92,33 @@ var resourceMonitorSchema = map[string]*schema.Schema{
 // ResourceMonitor returns a pointer to the resource representing a resource monitor
 func ResourceMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateResourceMonitor,
-		Read:   ReadResourceMonitor,
+		CreateContext: CreateResourceMonitor,
+		ReadContext:   ReadResourceMonitor,
 		// Update: UpdateResourceMonitor, @TODO implement updates
-		Delete: DeleteResourceMonitor,
+		DeleteContext: DeleteResourceMonitor,
 
-		Schema: resourceMonitorSchema,
+		Schema:        resourceMonitorSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(resourceMonitorSchema, upgradeUnchangedV0),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customdiff.All(
+			requiredWith("frequency", "start_timestamp"),
+			intsAtMost(100, "suspend_triggers", "suspend_immediate_triggers", "notify_triggers"),
+		),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 	}
 }
 
 // CreateResourceMonitor implents schema.CreateFunc
-func CreateResourceMonitor(d *schema.ResourceData, meta interface{}) error {
+func CreateResourceMonitor(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 
@@ -136,40 +152,36 @@ func CreateResourceMonitor(d *schema.ResourceData, meta interface{}) error {
 
 	stmt := cb.Statement()
 
-	err := snowflake.Exec(db, stmt)
+	err := snowflake.ExecContext(ctx, db, stmt)
 	if err != nil {
-		return errors.Wrapf(err, "error creating resource monitor %v", name)
+		return diag.FromErr(errors.Wrapf(err, "error creating resource monitor %v", name))
 	}
 
 	d.SetId(name)
 
 	if d.Get("set_for_account").(bool) {
-		if err := snowflake.Exec(db, cb.SetOnAccount()); err != nil {
-			return errors.Wrapf(err, "error setting resource monitor %v on account", name)
+		if err := snowflake.ExecContext(ctx, db, cb.SetOnAccount()); err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error setting resource monitor %v on account", name))
 		}
 	}
 
 	if v, ok := d.GetOk("warehouses"); ok {
 		for _, w := range v.(*schema.Set).List() {
-			if err := snowflake.Exec(db, cb.SetOnWarehouse(w.(string))); err != nil {
-				return errors.Wrapf(err, "error setting resource monitor %v on warehouse %v", name, w.(string))
+			if err := snowflake.ExecContext(ctx, db, cb.SetOnWarehouse(w.(string))); err != nil {
+				return diag.FromErr(errors.Wrapf(err, "error setting resource monitor %v on warehouse %v", name, w.(string)))
 			}
 		}
 	}
 
-	if err := ReadResourceMonitor(d, meta); err != nil {
-		return err
-	}
-
-	return nil
+	return ReadResourceMonitor(ctx, d, meta)
 }
 
-// ReadResourceMonitor implements schema.ReadFunc
-func ReadResourceMonitor(d *schema.ResourceData, meta interface{}) error {
+// ReadResourceMonitor implements schema.ReadContextFunc
+func ReadResourceMonitor(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	stmt := snowflake.ResourceMonitor(d.Id()).Show()
 
-	row := snowflake.QueryRow(db, stmt)
+	row := snowflake.QueryRowContext(ctx, db, stmt)
 
 	rm, err := snowflake.ScanResourceMonitor(row)
 	if err == sql.ErrNoRows {
@@ -179,7 +191,7 @@ func ReadResourceMonitor(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Set string values
@@ -191,49 +203,49 @@ func ReadResourceMonitor(d *schema.ResourceData, meta interface{}) error {
 	}
 	err = setDataFromNullStrings(d, nullStrings)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Snowflake returns credit_quota as a float, but only accepts input as an int
 	if rm.CreditQuota.Valid {
 		cqf, err := strconv.ParseFloat(rm.CreditQuota.String, 64)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		err = d.Set("credit_quota", int(cqf))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	// Triggers
 	sTrigs, err := extractTriggerInts(rm.SuspendAt)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("suspend_triggers", sTrigs)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	siTrigs, err := extractTriggerInts(rm.SuspendImmediatelyAt)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("suspend_immediate_triggers", siTrigs)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	nTrigs, err := extractTriggerInts(rm.NotifyAt)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("notify_triggers", nTrigs)
 
 	// Account level
 	d.Set("set_for_account", rm.Level.Valid && rm.Level.String == "ACCOUNT")
 
-	return err
+	return diag.FromErr(err)
 }
 
 // setDataFromNullString blanks the value if v is null, otherwise sets the value to the value of v
@@ -271,15 +283,15 @@ func extractTriggerInts(s sql.NullString) ([]int, error) {
 	return out, nil
 }
 
-// DeleteResourceMonitor implements schema.DeleteFunc
-func DeleteResourceMonitor(d *schema.ResourceData, meta interface{}) error {
+// DeleteResourceMonitor implements schema.DeleteContextFunc
+func DeleteResourceMonitor(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 
 	stmt := snowflake.ResourceMonitor(d.Id()).Drop()
 
-	err := snowflake.Exec(db, stmt)
+	err := snowflake.ExecContext(ctx, db, stmt)
 	if err != nil {
-		return errors.Wrapf(err, "error deleting resource monitor %v", d.Id())
+		return diag.FromErr(errors.Wrapf(err, "error deleting resource monitor %v", d.Id()))
 	}
 
 	d.SetId("")
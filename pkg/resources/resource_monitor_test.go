@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -42,8 +43,8 @@ func TestResourceMonitorCreate(t *testing.T) {
 		mock.ExpectExec(`^ALTER ACCOUNT SET RESOURCE_MONITOR = "good_name"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		expectReadResourceMonitor(mock)
-		err := resources.CreateResourceMonitor(d, db)
-		r.NoError(err)
+		diags := resources.CreateResourceMonitor(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -71,8 +72,8 @@ func TestResourceMonitorDelete(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`^DROP RESOURCE MONITOR "good_name"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		err := resources.DeleteResourceMonitor(d, db)
-		r.NoError(err)
+		diags := resources.DeleteResourceMonitor(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -109,8 +110,26 @@ func TestResourceMonitorRead(t *testing.T) {
 		r.NotEmpty(d.State())
 		q := snowflake.ResourceMonitor(d.Id()).Show()
 		mock.ExpectQuery(q).WillReturnError(sql.ErrNoRows)
-		err := resources.ReadResourceMonitor(d, db)
+		diags := resources.ReadResourceMonitor(context.Background(), d, db)
 		r.Empty(d.State())
-		r.Nil(err)
+		r.Empty(diags)
+	})
+}
+
+func TestResourceMonitorCustomizeDiff(t *testing.T) {
+	r := require.New(t)
+
+	err := planDiff(resources.ResourceMonitor(), map[string]interface{}{
+		"name":             "good_name",
+		"notify_triggers":  []interface{}{50, 75, 90, 95, 99, 100},
+		"suspend_triggers": []interface{}{100},
+	})
+	r.NoError(err)
+
+	err = planDiff(resources.ResourceMonitor(), map[string]interface{}{
+		"name":                       "good_name",
+		"suspend_immediate_triggers": []interface{}{90, 110},
 	})
+	r.Error(err)
+	r.Contains(err.Error(), "suspend_immediate_triggers must not contain values greater than 100, got 110")
 }
@@ -1,11 +1,13 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -25,27 +27,37 @@ var roleSchema = map[string]*schema.Schema{
 
 func Role() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateRole,
-		Read:   ReadRole,
-		Delete: DeleteRole,
-		Update: UpdateRole,
+		CreateContext: CreateRole,
+		ReadContext:   ReadRole,
+		DeleteContext: DeleteRole,
+		UpdateContext: UpdateRole,
 
-		Schema: roleSchema,
+		Schema:        roleSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(roleSchema, upgradeUnchangedV0),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 	}
 }
 
-func CreateRole(d *schema.ResourceData, meta interface{}) error {
-	return CreateResource("role", roleProperties, roleSchema, snowflake.Role, ReadRole)(d, meta)
+func CreateRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return CreateResource("role", roleProperties, roleSchema, snowflake.Role, ReadRole)(ctx, d, meta)
 }
 
-func ReadRole(d *schema.ResourceData, meta interface{}) error {
+func ReadRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	id := d.Id()
 
-	row := snowflake.QueryRow(db, fmt.Sprintf("SHOW ROLES LIKE '%s'", id))
+	row := snowflake.QueryRowContext(ctx, db, fmt.Sprintf("SHOW ROLES LIKE '%s'", snowflake.EscapeString(id)))
 	role, err := snowflake.ScanRole(row)
 	if err == sql.ErrNoRows {
 		// If not found, mark resource to be removed from statefile during apply or refresh
@@ -54,25 +66,25 @@ func ReadRole(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("name", role.Name.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("comment", role.Comment.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return err
+	return diag.FromErr(err)
 }
 
-func UpdateRole(d *schema.ResourceData, meta interface{}) error {
-	return UpdateResource("role", roleProperties, roleSchema, snowflake.Role, ReadRole)(d, meta)
+func UpdateRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return UpdateResource("role", roleProperties, roleSchema, snowflake.Role, ReadRole)(ctx, d, meta)
 }
 
-func DeleteRole(d *schema.ResourceData, meta interface{}) error {
-	return DeleteResource("role", snowflake.Role)(d, meta)
+func DeleteRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return DeleteResource("role", snowflake.Role)(ctx, d, meta)
 }
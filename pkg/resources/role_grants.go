@@ -1,62 +1,76 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
 )
 
+var roleGrantsSchema = map[string]*schema.Schema{
+	"role_name": {
+		Type:        schema.TypeString,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Required:    true,
+		Description: "The name of the role we are granting.",
+		ValidateFunc: func(val interface{}, key string) ([]string, []error) {
+			return snowflake.ValidateIdentifier(val)
+		},
+	},
+	"roles": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Grants role to this specified role.",
+	},
+	"users": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Grants role to this specified user.",
+	},
+}
+
 func RoleGrants() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateRoleGrants,
-		Read:   ReadRoleGrants,
-		Delete: DeleteRoleGrants,
-		Update: UpdateRoleGrants,
-
-		Schema: map[string]*schema.Schema{
-			"role_name": {
-				Type:        schema.TypeString,
-				Elem:        &schema.Schema{Type: schema.TypeString},
-				Required:    true,
-				Description: "The name of the role we are granting.",
-				ValidateFunc: func(val interface{}, key string) ([]string, []error) {
-					return snowflake.ValidateIdentifier(val)
-				},
-			},
-			"roles": {
-				Type:        schema.TypeSet,
-				Elem:        &schema.Schema{Type: schema.TypeString},
-				Optional:    true,
-				Description: "Grants role to this specified role.",
-			},
-			"users": {
-				Type:        schema.TypeSet,
-				Elem:        &schema.Schema{Type: schema.TypeString},
-				Optional:    true,
-				Description: "Grants role to this specified user.",
-			},
+		CreateContext: CreateRoleGrants,
+		ReadContext:   ReadRoleGrants,
+		DeleteContext: DeleteRoleGrants,
+		UpdateContext: UpdateRoleGrants,
+
+		Schema:        roleGrantsSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(roleGrantsSchema, upgradeGrantIDV0),
 		},
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 	}
 }
 
-func CreateRoleGrants(d *schema.ResourceData, meta interface{}) error {
+func CreateRoleGrants(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	roleName := d.Get("role_name").(string)
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 	users := expandStringList(d.Get("users").(*schema.Set).List())
 
 	if len(roles) == 0 && len(users) == 0 {
-		return fmt.Errorf("no users or roles specified for role grants")
+		return diag.FromErr(fmt.Errorf("no users or roles specified for role grants"))
 	}
 
 	grant := &grantID{
@@ -67,34 +81,34 @@ func CreateRoleGrants(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(dataIDInput)
 
 	if err != nil {
-		return errors.Wrap(err, "error creating role grant")
+		return diag.FromErr(errors.Wrap(err, "error creating role grant"))
 	}
 	for _, role := range roles {
-		err := grantRoleToRole(db, roleName, role)
+		err := grantRoleToRole(ctx, db, roleName, role)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	for _, user := range users {
-		err := grantRoleToUser(db, roleName, user)
+		err := grantRoleToUser(ctx, db, roleName, user)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return ReadRoleGrants(d, meta)
+	return ReadRoleGrants(ctx, d, meta)
 }
 
-func grantRoleToRole(db *sql.DB, role1, role2 string) error {
+func grantRoleToRole(ctx context.Context, db *sql.DB, role1, role2 string) error {
 	g := snowflake.RoleGrant(role1)
-	err := snowflake.Exec(db, g.Role(role2).Grant())
+	err := snowflake.ExecContext(ctx, db, g.Role(role2).Grant())
 	return err
 }
 
-func grantRoleToUser(db *sql.DB, role1, user string) error {
+func grantRoleToUser(ctx context.Context, db *sql.DB, role1, user string) error {
 	g := snowflake.RoleGrant(role1)
-	err := snowflake.Exec(db, g.User(user).Grant())
+	err := snowflake.ExecContext(ctx, db, g.User(user).Grant())
 	return err
 }
 
@@ -106,12 +120,12 @@ type roleGrant struct {
 	Grantedby   sql.NullString `db:"granted_by"`
 }
 
-func ReadRoleGrants(d *schema.ResourceData, meta interface{}) error {
+func ReadRoleGrants(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	log.Println(d.Id())
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	roleName := grantID.ResourceName
 
@@ -121,9 +135,9 @@ func ReadRoleGrants(d *schema.ResourceData, meta interface{}) error {
 	roles := make([]string, 0)
 	users := make([]string, 0)
 
-	grants, err := readGrants(db, roleName)
+	grants, err := readGrants(ctx, db, roleName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	for _, grant := range grants {
@@ -141,31 +155,31 @@ func ReadRoleGrants(d *schema.ResourceData, meta interface{}) error {
 				}
 			}
 		default:
-			return fmt.Errorf("unknown grant type %s", grant.GrantedTo.String)
+			return diag.FromErr(fmt.Errorf("unknown grant type %s", grant.GrantedTo.String))
 		}
 	}
 
 	err = d.Set("role_name", roleName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("roles", roles)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("users", users)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func readGrants(db *sql.DB, roleName string) ([]*roleGrant, error) {
+func readGrants(ctx context.Context, db *sql.DB, roleName string) ([]*roleGrant, error) {
 	sdb := sqlx.NewDb(db, "snowflake")
 
-	stmt := fmt.Sprintf(`SHOW GRANTS OF ROLE "%s"`, roleName)
-	rows, err := sdb.Queryx(stmt)
+	stmt := fmt.Sprintf(`SHOW GRANTS OF ROLE %v`, snowflake.QuoteIdentifier(roleName))
+	rows, err := sdb.QueryxContext(ctx, stmt)
 	if err != nil {
 		return nil, err
 	}
@@ -194,7 +208,7 @@ func readGrants(db *sql.DB, roleName string) ([]*roleGrant, error) {
 	return grants, nil
 }
 
-func DeleteRoleGrants(d *schema.ResourceData, meta interface{}) error {
+func DeleteRoleGrants(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	roleName := d.Get("role_name").(string)
 
@@ -202,16 +216,16 @@ func DeleteRoleGrants(d *schema.ResourceData, meta interface{}) error {
 	users := expandStringList(d.Get("users").(*schema.Set).List())
 
 	for _, role := range roles {
-		err := revokeRoleFromRole(db, roleName, role)
+		err := revokeRoleFromRole(ctx, db, roleName, role)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	for _, user := range users {
-		err := revokeRoleFromUser(db, roleName, user)
+		err := revokeRoleFromUser(ctx, db, roleName, user)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -219,23 +233,23 @@ func DeleteRoleGrants(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func revokeRoleFromRole(db *sql.DB, role1, role2 string) error {
+func revokeRoleFromRole(ctx context.Context, db *sql.DB, role1, role2 string) error {
 	rg := snowflake.RoleGrant(role1).Role(role2)
-	err := snowflake.Exec(db, rg.Revoke())
+	err := snowflake.ExecContext(ctx, db, rg.Revoke())
 	return err
 }
 
-func revokeRoleFromUser(db *sql.DB, role1, user string) error {
+func revokeRoleFromUser(ctx context.Context, db *sql.DB, role1, user string) error {
 	rg := snowflake.RoleGrant(role1).User(user)
-	err := snowflake.Exec(db, rg.Revoke())
+	err := snowflake.ExecContext(ctx, db, rg.Revoke())
 	return err
 }
 
-func UpdateRoleGrants(d *schema.ResourceData, meta interface{}) error {
+func UpdateRoleGrants(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	roleName := d.Get("role_name").(string)
 
-	x := func(resource string, grant func(db *sql.DB, role string, target string) error, revoke func(db *sql.DB, role string, target string) error) error {
+	x := func(resource string, grant func(ctx context.Context, db *sql.DB, role string, target string) error, revoke func(ctx context.Context, db *sql.DB, role string, target string) error) error {
 		o, n := d.GetChange(resource)
 
 		if o == nil {
@@ -251,13 +265,13 @@ func UpdateRoleGrants(d *schema.ResourceData, meta interface{}) error {
 		add := expandStringList(ns.Difference(os).List())
 
 		for _, user := range remove {
-			err := revoke(db, roleName, user)
+			err := revoke(ctx, db, roleName, user)
 			if err != nil {
 				return err
 			}
 		}
 		for _, user := range add {
-			err := grant(db, roleName, user)
+			err := grant(ctx, db, roleName, user)
 			if err != nil {
 				return err
 			}
@@ -267,13 +281,13 @@ func UpdateRoleGrants(d *schema.ResourceData, meta interface{}) error {
 
 	err := x("users", grantRoleToUser, revokeRoleFromUser)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = x("roles", grantRoleToRole, revokeRoleFromRole)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return ReadRoleGrants(d, meta)
+	return ReadRoleGrants(ctx, d, meta)
 }
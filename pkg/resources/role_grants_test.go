@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -33,8 +34,8 @@ func TestRoleGrantsCreate(t *testing.T) {
 		mock.ExpectExec(`GRANT ROLE "good_name" TO USER "user1"`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`GRANT ROLE "good_name" TO USER "user2"`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadRoleGrants(mock)
-		err := resources.CreateRoleGrants(d, db)
-		r.NoError(err)
+		diags := resources.CreateRoleGrants(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -64,8 +65,8 @@ func TestRoleGrantsRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadRoleGrants(mock)
-		err := resources.ReadRoleGrants(d, db)
-		r.NoError(err)
+		diags := resources.ReadRoleGrants(context.Background(), d, db)
+		r.Empty(diags)
 		r.Len(d.Get("users").(*schema.Set).List(), 2)
 		r.Len(d.Get("roles").(*schema.Set).List(), 2)
 	})
@@ -86,7 +87,7 @@ func TestRoleGrantsDelete(t *testing.T) {
 		mock.ExpectExec(`REVOKE ROLE "drop_it" FROM ROLE "role2"`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`REVOKE ROLE "drop_it" FROM USER "user1"`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`REVOKE ROLE "drop_it" FROM USER "user2"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteRoleGrants(d, db)
-		r.NoError(err)
+		diags := resources.DeleteRoleGrants(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
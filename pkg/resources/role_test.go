@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -31,8 +32,8 @@ func TestRoleCreate(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`CREATE ROLE "good_name" COMMENT='great comment'`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadRole(mock)
-		err := resources.CreateRole(d, db)
-		r.NoError(err)
+		diags := resources.CreateRole(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -51,17 +52,17 @@ func TestRoleRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadRole(mock)
-		err := resources.ReadRole(d, db)
-		r.NoError(err)
+		diags := resources.ReadRole(context.Background(), d, db)
+		r.Empty(diags)
 		r.Equal("mock comment", d.Get("comment").(string))
 		r.Equal("role name", d.Get("name").(string))
 
 		// Test when resource is not found, checking if state will be empty
 		r.NotEmpty(d.State())
 		mock.ExpectQuery(`SHOW ROLES LIKE 'good_name'`).WillReturnError(sql.ErrNoRows)
-		err2 := resources.ReadRole(d, db)
+		diags2 := resources.ReadRole(context.Background(), d, db)
 		r.Empty(d.State())
-		r.Nil(err2)
+		r.Empty(diags2)
 	})
 }
 
@@ -72,7 +73,7 @@ func TestRoleDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP ROLE "drop_it"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteRole(d, db)
-		r.NoError(err)
+		diags := resources.DeleteRole(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
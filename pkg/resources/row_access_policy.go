@@ -2,12 +2,14 @@ package resources
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/csv"
 	"fmt"
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -53,6 +55,11 @@ var rowAccessPolicySchema = map[string]*schema.Schema{
 		Optional:    true,
 		Description: "Specifies a comment for the row access policy.",
 	},
+	"fully_qualified_name": {
+		Type:        schema.TypeString,
+		Description: "The fully qualified name of the row access policy, to attach it to tables and views.",
+		Computed:    true,
+	},
 }
 
 type rowAccessPolicyID struct {
@@ -93,10 +100,15 @@ func rowAccessPolicyIDFromString(stringID string) (*rowAccessPolicyID, error) {
 		return nil, fmt.Errorf("3 fields allowed")
 	}
 
+	names, err := objectNames(lines[0][:3])
+	if err != nil {
+		return nil, err
+	}
+
 	rowAccessPolicyResult := &rowAccessPolicyID{
-		DatabaseName:        lines[0][0],
-		SchemaName:          lines[0][1],
-		RowAccessPolicyName: lines[0][2],
+		DatabaseName:        names[0].Name(),
+		SchemaName:          names[1].Name(),
+		RowAccessPolicyName: names[2].Name(),
 	}
 	return rowAccessPolicyResult, nil
 }
@@ -104,20 +116,30 @@ func rowAccessPolicyIDFromString(stringID string) (*rowAccessPolicyID, error) {
 // RowAccessPolicy returns a pointer to the resource representing a row access policy
 func RowAccessPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateRowAccessPolicy,
-		Read:   ReadRowAccessPolicy,
-		Update: UpdateRowAccessPolicy,
-		Delete: DeleteRowAccessPolicy,
-
-		Schema: rowAccessPolicySchema,
+		CreateContext: CreateRowAccessPolicy,
+		ReadContext:   ReadRowAccessPolicy,
+		UpdateContext: UpdateRowAccessPolicy,
+		DeleteContext: DeleteRowAccessPolicy,
+
+		Schema:        rowAccessPolicySchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(rowAccessPolicySchema, upgradeQuotedNamesV0("database", "schema", "name")),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 	}
 }
 
-// CreateRowAccessPolicy implements schema.CreateFunc
-func CreateRowAccessPolicy(d *schema.ResourceData, meta interface{}) error {
+// CreateRowAccessPolicy implements schema.CreateContextFunc
+func CreateRowAccessPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 	database := d.Get("database").(string)
@@ -136,9 +158,9 @@ func CreateRowAccessPolicy(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	stmt := builder.Create()
-	err := snowflake.Exec(db, stmt)
+	err := snowflake.ExecContext(ctx, db, stmt)
 	if err != nil {
-		return errors.Wrapf(err, "error creating row access policy %v", name)
+		return diag.FromErr(errors.Wrapf(err, "error creating row access policy %v", name))
 	}
 
 	rowAccessPolicyID := &rowAccessPolicyID{
@@ -148,19 +170,19 @@ func CreateRowAccessPolicy(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := rowAccessPolicyID.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadRowAccessPolicy(d, meta)
+	return ReadRowAccessPolicy(ctx, d, meta)
 }
 
-// ReadRowAccessPolicy implements schema.ReadFunc
-func ReadRowAccessPolicy(d *schema.ResourceData, meta interface{}) error {
+// ReadRowAccessPolicy implements schema.ReadContextFunc
+func ReadRowAccessPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	rowAccessPolicyID, err := rowAccessPolicyIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := rowAccessPolicyID.DatabaseName
@@ -171,37 +193,42 @@ func ReadRowAccessPolicy(d *schema.ResourceData, meta interface{}) error {
 
 	showSQL := builder.Show()
 
-	row := snowflake.QueryRow(db, showSQL)
+	row := snowflake.QueryRowContext(ctx, db, showSQL)
 
 	s, err := snowflake.ScanRowAccessPolicies(row)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("name", s.Name.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("database", s.DatabaseName.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("schema", s.SchemaName.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("comment", s.Comment.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("fully_qualified_name", snowflake.AddressEscape(s.DatabaseName.String, s.SchemaName.String, s.Name.String))
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	descSQL := builder.Describe()
-	rows, err := snowflake.Query(db, descSQL)
+	rows, err := snowflake.QueryContext(ctx, db, descSQL)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var (
@@ -213,30 +240,30 @@ func ReadRowAccessPolicy(d *schema.ResourceData, meta interface{}) error {
 	for rows.Next() {
 		err := rows.Scan(&name, &signature, &returnType, &body)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		err = d.Set("row_access_expression", body)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		err = d.Set("signature", ParseSignature(signature))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return err
+	return diag.FromErr(err)
 }
 
-// UpdateRowAccessPolicy implements schema.UpdateFunc
-func UpdateRowAccessPolicy(d *schema.ResourceData, meta interface{}) error {
+// UpdateRowAccessPolicy implements schema.UpdateContextFunc
+func UpdateRowAccessPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 
 	rowAccessPolicyID, err := rowAccessPolicyIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := rowAccessPolicyID.DatabaseName
@@ -249,15 +276,15 @@ func UpdateRowAccessPolicy(d *schema.ResourceData, meta interface{}) error {
 		comment := d.Get("comment")
 		if c := comment.(string); c == "" {
 			q := builder.RemoveComment()
-			err := snowflake.Exec(db, q)
+			err := snowflake.ExecContext(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error unsetting comment for row access policy on %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error unsetting comment for row access policy on %v", d.Id()))
 			}
 		} else {
 			q := builder.ChangeComment(c)
-			err := snowflake.Exec(db, q)
+			err := snowflake.ExecContext(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error updating comment for row access policy on %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error updating comment for row access policy on %v", d.Id()))
 			}
 		}
 	}
@@ -265,21 +292,21 @@ func UpdateRowAccessPolicy(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("row_access_expression") {
 		rowAccessExpression := d.Get("row_access_expression")
 		q := builder.ChangeRowAccessExpression(rowAccessExpression.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating row access policy expression on %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating row access policy expression on %v", d.Id()))
 		}
 	}
 
-	return ReadRowAccessPolicy(d, meta)
+	return ReadRowAccessPolicy(ctx, d, meta)
 }
 
-// DeleteRowAccessPolicy implements schema.DeleteFunc
-func DeleteRowAccessPolicy(d *schema.ResourceData, meta interface{}) error {
+// DeleteRowAccessPolicy implements schema.DeleteContextFunc
+func DeleteRowAccessPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	rowAccessPolicyID, err := rowAccessPolicyIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := rowAccessPolicyID.DatabaseName
@@ -288,9 +315,9 @@ func DeleteRowAccessPolicy(d *schema.ResourceData, meta interface{}) error {
 
 	q := snowflake.RowAccessPolicy(policyName, dbName, schema).Drop()
 
-	err = snowflake.Exec(db, q)
+	err = snowflake.ExecContext(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error deleting row access policy %v", d.Id())
+		return diag.FromErr(errors.Wrapf(err, "error deleting row access policy %v", d.Id()))
 	}
 
 	d.SetId("")
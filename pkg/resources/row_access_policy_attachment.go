@@ -0,0 +1,287 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+)
+
+var rowAccessPolicyAttachmentSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database of the table or view.",
+		ForceNew:    true,
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema of the table or view.",
+		ForceNew:    true,
+	},
+	"object_type": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "TABLE",
+		Description:  "The type of the object to protect, one of TABLE or VIEW.",
+		ForceNew:     true,
+		ValidateFunc: validation.StringInSlice(policyAttachmentObjectTypes, false),
+	},
+	"object_name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The name of the table or view.",
+		ForceNew:    true,
+	},
+	"row_access_policy": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The fully qualified name of the row access policy, e.g. `snowflake_row_access_policy.policy.fully_qualified_name`.",
+		ValidateFunc: func(val interface{}, key string) ([]string, []error) {
+			return snowflake.ValidateQualifiedName(val)
+		},
+	},
+	"columns": {
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Required:    true,
+		MinItems:    1,
+		Description: "The columns passed to the policy, in the order of its signature.",
+	},
+	"force": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Replaces a row access policy already added to the object when creating the attachment. Without it, creating the attachment fails if the object has a row access policy already.",
+	},
+}
+
+type rowAccessPolicyAttachmentID struct {
+	DatabaseName string
+	SchemaName   string
+	ObjectType   string
+	ObjectName   string
+}
+
+// String() takes in a rowAccessPolicyAttachmentID object and returns a pipe-delimited string:
+// DatabaseName|SchemaName|ObjectType|ObjectName
+func (rapai *rowAccessPolicyAttachmentID) String() (string, error) {
+	var buf bytes.Buffer
+	csvWriter := csv.NewWriter(&buf)
+	csvWriter.Comma = policyAttachmentIDDelimiter
+	dataIdentifiers := [][]string{{rapai.DatabaseName, rapai.SchemaName, rapai.ObjectType, rapai.ObjectName}}
+	err := csvWriter.WriteAll(dataIdentifiers)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// rowAccessPolicyAttachmentIDFromString() takes in a pipe-delimited string: DatabaseName|SchemaName|ObjectType|ObjectName
+// and returns a rowAccessPolicyAttachmentID object
+func rowAccessPolicyAttachmentIDFromString(stringID string) (*rowAccessPolicyAttachmentID, error) {
+	reader := csv.NewReader(strings.NewReader(stringID))
+	reader.Comma = policyAttachmentIDDelimiter
+	lines, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("Not CSV compatible")
+	}
+
+	if len(lines) != 1 {
+		return nil, fmt.Errorf("1 line per row access policy attachment")
+	}
+	if len(lines[0]) != 4 {
+		return nil, fmt.Errorf("4 fields allowed")
+	}
+
+	return &rowAccessPolicyAttachmentID{
+		DatabaseName: lines[0][0],
+		SchemaName:   lines[0][1],
+		ObjectType:   lines[0][2],
+		ObjectName:   lines[0][3],
+	}, nil
+}
+
+func (rapai *rowAccessPolicyAttachmentID) builder() *snowflake.PolicyAttachmentBuilder {
+	return snowflake.PolicyAttachment(rapai.ObjectType, rapai.ObjectName, rapai.DatabaseName, rapai.SchemaName)
+}
+
+// RowAccessPolicyAttachment returns a pointer to the resource representing a row access policy added to a table or view
+func RowAccessPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateRowAccessPolicyAttachment,
+		ReadContext:   ReadRowAccessPolicyAttachment,
+		UpdateContext: UpdateRowAccessPolicyAttachment,
+		DeleteContext: DeleteRowAccessPolicyAttachment,
+
+		Schema:        rowAccessPolicyAttachmentSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(rowAccessPolicyAttachmentSchema, upgradeUnchangedV0),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+	}
+}
+
+// CreateRowAccessPolicyAttachment implements schema.CreateContextFunc
+func CreateRowAccessPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	attachmentID := &rowAccessPolicyAttachmentID{
+		DatabaseName: d.Get("database").(string),
+		SchemaName:   d.Get("schema").(string),
+		ObjectType:   d.Get("object_type").(string),
+		ObjectName:   d.Get("object_name").(string),
+	}
+	policy := d.Get("row_access_policy").(string)
+	columns := expandStringList(d.Get("columns").([]interface{}))
+	builder := attachmentID.builder()
+
+	stmt := builder.AddRowAccessPolicy(policy, columns)
+	if d.Get("force").(bool) {
+		// an object has a single row access policy, Snowflake has no FORCE
+		// for them but both can be swapped in one statement
+		ref, err := readRowAccessPolicy(ctx, db, attachmentID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if ref != nil {
+			stmt = builder.ReplaceRowAccessPolicy(ref.Policy(), policy, columns)
+		}
+	}
+	err := snowflake.ExecContext(ctx, db, stmt)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error adding row access policy %v on %v %v", policy, strings.ToLower(attachmentID.ObjectType), attachmentID.ObjectName))
+	}
+
+	dataIDInput, err := attachmentID.String()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(dataIDInput)
+
+	return ReadRowAccessPolicyAttachment(ctx, d, meta)
+}
+
+// ReadRowAccessPolicyAttachment implements schema.ReadContextFunc
+func ReadRowAccessPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	attachmentID, err := rowAccessPolicyAttachmentIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ref, err := readRowAccessPolicy(ctx, db, attachmentID)
+	if isNotFoundError(err) {
+		log.Printf("[DEBUG] %v (%s) not found", strings.ToLower(attachmentID.ObjectType), d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if ref == nil {
+		// If not found, mark resource to be removed from statefile during apply or refresh
+		log.Printf("[DEBUG] row access policy attachment (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	policy := d.Get("row_access_policy").(string)
+	if !ref.IsPolicy(policy) {
+		policy = ref.Policy()
+	}
+	columns, err := ref.ArgColumns()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	toSet := map[string]interface{}{
+		"database":          attachmentID.DatabaseName,
+		"schema":            attachmentID.SchemaName,
+		"object_type":       attachmentID.ObjectType,
+		"object_name":       attachmentID.ObjectName,
+		"row_access_policy": policy,
+		"columns":           columns,
+	}
+	for key, val := range toSet {
+		err = d.Set(key, val) //lintignore:R001
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+// UpdateRowAccessPolicyAttachment implements schema.UpdateContextFunc
+func UpdateRowAccessPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	attachmentID, err := rowAccessPolicyAttachmentIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("row_access_policy", "columns") {
+		old, new := d.GetChange("row_access_policy")
+		columns := expandStringList(d.Get("columns").([]interface{}))
+
+		stmt := attachmentID.builder().ReplaceRowAccessPolicy(old.(string), new.(string), columns)
+		err := snowflake.ExecContext(ctx, db, stmt)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error replacing row access policy %v with %v on %v %v", old, new, strings.ToLower(attachmentID.ObjectType), attachmentID.ObjectName))
+		}
+	}
+
+	return ReadRowAccessPolicyAttachment(ctx, d, meta)
+}
+
+// DeleteRowAccessPolicyAttachment implements schema.DeleteContextFunc
+func DeleteRowAccessPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	attachmentID, err := rowAccessPolicyAttachmentIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	policy := d.Get("row_access_policy").(string)
+
+	stmt := attachmentID.builder().DropRowAccessPolicy(policy)
+	err = snowflake.ExecContext(ctx, db, stmt)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error dropping row access policy %v on %v %v", policy, strings.ToLower(attachmentID.ObjectType), attachmentID.ObjectName))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// readRowAccessPolicy returns the reference of the row access policy added to
+// the object, nil if none is
+func readRowAccessPolicy(ctx context.Context, db *sql.DB, attachmentID *rowAccessPolicyAttachmentID) (*snowflake.PolicyReference, error) {
+	refs, err := snowflake.ListPolicyReferences(ctx, attachmentID.builder().ShowPolicyReferences(), db)
+	if err != nil {
+		return nil, err
+	}
+	for _, ref := range refs {
+		if ref.PolicyKind.String == snowflake.RowAccessPolicyKind {
+			return &ref, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,99 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_RowAccessPolicyAttachment(t *testing.T) {
+	if _, ok := os.LookupEnv("SKIP_ROW_ACCESS_POLICY_TESTS"); ok {
+		t.Skip("Skipping TestAccRowAccessPolicyAttachment")
+	}
+
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: rowAccessPolicyAttachmentConfig(accName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("snowflake_row_access_policy_attachment.test", "row_access_policy", "snowflake_row_access_policy.first", "fully_qualified_name"),
+					resource.TestCheckResourceAttr("snowflake_row_access_policy_attachment.test", "columns.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_row_access_policy_attachment.test", "columns.0", "REGION"),
+				),
+			},
+			{
+				Config: rowAccessPolicyAttachmentConfig(accName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("snowflake_row_access_policy_attachment.test", "row_access_policy", "snowflake_row_access_policy.second", "fully_qualified_name"),
+				),
+			},
+			{
+				ResourceName:            "snowflake_row_access_policy_attachment.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force"},
+			},
+		},
+	})
+}
+
+func rowAccessPolicyAttachmentConfig(n string, policy string) string {
+	return fmt.Sprintf(`
+resource "snowflake_database" "test" {
+	name = "%[1]v"
+	comment = "Terraform acceptance test"
+}
+
+resource "snowflake_schema" "test" {
+	name = "%[1]v"
+	database = snowflake_database.test.name
+	comment = "Terraform acceptance test"
+}
+
+resource "snowflake_table" "test" {
+	name = "%[1]v"
+	database = snowflake_database.test.name
+	schema = snowflake_schema.test.name
+
+	column {
+		name = "REGION"
+		type = "VARCHAR(16777216)"
+	}
+}
+
+resource "snowflake_row_access_policy" "first" {
+	name = "%[1]v_FIRST"
+	database = snowflake_database.test.name
+	schema = snowflake_schema.test.name
+	signature = {
+		R = "VARCHAR",
+	}
+	row_access_expression = "r = 'EU'"
+}
+
+resource "snowflake_row_access_policy" "second" {
+	name = "%[1]v_SECOND"
+	database = snowflake_database.test.name
+	schema = snowflake_schema.test.name
+	signature = {
+		R = "VARCHAR",
+	}
+	row_access_expression = "r = 'US'"
+}
+
+resource "snowflake_row_access_policy_attachment" "test" {
+	database = snowflake_database.test.name
+	schema = snowflake_schema.test.name
+	object_name = snowflake_table.test.name
+	row_access_policy = snowflake_row_access_policy.%[2]v.fully_qualified_name
+	columns = ["REGION"]
+}
+`, n, policy)
+}
@@ -0,0 +1,112 @@
+package resources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/provider"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/resources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRowAccessPolicyAttachment(t *testing.T) {
+	r := require.New(t)
+	err := resources.RowAccessPolicyAttachment().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestRowAccessPolicyAttachmentCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"database":          "database_name",
+		"schema":            "schema_name",
+		"object_name":       "table_name",
+		"row_access_policy": "DATABASE_NAME.SCHEMA_NAME.REGIONS",
+		"columns":           []interface{}{"region"},
+	}
+	d := rowAccessPolicyAttachment(t, "", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`^ALTER TABLE "database_name"."schema_name"."table_name" ADD ROW ACCESS POLICY DATABASE_NAME.SCHEMA_NAME.REGIONS ON \("region"\)$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadPolicyReferences(mock, []interface{}{"DATABASE_NAME", "SCHEMA_NAME", "REGIONS", "ROW_ACCESS_POLICY", nil, `[ "region" ]`})
+
+		diags := resources.CreateRowAccessPolicyAttachment(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("database_name|schema_name|TABLE|table_name", d.Id())
+		r.Equal([]interface{}{"region"}, d.Get("columns"))
+	})
+}
+
+func TestRowAccessPolicyAttachmentCreateForce(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"database":          "database_name",
+		"schema":            "schema_name",
+		"object_name":       "table_name",
+		"row_access_policy": "DATABASE_NAME.SCHEMA_NAME.REGIONS",
+		"columns":           []interface{}{"region"},
+		"force":             true,
+	}
+	d := rowAccessPolicyAttachment(t, "", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadPolicyReferences(mock, []interface{}{"DATABASE_NAME", "SCHEMA_NAME", "old policy", "ROW_ACCESS_POLICY", nil, `[ "id" ]`})
+		mock.ExpectExec(
+			`^ALTER TABLE "database_name"."schema_name"."table_name" DROP ROW ACCESS POLICY DATABASE_NAME.SCHEMA_NAME."old policy", ADD ROW ACCESS POLICY DATABASE_NAME.SCHEMA_NAME.REGIONS ON \("region"\)$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadPolicyReferences(mock, []interface{}{"DATABASE_NAME", "SCHEMA_NAME", "REGIONS", "ROW_ACCESS_POLICY", nil, `[ "region" ]`})
+
+		diags := resources.CreateRowAccessPolicyAttachment(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
+
+func TestRowAccessPolicyAttachmentRead(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"row_access_policy": "database_name.schema_name.regions",
+		"columns":           []interface{}{"region"},
+	}
+	d := rowAccessPolicyAttachment(t, "database_name|schema_name|VIEW|table_name", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadPolicyReferences(mock,
+			[]interface{}{"DATABASE_NAME", "SCHEMA_NAME", "MASK", "MASKING_POLICY", "email", nil},
+			[]interface{}{"DATABASE_NAME", "SCHEMA_NAME", "REGIONS", "ROW_ACCESS_POLICY", nil, `[ "region", "id" ]`},
+		)
+		diags := resources.ReadRowAccessPolicyAttachment(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("database_name.schema_name.regions", d.Get("row_access_policy"))
+		r.Equal([]interface{}{"region", "id"}, d.Get("columns"))
+		r.Equal("VIEW", d.Get("object_type"))
+
+		expectReadPolicyReferences(mock)
+		diags = resources.ReadRowAccessPolicyAttachment(context.Background(), d, db)
+		r.Empty(diags)
+		r.Empty(d.Id())
+	})
+}
+
+func TestRowAccessPolicyAttachmentDelete(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"row_access_policy": "DATABASE_NAME.SCHEMA_NAME.REGIONS",
+		"columns":           []interface{}{"region"},
+	}
+	d := rowAccessPolicyAttachment(t, "database_name|schema_name|TABLE|table_name", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER TABLE "database_name"."schema_name"."table_name" DROP ROW ACCESS POLICY DATABASE_NAME.SCHEMA_NAME.REGIONS$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		diags := resources.DeleteRowAccessPolicyAttachment(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
@@ -1,8 +1,11 @@
 package resources
 
 import (
+	"context"
+
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -58,21 +61,30 @@ var rowAccessPolicyGrantSchema = map[string]*schema.Schema{
 func RowAccessPolicyGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create: CreateRowAccessPolicyGrant,
-			Read:   ReadRowAccessPolicyGrant,
-			Delete: DeleteRowAccessPolicyGrant,
-
-			Schema: rowAccessPolicyGrantSchema,
+			CreateContext: CreateRowAccessPolicyGrant,
+			ReadContext:   ReadRowAccessPolicyGrant,
+			DeleteContext: DeleteRowAccessPolicyGrant,
+
+			Schema:        rowAccessPolicyGrantSchema,
+			SchemaVersion: 1,
+			StateUpgraders: []schema.StateUpgrader{
+				stateUpgraderV0(rowAccessPolicyGrantSchema, upgradeGrantIDV0),
+			},
 			Importer: &schema.ResourceImporter{
 				StateContext: schema.ImportStatePassthroughContext,
 			},
+			Timeouts: &schema.ResourceTimeout{
+				Create: schema.DefaultTimeout(defaultTimeout),
+				Read:   schema.DefaultTimeout(defaultTimeout),
+				Delete: schema.DefaultTimeout(defaultTimeout),
+			},
 		},
 		ValidPrivs: validRowAccessPoilcyPrivileges,
 	}
 }
 
-// CreateRowAccessPolicyGrant implements schema.CreateFunc
-func CreateRowAccessPolicyGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateRowAccessPolicyGrant implements schema.CreateContextFunc
+func CreateRowAccessPolicyGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var rowAccessPolicyName string
 	if name, ok := d.GetOk("row_access_policy_name"); ok {
 		rowAccessPolicyName = name.(string)
@@ -85,9 +97,9 @@ func CreateRowAccessPolicyGrant(d *schema.ResourceData, meta interface{}) error
 
 	builder := snowflake.RowAccessPolicyGrant(dbName, schemaName, rowAccessPolicyName)
 
-	err := createGenericGrant(d, meta, builder)
+	err := createGenericGrant(ctx, d, meta, builder)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grant := &grantID{
@@ -100,18 +112,18 @@ func CreateRowAccessPolicyGrant(d *schema.ResourceData, meta interface{}) error
 	}
 	dataIDInput, err := grant.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadRowAccessPolicyGrant(d, meta)
+	return ReadRowAccessPolicyGrant(ctx, d, meta)
 }
 
-// ReadRowAccessPolicyGrant implements schema.ReadFunc
-func ReadRowAccessPolicyGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadRowAccessPolicyGrant implements schema.ReadContextFunc
+func ReadRowAccessPolicyGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -120,35 +132,35 @@ func ReadRowAccessPolicyGrant(d *schema.ResourceData, meta interface{}) error {
 
 	err = d.Set("database_name", dbName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("schema_name", schemaName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("row_access_policy_name", rowAccessPolicyName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("privilege", priv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("with_grant_option", grantID.GrantOption)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	builder := snowflake.RowAccessPolicyGrant(dbName, schemaName, rowAccessPolicyName)
 
-	return readGenericGrant(d, meta, rowAccessPolicyGrantSchema, builder, false, validRowAccessPoilcyPrivileges)
+	return diag.FromErr(readGenericGrant(ctx, d, meta, rowAccessPolicyGrantSchema, builder, false, validRowAccessPoilcyPrivileges))
 }
 
-// DeleteRowAccessPolicyGrant implements schema.DeleteFunc
-func DeleteRowAccessPolicyGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteRowAccessPolicyGrant implements schema.DeleteContextFunc
+func DeleteRowAccessPolicyGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -156,5 +168,5 @@ func DeleteRowAccessPolicyGrant(d *schema.ResourceData, meta interface{}) error
 
 	builder := snowflake.RowAccessPolicyGrant(dbName, schemaName, rowAccessPolicyName)
 
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
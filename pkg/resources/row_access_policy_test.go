@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 	"time"
@@ -37,8 +38,8 @@ func TestRowAccessPolicyCreate(t *testing.T) {
 			`^CREATE ROW ACCESS POLICY "database_name"."schema_name"."policy_name" AS \(n VARCHAR, v VARCHAR\) RETURNS BOOLEAN -> case when current_role\(\) in \('ANALYST'\) then true else false end COMMENT = \'great comment\'$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadRowAccessPolicy(mock)
-		err := resources.CreateRowAccessPolicy(d, db)
-		r.NoError(err)
+		diags := resources.CreateRowAccessPolicy(context.Background(), d, db)
+		r.Empty(diags)
 		r.Equal("policy_name", d.Get("name").(string))
 	})
 }
@@ -76,7 +77,7 @@ func TestRowAccessPolicyDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`^DROP ROW ACCESS POLICY "database_name"."schema_name"."policy_name"$`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteRowAccessPolicy(d, db)
-		r.NoError(err)
+		diags := resources.DeleteRowAccessPolicy(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
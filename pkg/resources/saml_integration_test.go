@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -38,8 +39,8 @@ func TestSAMLIntegrationCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadSAMLIntegration(mock)
 
-		err := resources.CreateSAMLIntegration(d, db)
-		r.NoError(err)
+		diags := resources.CreateSAMLIntegration(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -51,8 +52,8 @@ func TestSAMLIntegrationRead(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadSAMLIntegration(mock)
 
-		err := resources.ReadSAMLIntegration(d, db)
-		r.NoError(err)
+		diags := resources.ReadSAMLIntegration(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -63,8 +64,8 @@ func TestSAMLIntegrationDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP SECURITY INTEGRATION "drop_it"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteSAMLIntegration(d, db)
-		r.NoError(err)
+		diags := resources.DeleteSAMLIntegration(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -2,6 +2,7 @@ package resources
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/csv"
 	"fmt"
@@ -9,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
@@ -98,9 +100,14 @@ func schemaIDFromString(stringID string) (*schemaID, error) {
 		return nil, fmt.Errorf("2 fields allowed")
 	}
 
+	names, err := objectNames(lines[0][:2])
+	if err != nil {
+		return nil, err
+	}
+
 	schemaResult := &schemaID{
-		DatabaseName: lines[0][0],
-		SchemaName:   lines[0][1],
+		DatabaseName: names[0].Name(),
+		SchemaName:   names[1].Name(),
 	}
 	return schemaResult, nil
 }
@@ -108,20 +115,30 @@ func schemaIDFromString(stringID string) (*schemaID, error) {
 // Schema returns a pointer to the resource representing a schema
 func Schema() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateSchema,
-		Read:   ReadSchema,
-		Update: UpdateSchema,
-		Delete: DeleteSchema,
-
-		Schema: schemaSchema,
+		CreateContext: CreateSchema,
+		ReadContext:   ReadSchema,
+		UpdateContext: UpdateSchema,
+		DeleteContext: DeleteSchema,
+
+		Schema:        schemaSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(schemaSchema, upgradeQuotedNamesV0("database", "name")),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 	}
 }
 
-// CreateSchema implements schema.CreateFunc
-func CreateSchema(d *schema.ResourceData, meta interface{}) error {
+// CreateSchema implements schema.CreateContextFunc
+func CreateSchema(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 	database := d.Get("database").(string)
@@ -152,9 +169,9 @@ func CreateSchema(d *schema.ResourceData, meta interface{}) error {
 
 	q := builder.Create()
 
-	err := snowflake.Exec(db, q)
+	err := snowflake.ExecContext(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error creating schema %v", name)
+		return diag.FromErr(errors.Wrapf(err, "error creating schema %v", name))
 	}
 
 	schemaID := &schemaID{
@@ -163,26 +180,26 @@ func CreateSchema(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := schemaID.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadSchema(d, meta)
+	return ReadSchema(ctx, d, meta)
 }
 
-// ReadSchema implements schema.ReadFunc
-func ReadSchema(d *schema.ResourceData, meta interface{}) error {
+// ReadSchema implements schema.ReadContextFunc
+func ReadSchema(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	schemaID, err := schemaIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := schemaID.DatabaseName
 	schema := schemaID.SchemaName
 
 	q := snowflake.Schema(schema).WithDB(dbName).Show()
-	row := snowflake.QueryRow(db, q)
+	row := snowflake.QueryRowContext(ctx, db, q)
 
 	s, err := snowflake.ScanSchema(row)
 	if err == sql.ErrNoRows {
@@ -192,22 +209,22 @@ func ReadSchema(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("name", s.Name.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("database", s.DatabaseName.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("comment", s.Comment.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// "retention_time" may sometimes be empty string instead of an integer
@@ -219,24 +236,24 @@ func ReadSchema(d *schema.ResourceData, meta interface{}) error {
 
 		i, err := strconv.ParseInt(retentionTime, 10, 64)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		err = d.Set("data_retention_days", i)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	// reset the options before reading back from the DB
 	err = d.Set("is_transient", false)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("is_managed", false)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if opts := s.Options.String; opts != "" {
@@ -245,12 +262,12 @@ func ReadSchema(d *schema.ResourceData, meta interface{}) error {
 			case "TRANSIENT":
 				err = d.Set("is_transient", true)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			case "MANAGED ACCESS":
 				err = d.Set("is_managed", true)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
@@ -259,11 +276,11 @@ func ReadSchema(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-// UpdateSchema implements schema.UpdateFunc
-func UpdateSchema(d *schema.ResourceData, meta interface{}) error {
+// UpdateSchema implements schema.UpdateContextFunc
+func UpdateSchema(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	schemaID, err := schemaIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := schemaID.DatabaseName
@@ -275,9 +292,9 @@ func UpdateSchema(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("comment") {
 		comment := d.Get("comment")
 		q := builder.ChangeComment(comment.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating schema comment on %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating schema comment on %v", d.Id()))
 		}
 	}
 
@@ -290,9 +307,9 @@ func UpdateSchema(d *schema.ResourceData, meta interface{}) error {
 			q = builder.Unmanage()
 		}
 
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error changing management state on %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error changing management state on %v", d.Id()))
 		}
 	}
 
@@ -300,23 +317,23 @@ func UpdateSchema(d *schema.ResourceData, meta interface{}) error {
 		days := d.Get("data_retention_days")
 
 		q := builder.ChangeDataRetentionDays(days.(int))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating data retention days on %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating data retention days on %v", d.Id()))
 		}
 	}
 
-	handleTagChanges(db, d, builder)
+	handleTagChanges(ctx, db, d, builder)
 
-	return ReadSchema(d, meta)
+	return ReadSchema(ctx, d, meta)
 }
 
-// DeleteSchema implements schema.DeleteFunc
-func DeleteSchema(d *schema.ResourceData, meta interface{}) error {
+// DeleteSchema implements schema.DeleteContextFunc
+func DeleteSchema(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	schemaID, err := schemaIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := schemaID.DatabaseName
@@ -324,9 +341,9 @@ func DeleteSchema(d *schema.ResourceData, meta interface{}) error {
 
 	q := snowflake.Schema(schema).WithDB(dbName).Drop()
 
-	err = snowflake.Exec(db, q)
+	err = snowflake.ExecContext(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error deleting schema %v", d.Id())
+		return diag.FromErr(errors.Wrapf(err, "error deleting schema %v", d.Id()))
 	}
 
 	d.SetId("")
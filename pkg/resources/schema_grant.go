@@ -1,8 +1,11 @@
 package resources
 
 import (
+	"context"
+
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -85,22 +88,32 @@ var schemaGrantSchema = map[string]*schema.Schema{
 func SchemaGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create: CreateSchemaGrant,
-			Read:   ReadSchemaGrant,
-			Delete: DeleteSchemaGrant,
-			Update: UpdateSchemaGrant,
+			CreateContext: CreateSchemaGrant,
+			ReadContext:   ReadSchemaGrant,
+			DeleteContext: DeleteSchemaGrant,
+			UpdateContext: UpdateSchemaGrant,
 
-			Schema: schemaGrantSchema,
+			Schema:        schemaGrantSchema,
+			SchemaVersion: 1,
+			StateUpgraders: []schema.StateUpgrader{
+				stateUpgraderV0(schemaGrantSchema, upgradeGrantIDV0),
+			},
 			Importer: &schema.ResourceImporter{
 				StateContext: schema.ImportStatePassthroughContext,
 			},
+			Timeouts: &schema.ResourceTimeout{
+				Create: schema.DefaultTimeout(defaultTimeout),
+				Read:   schema.DefaultTimeout(defaultTimeout),
+				Update: schema.DefaultTimeout(defaultTimeout),
+				Delete: schema.DefaultTimeout(defaultTimeout),
+			},
 		},
 		ValidPrivs: validSchemaPrivileges,
 	}
 }
 
-// CreateSchemaGrant implements schema.CreateFunc
-func CreateSchemaGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateSchemaGrant implements schema.CreateContextFunc
+func CreateSchemaGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var schemaName string
 	if _, ok := d.GetOk("schema_name"); ok {
 		schemaName = d.Get("schema_name").(string)
@@ -114,7 +127,7 @@ func CreateSchemaGrant(d *schema.ResourceData, meta interface{}) error {
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 
 	if (schemaName == "") && !onFuture {
-		return errors.New("schema_name must be set unless on_future is true.")
+		return diag.FromErr(errors.New("schema_name must be set unless on_future is true."))
 	}
 
 	var builder snowflake.GrantBuilder
@@ -124,9 +137,9 @@ func CreateSchemaGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.SchemaGrant(db, schemaName)
 	}
 
-	err := createGenericGrant(d, meta, builder)
+	err := createGenericGrant(ctx, d, meta, builder)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grantID := &grantID{
@@ -138,15 +151,15 @@ func CreateSchemaGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := grantID.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadSchemaGrant(d, meta)
+	return ReadSchemaGrant(ctx, d, meta)
 }
 
-// UpdateSchemaGrant implements schema.UpdateFunc
-func UpdateSchemaGrant(d *schema.ResourceData, meta interface{}) error {
+// UpdateSchemaGrant implements schema.UpdateContextFunc
+func UpdateSchemaGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// for now the only thing we can update are roles or shares
 	// if nothing changed, nothing to update and we're done
 	if !d.HasChanges("roles", "shares") {
@@ -166,7 +179,7 @@ func UpdateSchemaGrant(d *schema.ResourceData, meta interface{}) error {
 
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := grantID.ResourceName
@@ -182,18 +195,18 @@ func UpdateSchemaGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// first revoke
-	if err := deleteGenericGrantRolesAndShares(
+	if err := deleteGenericGrantRolesAndShares(ctx,
 		meta,
 		builder,
 		grantID.Privilege,
 		rolesToRevoke,
 		sharesToRevoke,
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// then add
-	if err := createGenericGrantRolesAndShares(
+	if err := createGenericGrantRolesAndShares(ctx,
 		meta,
 		builder,
 		grantID.Privilege,
@@ -201,29 +214,29 @@ func UpdateSchemaGrant(d *schema.ResourceData, meta interface{}) error {
 		rolesToAdd,
 		sharesToAdd,
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Done, refresh state
-	return ReadSchemaGrant(d, meta)
+	return ReadSchemaGrant(ctx, d, meta)
 }
 
-// ReadSchemaGrant implements schema.ReadFunc
-func ReadSchemaGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadSchemaGrant implements schema.ReadContextFunc
+func ReadSchemaGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
 	err = d.Set("database_name", dbName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("schema_name", schemaName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	onFuture := false
 	if schemaName == "" {
@@ -231,15 +244,15 @@ func ReadSchemaGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	err = d.Set("on_future", onFuture)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("privilege", grantID.Privilege)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("with_grant_option", grantID.GrantOption)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var builder snowflake.GrantBuilder
@@ -248,14 +261,14 @@ func ReadSchemaGrant(d *schema.ResourceData, meta interface{}) error {
 	} else {
 		builder = snowflake.SchemaGrant(dbName, schemaName)
 	}
-	return readGenericGrant(d, meta, schemaGrantSchema, builder, onFuture, validSchemaPrivileges)
+	return diag.FromErr(readGenericGrant(ctx, d, meta, schemaGrantSchema, builder, onFuture, validSchemaPrivileges))
 }
 
-// DeleteSchemaGrant implements schema.DeleteFunc
-func DeleteSchemaGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteSchemaGrant implements schema.DeleteContextFunc
+func DeleteSchemaGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := grantID.ResourceName
@@ -271,5 +284,5 @@ func DeleteSchemaGrant(d *schema.ResourceData, meta interface{}) error {
 	} else {
 		builder = snowflake.SchemaGrant(dbName, schemaName)
 	}
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -38,8 +39,8 @@ func TestSchemaCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		expectReadSchema(mock)
-		err := resources.CreateSchema(d, db)
-		r.NoError(err)
+		diags := resources.CreateSchema(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -59,9 +60,9 @@ func TestSchemaRead(t *testing.T) {
 		r.NotEmpty(d.State())
 		q := snowflake.Schema("good_name").WithDB("test_db").Show()
 		mock.ExpectQuery(q).WillReturnError(sql.ErrNoRows)
-		err := resources.ReadSchema(d, db)
+		diags := resources.ReadSchema(context.Background(), d, db)
 		r.Empty(d.State())
-		r.Nil(err)
+		r.Empty(diags)
 	})
 }
 
@@ -1,12 +1,14 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
@@ -62,20 +64,30 @@ var scimIntegrationSchema = map[string]*schema.Schema{
 // SCIMIntegration returns a pointer to the resource representing a network policy
 func SCIMIntegration() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateSCIMIntegration,
-		Read:   ReadSCIMIntegration,
-		Update: UpdateSCIMIntegration,
-		Delete: DeleteSCIMIntegration,
-
-		Schema: scimIntegrationSchema,
+		CreateContext: CreateSCIMIntegration,
+		ReadContext:   ReadSCIMIntegration,
+		UpdateContext: UpdateSCIMIntegration,
+		DeleteContext: DeleteSCIMIntegration,
+
+		Schema:        scimIntegrationSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(scimIntegrationSchema, upgradeUnchangedV0),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 	}
 }
 
-// CreateSCIMIntegration implements schema.CreateFunc
-func CreateSCIMIntegration(d *schema.ResourceData, meta interface{}) error {
+// CreateSCIMIntegration implements schema.CreateContextFunc
+func CreateSCIMIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 
@@ -91,46 +103,46 @@ func CreateSCIMIntegration(d *schema.ResourceData, meta interface{}) error {
 		stmt.SetString(`NETWORK_POLICY`, d.Get("network_policy").(string))
 	}
 
-	err := snowflake.Exec(db, stmt.Statement())
+	err := snowflake.ExecContext(ctx, db, stmt.Statement())
 	if err != nil {
-		return errors.Wrap(err, "error creating security integration")
+		return diag.FromErr(errors.Wrap(err, "error creating security integration"))
 	}
 
 	d.SetId(name)
 
-	return ReadSCIMIntegration(d, meta)
+	return ReadSCIMIntegration(ctx, d, meta)
 }
 
-// ReadSCIMIntegration implements schema.ReadFunc
-func ReadSCIMIntegration(d *schema.ResourceData, meta interface{}) error {
+// ReadSCIMIntegration implements schema.ReadContextFunc
+func ReadSCIMIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	id := d.Id()
 
 	stmt := snowflake.ScimIntegration(id).Show()
-	row := snowflake.QueryRow(db, stmt)
+	row := snowflake.QueryRowContext(ctx, db, stmt)
 
 	// Some properties can come from the SHOW INTEGRATION call
 
 	s, err := snowflake.ScanScimIntegration(row)
 	if err != nil {
-		return errors.Wrap(err, "could not show security integration")
+		return diag.FromErr(errors.Wrap(err, "could not show security integration"))
 	}
 
 	// Note: category must be Security or something is broken
 	if c := s.Category.String; c != "SECURITY" {
-		return fmt.Errorf("expected %v to be an Security integration, got %v", id, c)
+		return diag.FromErr(fmt.Errorf("expected %v to be an Security integration, got %v", id, c))
 	}
 
 	if err := d.Set("scim_client", strings.TrimPrefix(s.IntegrationType.String, "SCIM - ")); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("name", s.Name.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("created_on", s.CreatedOn.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Some properties come from the DESCRIBE INTEGRATION call
@@ -138,34 +150,34 @@ func ReadSCIMIntegration(d *schema.ResourceData, meta interface{}) error {
 	var k, pType string
 	var v, unused interface{}
 	stmt = snowflake.ScimIntegration(id).Describe()
-	rows, err := db.Query(stmt)
+	rows, err := db.QueryContext(ctx, stmt)
 	if err != nil {
-		return errors.Wrap(err, "could not describe security integration")
+		return diag.FromErr(errors.Wrap(err, "could not describe security integration"))
 	}
 	defer rows.Close()
 	for rows.Next() {
 		if err := rows.Scan(&k, &pType, &v, &unused); err != nil {
-			return errors.Wrap(err, "unable to parse security integration rows")
+			return diag.FromErr(errors.Wrap(err, "unable to parse security integration rows"))
 		}
 		switch k {
 		case "NETWORK_POLICY":
 			if err = d.Set("network_policy", v.(string)); err != nil {
-				return errors.Wrap(err, "unable to set network policy for security integration")
+				return diag.FromErr(errors.Wrap(err, "unable to set network policy for security integration"))
 			}
 		case "RUN_AS_ROLE":
 			if err = d.Set("provisioner_role", v.(string)); err != nil {
-				return errors.Wrap(err, "unable to set provisioner role for security integration")
+				return diag.FromErr(errors.Wrap(err, "unable to set provisioner role for security integration"))
 			}
 		default:
 			log.Printf("[WARN] unexpected security integration property %v returned from Snowflake", k)
 		}
 	}
 
-	return err
+	return diag.FromErr(err)
 }
 
-// UpdateSCIMIntegration implements schema.UpdateFunc
-func UpdateSCIMIntegration(d *schema.ResourceData, meta interface{}) error {
+// UpdateSCIMIntegration implements schema.UpdateContextFunc
+func UpdateSCIMIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	id := d.Id()
 
@@ -187,9 +199,9 @@ func UpdateSCIMIntegration(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("network_policy") {
 		v := d.Get("network_policy").(string)
 		if len(v) == 0 {
-			err := snowflake.Exec(db, fmt.Sprintf(`ALTER SECURITY INTEGRATION %v UNSET NETWORK_POLICY`, id))
+			err := snowflake.ExecContext(ctx, db, fmt.Sprintf(`ALTER SECURITY INTEGRATION %v UNSET NETWORK_POLICY`, id))
 			if err != nil {
-				return errors.Wrap(err, "error unsetting network_policy")
+				return diag.FromErr(errors.Wrap(err, "error unsetting network_policy"))
 			}
 		} else {
 			runSetStatement = true
@@ -198,15 +210,15 @@ func UpdateSCIMIntegration(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if runSetStatement {
-		if err := snowflake.Exec(db, stmt.Statement()); err != nil {
-			return errors.Wrap(err, "error updating security integration")
+		if err := snowflake.ExecContext(ctx, db, stmt.Statement()); err != nil {
+			return diag.FromErr(errors.Wrap(err, "error updating security integration"))
 		}
 	}
 
-	return ReadSCIMIntegration(d, meta)
+	return ReadSCIMIntegration(ctx, d, meta)
 }
 
-// DeleteSCIMIntegration implements schema.DeleteFunc
-func DeleteSCIMIntegration(d *schema.ResourceData, meta interface{}) error {
-	return DeleteResource("", snowflake.ScimIntegration)(d, meta)
+// DeleteSCIMIntegration implements schema.DeleteContextFunc
+func DeleteSCIMIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return DeleteResource("", snowflake.ScimIntegration)(ctx, d, meta)
 }
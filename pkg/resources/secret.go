@@ -0,0 +1,333 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+)
+
+var secretTypes = []string{snowflake.SecretTypeGenericString, snowflake.SecretTypePassword, snowflake.SecretTypeOAuth2}
+
+var secretSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the identifier for the secret; must be unique for the database and schema in which the secret is created.",
+		ForceNew:    true,
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database in which to create the secret.",
+		ForceNew:    true,
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema in which to create the secret.",
+		ForceNew:    true,
+	},
+	"type": {
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  fmt.Sprintf("The type of the secret, one of %v.", strings.Join(secretTypes, ", ")),
+		ForceNew:     true,
+		ValidateFunc: validation.StringInSlice(secretTypes, false),
+	},
+	"secret_string": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Sensitive:   true,
+		Description: "The string to store in a GENERIC_STRING secret, required and not empty for those.",
+	},
+	"username": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "The username to store in a PASSWORD secret.",
+		RequiredWith: []string{"password"},
+	},
+	"password": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Sensitive:    true,
+		Description:  "The password to store in a PASSWORD secret.",
+		RequiredWith: []string{"username"},
+	},
+	"api_authentication": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The security integration an OAUTH2 secret authenticates with, required for those.",
+		ForceNew:    true,
+	},
+	"oauth_scopes": {
+		Type:          schema.TypeSet,
+		Elem:          &schema.Schema{Type: schema.TypeString},
+		Optional:      true,
+		Description:   "The scopes an OAUTH2 secret using the client credentials flow requests.",
+		ConflictsWith: []string{"oauth_refresh_token"},
+	},
+	"oauth_refresh_token": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Sensitive:    true,
+		Description:  "The refresh token of an OAUTH2 secret using the authorization code grant flow.",
+		RequiredWith: []string{"oauth_refresh_token_expiry_time"},
+	},
+	"oauth_refresh_token_expiry_time": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "The timestamp at which the refresh token of an OAUTH2 secret expires, e.g. `2030-01-01 00:00:00`.",
+		RequiredWith: []string{"oauth_refresh_token"},
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the secret.",
+	},
+	"fully_qualified_name": {
+		Type:        schema.TypeString,
+		Description: "The fully qualified name of the secret, to use it in functions, procedures and external access integrations.",
+		Computed:    true,
+	},
+}
+
+type secretID struct {
+	DatabaseName string
+	SchemaName   string
+	SecretName   string
+}
+
+// String() takes in a secretID object and returns a pipe-delimited string:
+// DatabaseName|SchemaName|SecretName
+func (si *secretID) String() (string, error) {
+	var buf bytes.Buffer
+	csvWriter := csv.NewWriter(&buf)
+	csvWriter.Comma = pipeIDDelimiter
+	dataIdentifiers := [][]string{{si.DatabaseName, si.SchemaName, si.SecretName}}
+	err := csvWriter.WriteAll(dataIdentifiers)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// secretIDFromString() takes in a pipe-delimited string: DatabaseName|SchemaName|SecretName
+// and returns a secretID object
+func secretIDFromString(stringID string) (*secretID, error) {
+	reader := csv.NewReader(strings.NewReader(stringID))
+	reader.Comma = pipeIDDelimiter
+	lines, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("Not CSV compatible")
+	}
+
+	if len(lines) != 1 {
+		return nil, fmt.Errorf("1 line per secret")
+	}
+	if len(lines[0]) != 3 {
+		return nil, fmt.Errorf("3 fields allowed")
+	}
+
+	names, err := objectNames(lines[0][:3])
+	if err != nil {
+		return nil, err
+	}
+
+	return &secretID{
+		DatabaseName: names[0].Name(),
+		SchemaName:   names[1].Name(),
+		SecretName:   names[2].Name(),
+	}, nil
+}
+
+func (si *secretID) builder() *snowflake.SecretBuilder {
+	return snowflake.Secret(si.SecretName, si.DatabaseName, si.SchemaName)
+}
+
+// Secret returns a pointer to the resource representing a secret
+func Secret() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateSecret,
+		ReadContext:   ReadSecret,
+		UpdateContext: UpdateSecret,
+		DeleteContext: DeleteSecret,
+
+		Schema:        secretSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(secretSchema, upgradeUnchangedV0),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: customdiff.All(
+			setOnlyWhen("type", []string{snowflake.SecretTypeGenericString}, "secret_string"),
+			setOnlyWhen("type", []string{snowflake.SecretTypePassword}, "username", "password"),
+			setOnlyWhen("type", []string{snowflake.SecretTypeOAuth2}, "api_authentication", "oauth_scopes", "oauth_refresh_token", "oauth_refresh_token_expiry_time"),
+			requiredWhen("type", snowflake.SecretTypeGenericString, "secret_string"),
+			requiredWhen("type", snowflake.SecretTypePassword, "username", "password"),
+			requiredWhen("type", snowflake.SecretTypeOAuth2, "api_authentication"),
+		),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+	}
+}
+
+// CreateSecret implements schema.CreateContextFunc
+func CreateSecret(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+
+	secretID := &secretID{
+		DatabaseName: d.Get("database").(string),
+		SchemaName:   d.Get("schema").(string),
+		SecretName:   d.Get("name").(string),
+	}
+	builder := secretID.builder().
+		WithType(d.Get("type").(string)).
+		WithSecretString(d.Get("secret_string").(string)).
+		WithUsername(d.Get("username").(string)).
+		WithPassword(d.Get("password").(string)).
+		WithAPIAuthentication(d.Get("api_authentication").(string)).
+		WithOAuthScopes(expandStringList(d.Get("oauth_scopes").(*schema.Set).List())).
+		WithOAuthRefreshToken(d.Get("oauth_refresh_token").(string), d.Get("oauth_refresh_token_expiry_time").(string)).
+		WithComment(d.Get("comment").(string))
+
+	err := snowflake.ExecContext(ctx, db, builder.Create())
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error creating secret %v", builder.QualifiedName()))
+	}
+
+	dataIDInput, err := secretID.String()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(dataIDInput)
+
+	return ReadSecret(ctx, d, meta)
+}
+
+// ReadSecret implements schema.ReadContextFunc, the values stored in the
+// secret cannot be read back
+func ReadSecret(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	secretID, err := secretIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	row := snowflake.QueryRowContext(ctx, db, secretID.builder().Show())
+	s, err := snowflake.ScanSecret(row)
+	if err == sql.ErrNoRows {
+		// If not found, mark resource to be removed from statefile during apply or refresh
+		log.Printf("[DEBUG] secret (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	toSet := map[string]interface{}{
+		"name":                 s.Name,
+		"database":             s.DatabaseName,
+		"schema":               s.SchemaName,
+		"type":                 s.SecretType,
+		"comment":              s.Comment.String,
+		"fully_qualified_name": snowflake.AddressEscape(s.DatabaseName, s.SchemaName, s.Name),
+	}
+	if s.SecretType == snowflake.SecretTypeOAuth2 && d.Get("oauth_refresh_token").(string) == "" {
+		toSet["oauth_scopes"] = s.ListOAuthScopes()
+	}
+	for key, val := range toSet {
+		err = d.Set(key, val) //lintignore:R001
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+// UpdateSecret implements schema.UpdateContextFunc
+func UpdateSecret(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	secretID, err := secretIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	builder := secretID.builder()
+
+	if d.HasChange("secret_string") {
+		err = snowflake.ExecContext(ctx, db, builder.ChangeSecretString(d.Get("secret_string").(string)))
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error updating secret string on secret %v", d.Id()))
+		}
+	}
+
+	if d.HasChanges("username", "password") {
+		err = snowflake.ExecContext(ctx, db, builder.ChangeCredentials(d.Get("username").(string), d.Get("password").(string)))
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error updating credentials on secret %v", d.Id()))
+		}
+	}
+
+	if d.HasChange("oauth_scopes") {
+		err = snowflake.ExecContext(ctx, db, builder.ChangeOAuthScopes(expandStringList(d.Get("oauth_scopes").(*schema.Set).List())))
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error updating oauth scopes on secret %v", d.Id()))
+		}
+	}
+
+	if d.HasChanges("oauth_refresh_token", "oauth_refresh_token_expiry_time") {
+		err = snowflake.ExecContext(ctx, db, builder.ChangeOAuthRefreshToken(d.Get("oauth_refresh_token").(string), d.Get("oauth_refresh_token_expiry_time").(string)))
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error updating oauth refresh token on secret %v", d.Id()))
+		}
+	}
+
+	if d.HasChange("comment") {
+		var q string
+		if c := d.Get("comment").(string); c != "" {
+			q = builder.ChangeComment(c)
+		} else {
+			q = builder.RemoveComment()
+		}
+		err = snowflake.ExecContext(ctx, db, q)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error updating comment on secret %v", d.Id()))
+		}
+	}
+
+	return ReadSecret(ctx, d, meta)
+}
+
+// DeleteSecret implements schema.DeleteContextFunc
+func DeleteSecret(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	secretID, err := secretIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = snowflake.ExecContext(ctx, db, secretID.builder().Drop())
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error deleting secret %v", d.Id()))
+	}
+
+	d.SetId("")
+	return nil
+}
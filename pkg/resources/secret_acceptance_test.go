@@ -0,0 +1,69 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_Secret(t *testing.T) {
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: secretConfig(accName, "hunter2", "test comment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_secret.test", "name", accName),
+					resource.TestCheckResourceAttr("snowflake_secret.test", "type", "PASSWORD"),
+					resource.TestCheckResourceAttr("snowflake_secret.test", "username", "api_user"),
+					resource.TestCheckResourceAttr("snowflake_secret.test", "comment", "test comment"),
+					resource.TestCheckResourceAttr("snowflake_secret.test", "fully_qualified_name", fmt.Sprintf(`"%[1]v"."%[1]v"."%[1]v"`, accName)),
+				),
+			},
+			{
+				Config: secretConfig(accName, "correct horse battery staple", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_secret.test", "password", "correct horse battery staple"),
+					resource.TestCheckResourceAttr("snowflake_secret.test", "comment", ""),
+				),
+			},
+			{
+				ResourceName:      "snowflake_secret.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the values stored in the secret cannot be read back
+				ImportStateVerifyIgnore: []string{"username", "password"},
+			},
+		},
+	})
+}
+
+func secretConfig(n string, password string, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_database" "test" {
+	name = "%[1]v"
+	comment = "Terraform acceptance test"
+}
+
+resource "snowflake_schema" "test" {
+	name = "%[1]v"
+	database = snowflake_database.test.name
+	comment = "Terraform acceptance test"
+}
+
+resource "snowflake_secret" "test" {
+	name = "%[1]v"
+	database = snowflake_database.test.name
+	schema = snowflake_schema.test.name
+	type = "PASSWORD"
+	username = "api_user"
+	password = "%[2]v"
+	comment = "%[3]v"
+}
+`, n, password, comment)
+}
@@ -0,0 +1,163 @@
+package resources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/provider"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/resources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecret(t *testing.T) {
+	r := require.New(t)
+	err := resources.Secret().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestSecretCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":     "test_secret",
+		"database": "test_db",
+		"schema":   "test_schema",
+		"type":     "PASSWORD",
+		"username": "api_user",
+		"password": "hunter2",
+		"comment":  "wow comment",
+	}
+
+	d := schema.TestResourceDataRaw(t, resources.Secret().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`^CREATE SECRET "test_db"."test_schema"."test_secret" TYPE = PASSWORD USERNAME = 'api_user' PASSWORD = 'hunter2' COMMENT = 'wow comment'$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		expectReadSecret(mock, "PASSWORD", "")
+		diags := resources.CreateSecret(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("test_db|test_schema|test_secret", d.Id())
+		r.Equal(`"test_db"."test_schema"."test_secret"`, d.Get("fully_qualified_name").(string))
+		r.Equal("hunter2", d.Get("password").(string))
+	})
+}
+
+func TestSecretCustomizeDiff(t *testing.T) {
+	r := require.New(t)
+
+	err := planDiff(resources.Secret(), map[string]interface{}{
+		"name":     "test_secret",
+		"database": "test_db",
+		"schema":   "test_schema",
+		"type":     "PASSWORD",
+		"username": "api_user",
+		"password": "hunter2",
+	})
+	r.NoError(err)
+
+	err = planDiff(resources.Secret(), map[string]interface{}{
+		"name":          "test_secret",
+		"database":      "test_db",
+		"schema":        "test_schema",
+		"type":          "GENERIC_STRING",
+		"secret_string": "s3cr3t",
+		"username":      "api_user",
+		"password":      "hunter2",
+	})
+	r.Error(err)
+	r.Contains(err.Error(), "username can only be set when type is PASSWORD")
+
+	err = planDiff(resources.Secret(), map[string]interface{}{
+		"name":          "test_secret",
+		"database":      "test_db",
+		"schema":        "test_schema",
+		"type":          "GENERIC_STRING",
+		"secret_string": "",
+	})
+	r.Error(err)
+	r.Contains(err.Error(), "secret_string must be set when type is GENERIC_STRING")
+
+	err = planDiff(resources.Secret(), map[string]interface{}{
+		"name":     "test_secret",
+		"database": "test_db",
+		"schema":   "test_schema",
+		"type":     "OAUTH2",
+	})
+	r.Error(err)
+	r.Contains(err.Error(), "api_authentication must be set when type is OAUTH2")
+}
+
+func TestSecretRead(t *testing.T) {
+	r := require.New(t)
+
+	d := secret(t, "test_db|test_schema|test_secret", map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadSecret(mock, "OAUTH2", "[read, write]")
+		diags := resources.ReadSecret(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("test_secret", d.Get("name").(string))
+		r.Equal("OAUTH2", d.Get("type").(string))
+		r.Equal("wow comment", d.Get("comment").(string))
+		r.ElementsMatch([]interface{}{"read", "write"}, d.Get("oauth_scopes").(*schema.Set).List())
+	})
+}
+
+func TestSecretReadNotFound(t *testing.T) {
+	r := require.New(t)
+
+	d := secret(t, "test_db|test_schema|test_secret", map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"created_on", "name", "database_name", "schema_name", "owner", "comment", "secret_type", "oauth_scopes"})
+		mock.ExpectQuery(`^SHOW SECRETS LIKE 'test_secret' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+		diags := resources.ReadSecret(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("", d.Id())
+	})
+}
+
+func TestSecretUpdate(t *testing.T) {
+	r := require.New(t)
+
+	d := secret(t, "test_db|test_schema|test_secret", map[string]interface{}{
+		"type":          "GENERIC_STRING",
+		"secret_string": "n3w s3cr3t",
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER SECRET "test_db"."test_schema"."test_secret" SET SECRET_STRING = 'n3w s3cr3t'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadSecret(mock, "GENERIC_STRING", "")
+		diags := resources.UpdateSecret(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
+
+func TestSecretDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := secret(t, "test_db|test_schema|test_secret", map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^DROP SECRET "test_db"."test_schema"."test_secret"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		diags := resources.DeleteSecret(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("", d.Id())
+	})
+}
+
+func expectReadSecret(mock sqlmock.Sqlmock, secretType string, scopes string) {
+	rows := sqlmock.NewRows([]string{
+		"created_on", "name", "database_name", "schema_name", "owner", "comment", "secret_type", "oauth_scopes",
+	}).AddRow(
+		"2022-01-01 00:00:00", "test_secret", "test_db", "test_schema", "ACCOUNTADMIN", "wow comment", secretType, scopes,
+	)
+	mock.ExpectQuery(`^SHOW SECRETS LIKE 'test_secret' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+}
@@ -1,12 +1,14 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 	"strconv"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -56,20 +58,30 @@ var sequenceProperties = []string{"comment", "data_retention_time_in_days"}
 // Sequence returns a pointer to the resource representing a sequence
 func Sequence() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateSequence,
-		Read:   ReadSequence,
-		Delete: DeleteSequence,
-		Update: UpdateSequence,
-
-		Schema: sequenceSchema,
+		CreateContext: CreateSequence,
+		ReadContext:   ReadSequence,
+		DeleteContext: DeleteSequence,
+		UpdateContext: UpdateSequence,
+
+		Schema:        sequenceSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(sequenceSchema, upgradeUnchangedV0),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 	}
 }
 
-// CreateSequence implements schema.CreateFunc
-func CreateSequence(d *schema.ResourceData, meta interface{}) error {
+// CreateSequence implements schema.CreateContextFunc
+func CreateSequence(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	database := d.Get("database").(string)
 	schema := d.Get("schema").(string)
@@ -85,16 +97,16 @@ func CreateSequence(d *schema.ResourceData, meta interface{}) error {
 		sq.WithComment(v.(string))
 	}
 
-	err := snowflake.Exec(db, sq.Create())
+	err := snowflake.ExecContext(ctx, db, sq.Create())
 	if err != nil {
-		return errors.Wrapf(err, "error creating sequence")
+		return diag.FromErr(errors.Wrapf(err, "error creating sequence"))
 	}
 
-	return ReadSequence(d, meta)
+	return ReadSequence(ctx, d, meta)
 }
 
-// ReadSequence implements schema.ReadFunc
-func ReadSequence(d *schema.ResourceData, meta interface{}) error {
+// ReadSequence implements schema.ReadContextFunc
+func ReadSequence(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	database := d.Get("database").(string)
 	schema := d.Get("schema").(string)
@@ -102,7 +114,7 @@ func ReadSequence(d *schema.ResourceData, meta interface{}) error {
 
 	seq := snowflake.Sequence(name, database, schema)
 	stmt := seq.Show()
-	row := snowflake.QueryRow(db, stmt)
+	row := snowflake.QueryRowContext(ctx, db, stmt)
 
 	sequence, err := snowflake.ScanSequence(row)
 
@@ -113,65 +125,65 @@ func ReadSequence(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return errors.Wrap(err, "unable to scan row for SHOW SEQUENCES")
+		return diag.FromErr(errors.Wrap(err, "unable to scan row for SHOW SEQUENCES"))
 	}
 
 	err = d.Set("schema", sequence.SchemaName.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("database", sequence.DBName.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("comment", sequence.Comment.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	i, err := strconv.ParseInt(sequence.Increment.String, 10, 64)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("increment", i)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	i, err = strconv.ParseInt(sequence.NextValue.String, 10, 64)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("next_value", i)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("fully_qualified_name", seq.Address())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf(`%v|%v|%v`, sequence.DBName.String, sequence.SchemaName.String, sequence.Name.String))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return err
+	return diag.FromErr(err)
 }
 
-func UpdateSequence(d *schema.ResourceData, meta interface{}) error {
+func UpdateSequence(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	database := d.Get("database").(string)
 	schema := d.Get("schema").(string)
 	name := d.Get("name").(string)
 	next := d.Get("next_value").(int)
 
-	DeleteSequence(d, meta)
+	DeleteSequence(ctx, d, meta)
 
 	sq := snowflake.Sequence(name, database, schema)
 
@@ -185,15 +197,15 @@ func UpdateSequence(d *schema.ResourceData, meta interface{}) error {
 
 	sq.WithStart(next)
 
-	err := snowflake.Exec(db, sq.Create())
+	err := snowflake.ExecContext(ctx, db, sq.Create())
 	if err != nil {
-		return errors.Wrapf(err, "error creating sequence")
+		return diag.FromErr(errors.Wrapf(err, "error creating sequence"))
 	}
 
-	return ReadSequence(d, meta)
+	return ReadSequence(ctx, d, meta)
 }
 
-func DeleteSequence(d *schema.ResourceData, meta interface{}) error {
+func DeleteSequence(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	database := d.Get("database").(string)
 	schema := d.Get("schema").(string)
@@ -201,9 +213,9 @@ func DeleteSequence(d *schema.ResourceData, meta interface{}) error {
 
 	stmt := snowflake.Sequence(name, database, schema).Drop()
 
-	err := snowflake.Exec(db, stmt)
+	err := snowflake.ExecContext(ctx, db, stmt)
 	if err != nil {
-		return errors.Wrapf(err, "error dropping sequence %s", name)
+		return diag.FromErr(errors.Wrapf(err, "error dropping sequence %s", name))
 	}
 
 	d.SetId("")
@@ -1,8 +1,11 @@
 package resources
 
 import (
+	"context"
+
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -66,21 +69,30 @@ var sequenceGrantSchema = map[string]*schema.Schema{
 func SequenceGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create: CreateSequenceGrant,
-			Read:   ReadSequenceGrant,
-			Delete: DeleteSequenceGrant,
-
-			Schema: sequenceGrantSchema,
+			CreateContext: CreateSequenceGrant,
+			ReadContext:   ReadSequenceGrant,
+			DeleteContext: DeleteSequenceGrant,
+
+			Schema:        sequenceGrantSchema,
+			SchemaVersion: 1,
+			StateUpgraders: []schema.StateUpgrader{
+				stateUpgraderV0(sequenceGrantSchema, upgradeGrantIDV0),
+			},
 			Importer: &schema.ResourceImporter{
 				StateContext: schema.ImportStatePassthroughContext,
 			},
+			Timeouts: &schema.ResourceTimeout{
+				Create: schema.DefaultTimeout(defaultTimeout),
+				Read:   schema.DefaultTimeout(defaultTimeout),
+				Delete: schema.DefaultTimeout(defaultTimeout),
+			},
 		},
 		ValidPrivs: validSequencePrivileges,
 	}
 }
 
-// CreateSequenceGrant implements schema.CreateFunc
-func CreateSequenceGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateSequenceGrant implements schema.CreateContextFunc
+func CreateSequenceGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var sequenceName string
 	if name, ok := d.GetOk("sequence_name"); ok {
 		sequenceName = name.(string)
@@ -93,10 +105,10 @@ func CreateSequenceGrant(d *schema.ResourceData, meta interface{}) error {
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 
 	if (sequenceName == "") && !futureSequences {
-		return errors.New("sequence_name must be set unless on_future is true.")
+		return diag.FromErr(errors.New("sequence_name must be set unless on_future is true."))
 	}
 	if (sequenceName != "") && futureSequences {
-		return errors.New("sequence_name must be empty if on_future is true.")
+		return diag.FromErr(errors.New("sequence_name must be empty if on_future is true."))
 	}
 
 	var builder snowflake.GrantBuilder
@@ -106,9 +118,9 @@ func CreateSequenceGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.SequenceGrant(dbName, schemaName, sequenceName)
 	}
 
-	err := createGenericGrant(d, meta, builder)
+	err := createGenericGrant(ctx, d, meta, builder)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grant := &grantID{
@@ -121,18 +133,18 @@ func CreateSequenceGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := grant.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadSequenceGrant(d, meta)
+	return ReadSequenceGrant(ctx, d, meta)
 }
 
-// ReadSequenceGrant implements schema.ReadFunc
-func ReadSequenceGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadSequenceGrant implements schema.ReadContextFunc
+func ReadSequenceGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -141,11 +153,11 @@ func ReadSequenceGrant(d *schema.ResourceData, meta interface{}) error {
 
 	err = d.Set("database_name", dbName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("schema_name", schemaName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	futureSequencesEnabled := false
 	if sequenceName == "" {
@@ -153,19 +165,19 @@ func ReadSequenceGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	err = d.Set("sequence_name", sequenceName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("on_future", futureSequencesEnabled)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("privilege", priv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("with_grant_option", grantID.GrantOption)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var builder snowflake.GrantBuilder
@@ -175,14 +187,14 @@ func ReadSequenceGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.SequenceGrant(dbName, schemaName, sequenceName)
 	}
 
-	return readGenericGrant(d, meta, sequenceGrantSchema, builder, futureSequencesEnabled, validSequencePrivileges)
+	return diag.FromErr(readGenericGrant(ctx, d, meta, sequenceGrantSchema, builder, futureSequencesEnabled, validSequencePrivileges))
 }
 
-// DeleteSequenceGrant implements schema.DeleteFunc
-func DeleteSequenceGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteSequenceGrant implements schema.DeleteContextFunc
+func DeleteSequenceGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -196,5 +208,5 @@ func DeleteSequenceGrant(d *schema.ResourceData, meta interface{}) error {
 	} else {
 		builder = snowflake.SequenceGrant(dbName, schemaName, sequenceName)
 	}
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -53,8 +54,8 @@ func TestSequenceCreate(t *testing.T) {
 			"mock comment",
 		)
 		mock.ExpectQuery(`SHOW SEQUENCES LIKE 'good_name' IN SCHEMA "database"."schema"`).WillReturnRows(rows)
-		err := resources.CreateSequence(d, db)
-		r.NoError(err)
+		diags := resources.CreateSequence(context.Background(), d, db)
+		r.Empty(diags)
 		r.Equal("database|schema|good_name", d.Id())
 	})
 }
@@ -90,8 +91,8 @@ func TestSequenceRead(t *testing.T) {
 			"mock comment",
 		)
 		mock.ExpectQuery(`SHOW SEQUENCES LIKE 'good_name' IN SCHEMA "database"."schema"`).WillReturnRows(rows)
-		err := resources.ReadSequence(d, db)
-		r.NoError(err)
+		diags := resources.ReadSequence(context.Background(), d, db)
+		r.Empty(diags)
 		r.Equal("good_name", d.Get("name").(string))
 		r.Equal("schema", d.Get("schema").(string))
 		r.Equal("database", d.Get("database").(string))
@@ -115,8 +116,8 @@ func TestSequenceDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP SEQUENCE "database"."schema"."drop_it"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteSequence(d, db)
-		r.NoError(err)
+		diags := resources.DeleteSequence(context.Background(), d, db)
+		r.Empty(diags)
 		r.Equal("", d.Id())
 	})
 }
@@ -0,0 +1,80 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// sessionPolicy describes session policies, their properties defaulting as in Snowflake
+var sessionPolicy = &userPolicyKind{
+	name:    "session policy",
+	builder: snowflake.SessionPolicy,
+	prefix:  "SESSION_",
+	properties: map[string]*schema.Schema{
+		"idle_timeout_mins": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      240,
+			Description:  "Specifies the number of minutes a session of Snowflake clients can be idle before it times out, from 5 to 240.",
+			ValidateFunc: validation.IntBetween(5, 240),
+		},
+		"ui_idle_timeout_mins": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      240,
+			Description:  "Specifies the number of minutes a session of the Snowflake web interface can be idle before it times out, from 5 to 240.",
+			ValidateFunc: validation.IntBetween(5, 240),
+		},
+	},
+}
+
+var sessionPolicySchema = sessionPolicy.schema()
+
+// SessionPolicy returns a pointer to the resource representing a session policy
+func SessionPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateSessionPolicy,
+		ReadContext:   ReadSessionPolicy,
+		UpdateContext: UpdateSessionPolicy,
+		DeleteContext: DeleteSessionPolicy,
+
+		Schema:        sessionPolicySchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(sessionPolicySchema, upgradeUnchangedV0),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+	}
+}
+
+// CreateSessionPolicy implements schema.CreateContextFunc
+func CreateSessionPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return sessionPolicy.create(ctx, d, meta)
+}
+
+// ReadSessionPolicy implements schema.ReadContextFunc
+func ReadSessionPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return sessionPolicy.read(ctx, d, meta)
+}
+
+// UpdateSessionPolicy implements schema.UpdateContextFunc
+func UpdateSessionPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return sessionPolicy.update(ctx, d, meta)
+}
+
+// DeleteSessionPolicy implements schema.DeleteContextFunc
+func DeleteSessionPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return sessionPolicy.delete(ctx, d, meta)
+}
@@ -0,0 +1,61 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_SessionPolicy(t *testing.T) {
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: sessionPolicyConfig(accName, 30),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_session_policy.test", "name", accName),
+					resource.TestCheckResourceAttr("snowflake_session_policy.test", "idle_timeout_mins", "30"),
+					resource.TestCheckResourceAttr("snowflake_session_policy.test", "ui_idle_timeout_mins", "240"),
+				),
+			},
+			{
+				Config: sessionPolicyConfig(accName, 60),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_session_policy.test", "idle_timeout_mins", "60"),
+				),
+			},
+			{
+				ResourceName:      "snowflake_session_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func sessionPolicyConfig(n string, idleTimeout int) string {
+	return fmt.Sprintf(`
+resource "snowflake_database" "test" {
+	name = "%[1]v"
+	comment = "Terraform acceptance test"
+}
+
+resource "snowflake_schema" "test" {
+	name = "%[1]v"
+	database = snowflake_database.test.name
+	comment = "Terraform acceptance test"
+}
+
+resource "snowflake_session_policy" "test" {
+	name = "%[1]v"
+	database = snowflake_database.test.name
+	schema = snowflake_schema.test.name
+	idle_timeout_mins = %[2]v
+}
+`, n, idleTimeout)
+}
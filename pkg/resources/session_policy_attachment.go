@@ -0,0 +1,61 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var sessionPolicyAttachmentSchema = sessionPolicy.attachmentSchema()
+
+// SessionPolicyAttachment returns a pointer to the resource representing a session policy attachment
+func SessionPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateSessionPolicyAttachment,
+		ReadContext:   ReadSessionPolicyAttachment,
+		UpdateContext: UpdateSessionPolicyAttachment,
+		DeleteContext: DeleteSessionPolicyAttachment,
+
+		Schema:        sessionPolicyAttachmentSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(sessionPolicyAttachmentSchema, upgradeUnchangedV0),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportSessionPolicyAttachment,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+	}
+}
+
+// CreateSessionPolicyAttachment implements schema.CreateContextFunc
+func CreateSessionPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return sessionPolicy.createAttachment(ctx, d, meta)
+}
+
+// ImportSessionPolicyAttachment implements schema.StateContextFunc. Every user
+// the policy is set on is attached.
+func ImportSessionPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	return sessionPolicy.importAttachment(ctx, d, meta)
+}
+
+// ReadSessionPolicyAttachment implements schema.ReadContextFunc
+func ReadSessionPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return sessionPolicy.readAttachment(ctx, d, meta)
+}
+
+// UpdateSessionPolicyAttachment implements schema.UpdateContextFunc
+func UpdateSessionPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return sessionPolicy.updateAttachment(ctx, d, meta)
+}
+
+// DeleteSessionPolicyAttachment implements schema.DeleteContextFunc
+func DeleteSessionPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return sessionPolicy.deleteAttachment(ctx, d, meta)
+}
@@ -0,0 +1,77 @@
+package resources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/provider"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/resources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionPolicy(t *testing.T) {
+	r := require.New(t)
+	err := resources.SessionPolicy().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestSessionPolicyCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":              "test_policy",
+		"database":          "test_db",
+		"schema":            "test_schema",
+		"idle_timeout_mins": 30,
+	}
+
+	d := schema.TestResourceDataRaw(t, resources.SessionPolicy().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`^CREATE SESSION POLICY "test_db"."test_schema"."test_policy" SESSION_IDLE_TIMEOUT_MINS = 30 SESSION_UI_IDLE_TIMEOUT_MINS = 240$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		expectReadSessionPolicy(mock)
+		diags := resources.CreateSessionPolicy(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("test_db|test_schema|test_policy", d.Id())
+	})
+}
+
+func TestSessionPolicyRead(t *testing.T) {
+	r := require.New(t)
+
+	d := sessionPolicy(t, "test_db|test_schema|test_policy", map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadSessionPolicy(mock)
+		diags := resources.ReadSessionPolicy(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("test_policy", d.Get("name").(string))
+		r.Equal(30, d.Get("idle_timeout_mins").(int))
+		r.Equal(240, d.Get("ui_idle_timeout_mins").(int))
+	})
+}
+
+func expectReadSessionPolicy(mock sqlmock.Sqlmock) {
+	showRows := sqlmock.NewRows([]string{
+		"created_on", "name", "database_name", "schema_name", "kind", "owner", "comment",
+	}).AddRow(
+		"2023-01-01 00:00:00", "test_policy", "test_db", "test_schema", "SESSION_POLICY", "ACCOUNTADMIN", "",
+	)
+	mock.ExpectQuery(`^SHOW SESSION POLICIES LIKE 'test_policy' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(showRows)
+
+	// session policies are described by a single row
+	describeRows := sqlmock.NewRows([]string{
+		"created_on", "name", "session_idle_timeout_mins", "session_ui_idle_timeout_mins", "comment",
+	}).AddRow(
+		"2023-01-01 00:00:00", "test_policy", 30, 240, "",
+	)
+	mock.ExpectQuery(`^DESCRIBE SESSION POLICY "test_db"."test_schema"."test_policy"$`).WillReturnRows(describeRows)
+}
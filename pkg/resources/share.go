@@ -1,12 +1,14 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 
@@ -42,29 +44,39 @@ var shareSchema = map[string]*schema.Schema{
 // Share returns a pointer to the resource representing a share
 func Share() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateShare,
-		Read:   ReadShare,
-		Update: UpdateShare,
-		Delete: DeleteShare,
-
-		Schema: shareSchema,
+		CreateContext: CreateShare,
+		ReadContext:   ReadShare,
+		UpdateContext: UpdateShare,
+		DeleteContext: DeleteShare,
+
+		Schema:        shareSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(shareSchema, upgradeUnchangedV0),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 	}
 }
 
-// CreateShare implements schema.CreateFunc
-func CreateShare(d *schema.ResourceData, meta interface{}) error {
+// CreateShare implements schema.CreateContextFunc
+func CreateShare(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 
 	builder := snowflake.Share(name).Create()
 	builder.SetString("COMMENT", d.Get("comment").(string))
 
-	err := snowflake.Exec(db, builder.Statement())
+	err := snowflake.ExecContext(ctx, db, builder.Statement())
 	if err != nil {
-		return errors.Wrapf(err, "error creating share")
+		return diag.FromErr(errors.Wrapf(err, "error creating share"))
 	}
 	d.SetId(name)
 
@@ -72,15 +84,15 @@ func CreateShare(d *schema.ResourceData, meta interface{}) error {
 
 	// @TODO flesh out the share type in the snowflake package since it doesn't
 	// follow the normal generic rules
-	err = setAccounts(d, meta)
+	err = setAccounts(ctx, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return ReadShare(d, meta)
+	return ReadShare(ctx, d, meta)
 }
 
-func setAccounts(d *schema.ResourceData, meta interface{}) error {
+func setAccounts(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 	accs := expandStringList(d.Get("accounts").([]interface{}))
@@ -93,10 +105,6 @@ func setAccounts(d *schema.ResourceData, meta interface{}) error {
 		// 1. Create new temporary DB
 		tempName := fmt.Sprintf("TEMP_%v_%d", name, time.Now().Unix())
 		tempDB := snowflake.Database(tempName)
-		err := snowflake.Exec(db, tempDB.Create().Statement())
-		if err != nil {
-			return errors.Wrapf(err, "error creating temporary DB %v", tempName)
-		}
 
 		// 2. Create temporary DB grant to the share
 		tempDBGrant := snowflake.DatabaseGrant(tempName)
@@ -111,47 +119,42 @@ func setAccounts(d *schema.ResourceData, meta interface{}) error {
 		// case where the main db doesn't already exist, so it will need to be revoked
 		// before deleting the temp db. Where USAGE hasn't been already granted it is not
 		// an error to revoke it, so it's ok to just do the revoke every time.
-		err = snowflake.Exec(db, tempDBGrant.Share(name).Grant("REFERENCE_USAGE", false))
-		if err != nil {
-			return errors.Wrapf(err, "error creating temporary DB REFERENCE_USAGE grant %v", tempName)
+		steps := []snowflake.Step{
+			{Statement: tempDB.Create().Statement(), Undo: []string{tempDB.Drop()}},
+			{Statement: tempDBGrant.Share(name).Grant("REFERENCE_USAGE", false), Undo: tempDBGrant.Share(name).Revoke("REFERENCE_USAGE")},
+			// 3. Add the accounts to the share
+			{Statement: fmt.Sprintf(`ALTER SHARE %v SET ACCOUNTS=%v`, snowflake.QuoteIdentifier(name), strings.Join(accs, ","))},
 		}
 
-		// 3. Add the accounts to the share
-		q := fmt.Sprintf(`ALTER SHARE "%v" SET ACCOUNTS=%v`, name, strings.Join(accs, ","))
-		err = snowflake.Exec(db, q)
-		if err != nil {
-			return errors.Wrapf(err, "error adding accounts to share %v", name)
+		// 4. Revoke temporary DB grant to the share, including the maybe
+		// automatically granted USAGE privilege.
+		for _, revoke := range tempDBGrant.Share(name).Revoke("REFERENCE_USAGE") {
+			steps = append(steps, snowflake.Step{Statement: revoke})
 		}
-
-		// 4. Revoke temporary DB grant to the share
-		err = snowflake.ExecMulti(db, tempDBGrant.Share(name).Revoke("REFERENCE_USAGE"))
-		if err != nil {
-			return errors.Wrapf(err, "error revoking temporary DB REFERENCE_USAGE grant %v", tempName)
-		}
-
-		// revoke the maybe automatically granted USAGE privilege.
-		err = snowflake.ExecMulti(db, tempDBGrant.Share(name).Revoke("USAGE"))
-		if err != nil {
-			return errors.Wrapf(err, "error revoking temporary DB grant %v", tempName)
+		for _, revoke := range tempDBGrant.Share(name).Revoke("USAGE") {
+			steps = append(steps, snowflake.Step{Statement: revoke})
 		}
 
 		// 5. Remove the temporary DB
-		err = snowflake.Exec(db, tempDB.Drop())
+		steps = append(steps, snowflake.Step{Statement: tempDB.Drop()})
+
+		// The temporary DB is dropped again if any of the steps fail.
+		err := snowflake.ExecSteps(ctx, db, steps)
 		if err != nil {
-			return errors.Wrapf(err, "error dropping temporary DB %v", tempName)
+			return errors.Wrapf(err, "error adding accounts to share %v", name)
 		}
 	}
 
 	return nil
 }
 
-// ReadShare implements schema.ReadFunc
-func ReadShare(d *schema.ResourceData, meta interface{}) error {
+// ReadShare implements schema.ReadContextFunc
+func ReadShare(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	id := d.Id()
 
 	stmt := snowflake.Share(id).Show()
-	row := snowflake.QueryRow(db, stmt)
+	row := snowflake.QueryRowContext(ctx, db, stmt)
 
 	s, err := snowflake.ScanShare(row)
 	if err == sql.ErrNoRows {
@@ -161,40 +164,40 @@ func ReadShare(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("name", StripAccountFromName(s.Name.String))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("comment", s.Comment.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	accs := strings.FieldsFunc(s.To.String, func(c rune) bool { return c == ',' })
 	err = d.Set("accounts", accs)
 
-	return err
+	return diag.FromErr(err)
 }
 
-// UpdateShare implements schema.UpdateFunc
-func UpdateShare(d *schema.ResourceData, meta interface{}) error {
+// UpdateShare implements schema.UpdateContextFunc
+func UpdateShare(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Change the accounts first - this is a special case and won't work using the generic method
 	if d.HasChange("accounts") {
-		err := setAccounts(d, meta)
+		err := setAccounts(ctx, d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return UpdateResource("this does not seem to be used", shareProperties, shareSchema, snowflake.Share, ReadShare)(d, meta)
+	return UpdateResource("this does not seem to be used", shareProperties, shareSchema, snowflake.Share, ReadShare)(ctx, d, meta)
 }
 
-// DeleteShare implements schema.DeleteFunc
-func DeleteShare(d *schema.ResourceData, meta interface{}) error {
-	return DeleteResource("this does not seem to be used", snowflake.Share)(d, meta)
+// DeleteShare implements schema.DeleteContextFunc
+func DeleteShare(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return DeleteResource("this does not seem to be used", snowflake.Share)(ctx, d, meta)
 }
 
 // ShareExists implements schema.ExistsFunc
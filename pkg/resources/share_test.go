@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -37,18 +38,12 @@ func TestShareCreate(t *testing.T) {
 		mock.ExpectExec(`^GRANT REFERENCE_USAGE ON DATABASE "TEMP_test-share_\d*" TO SHARE "test-share"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^ALTER SHARE "test-share" SET ACCOUNTS=bob123,sue456$`).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		mock.ExpectBegin()
 		mock.ExpectExec(`^REVOKE REFERENCE_USAGE ON DATABASE "TEMP_test-share_\d*" FROM SHARE "test-share"$`).WillReturnResult(sqlmock.NewResult(1, 1))
-		mock.ExpectCommit()
-
-		mock.ExpectBegin()
 		mock.ExpectExec(`^REVOKE USAGE ON DATABASE "TEMP_test-share_\d*" FROM SHARE "test-share"$`).WillReturnResult(sqlmock.NewResult(1, 1))
-		mock.ExpectCommit()
-
 		mock.ExpectExec(`^DROP DATABASE "TEMP_test-share_\d*"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadShare(mock)
-		err := resources.CreateShare(d, db)
-		r.NoError(err)
+		diags := resources.CreateShare(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -85,8 +80,8 @@ func TestShareRead(t *testing.T) {
 		r.NotEmpty(d.State())
 		q := snowflake.Share(d.Id()).Show()
 		mock.ExpectQuery(q).WillReturnError(sql.ErrNoRows)
-		err := resources.ReadShare(d, db)
+		diags := resources.ReadShare(context.Background(), d, db)
 		r.Empty(d.State())
-		r.Nil(err)
+		r.Empty(diags)
 	})
 }
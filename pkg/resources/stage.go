@@ -2,6 +2,7 @@ package resources
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/csv"
 	"fmt"
@@ -9,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -129,10 +131,15 @@ func stageIDFromString(stringID string) (*stageID, error) {
 		return nil, fmt.Errorf("3 fields allowed")
 	}
 
+	names, err := objectNames(lines[0][:3])
+	if err != nil {
+		return nil, err
+	}
+
 	stageResult := &stageID{
-		DatabaseName: lines[0][0],
-		SchemaName:   lines[0][1],
-		StageName:    lines[0][2],
+		DatabaseName: names[0].Name(),
+		SchemaName:   names[1].Name(),
+		StageName:    names[2].Name(),
 	}
 	return stageResult, nil
 }
@@ -140,20 +147,30 @@ func stageIDFromString(stringID string) (*stageID, error) {
 // Stage returns a pointer to the resource representing a stage
 func Stage() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateStage,
-		Read:   ReadStage,
-		Update: UpdateStage,
-		Delete: DeleteStage,
-
-		Schema: stageSchema,
+		CreateContext: CreateStage,
+		ReadContext:   ReadStage,
+		UpdateContext: UpdateStage,
+		DeleteContext: DeleteStage,
+
+		Schema:        stageSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(stageSchema, upgradeQuotedNamesV0("database", "schema", "name")),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 	}
 }
 
-// CreateStage implements schema.CreateFunc
-func CreateStage(d *schema.ResourceData, meta interface{}) error {
+// CreateStage implements schema.CreateContextFunc
+func CreateStage(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 	database := d.Get("database").(string)
@@ -201,9 +218,9 @@ func CreateStage(d *schema.ResourceData, meta interface{}) error {
 
 	q := builder.Create()
 
-	err := snowflake.Exec(db, q)
+	err := snowflake.ExecContext(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error creating stage %v", name)
+		return diag.FromErr(errors.Wrapf(err, "error creating stage %v", name))
 	}
 
 	stageID := &stageID{
@@ -213,20 +230,20 @@ func CreateStage(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := stageID.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadStage(d, meta)
+	return ReadStage(ctx, d, meta)
 }
 
-// ReadStage implements schema.ReadFunc
+// ReadStage implements schema.ReadContextFunc
 // credentials and encryption are omitted, they cannot be read via SHOW or DESCRIBE
-func ReadStage(d *schema.ResourceData, meta interface{}) error {
+func ReadStage(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	stageID, err := stageIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := stageID.DatabaseName
@@ -242,80 +259,80 @@ func ReadStage(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	sq := snowflake.Stage(stage, dbName, schema).Show()
-	row := snowflake.QueryRow(db, sq)
+	row := snowflake.QueryRowContext(ctx, db, sq)
 
 	s, err := snowflake.ScanStageShow(row)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("name", s.Name)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("database", s.DatabaseName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("schema", s.SchemaName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("url", stageDesc.Url)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("file_format", stageDesc.FileFormat)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("copy_options", stageDesc.CopyOptions)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("directory", stageDesc.Directory)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("storage_integration", s.StorageIntegration)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("comment", s.Comment)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("aws_external_id", stageDesc.AwsExternalID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("snowflake_iam_user", stageDesc.SnowflakeIamUser)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-// UpdateStage implements schema.UpdateFunc
-func UpdateStage(d *schema.ResourceData, meta interface{}) error {
+// UpdateStage implements schema.UpdateContextFunc
+func UpdateStage(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	stageID, err := stageIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := stageID.DatabaseName
@@ -328,74 +345,74 @@ func UpdateStage(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("url") {
 		url := d.Get("url")
 		q := builder.ChangeURL(url.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating stage url on %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating stage url on %v", d.Id()))
 		}
 	}
 
 	if d.HasChange("credentials") {
 		credentials := d.Get("credentials")
 		q := builder.ChangeCredentials(credentials.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating stage credentials on %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating stage credentials on %v", d.Id()))
 		}
 	}
 
 	if d.HasChange("storage_integration") {
 		si := d.Get("storage_integration")
 		q := builder.ChangeStorageIntegration(si.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating stage storage integration on %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating stage storage integration on %v", d.Id()))
 		}
 	}
 
 	if d.HasChange("encryption") {
 		encryption := d.Get("encryption")
 		q := builder.ChangeEncryption(encryption.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating stage encryption on %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating stage encryption on %v", d.Id()))
 		}
 	}
 	if d.HasChange("file_format") {
 		fileFormat := d.Get("file_format")
 		q := builder.ChangeFileFormat(fileFormat.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating stage file formaat on %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating stage file formaat on %v", d.Id()))
 		}
 	}
 	if d.HasChange("copy_options") {
 		copyOptions := d.Get("copy_options")
 		q := builder.ChangeCopyOptions(copyOptions.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating stage copy options on %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating stage copy options on %v", d.Id()))
 		}
 	}
 	if d.HasChange("comment") {
 		comment := d.Get("comment")
 		q := builder.ChangeComment(comment.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating stage comment on %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating stage comment on %v", d.Id()))
 		}
 	}
 
-	handleTagChanges(db, d, builder)
+	handleTagChanges(ctx, db, d, builder)
 
-	return ReadStage(d, meta)
+	return ReadStage(ctx, d, meta)
 }
 
-// DeleteStage implements schema.DeleteFunc
-func DeleteStage(d *schema.ResourceData, meta interface{}) error {
+// DeleteStage implements schema.DeleteContextFunc
+func DeleteStage(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	stageID, err := stageIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := stageID.DatabaseName
@@ -404,9 +421,9 @@ func DeleteStage(d *schema.ResourceData, meta interface{}) error {
 
 	q := snowflake.Stage(stage, dbName, schema).Drop()
 
-	err = snowflake.Exec(db, q)
+	err = snowflake.ExecContext(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error deleting stage %v", d.Id())
+		return diag.FromErr(errors.Wrapf(err, "error deleting stage %v", d.Id()))
 	}
 
 	d.SetId("")
@@ -1,8 +1,11 @@
 package resources
 
 import (
+	"context"
+
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -77,21 +80,30 @@ var stageGrantSchema = map[string]*schema.Schema{
 func StageGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create: CreateStageGrant,
-			Read:   ReadStageGrant,
-			Delete: DeleteStageGrant,
-
-			Schema: stageGrantSchema,
+			CreateContext: CreateStageGrant,
+			ReadContext:   ReadStageGrant,
+			DeleteContext: DeleteStageGrant,
+
+			Schema:        stageGrantSchema,
+			SchemaVersion: 1,
+			StateUpgraders: []schema.StateUpgrader{
+				stateUpgraderV0(stageGrantSchema, upgradeGrantIDV0),
+			},
 			Importer: &schema.ResourceImporter{
 				StateContext: schema.ImportStatePassthroughContext,
 			},
+			Timeouts: &schema.ResourceTimeout{
+				Create: schema.DefaultTimeout(defaultTimeout),
+				Read:   schema.DefaultTimeout(defaultTimeout),
+				Delete: schema.DefaultTimeout(defaultTimeout),
+			},
 		},
 		ValidPrivs: validStagePrivileges,
 	}
 }
 
-// CreateStageGrant implements schema.CreateFunc
-func CreateStageGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateStageGrant implements schema.CreateContextFunc
+func CreateStageGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var stageName string
 	if name, ok := d.GetOk("stage_name"); ok {
 		stageName = name.(string)
@@ -109,9 +121,9 @@ func CreateStageGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.StageGrant(dbName, schemaName, stageName)
 	}
 
-	err := createGenericGrant(d, meta, builder)
+	err := createGenericGrant(ctx, d, meta, builder)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grant := &grantID{
@@ -123,18 +135,18 @@ func CreateStageGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := grant.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadStageGrant(d, meta)
+	return ReadStageGrant(ctx, d, meta)
 }
 
-// ReadStageGrant implements schema.ReadFunc
-func ReadStageGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadStageGrant implements schema.ReadContextFunc
+func ReadStageGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -143,11 +155,11 @@ func ReadStageGrant(d *schema.ResourceData, meta interface{}) error {
 
 	err = d.Set("database_name", dbName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("schema_name", schemaName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	futureStagesEnabled := false
 	if stageName == "" {
@@ -155,19 +167,19 @@ func ReadStageGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	err = d.Set("stage_name", stageName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("on_future", futureStagesEnabled)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("privilege", priv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("with_grant_option", grantID.GrantOption)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var builder snowflake.GrantBuilder
@@ -177,14 +189,14 @@ func ReadStageGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.StageGrant(dbName, schemaName, stageName)
 	}
 
-	return readGenericGrant(d, meta, stageGrantSchema, builder, futureStagesEnabled, validStagePrivileges)
+	return diag.FromErr(readGenericGrant(ctx, d, meta, stageGrantSchema, builder, futureStagesEnabled, validStagePrivileges))
 }
 
-// DeleteStageGrant implements schema.DeleteFunc
-func DeleteStageGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteStageGrant implements schema.DeleteContextFunc
+func DeleteStageGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -199,5 +211,5 @@ func DeleteStageGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.StageGrant(dbName, schemaName, stageName)
 	}
 
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
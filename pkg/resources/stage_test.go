@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -38,8 +39,8 @@ func TestInternalStageCreate(t *testing.T) {
 
 		expectReadStage(mock)
 		expectReadStageShow(mock)
-		err := resources.CreateStage(d, db)
-		r.NoError(err)
+		diags := resources.CreateStage(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -63,8 +64,8 @@ func TestExternalStageCreate(t *testing.T) {
 
 		expectReadStage(mock)
 		expectReadStageShow(mock)
-		err := resources.CreateStage(d, db)
-		r.NoError(err)
+		diags := resources.CreateStage(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -100,8 +101,8 @@ func TestStageRead(t *testing.T) {
 		r.NotEmpty(d.State())
 		q := snowflake.Stage("test_stage", "test_db", "test_schema").Describe()
 		mock.ExpectQuery(q).WillReturnError(sql.ErrNoRows)
-		err := resources.ReadStage(d, db)
+		diags := resources.ReadStage(context.Background(), d, db)
 		r.Empty(d.State())
-		r.Nil(err)
+		r.Empty(diags)
 	})
 }
@@ -0,0 +1,143 @@
+package resources
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Every resource declares a SchemaVersion along with one StateUpgrader per
+// earlier version. Changes to the format of an ID or to the name of an
+// attribute are then applied to the stored state once, when Terraform reads it
+// for the first time with a newer provider, instead of being guessed by the ID
+// parsers on every read.
+//
+// Version 0 is the state written before resources were versioned. When
+// bumping a resource past version 1, freeze the schema passed to the upgrader
+// of the version being replaced so that it keeps describing the old state.
+
+// stateUpgraderV0 returns the upgrader from version 0 of a resource whose
+// version 0 schema is s.
+func stateUpgraderV0(s map[string]*schema.Schema, upgrade schema.StateUpgradeFunc) schema.StateUpgrader {
+	return schema.StateUpgrader{
+		Version: 0,
+		Type:    (&schema.Resource{Schema: s}).CoreConfigSchema().ImpliedType(),
+		Upgrade: upgrade,
+	}
+}
+
+// upgradeUnchangedV0 is the version 0 upgrade of resources whose state did not
+// change in version 1.
+func upgradeUnchangedV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return rawState, nil
+}
+
+// upgradeGrantIDV0 upgrades the IDs of grant resources written before the
+// grant option was part of the ID:
+// resourceName|schemaName|ObjectName|Privilege|Roles becomes
+// resourceName|schemaName|ObjectName|Privilege|Roles|GrantOption
+// with the grant option taken from the with_grant_option attribute.
+func upgradeGrantIDV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+	id, ok := rawState["id"].(string)
+	if !ok || id == "" {
+		return rawState, nil
+	}
+
+	reader := csv.NewReader(strings.NewReader(id))
+	reader.Comma = grantIDDelimiter
+	lines, err := reader.ReadAll()
+	if err != nil || len(lines) != 1 {
+		return nil, fmt.Errorf("unable to upgrade grant ID %v", id)
+	}
+
+	switch len(lines[0]) {
+	case 6:
+		return rawState, nil
+	case 5:
+		grantOption, _ := rawState["with_grant_option"].(bool)
+		grant := &grantID{
+			ResourceName: lines[0][0],
+			SchemaName:   lines[0][1],
+			ObjectName:   lines[0][2],
+			Privilege:    lines[0][3],
+			Roles:        strings.Split(lines[0][4], ","),
+			GrantOption:  grantOption,
+		}
+		upgradedID, err := grant.String()
+		if err != nil {
+			return nil, err
+		}
+		rawState["id"] = upgradedID
+		return rawState, nil
+	default:
+		return nil, fmt.Errorf("unable to upgrade grant ID %v: expected 5 or 6 fields, got %d", id, len(lines[0]))
+	}
+}
+
+// upgradeDelimitedIDV0 upgrades the IDs of views, functions and procedures
+// written before their names were quoted in the ID: the plain pipe-delimited
+// names of database|schema|name are written again with the CSV quoting of
+// formatObjectID, so that names containing double quotes keep reading back.
+// Names quoted by hand are then upgraded as by upgradeQuotedNamesV0.
+func upgradeDelimitedIDV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+	id, ok := rawState["id"].(string)
+	if !ok || id == "" {
+		return rawState, nil
+	}
+
+	rawState["id"] = formatObjectID(strings.Split(id, "|")...)
+	return upgradeQuotedNamesV0("database", "schema", "name")(ctx, rawState, meta)
+}
+
+// upgradeQuotedNamesV0 returns the upgrade of objects whose names were quoted
+// by hand, e.g. name = "\"My table\"", before the provider quoted names itself
+// and rejected quoted ones. The double quotes around the names are removed
+// from the given attributes and from the matching first fields of the ID,
+// database|schema|name for attributes database, schema and name.
+func upgradeQuotedNamesV0(attributes ...string) schema.StateUpgradeFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		if rawState == nil {
+			return rawState, nil
+		}
+		for _, attribute := range attributes {
+			if name, ok := rawState[attribute].(string); ok {
+				rawState[attribute] = unquoteName(name)
+			}
+		}
+		id, ok := rawState["id"].(string)
+		if !ok || id == "" {
+			return rawState, nil
+		}
+
+		reader := csv.NewReader(strings.NewReader(id))
+		reader.Comma = objectIDDelimiter
+		lines, err := reader.ReadAll()
+		if err != nil || len(lines) != 1 || len(lines[0]) < len(attributes) {
+			return nil, fmt.Errorf("unable to upgrade ID %v", id)
+		}
+		fields := lines[0]
+		for i := range attributes {
+			fields[i] = unquoteName(fields[i])
+		}
+		rawState["id"] = formatObjectID(fields...)
+		return rawState, nil
+	}
+}
+
+// unquoteName returns name without the double quotes around it, if any, and
+// with the double quotes it escapes by doubling them unescaped.
+func unquoteName(name string) string {
+	if len(name) > 1 && strings.HasPrefix(name, `"`) && strings.HasSuffix(name, `"`) {
+		return strings.ReplaceAll(name[1:len(name)-1], `""`, `"`)
+	}
+	return name
+}
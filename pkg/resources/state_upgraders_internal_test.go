@@ -0,0 +1,154 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpgradeGrantIDV0(t *testing.T) {
+	r := require.New(t)
+
+	// legacy ID, grant option taken from the state
+	state, err := upgradeGrantIDV0(context.Background(), map[string]interface{}{
+		"id":                "database_name|schema|view_name|SELECT|test1,test2",
+		"with_grant_option": true,
+	}, nil)
+	r.NoError(err)
+	r.Equal("database_name|schema|view_name|SELECT|test1,test2|true", state["id"])
+
+	// legacy ID without with_grant_option in the state
+	state, err = upgradeGrantIDV0(context.Background(), map[string]interface{}{
+		"id": "role_name||||test1,test2",
+	}, nil)
+	r.NoError(err)
+	r.Equal("role_name||||test1,test2|false", state["id"])
+
+	// current ID is left alone
+	state, err = upgradeGrantIDV0(context.Background(), map[string]interface{}{
+		"id":                "database_name|||USAGE||false",
+		"with_grant_option": true,
+	}, nil)
+	r.NoError(err)
+	r.Equal("database_name|||USAGE||false", state["id"])
+
+	// unknown format
+	_, err = upgradeGrantIDV0(context.Background(), map[string]interface{}{
+		"id": "database_name|USAGE",
+	}, nil)
+	r.EqualError(err, "unable to upgrade grant ID database_name|USAGE: expected 5 or 6 fields, got 2")
+}
+
+func TestUpgradeDelimitedIDV0(t *testing.T) {
+	r := require.New(t)
+
+	// plain names are left alone
+	state, err := upgradeDelimitedIDV0(context.Background(), map[string]interface{}{
+		"id": "database_name|schema_name|func_name|VARCHAR-DATE",
+	}, nil)
+	r.NoError(err)
+	r.Equal("database_name|schema_name|func_name|VARCHAR-DATE", state["id"])
+
+	// names with double quotes are quoted
+	state, err = upgradeDelimitedIDV0(context.Background(), map[string]interface{}{
+		"id": `database_name|schema_name|my "view"`,
+	}, nil)
+	r.NoError(err)
+	r.Equal(`database_name|schema_name|"my ""view"""`, state["id"])
+
+	db, schemaName, view, err := splitViewID(state["id"].(string))
+	r.NoError(err)
+	r.Equal("database_name", db)
+	r.Equal("schema_name", schemaName)
+	r.Equal(`my "view"`, view)
+
+	// names quoted by hand are unquoted
+	state, err = upgradeDelimitedIDV0(context.Background(), map[string]interface{}{
+		"id":   `database_name|schema_name|"My view"`,
+		"name": `"My view"`,
+	}, nil)
+	r.NoError(err)
+	r.Equal("database_name|schema_name|My view", state["id"])
+	r.Equal("My view", state["name"])
+}
+
+func TestUpgradeQuotedNamesV0(t *testing.T) {
+	r := require.New(t)
+
+	upgrade := upgradeQuotedNamesV0("database", "schema", "name")
+	state, err := upgrade(context.Background(), map[string]interface{}{
+		"id":       `"""My db"""|schema_name|"""My ""quoted"" table"""`,
+		"database": `"My db"`,
+		"schema":   "schema_name",
+		"name":     `"My ""quoted"" table"`,
+		"comment":  `"not a name"`,
+	}, nil)
+	r.NoError(err)
+	r.Equal(`My db|schema_name|"My ""quoted"" table"`, state["id"])
+	r.Equal("My db", state["database"])
+	r.Equal("schema_name", state["schema"])
+	r.Equal(`My "quoted" table`, state["name"])
+	r.Equal(`"not a name"`, state["comment"])
+
+	// fields after the names are left alone
+	state, err = upgrade(context.Background(), map[string]interface{}{
+		"id": `db|schema|"""FN"""|"""VARCHAR"""`,
+	}, nil)
+	r.NoError(err)
+	r.Equal(`db|schema|FN|"""VARCHAR"""`, state["id"])
+
+	_, err = upgrade(context.Background(), map[string]interface{}{
+		"id": "db|schema",
+	}, nil)
+	r.EqualError(err, "unable to upgrade ID db|schema")
+}
+
+func TestResourceUpgradersQuotedNames(t *testing.T) {
+	tests := []struct {
+		name     string
+		resource *schema.Resource
+		id       string
+		parse    func(string) error
+	}{
+		{"external_function", ExternalFunction(), `"""DB"""|"""SCHEMA"""|"""FN"""|VARCHAR`, func(id string) error { _, err := externalFunctionIDFromString(id); return err }},
+		{"external_table", ExternalTable(), `"""DB"""|"""SCHEMA"""|"""ET"""`, func(id string) error { _, err := externalTableIDFromString(id); return err }},
+		{"file_format", FileFormat(), `"""DB"""|"""SCHEMA"""|"""FF"""`, func(id string) error { _, err := fileFormatIDFromString(id); return err }},
+		{"masking_policy", MaskingPolicy(), `"""DB"""|"""SCHEMA"""|"""MP"""`, func(id string) error { _, err := maskingPolicyIDFromString(id); return err }},
+		{"materialized_view", MaterializedView(), `"""DB"""|"""SCHEMA"""|"""MV"""`, func(id string) error { _, err := materializedViewIDFromString(id); return err }},
+		{"pipe", Pipe(), `"""DB"""|"""SCHEMA"""|"""PIPE"""`, func(id string) error { _, err := pipeIDFromString(id); return err }},
+		{"row_access_policy", RowAccessPolicy(), `"""DB"""|"""SCHEMA"""|"""RAP"""`, func(id string) error { _, err := rowAccessPolicyIDFromString(id); return err }},
+		{"schema", Schema(), `"""DB"""|"""SCHEMA"""`, func(id string) error { _, err := schemaIDFromString(id); return err }},
+		{"stage", Stage(), `"""DB"""|"""SCHEMA"""|"""STAGE"""`, func(id string) error { _, err := stageIDFromString(id); return err }},
+		{"stream", Stream(), `"""DB"""|"""SCHEMA"""|"""STREAM"""`, func(id string) error { _, err := streamIDFromString(id); return err }},
+		{"table", Table(), `"""DB"""|"""SCHEMA"""|"""TABLE"""`, func(id string) error { _, err := tableIDFromString(id); return err }},
+		{"tag", Tag(), `"""DB"""|"""SCHEMA"""|"""TAG"""`, func(id string) error { _, err := tagIDFromString(id); return err }},
+		{"task", Task(), `"""DB"""|"""SCHEMA"""|"""TASK"""`, func(id string) error { _, err := taskIDFromString(id); return err }},
+		{"view", View(), `"DB"|"SCHEMA"|"VIEW"`, func(id string) error { _, _, _, err := splitViewID(id); return err }},
+		{"function", Function(), `"DB"|"SCHEMA"|"FN"|VARCHAR`, func(id string) error { _, err := splitFunctionID(id); return err }},
+		{"procedure", Procedure(), `"DB"|"SCHEMA"|"PROC"|VARCHAR`, func(id string) error { _, err := splitProcedureID(id); return err }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+			r.Equal(1, tt.resource.SchemaVersion)
+			r.Len(tt.resource.StateUpgraders, 1)
+
+			state, err := tt.resource.StateUpgraders[0].Upgrade(context.Background(), map[string]interface{}{"id": tt.id, "name": `"NAME"`}, nil)
+			r.NoError(err)
+			r.NoError(tt.parse(state["id"].(string)))
+			r.Equal("NAME", state["name"])
+		})
+	}
+}
+
+func TestStateUpgraderV0(t *testing.T) {
+	r := require.New(t)
+
+	upgrader := stateUpgraderV0(databaseGrantSchema, upgradeGrantIDV0)
+	r.Equal(0, upgrader.Version)
+	r.True(upgrader.Type.IsObjectType())
+	r.True(upgrader.Type.HasAttribute("id"))
+	r.True(upgrader.Type.HasAttribute("database_name"))
+}
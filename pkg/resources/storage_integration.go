@@ -1,12 +1,14 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -105,20 +107,30 @@ var storageIntegrationSchema = map[string]*schema.Schema{
 // StorageIntegration returns a pointer to the resource representing a storage integration
 func StorageIntegration() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateStorageIntegration,
-		Read:   ReadStorageIntegration,
-		Update: UpdateStorageIntegration,
-		Delete: DeleteStorageIntegration,
-
-		Schema: storageIntegrationSchema,
+		CreateContext: CreateStorageIntegration,
+		ReadContext:   ReadStorageIntegration,
+		UpdateContext: UpdateStorageIntegration,
+		DeleteContext: DeleteStorageIntegration,
+
+		Schema:        storageIntegrationSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(storageIntegrationSchema, upgradeUnchangedV0),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 	}
 }
 
-// CreateStorageIntegration implements schema.CreateFunc
-func CreateStorageIntegration(d *schema.ResourceData, meta interface{}) error {
+// CreateStorageIntegration implements schema.CreateContextFunc
+func CreateStorageIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 
@@ -146,26 +158,26 @@ func CreateStorageIntegration(d *schema.ResourceData, meta interface{}) error {
 	// Now, set the storage provider
 	err := setStorageProviderSettings(d, stmt)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = snowflake.Exec(db, stmt.Statement())
+	err = snowflake.ExecContext(ctx, db, stmt.Statement())
 	if err != nil {
-		return fmt.Errorf("error creating storage integration: %w", err)
+		return diag.FromErr(fmt.Errorf("error creating storage integration: %w", err))
 	}
 
 	d.SetId(name)
 
-	return ReadStorageIntegration(d, meta)
+	return ReadStorageIntegration(ctx, d, meta)
 }
 
-// ReadStorageIntegration implements schema.ReadFunc
-func ReadStorageIntegration(d *schema.ResourceData, meta interface{}) error {
+// ReadStorageIntegration implements schema.ReadContextFunc
+func ReadStorageIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	id := d.Id()
 
 	stmt := snowflake.StorageIntegration(d.Id()).Show()
-	row := snowflake.QueryRow(db, stmt)
+	row := snowflake.QueryRowContext(ctx, db, stmt)
 
 	// Some properties can come from the SHOW INTEGRATION call
 
@@ -177,28 +189,28 @@ func ReadStorageIntegration(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Could not show storage integration: %w", err)
+		return diag.FromErr(fmt.Errorf("Could not show storage integration: %w", err))
 	}
 
 	// Note: category must be STORAGE or something is broken
 	if c := s.Category.String; c != "STORAGE" {
-		return fmt.Errorf("Expected %v to be a STORAGE integration, got %v", id, c)
+		return diag.FromErr(fmt.Errorf("Expected %v to be a STORAGE integration, got %v", id, c))
 	}
 
 	if err := d.Set("name", s.Name.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("type", s.IntegrationType.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("created_on", s.CreatedOn.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("enabled", s.Enabled.Bool); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Some properties come from the DESCRIBE INTEGRATION call
@@ -206,14 +218,14 @@ func ReadStorageIntegration(d *schema.ResourceData, meta interface{}) error {
 	var k, pType string
 	var v, unused interface{}
 	stmt = snowflake.StorageIntegration(d.Id()).Describe()
-	rows, err := db.Query(stmt)
+	rows, err := db.QueryContext(ctx, stmt)
 	if err != nil {
-		return fmt.Errorf("Could not describe storage integration: %w", err)
+		return diag.FromErr(fmt.Errorf("Could not describe storage integration: %w", err))
 	}
 	defer rows.Close()
 	for rows.Next() {
 		if err := rows.Scan(&k, &pType, &v, &unused); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		switch k {
 		case "ENABLED":
@@ -221,63 +233,63 @@ func ReadStorageIntegration(d *schema.ResourceData, meta interface{}) error {
 		case "COMMENT":
 			if val := v.(string); val != "" {
 				if err = d.Set("comment", v.(string)); err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		case "STORAGE_PROVIDER":
 			if err = d.Set("storage_provider", v.(string)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "STORAGE_ALLOWED_LOCATIONS":
 			if err = d.Set("storage_allowed_locations", strings.Split(v.(string), ",")); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "STORAGE_BLOCKED_LOCATIONS":
 			if val := v.(string); val != "" {
 				if err = d.Set("storage_blocked_locations", strings.Split(val, ",")); err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		case "STORAGE_AWS_IAM_USER_ARN":
 			if err = d.Set("storage_aws_iam_user_arn", v.(string)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "STORAGE_AWS_OBJECT_ACL":
 			if val := v.(string); val != "" {
 				if err = d.Set("storage_aws_object_acl", v.(string)); err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		case "STORAGE_AWS_ROLE_ARN":
 			if err = d.Set("storage_aws_role_arn", v.(string)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "STORAGE_AWS_EXTERNAL_ID":
 			if err = d.Set("storage_aws_external_id", v.(string)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "STORAGE_GCP_SERVICE_ACCOUNT":
 			if err = d.Set("storage_gcp_service_account", v.(string)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "AZURE_CONSENT_URL":
 			if err = d.Set("azure_consent_url", v.(string)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "AZURE_MULTI_TENANT_APP_NAME":
 			if err = d.Set("azure_multi_tenant_app_name", v.(string)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		default:
 			log.Printf("[WARN] unexpected property %v returned from Snowflake", k)
 		}
 	}
 
-	return err
+	return diag.FromErr(err)
 }
 
-// UpdateStorageIntegration implements schema.UpdateFunc
-func UpdateStorageIntegration(d *schema.ResourceData, meta interface{}) error {
+// UpdateStorageIntegration implements schema.UpdateContextFunc
+func UpdateStorageIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	id := d.Id()
 
@@ -309,9 +321,9 @@ func UpdateStorageIntegration(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("storage_blocked_locations") {
 		v := d.Get("storage_blocked_locations").([]interface{})
 		if len(v) == 0 {
-			err := unsetStorageIntegrationProp(db, d.Id(), "STORAGE_BLOCKED_LOCATIONS")
+			err := unsetStorageIntegrationProp(ctx, db, d.Id(), "STORAGE_BLOCKED_LOCATIONS")
 			if err != nil {
-				return fmt.Errorf("error unsetting storage_blocked_locations: %w", err)
+				return diag.FromErr(fmt.Errorf("error unsetting storage_blocked_locations: %w", err))
 			}
 		} else {
 			runSetStatement = true
@@ -322,14 +334,14 @@ func UpdateStorageIntegration(d *schema.ResourceData, meta interface{}) error {
 	// also need to UNSET STORAGE_AWS_OBJECT_ACL if removed
 	if d.HasChange("storage_aws_object_acl") {
 		if _, ok := d.GetOk("storage_aws_object_acl"); ok {
-			err := setStorageIntegrationProp(db, d.Id(), "STORAGE_AWS_OBJECT_ACL", "bucket-owner-full-control")
+			err := setStorageIntegrationProp(ctx, db, d.Id(), "STORAGE_AWS_OBJECT_ACL", "bucket-owner-full-control")
 			if err != nil {
-				return fmt.Errorf("error setting storage_aws_object_acl: %w", err)
+				return diag.FromErr(fmt.Errorf("error setting storage_aws_object_acl: %w", err))
 			}
 		} else {
-			err := unsetStorageIntegrationProp(db, d.Id(), "STORAGE_AWS_OBJECT_ACL")
+			err := unsetStorageIntegrationProp(ctx, db, d.Id(), "STORAGE_AWS_OBJECT_ACL")
 			if err != nil {
-				return fmt.Errorf("error unsetting storage_aws_object_acl: %w", err)
+				return diag.FromErr(fmt.Errorf("error unsetting storage_aws_object_acl: %w", err))
 			}
 		}
 	}
@@ -338,7 +350,7 @@ func UpdateStorageIntegration(d *schema.ResourceData, meta interface{}) error {
 		runSetStatement = true
 		err := setStorageProviderSettings(d, stmt)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		if d.HasChange("storage_aws_role_arn") {
@@ -356,17 +368,17 @@ func UpdateStorageIntegration(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if runSetStatement {
-		if err := snowflake.Exec(db, stmt.Statement()); err != nil {
-			return fmt.Errorf("error updating storage integration: %w", err)
+		if err := snowflake.ExecContext(ctx, db, stmt.Statement()); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating storage integration: %w", err))
 		}
 	}
 
-	return ReadStorageIntegration(d, meta)
+	return ReadStorageIntegration(ctx, d, meta)
 }
 
-// DeleteStorageIntegration implements schema.DeleteFunc
-func DeleteStorageIntegration(d *schema.ResourceData, meta interface{}) error {
-	return DeleteResource("", snowflake.StorageIntegration)(d, meta)
+// DeleteStorageIntegration implements schema.DeleteContextFunc
+func DeleteStorageIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return DeleteResource("", snowflake.StorageIntegration)(ctx, d, meta)
 }
 
 // StorageIntegrationExists implements schema.ExistsFunc
@@ -413,12 +425,12 @@ func setStorageProviderSettings(data *schema.ResourceData, stmt snowflake.Settin
 	return nil
 }
 
-func setStorageIntegrationProp(db *sql.DB, name string, prop string, val string) error {
-	stmt := fmt.Sprintf(`ALTER STORAGE INTEGRATION "%s" SET %s = '%s'`, name, prop, val)
-	return snowflake.Exec(db, stmt)
+func setStorageIntegrationProp(ctx context.Context, db *sql.DB, name string, prop string, val string) error {
+	stmt := fmt.Sprintf(`ALTER STORAGE INTEGRATION %v SET %s = '%s'`, snowflake.QuoteIdentifier(name), prop, val)
+	return snowflake.ExecContext(ctx, db, stmt)
 }
 
-func unsetStorageIntegrationProp(db *sql.DB, name string, prop string) error {
-	stmt := fmt.Sprintf(`ALTER STORAGE INTEGRATION "%s" UNSET %s`, name, prop)
-	return snowflake.Exec(db, stmt)
+func unsetStorageIntegrationProp(ctx context.Context, db *sql.DB, name string, prop string) error {
+	stmt := fmt.Sprintf(`ALTER STORAGE INTEGRATION %v UNSET %s`, snowflake.QuoteIdentifier(name), prop)
+	return snowflake.ExecContext(ctx, db, stmt)
 }
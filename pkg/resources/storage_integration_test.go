@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -38,8 +39,8 @@ func TestStorageIntegrationCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadStorageIntegration(mock)
 
-		err := resources.CreateStorageIntegration(d, db)
-		r.NoError(err)
+		diags := resources.CreateStorageIntegration(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -51,8 +52,8 @@ func TestStorageIntegrationRead(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadStorageIntegration(mock)
 
-		err := resources.ReadStorageIntegration(d, db)
-		r.NoError(err)
+		diags := resources.ReadStorageIntegration(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -64,8 +65,8 @@ func TestStorageIntegrationReadEmpty(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadStorageIntegrationEmpty(mock)
 
-		err := resources.ReadStorageIntegration(d, db)
-		r.Nil(err)
+		diags := resources.ReadStorageIntegration(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -94,8 +95,8 @@ func TestStorageIntegrationUpdate(t *testing.T) {
 		mock.ExpectQuery(`^SHOW STORAGE INTEGRATIONS LIKE 'test_storage_integration_acl'$`).WillReturnRows(showRows)
 		mock.ExpectQuery(`DESCRIBE STORAGE INTEGRATION "test_storage_integration_acl"$`).WillReturnRows(descRows)
 
-		err := resources.UpdateStorageIntegration(d, db)
-		r.NoError(err)
+		diags := resources.UpdateStorageIntegration(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -106,8 +107,8 @@ func TestStorageIntegrationDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP STORAGE INTEGRATION "drop_it"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteStorageIntegration(d, db)
-		r.NoError(err)
+		diags := resources.DeleteStorageIntegration(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -2,6 +2,7 @@ package resources
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/csv"
 	"fmt"
@@ -9,6 +10,8 @@ import (
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -43,11 +46,35 @@ var streamSchema = map[string]*schema.Schema{
 		Description: "Specifies a comment for the stream.",
 	},
 	"on_table": {
-		Type:        schema.TypeString,
-		Optional:    true,
-		ForceNew:    true,
-		Description: "Name of the table the stream will monitor.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Description:  "Name of the table the stream will monitor.",
+		ExactlyOneOf: streamSources,
+	},
+	"on_view": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Description:  "Name of the view the stream will monitor.",
+		ExactlyOneOf: streamSources,
 	},
+	"on_external_table": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Description:  "Name of the external table the stream will monitor; such streams must be insert_only.",
+		ExactlyOneOf: streamSources,
+	},
+	"on_stage": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Description:  "Name of the stage whose directory table the stream will monitor.",
+		ExactlyOneOf: streamSources,
+	},
+	"at":     streamOffsetSchema("at", "Specifies the point the stream starts tracking changes from, including changes made at that point.", "before"),
+	"before": streamOffsetSchema("before", "Specifies the point the stream starts tracking changes from, excluding changes made at that point.", "at"),
 	"append_only": {
 		Type:        schema.TypeBool,
 		Optional:    true,
@@ -74,19 +101,116 @@ var streamSchema = map[string]*schema.Schema{
 		Computed:    true,
 		Description: "Name of the role that owns the stream.",
 	},
+	"stale": {
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "Whether the stream has become stale, i.e. its offset is outside the data retention period of its source.",
+	},
+	"stale_after": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The time the stream will become stale unless it is consumed before then.",
+	},
+}
+
+// streamSources are the attributes naming the object a stream monitors, by the
+// source_type SHOW STREAMS reports for it
+var streamSources = []string{"on_table", "on_view", "on_external_table", "on_stage"}
+
+var streamSourceTypes = map[string]string{
+	"table":          "on_table",
+	"view":           "on_view",
+	"external table": "on_external_table",
+	"stage":          "on_stage",
+}
+
+func streamOffsetSchema(key, description, conflictsWith string) *schema.Schema {
+	exactlyOneOf := []string{key + ".0.timestamp", key + ".0.offset", key + ".0.statement", key + ".0.stream"}
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		ForceNew:      true,
+		MaxItems:      1,
+		Description:   description + " Only used when creating the stream and not read back, so adding it to a stream created or imported without it does not replace the stream.",
+		ConflictsWith: []string{conflictsWith},
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			o, _ := d.GetChange(key)
+			return d.Id() != "" && len(o.([]interface{})) == 0
+		},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"timestamp": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					Description:  "A timestamp, e.g. `2022-01-01 00:00:00 -0700`.",
+					ExactlyOneOf: exactlyOneOf,
+				},
+				"offset": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					Description:  "A difference in seconds from the current time, e.g. `-3600`.",
+					ExactlyOneOf: exactlyOneOf,
+				},
+				"statement": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					Description:  "The query ID of a statement.",
+					ExactlyOneOf: exactlyOneOf,
+				},
+				"stream": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					Description:  "The name of a stream whose current offset is used.",
+					ExactlyOneOf: exactlyOneOf,
+				},
+			},
+		},
+	}
+}
+
+// expandStreamOffset returns the offset configured in the at or before block, if any
+func expandStreamOffset(d *schema.ResourceData, key string) *snowflake.StreamOffset {
+	offsets := d.Get(key).([]interface{})
+	if len(offsets) == 0 || offsets[0] == nil {
+		return nil
+	}
+	o := offsets[0].(map[string]interface{})
+	return &snowflake.StreamOffset{
+		Timestamp: o["timestamp"].(string),
+		Offset:    o["offset"].(int),
+		Statement: o["statement"].(string),
+		Stream:    o["stream"].(string),
+	}
 }
 
 func Stream() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateStream,
-		Read:   ReadStream,
-		Update: UpdateStream,
-		Delete: DeleteStream,
-
-		Schema: streamSchema,
+		CreateContext: CreateStream,
+		ReadContext:   ReadStream,
+		UpdateContext: UpdateStream,
+		DeleteContext: DeleteStream,
+
+		Schema:        streamSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(streamSchema, upgradeQuotedNamesV0("database", "schema", "name")),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customdiff.All(
+			trueWith("insert_only", "on_external_table"),
+		),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 	}
 }
 
@@ -134,10 +258,15 @@ func streamIDFromString(stringID string) (*streamID, error) {
 		return nil, fmt.Errorf("3 fields allowed")
 	}
 
+	names, err := objectNames(lines[0][:3])
+	if err != nil {
+		return nil, err
+	}
+
 	streamResult := &streamID{
-		DatabaseName: lines[0][0],
-		SchemaName:   lines[0][1],
-		StreamName:   lines[0][2],
+		DatabaseName: names[0].Name(),
+		SchemaName:   names[1].Name(),
+		StreamName:   names[2].Name(),
 	}
 	return streamResult, nil
 }
@@ -145,6 +274,12 @@ func streamIDFromString(stringID string) (*streamID, error) {
 // streamOnTableIDFromString() takes in a dot-delimited string: DatabaseName.SchemaName.TableName
 // and returns a streamOnTableID object
 func streamOnTableIDFromString(stringID string) (*streamOnTableID, error) {
+	return streamSourceIDFromString("on_table", "target_table_name", stringID)
+}
+
+// streamSourceIDFromString() takes in a dot-delimited string: DatabaseName.SchemaName.ObjectName
+// naming the object set in key and returns a streamOnTableID object
+func streamSourceIDFromString(key, objectName, stringID string) (*streamOnTableID, error) {
 	reader := csv.NewReader(strings.NewReader(stringID))
 	reader.Comma = streamOnTableIDDelimiter
 	lines, err := reader.ReadAll()
@@ -156,8 +291,7 @@ func streamOnTableIDFromString(stringID string) (*streamOnTableID, error) {
 		return nil, fmt.Errorf("1 line at a time")
 	}
 	if len(lines[0]) != 3 {
-		//return nil, fmt.Errorf("on table format: database_name.schema_name.target_table_name")
-		return nil, fmt.Errorf("invalid format for on_table: %v , expected: <database_name.schema_name.target_table_name>", strings.Join(lines[0], "."))
+		return nil, fmt.Errorf("invalid format for %v: %v , expected: <database_name.schema_name.%v>", key, strings.Join(lines[0], "."), objectName)
 	}
 
 	streamOnTableResult := &streamOnTableID{
@@ -168,25 +302,47 @@ func streamOnTableIDFromString(stringID string) (*streamOnTableID, error) {
 	return streamOnTableResult, nil
 }
 
-// CreateStream implements schema.CreateFunc
-func CreateStream(d *schema.ResourceData, meta interface{}) error {
+// CreateStream implements schema.CreateContextFunc
+func CreateStream(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	database := d.Get("database").(string)
 	schema := d.Get("schema").(string)
 	name := d.Get("name").(string)
-	onTable := d.Get("on_table").(string)
 	appendOnly := d.Get("append_only").(bool)
 	insertOnly := d.Get("insert_only").(bool)
 	showInitialRows := d.Get("show_initial_rows").(bool)
 
 	builder := snowflake.Stream(name, database, schema)
 
-	resultOnTable, err := streamOnTableIDFromString(onTable)
-	if err != nil {
-		return err
+	switch {
+	case d.Get("on_view").(string) != "":
+		source, err := streamSourceIDFromString("on_view", "view_name", d.Get("on_view").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		builder.WithOnView(source.DatabaseName, source.SchemaName, source.OnTableName)
+	case d.Get("on_external_table").(string) != "":
+		source, err := streamSourceIDFromString("on_external_table", "external_table_name", d.Get("on_external_table").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		builder.WithOnExternalTable(source.DatabaseName, source.SchemaName, source.OnTableName)
+	case d.Get("on_stage").(string) != "":
+		source, err := streamSourceIDFromString("on_stage", "stage_name", d.Get("on_stage").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		builder.WithOnStage(source.DatabaseName, source.SchemaName, source.OnTableName)
+	default:
+		source, err := streamOnTableIDFromString(d.Get("on_table").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		builder.WithOnTable(source.DatabaseName, source.SchemaName, source.OnTableName)
 	}
 
-	builder.WithOnTable(resultOnTable.DatabaseName, resultOnTable.SchemaName, resultOnTable.OnTableName)
+	builder.WithAt(expandStreamOffset(d, "at"))
+	builder.WithBefore(expandStreamOffset(d, "before"))
 	builder.WithAppendOnly(appendOnly)
 	builder.WithInsertOnly(insertOnly)
 	builder.WithShowInitialRows(showInitialRows)
@@ -197,9 +353,9 @@ func CreateStream(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	stmt := builder.Create()
-	err = snowflake.Exec(db, stmt)
+	err := snowflake.ExecContext(ctx, db, stmt)
 	if err != nil {
-		return errors.Wrapf(err, "error creating stream %v", name)
+		return diag.FromErr(errors.Wrapf(err, "error creating stream %v", name))
 	}
 
 	streamID := &streamID{
@@ -209,19 +365,19 @@ func CreateStream(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := streamID.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadStream(d, meta)
+	return ReadStream(ctx, d, meta)
 }
 
-// ReadStream implements schema.ReadFunc
-func ReadStream(d *schema.ResourceData, meta interface{}) error {
+// ReadStream implements schema.ReadContextFunc
+func ReadStream(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	streamID, err := streamIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := streamID.DatabaseName
@@ -229,7 +385,7 @@ func ReadStream(d *schema.ResourceData, meta interface{}) error {
 	name := streamID.StreamName
 
 	stmt := snowflake.Stream(name, dbName, schema).Show()
-	row := snowflake.QueryRow(db, stmt)
+	row := snowflake.QueryRowContext(ctx, db, stmt)
 	stream, err := snowflake.ScanStream(row)
 	if err == sql.ErrNoRows {
 		// If not found, mark resource to be removed from statefile during apply or refresh
@@ -238,63 +394,84 @@ func ReadStream(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("name", stream.StreamName.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("database", stream.DatabaseName.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("schema", stream.SchemaName.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = d.Set("on_table", stream.TableName.String)
-	if err != nil {
-		return err
+	// streams created before SHOW STREAMS reported a source_type are on tables
+	sourceKey := "on_table"
+	if key, ok := streamSourceTypes[strings.ToLower(stream.SourceType.String)]; ok {
+		sourceKey = key
+	}
+	for _, key := range streamSources {
+		source := ""
+		if key == sourceKey {
+			source = stream.TableName.String
+		}
+		err = d.Set(key, source)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	err = d.Set("append_only", stream.Mode.String == "APPEND_ONLY")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = d.Set("insert_only", stream.InsertOnly)
+	err = d.Set("insert_only", stream.InsertOnly || stream.Mode.String == "INSERT_ONLY")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("show_initial_rows", stream.ShowInitialRows)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("comment", stream.Comment.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("owner", stream.Owner.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("stale", strings.EqualFold(stream.Stale.String, "true"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("stale_after", stream.StaleAfter.String)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-// DeleteStream implements schema.DeleteFunc
-func DeleteStream(d *schema.ResourceData, meta interface{}) error {
+// DeleteStream implements schema.DeleteContextFunc
+func DeleteStream(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	streamID, err := streamIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := streamID.DatabaseName
@@ -303,9 +480,9 @@ func DeleteStream(d *schema.ResourceData, meta interface{}) error {
 
 	q := snowflake.Stream(streamName, dbName, schema).Drop()
 
-	err = snowflake.Exec(db, q)
+	err = snowflake.ExecContext(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error deleting stream %v", d.Id())
+		return diag.FromErr(errors.Wrapf(err, "error deleting stream %v", d.Id()))
 	}
 
 	d.SetId("")
@@ -313,11 +490,11 @@ func DeleteStream(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-// UpdateStream implements schema.UpdateFunc
-func UpdateStream(d *schema.ResourceData, meta interface{}) error {
+// UpdateStream implements schema.UpdateContextFunc
+func UpdateStream(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	streamID, err := streamIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := streamID.DatabaseName
@@ -330,11 +507,11 @@ func UpdateStream(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("comment") {
 		comment := d.Get("comment")
 		q := builder.ChangeComment(comment.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating stream comment on %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating stream comment on %v", d.Id()))
 		}
 	}
 
-	return ReadStream(d, meta)
+	return ReadStream(ctx, d, meta)
 }
@@ -26,6 +26,10 @@ func TestAcc_Stream(t *testing.T) {
 					checkBool("snowflake_stream.test_stream", "append_only", false),
 					checkBool("snowflake_stream.test_stream", "insert_only", false),
 					checkBool("snowflake_stream.test_stream", "show_initial_rows", false),
+					resource.TestCheckResourceAttr("snowflake_stream.test_stream_on_view", "on_view", fmt.Sprintf("%s.%s.%s", accName, accName, "STREAM_ON_VIEW")),
+					resource.TestCheckResourceAttr("snowflake_stream.test_stream_on_view", "on_table", ""),
+					checkBool("snowflake_stream.test_stream_on_view", "stale", false),
+					resource.TestCheckResourceAttrSet("snowflake_stream.test_stream_on_view", "stale_after"),
 				),
 			},
 			{
@@ -84,6 +88,20 @@ resource "snowflake_table" "test_stream_on_table" {
 	}
 }
 
+resource "snowflake_view" "test_stream_on_view" {
+	database  = snowflake_database.test_database.name
+	schema    = snowflake_schema.test_schema.name
+	name      = "STREAM_ON_VIEW"
+	statement = "SELECT * FROM \"${snowflake_database.test_database.name}\".\"${snowflake_schema.test_schema.name}\".\"${snowflake_table.test_stream_on_table.name}\""
+}
+
+resource "snowflake_stream" "test_stream_on_view" {
+	database = snowflake_database.test_database.name
+	schema   = snowflake_schema.test_schema.name
+	name     = "%s_ON_VIEW"
+	on_view  = "${snowflake_database.test_database.name}.${snowflake_schema.test_schema.name}.${snowflake_view.test_stream_on_view.name}"
+}
+
 resource "snowflake_stream" "test_stream" {
 	database    = snowflake_database.test_database.name
 	schema      = snowflake_schema.test_schema.name
@@ -93,5 +111,5 @@ resource "snowflake_stream" "test_stream" {
 	%s
 }
 `
-	return fmt.Sprintf(s, name, name, name, append_only_config)
+	return fmt.Sprintf(s, name, name, name, name, append_only_config)
 }
@@ -1,8 +1,11 @@
 package resources
 
 import (
+	"context"
+
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -66,21 +69,30 @@ var streamGrantSchema = map[string]*schema.Schema{
 func StreamGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create: CreateStreamGrant,
-			Read:   ReadStreamGrant,
-			Delete: DeleteStreamGrant,
-
-			Schema: streamGrantSchema,
+			CreateContext: CreateStreamGrant,
+			ReadContext:   ReadStreamGrant,
+			DeleteContext: DeleteStreamGrant,
+
+			Schema:        streamGrantSchema,
+			SchemaVersion: 1,
+			StateUpgraders: []schema.StateUpgrader{
+				stateUpgraderV0(streamGrantSchema, upgradeGrantIDV0),
+			},
 			Importer: &schema.ResourceImporter{
 				StateContext: schema.ImportStatePassthroughContext,
 			},
+			Timeouts: &schema.ResourceTimeout{
+				Create: schema.DefaultTimeout(defaultTimeout),
+				Read:   schema.DefaultTimeout(defaultTimeout),
+				Delete: schema.DefaultTimeout(defaultTimeout),
+			},
 		},
 		ValidPrivs: validStreamPrivileges,
 	}
 }
 
-// CreateStreamGrant implements schema.CreateFunc
-func CreateStreamGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateStreamGrant implements schema.CreateContextFunc
+func CreateStreamGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var streamName string
 	if name, ok := d.GetOk("stream_name"); ok {
 		streamName = name.(string)
@@ -93,10 +105,10 @@ func CreateStreamGrant(d *schema.ResourceData, meta interface{}) error {
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 
 	if (streamName == "") && !futureStreams {
-		return errors.New("stream_name must be set unless on_future is true.")
+		return diag.FromErr(errors.New("stream_name must be set unless on_future is true."))
 	}
 	if (streamName != "") && futureStreams {
-		return errors.New("stream_name must be empty if on_future is true.")
+		return diag.FromErr(errors.New("stream_name must be empty if on_future is true."))
 	}
 
 	var builder snowflake.GrantBuilder
@@ -106,9 +118,9 @@ func CreateStreamGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.StreamGrant(dbName, schemaName, streamName)
 	}
 
-	err := createGenericGrant(d, meta, builder)
+	err := createGenericGrant(ctx, d, meta, builder)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grant := &grantID{
@@ -121,18 +133,18 @@ func CreateStreamGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := grant.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadStreamGrant(d, meta)
+	return ReadStreamGrant(ctx, d, meta)
 }
 
-// ReadStreamGrant implements schema.ReadFunc
-func ReadStreamGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadStreamGrant implements schema.ReadContextFunc
+func ReadStreamGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -141,11 +153,11 @@ func ReadStreamGrant(d *schema.ResourceData, meta interface{}) error {
 
 	err = d.Set("database_name", dbName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("schema_name", schemaName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	futureStreamsEnabled := false
 	if streamName == "" {
@@ -153,19 +165,19 @@ func ReadStreamGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	err = d.Set("stream_name", streamName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("on_future", futureStreamsEnabled)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("privilege", priv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("with_grant_option", grantID.GrantOption)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var builder snowflake.GrantBuilder
@@ -175,14 +187,14 @@ func ReadStreamGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.StreamGrant(dbName, schemaName, streamName)
 	}
 
-	return readGenericGrant(d, meta, streamGrantSchema, builder, futureStreamsEnabled, validStreamPrivileges)
+	return diag.FromErr(readGenericGrant(ctx, d, meta, streamGrantSchema, builder, futureStreamsEnabled, validStreamPrivileges))
 }
 
-// DeleteStreamGrant implements schema.DeleteFunc
-func DeleteStreamGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteStreamGrant implements schema.DeleteContextFunc
+func DeleteStreamGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -196,5 +208,5 @@ func DeleteStreamGrant(d *schema.ResourceData, meta interface{}) error {
 	} else {
 		builder = snowflake.StreamGrant(dbName, schemaName, streamName)
 	}
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -36,12 +37,87 @@ func TestStreamCreate(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`CREATE STREAM "database_name"."schema_name"."stream_name" ON TABLE "target_db"."target_schema"."target_table" COMMENT = 'great comment' APPEND_ONLY = true INSERT_ONLY = false SHOW_INITIAL_ROWS = true`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectStreamRead(mock)
-		err := resources.CreateStream(d, db)
-		r.NoError(err)
+		diags := resources.CreateStream(context.Background(), d, db)
+		r.Empty(diags)
 		r.Equal("stream_name", d.Get("name").(string))
 	})
 }
 
+func TestStreamCreateOnStage(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":     "stream_name",
+		"database": "database_name",
+		"schema":   "schema_name",
+		"on_stage": "target_db.target_schema.target_stage",
+		"at":       []interface{}{map[string]interface{}{"offset": -60}},
+	}
+	d := stream(t, "database_name|schema_name|stream_name", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`CREATE STREAM "database_name"."schema_name"."stream_name" ON STAGE "target_db"."target_schema"."target_stage" AT \(OFFSET => -60\)$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		rows := sqlmock.NewRows([]string{"name", "database_name", "schema_name", "owner", "comment", "table_name", "source_type", "type", "stale", "mode", "stale_after"}).AddRow("stream_name", "database_name", "schema_name", "owner_name", "", "TARGET_DB.TARGET_SCHEMA.TARGET_STAGE", "Stage", "DELTA", "false", "DEFAULT", "2022-01-15 00:00:00.000 -0800")
+		mock.ExpectQuery(`SHOW STREAMS LIKE 'stream_name' IN SCHEMA "database_name"."schema_name"`).WillReturnRows(rows)
+		diags := resources.CreateStream(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("TARGET_DB.TARGET_SCHEMA.TARGET_STAGE", d.Get("on_stage").(string))
+		r.Equal("", d.Get("on_table").(string))
+		r.Equal(false, d.Get("stale").(bool))
+		r.Equal("2022-01-15 00:00:00.000 -0800", d.Get("stale_after").(string))
+		r.Equal(-60, d.Get("at.0.offset").(int))
+	})
+}
+
+func TestStreamCustomizeDiff(t *testing.T) {
+	r := require.New(t)
+
+	err := planDiff(resources.Stream(), map[string]interface{}{
+		"name":              "stream_name",
+		"database":          "database_name",
+		"schema":            "schema_name",
+		"on_external_table": "target_db.target_schema.target_table",
+		"insert_only":       true,
+	})
+	r.NoError(err)
+
+	err = planDiff(resources.Stream(), map[string]interface{}{
+		"name":              "stream_name",
+		"database":          "database_name",
+		"schema":            "schema_name",
+		"on_external_table": "target_db.target_schema.target_table",
+	})
+	r.Error(err)
+	r.Contains(err.Error(), "insert_only must be true when on_external_table is set")
+}
+
+func TestStreamPlanAfterImport(t *testing.T) {
+	r := require.New(t)
+
+	imported := map[string]interface{}{
+		"name":     "stream_name",
+		"database": "database_name",
+		"schema":   "schema_name",
+		"on_table": "target_db.target_schema.target_table",
+	}
+	config := map[string]interface{}{
+		"name":     "stream_name",
+		"database": "database_name",
+		"schema":   "schema_name",
+		"on_table": "target_db.target_schema.target_table",
+		"at": []interface{}{map[string]interface{}{
+			"offset": -3600,
+		}},
+	}
+	_, diff := planUpdateDiff(t, resources.Stream(), "database_name|schema_name|stream_name", imported, config)
+	r.True(diff == nil || diff.Empty())
+
+	// a stream created with an offset is still replaced when it changes
+	imported["at"] = []interface{}{map[string]interface{}{"offset": -60}}
+	_, diff = planUpdateDiff(t, resources.Stream(), "database_name|schema_name|stream_name", imported, config)
+	r.True(diff.RequiresNew())
+}
+
 func expectStreamRead(mock sqlmock.Sqlmock) {
 	rows := sqlmock.NewRows([]string{"name", "database_name", "schema_name", "owner", "comment", "table_name", "type", "stale", "mode"}).AddRow("stream_name", "database_name", "schema_name", "owner_name", "grand comment", "target_table", "DELTA", false, "APPEND_ONLY")
 	mock.ExpectQuery(`SHOW STREAMS LIKE 'stream_name' IN SCHEMA "database_name"."schema_name"`).WillReturnRows(rows)
@@ -54,8 +130,8 @@ func TestStreamRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectStreamRead(mock)
-		err := resources.ReadStream(d, db)
-		r.NoError(err)
+		diags := resources.ReadStream(context.Background(), d, db)
+		r.Empty(diags)
 		r.Equal("stream_name", d.Get("name").(string))
 		r.Equal("database_name", d.Get("database").(string))
 		r.Equal("schema_name", d.Get("schema").(string))
@@ -65,9 +141,26 @@ func TestStreamRead(t *testing.T) {
 		r.NotEmpty(d.State())
 		q := snowflake.Stream("stream_name", "database_name", "schema_name").Show()
 		mock.ExpectQuery(q).WillReturnError(sql.ErrNoRows)
-		err2 := resources.ReadStream(d, db)
+		diags2 := resources.ReadStream(context.Background(), d, db)
 		r.Empty(d.State())
-		r.Nil(err2)
+		r.Empty(diags2)
+	})
+}
+
+func TestStreamReadOnView(t *testing.T) {
+	r := require.New(t)
+
+	d := stream(t, "database_name|schema_name|stream_name", map[string]interface{}{"name": "stream_name", "on_table": "target_table"})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"name", "database_name", "schema_name", "owner", "comment", "table_name", "source_type", "type", "stale", "mode", "stale_after"}).AddRow("stream_name", "database_name", "schema_name", "owner_name", "", "DB.SCHEMA.TARGET_VIEW", "View", "DELTA", "true", "APPEND_ONLY", "2022-01-01 00:00:00.000 -0800")
+		mock.ExpectQuery(`SHOW STREAMS LIKE 'stream_name' IN SCHEMA "database_name"."schema_name"`).WillReturnRows(rows)
+		diags := resources.ReadStream(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("DB.SCHEMA.TARGET_VIEW", d.Get("on_view").(string))
+		r.Equal("", d.Get("on_table").(string))
+		r.Equal(true, d.Get("append_only").(bool))
+		r.Equal(true, d.Get("stale").(bool))
 	})
 }
 
@@ -79,8 +172,8 @@ func TestStreamReadAppendOnlyMode(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		rows := sqlmock.NewRows([]string{"name", "database_name", "schema_name", "owner", "comment", "table_name", "type", "stale", "mode"}).AddRow("stream_name", "database_name", "schema_name", "owner_name", "grand comment", "target_table", "DELTA", false, "APPEND_ONLY")
 		mock.ExpectQuery(`SHOW STREAMS LIKE 'stream_name' IN SCHEMA "database_name"."schema_name"`).WillReturnRows(rows)
-		err := resources.ReadStream(d, db)
-		r.NoError(err)
+		diags := resources.ReadStream(context.Background(), d, db)
+		r.Empty(diags)
 		r.Equal(true, d.Get("append_only").(bool))
 	})
 }
@@ -93,8 +186,8 @@ func TestStreamReadDefaultMode(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		rows := sqlmock.NewRows([]string{"name", "database_name", "schema_name", "owner", "comment", "table_name", "type", "stale", "mode"}).AddRow("stream_name", "database_name", "schema_name", "owner_name", "grand comment", "target_table", "DELTA", false, "DEFAULT")
 		mock.ExpectQuery(`SHOW STREAMS LIKE 'stream_name' IN SCHEMA "database_name"."schema_name"`).WillReturnRows(rows)
-		err := resources.ReadStream(d, db)
-		r.NoError(err)
+		diags := resources.ReadStream(context.Background(), d, db)
+		r.Empty(diags)
 		r.Equal(false, d.Get("append_only").(bool))
 	})
 }
@@ -106,8 +199,8 @@ func TestStreamDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP STREAM "database_name"."schema_name"."drop_it"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteStream(d, db)
-		r.NoError(err)
+		diags := resources.DeleteStream(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -129,7 +222,7 @@ func TestStreamUpdate(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`ALTER STREAM "database_name"."schema_name"."stream_name" SET COMMENT = 'new stream comment'`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectStreamRead(mock)
-		err := resources.UpdateStream(d, db)
-		r.NoError(err)
+		diags := resources.UpdateStream(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
@@ -2,6 +2,7 @@ package resources
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/csv"
 	"fmt"
@@ -9,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
@@ -127,6 +129,15 @@ var tableSchema = map[string]*schema.Schema{
 					Default:     "",
 					Description: "Column comment",
 				},
+				"masking_policy": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "The fully qualified name of the masking policy set on the column, e.g. `snowflake_masking_policy.policy.fully_qualified_name`. Only the columns specifying a masking policy are checked for drift, leaving the others to `snowflake_masking_policy_attachment`.",
+					ValidateFunc: func(val interface{}, key string) ([]string, []error) {
+						return snowflake.ValidateQualifiedName(val)
+					},
+				},
 			},
 		},
 	},
@@ -181,15 +192,25 @@ var tableSchema = map[string]*schema.Schema{
 
 func Table() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateTable,
-		Read:   ReadTable,
-		Update: UpdateTable,
-		Delete: DeleteTable,
-
-		Schema: tableSchema,
+		CreateContext: CreateTable,
+		ReadContext:   ReadTable,
+		UpdateContext: UpdateTable,
+		DeleteContext: DeleteTable,
+
+		Schema:        tableSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(tableSchema, upgradeQuotedNamesV0("database", "schema", "name")),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 	}
 }
 
@@ -231,10 +252,15 @@ func tableIDFromString(stringID string) (*tableID, error) {
 		return nil, fmt.Errorf("3 fields allowed")
 	}
 
+	names, err := objectNames(lines[0][:3])
+	if err != nil {
+		return nil, err
+	}
+
 	tableResult := &tableID{
-		DatabaseName: lines[0][0],
-		SchemaName:   lines[0][1],
-		TableName:    lines[0][2],
+		DatabaseName: names[0].Name(),
+		SchemaName:   names[1].Name(),
+		TableName:    names[2].Name(),
 	}
 	return tableResult, nil
 }
@@ -295,6 +321,8 @@ type column struct {
 	_default *columnDefault
 	identity *columnIdentity
 	comment  string
+	// maskingPolicy is the qualified name of the masking policy, empty if none
+	maskingPolicy string
 }
 
 func (c column) toSnowflakeColumn() snowflake.Column {
@@ -311,7 +339,8 @@ func (c column) toSnowflakeColumn() snowflake.Column {
 	return *sC.WithName(c.name).
 		WithType(c.dataType).
 		WithNullable(c.nullable).
-		WithComment(c.comment)
+		WithComment(c.comment).
+		WithMaskingPolicy(c.maskingPolicy)
 }
 
 type columns []column
@@ -332,13 +361,15 @@ type changedColumn struct {
 	changedNullConstraint bool
 	dropedDefault         bool
 	changedComment        bool
+	changedMaskingPolicy  bool
+	oldMaskingPolicy      string
 }
 
 func (old columns) getChangedColumnProperties(new columns) (changed changedColumns) {
 	changed = changedColumns{}
 	for _, cO := range old {
 		for _, cN := range new {
-			changeColumn := changedColumn{cN, false, false, false, false}
+			changeColumn := changedColumn{newColumn: cN}
 			if cO.name == cN.name && cO.dataType != cN.dataType {
 				changeColumn.changedDataType = true
 			}
@@ -353,6 +384,11 @@ func (old columns) getChangedColumnProperties(new columns) (changed changedColum
 				changeColumn.changedComment = true
 			}
 
+			if cO.name == cN.name && cO.maskingPolicy != cN.maskingPolicy {
+				changeColumn.changedMaskingPolicy = true
+				changeColumn.oldMaskingPolicy = cO.maskingPolicy
+			}
+
 			changed = append(changed, changeColumn)
 		}
 	}
@@ -418,12 +454,13 @@ func getColumn(from interface{}) (to column) {
 	}
 
 	return column{
-		name:     c["name"].(string),
-		dataType: c["type"].(string),
-		nullable: c["nullable"].(bool),
-		_default: cd,
-		identity: id,
-		comment:  c["comment"].(string),
+		name:          c["name"].(string),
+		dataType:      c["type"].(string),
+		nullable:      c["nullable"].(bool),
+		_default:      cd,
+		identity:      id,
+		comment:       c["comment"].(string),
+		maskingPolicy: c["masking_policy"].(string),
 	}
 }
 
@@ -459,8 +496,8 @@ func (pk primarykey) toSnowflakePrimaryKey() snowflake.PrimaryKey {
 
 }
 
-// CreateTable implements schema.CreateFunc
-func CreateTable(d *schema.ResourceData, meta interface{}) error {
+// CreateTable implements schema.CreateContextFunc
+func CreateTable(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	database := d.Get("database").(string)
 	schema := d.Get("schema").(string)
@@ -498,9 +535,9 @@ func CreateTable(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	stmt := builder.Create()
-	err := snowflake.Exec(db, stmt)
+	err := snowflake.ExecContext(ctx, db, stmt)
 	if err != nil {
-		return errors.Wrapf(err, "error creating table %v", name)
+		return diag.FromErr(errors.Wrapf(err, "error creating table %v", name))
 	}
 
 	tableID := &tableID{
@@ -510,23 +547,23 @@ func CreateTable(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := tableID.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadTable(d, meta)
+	return ReadTable(ctx, d, meta)
 }
 
-// ReadTable implements schema.ReadFunc
-func ReadTable(d *schema.ResourceData, meta interface{}) error {
+// ReadTable implements schema.ReadContextFunc
+func ReadTable(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	tableID, err := tableIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	builder := snowflake.Table(tableID.TableName, tableID.DatabaseName, tableID.SchemaName)
 
-	row := snowflake.QueryRow(db, builder.Show())
+	row := snowflake.QueryRowContext(ctx, db, builder.Show())
 	table, err := snowflake.ScanTable(row)
 	if err == sql.ErrNoRows {
 		// If not found, mark resource to be removed from statefile during apply or refresh
@@ -535,28 +572,34 @@ func ReadTable(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Describe the table to read the cols
-	tableDescriptionRows, err := snowflake.Query(db, builder.ShowColumns())
+	tableDescriptionRows, err := snowflake.QueryContext(ctx, db, builder.ShowColumns())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tableDescription, err := snowflake.ScanTableDescription(tableDescriptionRows)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	showPkrows, err := snowflake.Query(db, builder.ShowPrimaryKeys())
+	showPkrows, err := snowflake.QueryContext(ctx, db, builder.ShowPrimaryKeys())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	pkDescription, err := snowflake.ScanPrimaryKeyDescription(showPkrows)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
+	}
+
+	columns := snowflake.NewColumns(tableDescription).Flatten()
+	err = readColumnMaskingPolicies(ctx, db, builder, d, columns)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	// Set the relevant data in the state
@@ -566,7 +609,7 @@ func ReadTable(d *schema.ResourceData, meta interface{}) error {
 		"database":            tableID.DatabaseName,
 		"schema":              tableID.SchemaName,
 		"comment":             table.Comment.String,
-		"column":              snowflake.NewColumns(tableDescription).Flatten(),
+		"column":              columns,
 		"cluster_by":          snowflake.ClusterStatementToList(table.ClusterBy.String),
 		"primary_key":         snowflake.FlattenTablePrimaryKey(pkDescription),
 		"data_retention_days": table.RetentionTime.Int32,
@@ -576,17 +619,56 @@ func ReadTable(d *schema.ResourceData, meta interface{}) error {
 	for key, val := range toSet {
 		err = d.Set(key, val) //lintignore:R001
 		if err != nil {
-			return err
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+// readColumnMaskingPolicies reads the masking policies of the flattened
+// columns specifying one in the state. The policies of other columns are left
+// to masking policy attachments.
+func readColumnMaskingPolicies(ctx context.Context, db *sql.DB, builder *snowflake.TableBuilder, d *schema.ResourceData, flattened []interface{}) error {
+	tracked := map[string]string{}
+	for _, c := range getColumns(d.Get("column")) {
+		if c.maskingPolicy != "" {
+			tracked[c.name] = c.maskingPolicy
+		}
+	}
+	if len(tracked) == 0 {
+		return nil
+	}
+
+	refs, err := snowflake.ListPolicyReferences(ctx, builder.ShowPolicyReferences(), db)
+	if err != nil {
+		return errors.Wrapf(err, "error reading masking policies of table %v", d.Id())
+	}
+	for _, f := range flattened {
+		col := f.(map[string]interface{})
+		policy, ok := tracked[col["name"].(string)]
+		if !ok {
+			continue
+		}
+		col["masking_policy"] = ""
+		for _, ref := range refs {
+			if ref.PolicyKind.String != snowflake.MaskingPolicyKind || ref.RefColumnName.String != col["name"] {
+				continue
+			}
+			if ref.IsPolicy(policy) {
+				col["masking_policy"] = policy
+			} else {
+				col["masking_policy"] = ref.Policy()
+			}
 		}
 	}
 	return nil
 }
 
-// UpdateTable implements schema.UpdateFunc
-func UpdateTable(d *schema.ResourceData, meta interface{}) error {
+// UpdateTable implements schema.UpdateContextFunc
+func UpdateTable(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tableID, err := tableIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := tableID.DatabaseName
@@ -599,9 +681,9 @@ func UpdateTable(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("comment") {
 		comment := d.Get("comment")
 		q := builder.ChangeComment(comment.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating table comment on %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating table comment on %v", d.Id()))
 		}
 	}
 
@@ -616,9 +698,9 @@ func UpdateTable(d *schema.ResourceData, meta interface{}) error {
 			q = builder.DropClustering()
 		}
 
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating table clustering on %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating table clustering on %v", d.Id()))
 		}
 	}
 	if d.HasChange("column") {
@@ -626,9 +708,9 @@ func UpdateTable(d *schema.ResourceData, meta interface{}) error {
 		removed, added, changed := getColumns(old).diffs(getColumns(new))
 		for _, cA := range removed {
 			q := builder.DropColumn(cA.name)
-			err := snowflake.Exec(db, q)
+			err := snowflake.ExecContext(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error dropping column on %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error dropping column on %v", d.Id()))
 			}
 		}
 		for _, cA := range added {
@@ -640,15 +722,23 @@ func UpdateTable(d *schema.ResourceData, meta interface{}) error {
 				q = builder.AddColumn(cA.name, cA.dataType, cA.nullable, nil, cA.identity.toSnowflakeColumnIdentity(), cA.comment)
 			} else {
 				if cA._default._type() != "constant" {
-					return fmt.Errorf("Failed to add column %v => Only adding a column as a constant is supported by Snowflake", cA.name)
+					return diag.FromErr(fmt.Errorf("Failed to add column %v => Only adding a column as a constant is supported by Snowflake", cA.name))
 				}
 
 				q = builder.AddColumn(cA.name, cA.dataType, cA.nullable, cA._default.toSnowflakeColumnDefault(), nil, cA.comment)
 			}
 
-			err := snowflake.Exec(db, q)
+			err := snowflake.ExecContext(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error adding column on %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error adding column on %v", d.Id()))
+			}
+
+			if cA.maskingPolicy != "" {
+				q := builder.SetColumnMaskingPolicy(cA.name, cA.maskingPolicy, false)
+				err := snowflake.ExecContext(ctx, db, q)
+				if err != nil {
+					return diag.FromErr(errors.Wrapf(err, "error setting masking policy of column %v on %v", cA.name, d.Id()))
+				}
 			}
 		}
 		for _, cA := range changed {
@@ -656,37 +746,50 @@ func UpdateTable(d *schema.ResourceData, meta interface{}) error {
 			if cA.changedDataType {
 
 				q := builder.ChangeColumnType(cA.newColumn.name, cA.newColumn.dataType)
-				err := snowflake.Exec(db, q)
+				err := snowflake.ExecContext(ctx, db, q)
 				if err != nil {
-					return errors.Wrapf(err, "error changing property on %v", d.Id())
+					return diag.FromErr(errors.Wrapf(err, "error changing property on %v", d.Id()))
 
 				}
 			}
 			if cA.changedNullConstraint {
 
 				q := builder.ChangeNullConstraint(cA.newColumn.name, cA.newColumn.nullable)
-				err := snowflake.Exec(db, q)
+				err := snowflake.ExecContext(ctx, db, q)
 				if err != nil {
-					return errors.Wrapf(err, "error changing property on %v", d.Id())
+					return diag.FromErr(errors.Wrapf(err, "error changing property on %v", d.Id()))
 
 				}
 			}
 			if cA.dropedDefault {
 				q := builder.DropColumnDefault(cA.newColumn.name)
-				err := snowflake.Exec(db, q)
+				err := snowflake.ExecContext(ctx, db, q)
 				if err != nil {
-					return errors.Wrapf(err, "error changing property on %v", d.Id())
+					return diag.FromErr(errors.Wrapf(err, "error changing property on %v", d.Id()))
 
 				}
 			}
 			if cA.changedComment {
 				q := builder.ChangeColumnComment(cA.newColumn.name, cA.newColumn.comment)
-				err := snowflake.Exec(db, q)
+				err := snowflake.ExecContext(ctx, db, q)
 				if err != nil {
-					return errors.Wrapf(err, "error changing property on %v", d.Id())
+					return diag.FromErr(errors.Wrapf(err, "error changing property on %v", d.Id()))
 
 				}
 			}
+			if cA.changedMaskingPolicy {
+				var q string
+				if cA.newColumn.maskingPolicy == "" {
+					q = builder.UnsetColumnMaskingPolicy(cA.newColumn.name)
+				} else {
+					// FORCE replaces the old policy without leaving the column unmasked
+					q = builder.SetColumnMaskingPolicy(cA.newColumn.name, cA.newColumn.maskingPolicy, cA.oldMaskingPolicy != "")
+				}
+				err := snowflake.ExecContext(ctx, db, q)
+				if err != nil {
+					return diag.FromErr(errors.Wrapf(err, "error changing masking policy of column %v on %v", cA.newColumn.name, d.Id()))
+				}
+			}
 
 		}
 	}
@@ -699,18 +802,18 @@ func UpdateTable(d *schema.ResourceData, meta interface{}) error {
 		if len(oldpk.keys) > 0 || len(newpk.keys) == 0 {
 			//drop our pk if there was an old primary key, or pk has been removed
 			q := builder.DropPrimaryKey()
-			err := snowflake.Exec(db, q)
+			err := snowflake.ExecContext(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error changing primary key first on %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error changing primary key first on %v", d.Id()))
 			}
 		}
 
 		if len(newpk.keys) > 0 {
 			// add our new pk
 			q := builder.ChangePrimaryKey(newpk.toSnowflakePrimaryKey())
-			err := snowflake.Exec(db, q)
+			err := snowflake.ExecContext(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error changing property on %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error changing property on %v", d.Id()))
 			}
 		}
 	}
@@ -718,31 +821,31 @@ func UpdateTable(d *schema.ResourceData, meta interface{}) error {
 		_, ndr := d.GetChange("data_retention_days")
 
 		q := builder.ChangeDataRetention(ndr.(int))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error changing property on %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error changing property on %v", d.Id()))
 		}
 	}
 	if d.HasChange("change_tracking") {
 		_, nct := d.GetChange("change_tracking")
 
 		q := builder.ChangeChangeTracking(nct.(bool))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error changing property on %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error changing property on %v", d.Id()))
 		}
 	}
-	handleTagChanges(db, d, builder)
+	handleTagChanges(ctx, db, d, builder)
 
-	return ReadTable(d, meta)
+	return ReadTable(ctx, d, meta)
 }
 
-// DeleteTable implements schema.DeleteFunc
-func DeleteTable(d *schema.ResourceData, meta interface{}) error {
+// DeleteTable implements schema.DeleteContextFunc
+func DeleteTable(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	tableID, err := tableIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := tableID.DatabaseName
@@ -751,9 +854,9 @@ func DeleteTable(d *schema.ResourceData, meta interface{}) error {
 
 	q := snowflake.Table(tableName, dbName, schema).Drop()
 
-	err = snowflake.Exec(db, q)
+	err = snowflake.ExecContext(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error deleting pipe %v", d.Id())
+		return diag.FromErr(errors.Wrapf(err, "error deleting pipe %v", d.Id()))
 	}
 
 	d.SetId("")
@@ -1,8 +1,11 @@
 package resources
 
 import (
+	"context"
+
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -78,22 +81,32 @@ var tableGrantSchema = map[string]*schema.Schema{
 func TableGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create: CreateTableGrant,
-			Read:   ReadTableGrant,
-			Delete: DeleteTableGrant,
-			Update: UpdateTableGrant,
+			CreateContext: CreateTableGrant,
+			ReadContext:   ReadTableGrant,
+			DeleteContext: DeleteTableGrant,
+			UpdateContext: UpdateTableGrant,
 
-			Schema: tableGrantSchema,
+			Schema:        tableGrantSchema,
+			SchemaVersion: 1,
+			StateUpgraders: []schema.StateUpgrader{
+				stateUpgraderV0(tableGrantSchema, upgradeGrantIDV0),
+			},
 			Importer: &schema.ResourceImporter{
 				StateContext: schema.ImportStatePassthroughContext,
 			},
+			Timeouts: &schema.ResourceTimeout{
+				Create: schema.DefaultTimeout(defaultTimeout),
+				Read:   schema.DefaultTimeout(defaultTimeout),
+				Update: schema.DefaultTimeout(defaultTimeout),
+				Delete: schema.DefaultTimeout(defaultTimeout),
+			},
 		},
 		ValidPrivs: validTablePrivileges,
 	}
 }
 
-// CreateTableGrant implements schema.CreateFunc
-func CreateTableGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateTableGrant implements schema.CreateContextFunc
+func CreateTableGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var (
 		tableName  string
 		schemaName string
@@ -115,11 +128,11 @@ func CreateTableGrant(d *schema.ResourceData, meta interface{}) error {
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 
 	if (schemaName == "") && !onFuture {
-		return errors.New("schema_name must be set unless on_future is true.")
+		return diag.FromErr(errors.New("schema_name must be set unless on_future is true."))
 	}
 
 	if (tableName == "") && !onFuture {
-		return errors.New("table_name must be set unless on_future is true.")
+		return diag.FromErr(errors.New("table_name must be set unless on_future is true."))
 	}
 
 	var builder snowflake.GrantBuilder
@@ -129,9 +142,9 @@ func CreateTableGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.TableGrant(dbName, schemaName, tableName)
 	}
 
-	err := createGenericGrant(d, meta, builder)
+	err := createGenericGrant(ctx, d, meta, builder)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// table_name is empty when on_future = true
@@ -148,17 +161,17 @@ func CreateTableGrant(d *schema.ResourceData, meta interface{}) error {
 
 	dataIDInput, err := grantID.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
-	return ReadTableGrant(d, meta)
+	return ReadTableGrant(ctx, d, meta)
 }
 
-// ReadTableGrant implements schema.ReadFunc
-func ReadTableGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadTableGrant implements schema.ReadContextFunc
+func ReadTableGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := grantID.ResourceName
@@ -168,11 +181,11 @@ func ReadTableGrant(d *schema.ResourceData, meta interface{}) error {
 
 	err = d.Set("database_name", dbName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("schema_name", schemaName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	onFuture := false
 	if tableName == "" {
@@ -180,19 +193,19 @@ func ReadTableGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	err = d.Set("table_name", tableName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("on_future", onFuture)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("privilege", priv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("with_grant_option", grantID.GrantOption)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var builder snowflake.GrantBuilder
@@ -202,14 +215,14 @@ func ReadTableGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.TableGrant(dbName, schemaName, tableName)
 	}
 
-	return readGenericGrant(d, meta, tableGrantSchema, builder, onFuture, validTablePrivileges)
+	return diag.FromErr(readGenericGrant(ctx, d, meta, tableGrantSchema, builder, onFuture, validTablePrivileges))
 }
 
-// DeleteTableGrant implements schema.DeleteFunc
-func DeleteTableGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteTableGrant implements schema.DeleteContextFunc
+func DeleteTableGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tableName := grantID.ObjectName
@@ -226,11 +239,11 @@ func DeleteTableGrant(d *schema.ResourceData, meta interface{}) error {
 	} else {
 		builder = snowflake.TableGrant(dbName, schemaName, tableName)
 	}
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
 
-// UpdateTableGrant implements schema.UpdateFunc
-func UpdateTableGrant(d *schema.ResourceData, meta interface{}) error {
+// UpdateTableGrant implements schema.UpdateContextFunc
+func UpdateTableGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// for now the only thing we can update are roles or shares
 	// if nothing changed, nothing to update and we're done
 	if !d.HasChanges("roles", "shares") {
@@ -260,7 +273,7 @@ func UpdateTableGrant(d *schema.ResourceData, meta interface{}) error {
 
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := grantID.ResourceName
@@ -277,18 +290,18 @@ func UpdateTableGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// first revoke
-	if err := deleteGenericGrantRolesAndShares(
+	if err := deleteGenericGrantRolesAndShares(ctx,
 		meta,
 		builder,
 		grantID.Privilege,
 		rolesToRevoke,
 		sharesToRevoke,
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// then add
-	if err := createGenericGrantRolesAndShares(
+	if err := createGenericGrantRolesAndShares(ctx,
 		meta,
 		builder,
 		grantID.Privilege,
@@ -296,9 +309,9 @@ func UpdateTableGrant(d *schema.ResourceData, meta interface{}) error {
 		rolesToAdd,
 		sharesToAdd,
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Done, refresh state
-	return ReadTableGrant(d, meta)
+	return ReadTableGrant(ctx, d, meta)
 }
@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 	"time"
@@ -42,8 +43,8 @@ func TestTableGrantCreate(t *testing.T) {
 		mock.ExpectExec(`^GRANT SELECT ON TABLE "test-db"."PUBLIC"."test-table" TO SHARE "test-share-1" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT SELECT ON TABLE "test-db"."PUBLIC"."test-table" TO SHARE "test-share-2" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadTableGrant(mock)
-		err := resources.CreateTableGrant(d, db)
-		r.NoError(err)
+		diags := resources.CreateTableGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -68,15 +69,15 @@ func TestTableGrantUpdate(t *testing.T) {
 		mock.ExpectExec(`^GRANT SELECT ON TABLE "test-db"."PUBLIC"."test-table" TO SHARE "test-share-2"`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadTableGrant(mock)
 
-		err := resources.UpdateTableGrant(d, db)
-		r.NoError(err)
+		diags := resources.UpdateTableGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
 func TestTableGrantRead(t *testing.T) {
 	r := require.New(t)
 
-	d := tableGrant(t, "test-db|PUBLIC|test-table|SELECT|false", map[string]interface{}{
+	d := tableGrant(t, "test-db|PUBLIC|test-table|SELECT||false", map[string]interface{}{
 		"table_name":        "test-table",
 		"schema_name":       "PUBLIC",
 		"database_name":     "test-db",
@@ -90,8 +91,8 @@ func TestTableGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadTableGrant(mock)
-		err := resources.ReadTableGrant(d, db)
-		r.NoError(err)
+		diags := resources.ReadTableGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 
 	roles := d.Get("roles").(*schema.Set)
@@ -142,7 +143,7 @@ func TestFutureTableGrantCreate(t *testing.T) {
 			`^GRANT SELECT ON FUTURE TABLES IN SCHEMA "test-db"."PUBLIC" TO ROLE "test-role-2" WITH GRANT OPTION$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFutureTableGrant(mock)
-		err := resources.CreateTableGrant(d, db)
+		diags := resources.CreateTableGrant(context.Background(), d, db)
 		roles := d.Get("roles").(*schema.Set)
 		// After the CreateTableGrant has been created a ReadTableGrant reads the current grants
 		// and this read should ignore test-role-3 what is returned by SHOW FUTURE GRANTS ON SCHEMA PUBLIC because
@@ -150,7 +151,7 @@ func TestFutureTableGrantCreate(t *testing.T) {
 		r.True(roles.Contains("test-role-1"))
 		r.True(roles.Contains("test-role-2"))
 		r.False(roles.Contains("test-role-3"))
-		r.NoError(err)
+		r.Empty(diags)
 	})
 
 	b := require.New(t)
@@ -173,8 +174,8 @@ func TestFutureTableGrantCreate(t *testing.T) {
 			`^GRANT SELECT ON FUTURE TABLES IN DATABASE "test-db" TO ROLE "test-role-2"$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFutureTableDatabaseGrant(mock)
-		err := resources.CreateTableGrant(d, db)
-		b.NoError(err)
+		diags := resources.CreateTableGrant(context.Background(), d, db)
+		b.Empty(diags)
 	})
 }
 
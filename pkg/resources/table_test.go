@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -59,8 +60,8 @@ func TestTableCreate(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`CREATE TABLE "database_name"."schema_name"."good_name" \("column1" OBJECT COMMENT '', "column2" VARCHAR NOT NULL COMMENT '', "column3" NUMBER\(38,0\) COMMENT 'some comment', "column4" VARCHAR NOT NULL DEFAULT 'hello' COMMENT '' ,CONSTRAINT "MY_KEY" PRIMARY KEY\("column1"\)\) COMMENT = 'great comment' DATA_RETENTION_TIME_IN_DAYS = 1 CHANGE_TRACKING = false`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectTableRead(mock)
-		err := resources.CreateTable(d, db)
-		r.NoError(err)
+		diags := resources.CreateTable(context.Background(), d, db)
+		r.Empty(diags)
 		r.Equal("good_name", d.Get("name").(string))
 		columns := d.Get("column").([]interface{})
 		r.Equal(4, len(columns))
@@ -114,8 +115,8 @@ func TestTableRead(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectTableRead(mock)
 
-		err := resources.ReadTable(d, db)
-		r.NoError(err)
+		diags := resources.ReadTable(context.Background(), d, db)
+		r.Empty(diags)
 		r.Equal("good_name", d.Get("name").(string))
 		r.Equal("mock comment", d.Get("comment").(string))
 
@@ -123,9 +124,43 @@ func TestTableRead(t *testing.T) {
 		r.NotEmpty(d.State())
 		q := snowflake.Table("good_name", "database_name", "schema_name").Show()
 		mock.ExpectQuery(q).WillReturnError(sql.ErrNoRows)
-		err2 := resources.ReadTable(d, db)
+		diags2 := resources.ReadTable(context.Background(), d, db)
 		r.Empty(d.State())
-		r.Nil(err2)
+		r.Empty(diags2)
+	})
+}
+
+func TestTableReadMaskingPolicy(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":     "good_name",
+		"database": "database_name",
+		"schema":   "schema_name",
+		"column": []interface{}{
+			map[string]interface{}{"name": "column1", "type": "OBJECT"},
+			map[string]interface{}{"name": "column2", "type": "VARCHAR", "masking_policy": `database_name.schema_name."mask"`},
+			map[string]interface{}{"name": "column3", "type": "NUMBER(38,0)", "masking_policy": `DATABASE_NAME.SCHEMA_NAME.MASK`},
+		},
+	}
+	d := table(t, "database_name|schema_name|good_name", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectTableRead(mock)
+		refRows := sqlmock.NewRows([]string{"POLICY_DB", "POLICY_SCHEMA", "POLICY_NAME", "POLICY_KIND", "REF_COLUMN_NAME", "REF_ARG_COLUMN_NAMES"}).
+			AddRow("DATABASE_NAME", "SCHEMA_NAME", "mask", "MASKING_POLICY", "column2", nil).
+			AddRow("DATABASE_NAME", "SCHEMA_NAME", "other_mask", "MASKING_POLICY", "column4", nil)
+		mock.ExpectQuery(`^SELECT \* FROM TABLE\("database_name".INFORMATION_SCHEMA.POLICY_REFERENCES\(REF_ENTITY_NAME => '"database_name"."schema_name"."good_name"', REF_ENTITY_DOMAIN => 'table'\)\)$`).WillReturnRows(refRows)
+
+		diags := resources.ReadTable(context.Background(), d, db)
+		r.Empty(diags)
+		columns := d.Get("column").([]interface{})
+		r.Len(columns, 4)
+		r.Equal(`database_name.schema_name."mask"`, columns[1].(map[string]interface{})["masking_policy"])
+		// column3 is no longer masked
+		r.Equal("", columns[2].(map[string]interface{})["masking_policy"])
+		// column4 is left to masking policy attachments
+		r.Equal("", columns[3].(map[string]interface{})["masking_policy"])
 	})
 }
 
@@ -136,7 +171,7 @@ func TestTableDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP TABLE "database_name"."schema_name"."drop_it"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteTable(d, db)
-		r.NoError(err)
+		diags := resources.DeleteTable(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
@@ -2,12 +2,14 @@ package resources
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/csv"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 
@@ -42,6 +44,17 @@ var tagSchema = map[string]*schema.Schema{
 		Optional:    true,
 		Description: "Specifies a comment for the tag.",
 	},
+	"allowed_values": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Restricts the values the tag can be set to. Any value is allowed when empty.",
+	},
+	"fully_qualified_name": {
+		Type:        schema.TypeString,
+		Description: "The fully qualified name of the tag, to associate it with objects.",
+		Computed:    true,
+	},
 }
 
 var tagReferenceSchema = &schema.Schema{
@@ -90,13 +103,13 @@ type TagBuilder interface {
 	ChangeTag(snowflake.TagValue) string
 }
 
-func handleTagChanges(db *sql.DB, d *schema.ResourceData, builder TagBuilder) error {
+func handleTagChanges(ctx context.Context, db *sql.DB, d *schema.ResourceData, builder TagBuilder) error {
 	if d.HasChange("tag") {
 		old, new := d.GetChange("tag")
 		removed, added, changed := getTags(old).diffs(getTags(new))
 		for _, tA := range removed {
 			q := builder.UnsetTag(tA.toSnowflakeTagValue())
-			err := snowflake.Exec(db, q)
+			err := snowflake.ExecContext(ctx, db, q)
 			if err != nil {
 				return errors.Wrapf(err, "error dropping tag on %v", d.Id())
 			}
@@ -104,14 +117,14 @@ func handleTagChanges(db *sql.DB, d *schema.ResourceData, builder TagBuilder) er
 		for _, tA := range added {
 			q := builder.AddTag(tA.toSnowflakeTagValue())
 
-			err := snowflake.Exec(db, q)
+			err := snowflake.ExecContext(ctx, db, q)
 			if err != nil {
 				return errors.Wrapf(err, "error adding column on %v", d.Id())
 			}
 		}
 		for _, tA := range changed {
 			q := builder.ChangeTag(tA.toSnowflakeTagValue())
-			err := snowflake.Exec(db, q)
+			err := snowflake.ExecContext(ctx, db, q)
 			if err != nil {
 				return errors.Wrapf(err, "error changing property on %v", d.Id())
 			}
@@ -152,10 +165,15 @@ func tagIDFromString(stringID string) (*tagID, error) {
 		return nil, fmt.Errorf("3 fields allowed")
 	}
 
+	names, err := objectNames(lines[0][:3])
+	if err != nil {
+		return nil, err
+	}
+
 	tagResult := &tagID{
-		DatabaseName: lines[0][0],
-		SchemaName:   lines[0][1],
-		TagName:      lines[0][2],
+		DatabaseName: names[0].Name(),
+		SchemaName:   names[1].Name(),
+		TagName:      names[2].Name(),
 	}
 	return tagResult, nil
 }
@@ -163,23 +181,34 @@ func tagIDFromString(stringID string) (*tagID, error) {
 // Schema returns a pointer to the resource representing a schema
 func Tag() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateTag,
-		Read:   ReadTag,
-		Update: UpdateTag,
-		Delete: DeleteTag,
-
-		Schema: tagSchema,
+		CreateContext: CreateTag,
+		ReadContext:   ReadTag,
+		UpdateContext: UpdateTag,
+		DeleteContext: DeleteTag,
+
+		Schema:        tagSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(tagSchema, upgradeQuotedNamesV0("database", "schema", "name")),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 	}
 }
 
-// CreateSchema implements schema.CreateFunc
-func CreateTag(d *schema.ResourceData, meta interface{}) error {
+// CreateSchema implements schema.CreateContextFunc
+func CreateTag(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 	database := d.Get("database").(string)
+	allowedValues := expandStringList(d.Get("allowed_values").(*schema.Set).List())
 	schema := d.Get("schema").(string)
 
 	builder := snowflake.Tag(name).WithDB(database).WithSchema(schema)
@@ -189,11 +218,15 @@ func CreateTag(d *schema.ResourceData, meta interface{}) error {
 		builder.WithComment(v.(string))
 	}
 
+	if len(allowedValues) > 0 {
+		builder.WithAllowedValues(allowedValues)
+	}
+
 	q := builder.Create()
 
-	err := snowflake.Exec(db, q)
+	err := snowflake.ExecContext(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error creating tag %v", name)
+		return diag.FromErr(errors.Wrapf(err, "error creating tag %v", name))
 	}
 
 	tagID := &tagID{
@@ -203,19 +236,19 @@ func CreateTag(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := tagID.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadTag(d, meta)
+	return ReadTag(ctx, d, meta)
 }
 
-// ReadSchema implements schema.ReadFunc
-func ReadTag(d *schema.ResourceData, meta interface{}) error {
+// ReadSchema implements schema.ReadContextFunc
+func ReadTag(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	tagID, err := tagIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := tagID.DatabaseName
@@ -223,7 +256,7 @@ func ReadTag(d *schema.ResourceData, meta interface{}) error {
 	tag := tagID.TagName
 
 	q := snowflake.Tag(tag).WithDB(dbName).WithSchema(schemaName).Show()
-	row := snowflake.QueryRow(db, q)
+	row := snowflake.QueryRowContext(ctx, db, q)
 
 	t, err := snowflake.ScanTag(row)
 	if err == sql.ErrNoRows {
@@ -233,37 +266,51 @@ func ReadTag(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("name", t.Name.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("database", t.DatabaseName.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("schema", t.SchemaName.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("comment", t.Comment.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
+	}
+
+	allowedValues, err := t.ListAllowedValues()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("allowed_values", allowedValues)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("fully_qualified_name", snowflake.AddressEscape(t.DatabaseName.String, t.SchemaName.String, t.Name.String))
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-// UpdateTag implements schema.UpdateFunc
-func UpdateTag(d *schema.ResourceData, meta interface{}) error {
+// UpdateTag implements schema.UpdateContextFunc
+func UpdateTag(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tagID, err := tagIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := tagID.DatabaseName
@@ -281,21 +328,46 @@ func UpdateTag(d *schema.ResourceData, meta interface{}) error {
 		} else {
 			q = builder.RemoveComment()
 		}
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating tag comment on %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating tag comment on %v", d.Id()))
+		}
+	}
+
+	if d.HasChange("allowed_values") {
+		old, new := d.GetChange("allowed_values")
+		oldValues := old.(*schema.Set)
+		newValues := new.(*schema.Set)
+
+		var qs []string
+		if newValues.Len() == 0 {
+			qs = append(qs, builder.UnsetAllowedValues())
+		} else {
+			// add first, a tag can not be left with an empty list of allowed values
+			if added := expandStringList(newValues.Difference(oldValues).List()); len(added) > 0 {
+				qs = append(qs, builder.AddAllowedValues(added))
+			}
+			if removed := expandStringList(oldValues.Difference(newValues).List()); len(removed) > 0 {
+				qs = append(qs, builder.DropAllowedValues(removed))
+			}
+		}
+		for _, q := range qs {
+			err := snowflake.ExecContext(ctx, db, q)
+			if err != nil {
+				return diag.FromErr(errors.Wrapf(err, "error updating allowed values of tag %v", d.Id()))
+			}
 		}
 	}
 
-	return ReadTag(d, meta)
+	return ReadTag(ctx, d, meta)
 }
 
-// DeleteTag implements schema.DeleteFunc
-func DeleteTag(d *schema.ResourceData, meta interface{}) error {
+// DeleteTag implements schema.DeleteContextFunc
+func DeleteTag(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	tagID, err := tagIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := tagID.DatabaseName
@@ -304,9 +376,9 @@ func DeleteTag(d *schema.ResourceData, meta interface{}) error {
 
 	q := snowflake.Tag(tag).WithDB(dbName).WithSchema(schemaName).Drop()
 
-	err = snowflake.Exec(db, q)
+	err = snowflake.ExecContext(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error deleting tag %v", d.Id())
+		return diag.FromErr(errors.Wrapf(err, "error deleting tag %v", d.Id()))
 	}
 
 	d.SetId("")
@@ -22,6 +22,8 @@ func TestAcc_Tag(t *testing.T) {
 					resource.TestCheckResourceAttr("snowflake_tag.test", "database", accName),
 					resource.TestCheckResourceAttr("snowflake_tag.test", "schema", accName),
 					resource.TestCheckResourceAttr("snowflake_tag.test", "comment", "Terraform acceptance test"),
+					resource.TestCheckResourceAttr("snowflake_tag.test", "allowed_values.#", "2"),
+					resource.TestCheckResourceAttr("snowflake_tag.test", "fully_qualified_name", fmt.Sprintf("%[1]v.%[1]v.%[1]v", accName)),
 				),
 			},
 		},
@@ -45,6 +47,7 @@ resource "snowflake_tag" "test" {
 	name = "%[1]v"
 	database = snowflake_database.test.name
 	schema = snowflake_schema.test.name
+	allowed_values = ["%[1]v", "OTHER"]
 	comment = "Terraform acceptance test"
 }
 
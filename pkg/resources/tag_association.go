@@ -0,0 +1,290 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+)
+
+const (
+	tagAssociationIDDelimiter = '|'
+)
+
+// tagAssociationObjectTypes are the objects tags can be set on
+var tagAssociationObjectTypes = []string{
+	"DATABASE", "SCHEMA", "TABLE", "VIEW", "MATERIALIZED VIEW", "EXTERNAL TABLE",
+	"STAGE", "STREAM", "TASK", "PIPE", "WAREHOUSE", "ROLE", "USER",
+}
+
+var tagAssociationSchema = map[string]*schema.Schema{
+	"tag": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The fully qualified name of the tag, e.g. `snowflake_tag.tag.fully_qualified_name`.",
+		ForceNew:    true,
+		ValidateFunc: func(val interface{}, key string) ([]string, []error) {
+			return snowflake.ValidateQualifiedName(val)
+		},
+	},
+	"tag_value": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The value of the tag.",
+	},
+	"object_type": {
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  fmt.Sprintf("The type of the object to tag, one of %v.", strings.Join(tagAssociationObjectTypes, ", ")),
+		ForceNew:     true,
+		ValidateFunc: validation.StringInSlice(tagAssociationObjectTypes, false),
+	},
+	"object_database": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The database of the object to tag, for objects in a database.",
+		ForceNew:    true,
+	},
+	"object_schema": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The schema of the object to tag, for objects in a schema.",
+		ForceNew:    true,
+	},
+	"object_name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The name of the object to tag.",
+		ForceNew:    true,
+	},
+	"column": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The column to tag instead of the table or view itself.",
+		ForceNew:    true,
+	},
+}
+
+type tagAssociationID struct {
+	Tag            string
+	ObjectType     string
+	ObjectDatabase string
+	ObjectSchema   string
+	ObjectName     string
+	ColumnName     string
+}
+
+// String() takes in a tagAssociationID object and returns a pipe-delimited string:
+// Tag|ObjectType|ObjectDatabase|ObjectSchema|ObjectName|ColumnName
+func (tai *tagAssociationID) String() (string, error) {
+	var buf bytes.Buffer
+	csvWriter := csv.NewWriter(&buf)
+	csvWriter.Comma = tagAssociationIDDelimiter
+	dataIdentifiers := [][]string{{tai.Tag, tai.ObjectType, tai.ObjectDatabase, tai.ObjectSchema, tai.ObjectName, tai.ColumnName}}
+	err := csvWriter.WriteAll(dataIdentifiers)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// tagAssociationIDFromString() takes in a pipe-delimited string: Tag|ObjectType|ObjectDatabase|ObjectSchema|ObjectName|ColumnName
+// and returns a tagAssociationID object
+func tagAssociationIDFromString(stringID string) (*tagAssociationID, error) {
+	reader := csv.NewReader(strings.NewReader(stringID))
+	reader.Comma = tagAssociationIDDelimiter
+	// the tag may be quoted, which is not CSV
+	reader.LazyQuotes = true
+	lines, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("Not CSV compatible")
+	}
+
+	if len(lines) != 1 {
+		return nil, fmt.Errorf("1 line per tag association")
+	}
+	if len(lines[0]) != 6 {
+		return nil, fmt.Errorf("6 fields allowed")
+	}
+
+	return &tagAssociationID{
+		Tag:            lines[0][0],
+		ObjectType:     lines[0][1],
+		ObjectDatabase: lines[0][2],
+		ObjectSchema:   lines[0][3],
+		ObjectName:     lines[0][4],
+		ColumnName:     lines[0][5],
+	}, nil
+}
+
+func (tai *tagAssociationID) builder() *snowflake.TagAssociationBuilder {
+	names := []string{}
+	for _, name := range []string{tai.ObjectDatabase, tai.ObjectSchema, tai.ObjectName} {
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	return snowflake.TagAssociation(tai.Tag, tai.ObjectType, names...).WithColumn(tai.ColumnName)
+}
+
+// TagAssociation returns a pointer to the resource representing a tag set on an object or column
+func TagAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateTagAssociation,
+		ReadContext:   ReadTagAssociation,
+		UpdateContext: UpdateTagAssociation,
+		DeleteContext: DeleteTagAssociation,
+
+		Schema:        tagAssociationSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(tagAssociationSchema, upgradeUnchangedV0),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+	}
+}
+
+// CreateTagAssociation implements schema.CreateContextFunc
+func CreateTagAssociation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	associationID := &tagAssociationID{
+		Tag:            d.Get("tag").(string),
+		ObjectType:     d.Get("object_type").(string),
+		ObjectDatabase: d.Get("object_database").(string),
+		ObjectSchema:   d.Get("object_schema").(string),
+		ObjectName:     d.Get("object_name").(string),
+		ColumnName:     d.Get("column").(string),
+	}
+
+	stmt := associationID.builder().Set(d.Get("tag_value").(string))
+	err := snowflake.ExecContext(ctx, db, stmt)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error setting tag %v on %v", associationID.Tag, associationID.builder().QualifiedName()))
+	}
+
+	dataIDInput, err := associationID.String()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(dataIDInput)
+
+	return ReadTagAssociation(ctx, d, meta)
+}
+
+// ReadTagAssociation implements schema.ReadContextFunc
+func ReadTagAssociation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	associationID, err := tagAssociationIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ref, err := readTagReference(ctx, db, associationID)
+	if isNotFoundError(err) {
+		log.Printf("[DEBUG] %v (%s) not found", strings.ToLower(associationID.ObjectType), d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if ref == nil {
+		// If not found, mark resource to be removed from statefile during apply or refresh
+		log.Printf("[DEBUG] tag association (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	toSet := map[string]interface{}{
+		"tag":             associationID.Tag,
+		"tag_value":       ref.TagValue.String,
+		"object_type":     associationID.ObjectType,
+		"object_database": associationID.ObjectDatabase,
+		"object_schema":   associationID.ObjectSchema,
+		"object_name":     associationID.ObjectName,
+		"column":          associationID.ColumnName,
+	}
+	for key, val := range toSet {
+		err = d.Set(key, val) //lintignore:R001
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+// UpdateTagAssociation implements schema.UpdateContextFunc
+func UpdateTagAssociation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	associationID, err := tagAssociationIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("tag_value") {
+		// setting the tag again replaces its value
+		stmt := associationID.builder().Set(d.Get("tag_value").(string))
+		err := snowflake.ExecContext(ctx, db, stmt)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error setting tag %v on %v", associationID.Tag, associationID.builder().QualifiedName()))
+		}
+	}
+
+	return ReadTagAssociation(ctx, d, meta)
+}
+
+// DeleteTagAssociation implements schema.DeleteContextFunc
+func DeleteTagAssociation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	associationID, err := tagAssociationIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	stmt := associationID.builder().Unset()
+	err = snowflake.ExecContext(ctx, db, stmt)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error unsetting tag %v on %v", associationID.Tag, associationID.builder().QualifiedName()))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// readTagReference returns the reference of the tag set directly on the
+// object, nil if the tag is unset or only inherited from a parent object
+func readTagReference(ctx context.Context, db *sql.DB, associationID *tagAssociationID) (*snowflake.TagReference, error) {
+	ids, err := snowflake.ParseQualifiedIdentifier(associationID.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := associationID.builder()
+	refs, err := snowflake.ListTagReferences(ctx, builder.ShowTagReferences(ids[0].Name()), db)
+	if err != nil {
+		return nil, err
+	}
+	for _, ref := range refs {
+		if ref.IsTag(associationID.Tag) && ref.Level.String == builder.Domain() && ref.ColumnName.String == associationID.ColumnName {
+			return &ref, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,92 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_TagAssociation(t *testing.T) {
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: tagAssociationConfig(accName, "finance"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("snowflake_tag_association.table", "tag", "snowflake_tag.test", "fully_qualified_name"),
+					resource.TestCheckResourceAttr("snowflake_tag_association.table", "tag_value", "finance"),
+					resource.TestCheckResourceAttr("snowflake_tag_association.column", "tag_value", "finance"),
+				),
+			},
+			{
+				Config: tagAssociationConfig(accName, "hr"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_tag_association.table", "tag_value", "hr"),
+					resource.TestCheckResourceAttr("snowflake_tag_association.column", "tag_value", "hr"),
+				),
+			},
+			{
+				ResourceName:      "snowflake_tag_association.column",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func tagAssociationConfig(n string, value string) string {
+	return fmt.Sprintf(`
+resource "snowflake_database" "test" {
+	name = "%[1]v"
+	comment = "Terraform acceptance test"
+}
+
+resource "snowflake_schema" "test" {
+	name = "%[1]v"
+	database = snowflake_database.test.name
+	comment = "Terraform acceptance test"
+}
+
+resource "snowflake_table" "test" {
+	name = "%[1]v"
+	database = snowflake_database.test.name
+	schema = snowflake_schema.test.name
+
+	column {
+		name = "EMAIL"
+		type = "VARCHAR(16777216)"
+	}
+}
+
+resource "snowflake_tag" "test" {
+	name = "%[1]v"
+	database = snowflake_database.test.name
+	schema = snowflake_schema.test.name
+	allowed_values = ["finance", "hr"]
+}
+
+resource "snowflake_tag_association" "table" {
+	tag = snowflake_tag.test.fully_qualified_name
+	tag_value = "%[2]v"
+	object_type = "TABLE"
+	object_database = snowflake_database.test.name
+	object_schema = snowflake_schema.test.name
+	object_name = snowflake_table.test.name
+}
+
+resource "snowflake_tag_association" "column" {
+	tag = snowflake_tag.test.fully_qualified_name
+	tag_value = "%[2]v"
+	object_type = "TABLE"
+	object_database = snowflake_database.test.name
+	object_schema = snowflake_schema.test.name
+	object_name = snowflake_table.test.name
+	column = "EMAIL"
+}
+`, n, value)
+}
@@ -0,0 +1,126 @@
+package resources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/provider"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/resources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagAssociation(t *testing.T) {
+	r := require.New(t)
+	err := resources.TagAssociation().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func expectReadTagReferences(mock sqlmock.Sqlmock, query string, refs ...[]interface{}) {
+	rows := sqlmock.NewRows([]string{"TAG_DATABASE", "TAG_SCHEMA", "TAG_NAME", "TAG_VALUE", "LEVEL", "OBJECT_NAME", "DOMAIN", "COLUMN_NAME"})
+	for _, ref := range refs {
+		rows.AddRow(ref[0], ref[1], ref[2], ref[3], ref[4], "table_name", ref[5], ref[6])
+	}
+	mock.ExpectQuery(query).WillReturnRows(rows)
+}
+
+func TestTagAssociationCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"tag":             "TAG_DB.TAG_SCHEMA.COST_CENTER",
+		"tag_value":       "finance",
+		"object_type":     "TABLE",
+		"object_database": "database_name",
+		"object_schema":   "schema_name",
+		"object_name":     "table_name",
+	}
+	d := tagAssociation(t, "", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`^ALTER TABLE "database_name"."schema_name"."table_name" SET TAG TAG_DB.TAG_SCHEMA.COST_CENTER = 'finance'$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadTagReferences(mock,
+			`^SELECT \* FROM TABLE\("TAG_DB".INFORMATION_SCHEMA.TAG_REFERENCES\('"database_name"."schema_name"."table_name"', 'TABLE'\)\)$`,
+			[]interface{}{"TAG_DB", "TAG_SCHEMA", "COST_CENTER", "finance", "TABLE", "TABLE", nil},
+		)
+
+		diags := resources.CreateTagAssociation(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("TAG_DB.TAG_SCHEMA.COST_CENTER|TABLE|database_name|schema_name|table_name|", d.Id())
+		r.Equal("finance", d.Get("tag_value"))
+	})
+}
+
+func TestTagAssociationReadColumn(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"tag":       "tag_db.tag_schema.cost_center",
+		"tag_value": "finance",
+	}
+	d := tagAssociation(t, "tag_db.tag_schema.cost_center|VIEW|database_name|schema_name|table_name|email", in)
+
+	query := `^SELECT \* FROM TABLE\("TAG_DB".INFORMATION_SCHEMA.TAG_REFERENCES\('"database_name"."schema_name"."table_name"."email"', 'COLUMN'\)\)$`
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadTagReferences(mock, query,
+			[]interface{}{"TAG_DB", "TAG_SCHEMA", "COST_CENTER", "hr", "COLUMN", "COLUMN", "email"},
+		)
+		diags := resources.ReadTagAssociation(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("hr", d.Get("tag_value"))
+		r.Equal("VIEW", d.Get("object_type"))
+		r.Equal("email", d.Get("column"))
+
+		// only inherited from the table
+		expectReadTagReferences(mock, query,
+			[]interface{}{"TAG_DB", "TAG_SCHEMA", "COST_CENTER", "hr", "TABLE", "COLUMN", "email"},
+		)
+		diags = resources.ReadTagAssociation(context.Background(), d, db)
+		r.Empty(diags)
+		r.Empty(d.Id())
+	})
+}
+
+func TestTagAssociationUpdate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"tag":         "TAG_DB.TAG_SCHEMA.COST_CENTER",
+		"tag_value":   "finance",
+		"object_type": "WAREHOUSE",
+		"object_name": "warehouse_name",
+	}
+	d := tagAssociation(t, "TAG_DB.TAG_SCHEMA.COST_CENTER|WAREHOUSE|||warehouse_name|", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER WAREHOUSE "warehouse_name" SET TAG TAG_DB.TAG_SCHEMA.COST_CENTER = 'finance'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		rows := sqlmock.NewRows([]string{"TAG_DATABASE", "TAG_SCHEMA", "TAG_NAME", "TAG_VALUE", "LEVEL", "DOMAIN", "COLUMN_NAME"}).
+			AddRow("TAG_DB", "TAG_SCHEMA", "COST_CENTER", "finance", "WAREHOUSE", "WAREHOUSE", nil)
+		mock.ExpectQuery(`^SELECT \* FROM TABLE\("TAG_DB".INFORMATION_SCHEMA.TAG_REFERENCES\('"warehouse_name"', 'WAREHOUSE'\)\)$`).WillReturnRows(rows)
+
+		diags := resources.UpdateTagAssociation(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
+
+func TestTagAssociationDelete(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"tag":         "TAG_DB.TAG_SCHEMA.COST_CENTER",
+		"tag_value":   "finance",
+		"object_type": "SCHEMA",
+		"object_name": "schema_name",
+	}
+	d := tagAssociation(t, "TAG_DB.TAG_SCHEMA.COST_CENTER|SCHEMA|database_name||schema_name|", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER SCHEMA "database_name"."schema_name" UNSET TAG TAG_DB.TAG_SCHEMA.COST_CENTER$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		diags := resources.DeleteTagAssociation(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
@@ -0,0 +1,211 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+var tagMaskingPolicyAssociationSchema = map[string]*schema.Schema{
+	"tag": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The fully qualified name of the tag, e.g. `snowflake_tag.tag.fully_qualified_name`.",
+		ForceNew:    true,
+		ValidateFunc: func(val interface{}, key string) ([]string, []error) {
+			return snowflake.ValidateQualifiedName(val)
+		},
+	},
+	"masking_policy": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The fully qualified name of the masking policy, e.g. `snowflake_masking_policy.policy.fully_qualified_name`.",
+		ForceNew:    true,
+		ValidateFunc: func(val interface{}, key string) ([]string, []error) {
+			return snowflake.ValidateQualifiedName(val)
+		},
+	},
+}
+
+type tagMaskingPolicyAssociationID struct {
+	Tag           string
+	MaskingPolicy string
+}
+
+// String() takes in a tagMaskingPolicyAssociationID object and returns a pipe-delimited string:
+// Tag|MaskingPolicy
+func (tmpai *tagMaskingPolicyAssociationID) String() (string, error) {
+	var buf bytes.Buffer
+	csvWriter := csv.NewWriter(&buf)
+	csvWriter.Comma = tagAssociationIDDelimiter
+	dataIdentifiers := [][]string{{tmpai.Tag, tmpai.MaskingPolicy}}
+	err := csvWriter.WriteAll(dataIdentifiers)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// tagMaskingPolicyAssociationIDFromString() takes in a pipe-delimited string: Tag|MaskingPolicy
+// and returns a tagMaskingPolicyAssociationID object
+func tagMaskingPolicyAssociationIDFromString(stringID string) (*tagMaskingPolicyAssociationID, error) {
+	reader := csv.NewReader(strings.NewReader(stringID))
+	reader.Comma = tagAssociationIDDelimiter
+	// the names may be quoted, which is not CSV
+	reader.LazyQuotes = true
+	lines, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("Not CSV compatible")
+	}
+
+	if len(lines) != 1 {
+		return nil, fmt.Errorf("1 line per tag masking policy association")
+	}
+	if len(lines[0]) != 2 {
+		return nil, fmt.Errorf("2 fields allowed")
+	}
+
+	return &tagMaskingPolicyAssociationID{
+		Tag:           lines[0][0],
+		MaskingPolicy: lines[0][1],
+	}, nil
+}
+
+func (tmpai *tagMaskingPolicyAssociationID) builder() (*snowflake.TagBuilder, error) {
+	ids, err := snowflake.ParseQualifiedIdentifier(tmpai.Tag)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) != 3 {
+		return nil, fmt.Errorf("tag %v is not a fully qualified name", tmpai.Tag)
+	}
+	return snowflake.Tag(ids[2].Name()).WithDB(ids[0].Name()).WithSchema(ids[1].Name()), nil
+}
+
+// TagMaskingPolicyAssociation returns a pointer to the resource representing a masking policy set on a tag
+func TagMaskingPolicyAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateTagMaskingPolicyAssociation,
+		ReadContext:   ReadTagMaskingPolicyAssociation,
+		DeleteContext: DeleteTagMaskingPolicyAssociation,
+
+		Schema:        tagMaskingPolicyAssociationSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(tagMaskingPolicyAssociationSchema, upgradeUnchangedV0),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+	}
+}
+
+// CreateTagMaskingPolicyAssociation implements schema.CreateContextFunc
+func CreateTagMaskingPolicyAssociation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	associationID := &tagMaskingPolicyAssociationID{
+		Tag:           d.Get("tag").(string),
+		MaskingPolicy: d.Get("masking_policy").(string),
+	}
+	builder, err := associationID.builder()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	stmt := builder.SetMaskingPolicy(associationID.MaskingPolicy)
+	err = snowflake.ExecContext(ctx, db, stmt)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error setting masking policy %v on tag %v", associationID.MaskingPolicy, associationID.Tag))
+	}
+
+	dataIDInput, err := associationID.String()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(dataIDInput)
+
+	return ReadTagMaskingPolicyAssociation(ctx, d, meta)
+}
+
+// ReadTagMaskingPolicyAssociation implements schema.ReadContextFunc
+func ReadTagMaskingPolicyAssociation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	associationID, err := tagMaskingPolicyAssociationIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	builder, err := associationID.builder()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	refs, err := snowflake.ListPolicyReferences(ctx, builder.ShowPolicyReferences(), db)
+	if isNotFoundError(err) {
+		log.Printf("[DEBUG] tag (%s) not found", associationID.Tag)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	found := false
+	for _, ref := range refs {
+		if ref.PolicyKind.String == snowflake.MaskingPolicyKind && ref.IsPolicy(associationID.MaskingPolicy) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		// If not found, mark resource to be removed from statefile during apply or refresh
+		log.Printf("[DEBUG] tag masking policy association (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	err = d.Set("tag", associationID.Tag)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("masking_policy", associationID.MaskingPolicy)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// DeleteTagMaskingPolicyAssociation implements schema.DeleteContextFunc
+func DeleteTagMaskingPolicyAssociation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	associationID, err := tagMaskingPolicyAssociationIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	builder, err := associationID.builder()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	stmt := builder.UnsetMaskingPolicy(associationID.MaskingPolicy)
+	err = snowflake.ExecContext(ctx, db, stmt)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error unsetting masking policy %v on tag %v", associationID.MaskingPolicy, associationID.Tag))
+	}
+
+	d.SetId("")
+	return nil
+}
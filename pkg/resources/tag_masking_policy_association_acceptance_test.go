@@ -0,0 +1,72 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_TagMaskingPolicyAssociation(t *testing.T) {
+	if _, ok := os.LookupEnv("SKIP_MASKING_POLICY_TESTS"); ok {
+		t.Skip("Skipping TestAccTagMaskingPolicyAssociation")
+	}
+
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: tagMaskingPolicyAssociationConfig(accName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("snowflake_tag_masking_policy_association.test", "tag", "snowflake_tag.test", "fully_qualified_name"),
+					resource.TestCheckResourceAttrPair("snowflake_tag_masking_policy_association.test", "masking_policy", "snowflake_masking_policy.test", "fully_qualified_name"),
+				),
+			},
+			{
+				ResourceName:      "snowflake_tag_masking_policy_association.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func tagMaskingPolicyAssociationConfig(n string) string {
+	return fmt.Sprintf(`
+resource "snowflake_database" "test" {
+	name = "%[1]v"
+	comment = "Terraform acceptance test"
+}
+
+resource "snowflake_schema" "test" {
+	name = "%[1]v"
+	database = snowflake_database.test.name
+	comment = "Terraform acceptance test"
+}
+
+resource "snowflake_tag" "test" {
+	name = "%[1]v"
+	database = snowflake_database.test.name
+	schema = snowflake_schema.test.name
+}
+
+resource "snowflake_masking_policy" "test" {
+	name = "%[1]v"
+	database = snowflake_database.test.name
+	schema = snowflake_schema.test.name
+	value_data_type = "VARCHAR"
+	masking_expression = "case when current_role() in ('ANALYST') then val else '***' end"
+	return_data_type = "VARCHAR(16777216)"
+}
+
+resource "snowflake_tag_masking_policy_association" "test" {
+	tag = snowflake_tag.test.fully_qualified_name
+	masking_policy = snowflake_masking_policy.test.fully_qualified_name
+}
+`, n)
+}
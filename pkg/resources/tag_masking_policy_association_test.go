@@ -0,0 +1,79 @@
+package resources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/provider"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/resources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/stretchr/testify/require"
+)
+
+const tagPolicyReferencesQuery = `^SELECT \* FROM TABLE\("TAG_DB".INFORMATION_SCHEMA.POLICY_REFERENCES\(REF_ENTITY_NAME => '"TAG_DB"."TAG_SCHEMA"."pii"', REF_ENTITY_DOMAIN => 'tag'\)\)$`
+
+func TestTagMaskingPolicyAssociation(t *testing.T) {
+	r := require.New(t)
+	err := resources.TagMaskingPolicyAssociation().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestTagMaskingPolicyAssociationCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"tag":            `TAG_DB.TAG_SCHEMA."pii"`,
+		"masking_policy": "POLICY_DB.POLICY_SCHEMA.MASK",
+	}
+	d := tagMaskingPolicyAssociation(t, "", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`^ALTER TAG "TAG_DB"."TAG_SCHEMA"."pii" SET MASKING POLICY POLICY_DB.POLICY_SCHEMA.MASK$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		rows := sqlmock.NewRows([]string{"POLICY_DB", "POLICY_SCHEMA", "POLICY_NAME", "POLICY_KIND"}).
+			AddRow("POLICY_DB", "POLICY_SCHEMA", "MASK", "MASKING_POLICY")
+		mock.ExpectQuery(tagPolicyReferencesQuery).WillReturnRows(rows)
+
+		diags := resources.CreateTagMaskingPolicyAssociation(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal(`"TAG_DB.TAG_SCHEMA.""pii"""|POLICY_DB.POLICY_SCHEMA.MASK`, d.Id())
+	})
+}
+
+func TestTagMaskingPolicyAssociationRead(t *testing.T) {
+	r := require.New(t)
+
+	d := tagMaskingPolicyAssociation(t, `TAG_DB.TAG_SCHEMA."pii"|POLICY_DB.POLICY_SCHEMA.MASK`, map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"POLICY_DB", "POLICY_SCHEMA", "POLICY_NAME", "POLICY_KIND"}).
+			AddRow("POLICY_DB", "POLICY_SCHEMA", "MASK", "MASKING_POLICY")
+		mock.ExpectQuery(tagPolicyReferencesQuery).WillReturnRows(rows)
+		diags := resources.ReadTagMaskingPolicyAssociation(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal(`TAG_DB.TAG_SCHEMA."pii"`, d.Get("tag"))
+		r.Equal("POLICY_DB.POLICY_SCHEMA.MASK", d.Get("masking_policy"))
+
+		rows = sqlmock.NewRows([]string{"POLICY_DB", "POLICY_SCHEMA", "POLICY_NAME", "POLICY_KIND"}).
+			AddRow("POLICY_DB", "POLICY_SCHEMA", "OTHER", "MASKING_POLICY")
+		mock.ExpectQuery(tagPolicyReferencesQuery).WillReturnRows(rows)
+		diags = resources.ReadTagMaskingPolicyAssociation(context.Background(), d, db)
+		r.Empty(diags)
+		r.Empty(d.Id())
+	})
+}
+
+func TestTagMaskingPolicyAssociationDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := tagMaskingPolicyAssociation(t, `TAG_DB.TAG_SCHEMA."pii"|POLICY_DB.POLICY_SCHEMA.MASK`, map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER TAG "TAG_DB"."TAG_SCHEMA"."pii" UNSET MASKING POLICY POLICY_DB.POLICY_SCHEMA.MASK$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		diags := resources.DeleteTagMaskingPolicyAssociation(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
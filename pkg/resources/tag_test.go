@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -37,8 +38,8 @@ func TestTagCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		expectReadTag(mock)
-		err := resources.CreateTag(d, db)
-		r.NoError(err)
+		diags := resources.CreateTag(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -61,8 +62,8 @@ func TestTagUpdate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		expectReadTag(mock)
-		err := resources.UpdateTag(d, db)
-		r.NoError(err)
+		diags := resources.UpdateTag(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -84,8 +85,8 @@ func TestTagDelete(t *testing.T) {
 			`^DROP TAG "test_db"."test_schema"."good_name"$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		err := resources.DeleteTag(d, db)
-		r.NoError(err)
+		diags := resources.DeleteTag(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -106,9 +107,9 @@ func TestTagRead(t *testing.T) {
 		r.NotEmpty(d.State())
 		q := snowflake.Tag("good_name").WithDB("test_db").WithSchema("test_schema").Show()
 		mock.ExpectQuery(q).WillReturnError(sql.ErrNoRows)
-		err := resources.ReadTag(d, db)
+		diags := resources.ReadTag(context.Background(), d, db)
 		r.Empty(d.State())
-		r.Nil(err)
+		r.Empty(diags)
 	})
 }
 
@@ -118,3 +119,32 @@ func expectReadTag(mock sqlmock.Sqlmock) {
 	).AddRow("2019-05-19 16:55:36.530 -0700", "good_name", "test_db", "test_schema", "admin", "great comment")
 	mock.ExpectQuery(`^SHOW TAGS LIKE 'good_name' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
 }
+
+func TestTagAllowedValues(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":           "good_name",
+		"database":       "test_db",
+		"schema":         "test_schema",
+		"allowed_values": []interface{}{"finance", "hr"},
+	}
+	d := schema.TestResourceDataRaw(t, resources.Tag().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`^CREATE TAG "test_db"."test_schema"."good_name" ALLOWED_VALUES '(finance|hr)', '(finance|hr)'$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		rows := sqlmock.NewRows([]string{
+			"created_on", "name", "database_name", "schema_name", "owner", "comment", "allowed_values"},
+		).AddRow("2019-05-19 16:55:36.530 -0700", "good_name", "test_db", "test_schema", "admin", "", `["finance","hr"]`)
+		mock.ExpectQuery(`^SHOW TAGS LIKE 'good_name' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+
+		diags := resources.CreateTag(context.Background(), d, db)
+		r.Empty(diags)
+		r.ElementsMatch([]interface{}{"finance", "hr"}, d.Get("allowed_values").(*schema.Set).List())
+		r.Equal(`"test_db"."test_schema"."good_name"`, d.Get("fully_qualified_name"))
+	})
+}
@@ -2,6 +2,7 @@ package resources
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/csv"
 	"fmt"
@@ -10,6 +11,8 @@ import (
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
@@ -141,7 +144,7 @@ func difference(a, b map[string]interface{}) map[string]interface{} {
 }
 
 // getActiveRootTask tries to retrieve the root of current task or returns the current (standalone) task
-func getActiveRootTask(data *schema.ResourceData, meta interface{}) (*snowflake.TaskBuilder, error) {
+func getActiveRootTask(ctx context.Context, data *schema.ResourceData, meta interface{}) (*snowflake.TaskBuilder, error) {
 	log.Println("[DEBUG] retrieving root task")
 
 	db := meta.(*sql.DB)
@@ -163,7 +166,7 @@ func getActiveRootTask(data *schema.ResourceData, meta interface{}) (*snowflake.
 	for {
 		builder := snowflake.Task(name, database, dbSchema)
 		q := builder.Show()
-		row := snowflake.QueryRow(db, q)
+		row := snowflake.QueryRowContext(ctx, db, q)
 		task, err := snowflake.ScanTask(row)
 
 		if err != nil && name != data.Get("name").(string) {
@@ -184,18 +187,18 @@ func getActiveRootTask(data *schema.ResourceData, meta interface{}) (*snowflake.
 }
 
 // getActiveRootTaskAndSuspend retrieves the root task and suspends it
-func getActiveRootTaskAndSuspend(data *schema.ResourceData, meta interface{}) (*snowflake.TaskBuilder, error) {
+func getActiveRootTaskAndSuspend(ctx context.Context, data *schema.ResourceData, meta interface{}) (*snowflake.TaskBuilder, error) {
 	db := meta.(*sql.DB)
 	name := data.Get("name").(string)
 
-	root, err := getActiveRootTask(data, meta)
+	root, err := getActiveRootTask(ctx, data, meta)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error retrieving root task %v", name)
 	}
 
 	if root != nil {
 		qr := root.Suspend()
-		err = snowflake.Exec(db, qr)
+		err = snowflake.ExecContext(ctx, db, qr)
 		if err != nil {
 			return nil, errors.Wrapf(err, "error suspending root task %v", name)
 		}
@@ -204,7 +207,7 @@ func getActiveRootTaskAndSuspend(data *schema.ResourceData, meta interface{}) (*
 	return root, nil
 }
 
-func resumeTask(root *snowflake.TaskBuilder, meta interface{}) {
+func resumeTask(ctx context.Context, root *snowflake.TaskBuilder, meta interface{}) {
 	if root == nil {
 		return
 	}
@@ -215,7 +218,7 @@ func resumeTask(root *snowflake.TaskBuilder, meta interface{}) {
 
 	db := meta.(*sql.DB)
 	qr := root.Resume()
-	err := snowflake.Exec(db, qr)
+	err := snowflake.ExecContext(ctx, db, qr)
 	if err != nil {
 		log.Fatal(errors.Wrapf(err, "error resuming root task %v", root.QualifiedName()))
 	}
@@ -238,10 +241,15 @@ func taskIDFromString(stringID string) (*taskID, error) {
 		return nil, fmt.Errorf("3 fields allowed")
 	}
 
+	names, err := objectNames(lines[0][:3])
+	if err != nil {
+		return nil, err
+	}
+
 	taskResult := &taskID{
-		DatabaseName: lines[0][0],
-		SchemaName:   lines[0][1],
-		TaskName:     lines[0][2],
+		DatabaseName: names[0].Name(),
+		SchemaName:   names[1].Name(),
+		TaskName:     names[2].Name(),
 	}
 	return taskResult, nil
 }
@@ -249,24 +257,39 @@ func taskIDFromString(stringID string) (*taskID, error) {
 // Task returns a pointer to the resource representing a task
 func Task() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateTask,
-		Read:   ReadTask,
-		Update: UpdateTask,
-		Delete: DeleteTask,
-
-		Schema: taskSchema,
+		CreateContext: CreateTask,
+		ReadContext:   ReadTask,
+		UpdateContext: UpdateTask,
+		DeleteContext: DeleteTask,
+
+		Schema:        taskSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(taskSchema, upgradeQuotedNamesV0("database", "schema", "name")),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customdiff.All(
+			// ConflictsWith only catches this when both values are literals
+			mutuallyExclusive("schedule", "after"),
+			mutuallyExclusive("warehouse", "user_task_managed_initial_warehouse_size"),
+		),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 	}
 }
 
-// ReadTask implements schema.ReadFunc
-func ReadTask(d *schema.ResourceData, meta interface{}) error {
+// ReadTask implements schema.ReadContextFunc
+func ReadTask(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	taskID, err := taskIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	database := taskID.DatabaseName
@@ -275,7 +298,7 @@ func ReadTask(d *schema.ResourceData, meta interface{}) error {
 
 	builder := snowflake.Task(name, database, schema)
 	q := builder.Show()
-	row := snowflake.QueryRow(db, q)
+	row := snowflake.QueryRowContext(ctx, db, q)
 	t, err := snowflake.ScanTask(row)
 	if err == sql.ErrNoRows {
 		// If not found, mark resource to be removed from statefile during apply or refresh
@@ -284,42 +307,42 @@ func ReadTask(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("enabled", t.IsEnabled())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("name", t.Name)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("database", t.DatabaseName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("schema", t.SchemaName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("warehouse", t.Warehouse)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("schedule", t.Schedule)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("comment", t.Comment)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// The "DESCRIBE TASK ..." command returns the string "null" for error_integration
@@ -329,34 +352,34 @@ func ReadTask(d *schema.ResourceData, meta interface{}) error {
 	}
 	err = d.Set("error_integration", t.ErrorIntegration.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if t.Predecessors != nil {
 		err = d.Set("after", t.GetPredecessorName())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	err = d.Set("when", t.Condition)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("sql_statement", t.Definition)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	q = builder.ShowParameters()
-	paramRows, err := snowflake.Query(db, q)
+	paramRows, err := snowflake.QueryContext(ctx, db, q)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	params, err := snowflake.ScanTaskParameters(paramRows)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(params) > 0 {
@@ -377,7 +400,7 @@ func ReadTask(d *schema.ResourceData, meta interface{}) error {
 			case "USER_TASK_TIMEOUT_MS":
 				timeout, err := strconv.ParseInt(param.Value, 10, 64)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 
 				fieldParameters["user_task_timeout_ms"] = timeout
@@ -388,13 +411,13 @@ func ReadTask(d *schema.ResourceData, meta interface{}) error {
 
 		err := d.Set("session_parameters", sessionParameters)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		for key, value := range fieldParameters {
 			err = d.Set(key, value)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
@@ -403,8 +426,8 @@ func ReadTask(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-// CreateTask implements schema.CreateFunc
-func CreateTask(d *schema.ResourceData, meta interface{}) error {
+// CreateTask implements schema.CreateContextFunc
+func CreateTask(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	var err error
 	db := meta.(*sql.DB)
@@ -447,11 +470,11 @@ func CreateTask(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if v, ok := d.GetOk("after"); ok {
-		root, err := getActiveRootTaskAndSuspend(d, meta)
+		root, err := getActiveRootTaskAndSuspend(ctx, d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
-		defer resumeTask(root, meta)
+		defer resumeTask(ctx, root, meta)
 
 		builder.WithDependency(v.(string))
 	}
@@ -461,16 +484,16 @@ func CreateTask(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	q := builder.Create()
-	err = snowflake.Exec(db, q)
+	err = snowflake.ExecContext(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error creating task %v", name)
+		return diag.FromErr(errors.Wrapf(err, "error creating task %v", name))
 	}
 
 	if enabled {
 		q = builder.Resume()
-		err = snowflake.Exec(db, q)
+		err = snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error starting task %v", name)
+			return diag.FromErr(errors.Wrapf(err, "error starting task %v", name))
 		}
 	}
 
@@ -481,19 +504,19 @@ func CreateTask(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := taskID.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadTask(d, meta)
+	return ReadTask(ctx, d, meta)
 }
 
-// UpdateTask implements schema.UpdateFunc
-func UpdateTask(d *schema.ResourceData, meta interface{}) error {
+// UpdateTask implements schema.UpdateContextFunc
+func UpdateTask(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	taskID, err := taskIDFromString(d.Id())
 	var needResumeCurrentTask = false
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	db := meta.(*sql.DB)
@@ -501,9 +524,9 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 	dbSchema := taskID.SchemaName
 	name := taskID.TaskName
 	builder := snowflake.Task(name, database, dbSchema)
-	root, err := getActiveRootTaskAndSuspend(d, meta)
+	root, err := getActiveRootTaskAndSuspend(ctx, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("warehouse") {
@@ -516,9 +539,9 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 			q = builder.ChangeWarehouse(newWarehouse.(string))
 		}
 
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating warehouse on task %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating warehouse on task %v", d.Id()))
 		}
 	}
 
@@ -528,9 +551,9 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 
 		if warehouse == "" && newSize != "" {
 			var q = builder.SwitchManagedWithInitialSize(newSize.(string))
-			err := snowflake.Exec(db, q)
+			err := snowflake.ExecContext(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error updating user_task_managed_initial_warehouse_size on task %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error updating user_task_managed_initial_warehouse_size on task %v", d.Id()))
 			}
 		}
 
@@ -543,9 +566,9 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 		} else {
 			q = builder.RemoveErrorIntegration()
 		}
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating task error_integration on %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating task error_integration on %v", d.Id()))
 		}
 	}
 
@@ -559,17 +582,17 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 		old, _ := d.GetChange("after")
 
 		q = builder.Suspend()
-		err = snowflake.Exec(db, q)
+		err = snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error suspending task %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error suspending task %v", d.Id()))
 		}
 		needResumeCurrentTask = d.Get("enabled").(bool)
 
 		if old != "" {
 			q = builder.RemoveDependency(old.(string))
-			err = snowflake.Exec(db, q)
+			err = snowflake.ExecContext(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error removing old after dependency from task %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error removing old after dependency from task %v", d.Id()))
 			}
 		}
 	}
@@ -582,9 +605,9 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 		} else {
 			q = builder.ChangeSchedule(new.(string))
 		}
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating schedule on task %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating schedule on task %v", d.Id()))
 		}
 	}
 
@@ -596,9 +619,9 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 		} else {
 			q = builder.ChangeTimeout(new.(int))
 		}
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating user task timeout on task %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating user task timeout on task %v", d.Id()))
 		}
 	}
 
@@ -610,9 +633,9 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 		} else {
 			q = builder.ChangeComment(new.(string))
 		}
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating comment on task %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating comment on task %v", d.Id()))
 		}
 	}
 
@@ -624,9 +647,9 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 
 		if new != "" {
 			q = builder.AddDependency(new.(string))
-			err := snowflake.Exec(db, q)
+			err := snowflake.ExecContext(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error adding after dependency on task %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error adding after dependency on task %v", d.Id()))
 			}
 		}
 	}
@@ -649,17 +672,17 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 
 		if len(remove) > 0 {
 			q = builder.RemoveSessionParameters(remove)
-			err := snowflake.Exec(db, q)
+			err := snowflake.ExecContext(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error removing session_parameters on task %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error removing session_parameters on task %v", d.Id()))
 			}
 		}
 
 		if len(add) > 0 {
 			q = builder.AddSessionParameters(add)
-			err := snowflake.Exec(db, q)
+			err := snowflake.ExecContext(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error adding session_parameters to task %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error adding session_parameters to task %v", d.Id()))
 			}
 		}
 	}
@@ -667,18 +690,18 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("when") {
 		new := d.Get("when")
 		q := builder.ChangeCondition(new.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating when condition on task %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating when condition on task %v", d.Id()))
 		}
 	}
 
 	if d.HasChange("sql_statement") {
 		new := d.Get("sql_statement")
 		q := builder.ChangeSqlStatement(new.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating sql statement on task %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating sql statement on task %v", d.Id()))
 		}
 	}
 
@@ -699,46 +722,46 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 
-		err := snowflake.Exec(db, q)
+		err := snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating task state %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating task state %v", d.Id()))
 		}
 	}
 
 	if needResumeCurrentTask {
-		resumeTask(builder, meta)
+		resumeTask(ctx, builder, meta)
 	}
 
-	resumeTask(root, meta)
-	return ReadTask(d, meta)
+	resumeTask(ctx, root, meta)
+	return ReadTask(ctx, d, meta)
 }
 
-// DeleteTask implements schema.DeleteFunc
-func DeleteTask(d *schema.ResourceData, meta interface{}) error {
+// DeleteTask implements schema.DeleteContextFunc
+func DeleteTask(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	taskID, err := taskIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	database := taskID.DatabaseName
 	schema := taskID.SchemaName
 	name := taskID.TaskName
 
-	root, err := getActiveRootTaskAndSuspend(d, meta)
+	root, err := getActiveRootTaskAndSuspend(ctx, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// only resume the root when not a standalone task
 	if root != nil && name != root.Name() {
-		defer resumeTask(root, meta)
+		defer resumeTask(ctx, root, meta)
 	}
 
 	q := snowflake.Task(name, database, schema).Drop()
-	err = snowflake.Exec(db, q)
+	err = snowflake.ExecContext(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error deleting task %v", d.Id())
+		return diag.FromErr(errors.Wrapf(err, "error deleting task %v", d.Id()))
 	}
 
 	d.SetId("")
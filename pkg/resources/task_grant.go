@@ -1,8 +1,11 @@
 package resources
 
 import (
+	"context"
+
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -67,21 +70,30 @@ var taskGrantSchema = map[string]*schema.Schema{
 func TaskGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create: CreateTaskGrant,
-			Read:   ReadTaskGrant,
-			Delete: DeleteTaskGrant,
-
-			Schema: taskGrantSchema,
+			CreateContext: CreateTaskGrant,
+			ReadContext:   ReadTaskGrant,
+			DeleteContext: DeleteTaskGrant,
+
+			Schema:        taskGrantSchema,
+			SchemaVersion: 1,
+			StateUpgraders: []schema.StateUpgrader{
+				stateUpgraderV0(taskGrantSchema, upgradeGrantIDV0),
+			},
 			Importer: &schema.ResourceImporter{
 				StateContext: schema.ImportStatePassthroughContext,
 			},
+			Timeouts: &schema.ResourceTimeout{
+				Create: schema.DefaultTimeout(defaultTimeout),
+				Read:   schema.DefaultTimeout(defaultTimeout),
+				Delete: schema.DefaultTimeout(defaultTimeout),
+			},
 		},
 		ValidPrivs: validTaskPrivileges,
 	}
 }
 
-// CreateTaskGrant implements schema.CreateFunc
-func CreateTaskGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateTaskGrant implements schema.CreateContextFunc
+func CreateTaskGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var taskName string
 	if name, ok := d.GetOk("task_name"); ok {
 		taskName = name.(string)
@@ -94,10 +106,10 @@ func CreateTaskGrant(d *schema.ResourceData, meta interface{}) error {
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 
 	if (taskName == "") && !futureTasks {
-		return errors.New("task_name must be set unless on_future is true.")
+		return diag.FromErr(errors.New("task_name must be set unless on_future is true."))
 	}
 	if (taskName != "") && futureTasks {
-		return errors.New("task_name must be empty if on_future is true.")
+		return diag.FromErr(errors.New("task_name must be empty if on_future is true."))
 	}
 
 	var builder snowflake.GrantBuilder
@@ -107,9 +119,9 @@ func CreateTaskGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.TaskGrant(dbName, schemaName, taskName)
 	}
 
-	err := createGenericGrant(d, meta, builder)
+	err := createGenericGrant(ctx, d, meta, builder)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grant := &grantID{
@@ -122,18 +134,18 @@ func CreateTaskGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := grant.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadTaskGrant(d, meta)
+	return ReadTaskGrant(ctx, d, meta)
 }
 
-// ReadTaskGrant implements schema.ReadFunc
-func ReadTaskGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadTaskGrant implements schema.ReadContextFunc
+func ReadTaskGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -142,11 +154,11 @@ func ReadTaskGrant(d *schema.ResourceData, meta interface{}) error {
 
 	err = d.Set("database_name", dbName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("schema_name", schemaName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	futureTasksEnabled := false
 	if taskName == "" {
@@ -154,19 +166,19 @@ func ReadTaskGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	err = d.Set("task_name", taskName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("on_future", futureTasksEnabled)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("privilege", priv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("with_grant_option", grantID.GrantOption)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var builder snowflake.GrantBuilder
@@ -176,14 +188,14 @@ func ReadTaskGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.TaskGrant(dbName, schemaName, taskName)
 	}
 
-	return readGenericGrant(d, meta, taskGrantSchema, builder, futureTasksEnabled, validTaskPrivileges)
+	return diag.FromErr(readGenericGrant(ctx, d, meta, taskGrantSchema, builder, futureTasksEnabled, validTaskPrivileges))
 }
 
-// DeleteTaskGrant implements schema.DeleteFunc
-func DeleteTaskGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteTaskGrant implements schema.DeleteContextFunc
+func DeleteTaskGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -197,5 +209,5 @@ func DeleteTaskGrant(d *schema.ResourceData, meta interface{}) error {
 	} else {
 		builder = snowflake.TaskGrant(dbName, schemaName, taskName)
 	}
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
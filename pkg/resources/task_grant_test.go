@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 	"time"
@@ -37,8 +38,8 @@ func TestTaskGrantCreate(t *testing.T) {
 		mock.ExpectExec(`^GRANT OPERATE ON TASK "test-db"."PUBLIC"."test-task" TO ROLE "test-role-1" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT OPERATE ON TASK "test-db"."PUBLIC"."test-task" TO ROLE "test-role-2" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadTaskGrant(mock)
-		err := resources.CreateTaskGrant(d, db)
-		r.NoError(err)
+		diags := resources.CreateTaskGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -58,8 +59,8 @@ func TestTaskGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadTaskGrant(mock)
-		err := resources.ReadTaskGrant(d, db)
-		r.NoError(err)
+		diags := resources.ReadTaskGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 
 	roles := d.Get("roles").(*schema.Set)
@@ -101,8 +102,8 @@ func TestFutureTaskGrantCreate(t *testing.T) {
 			`^GRANT OPERATE ON FUTURE TASKS IN SCHEMA "test-db"."PUBLIC" TO ROLE "test-role-2" WITH GRANT OPTION$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFutureTaskGrant(mock)
-		err := resources.CreateTaskGrant(d, db)
-		r.NoError(err)
+		diags := resources.CreateTaskGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 
 	b := require.New(t)
@@ -125,8 +126,8 @@ func TestFutureTaskGrantCreate(t *testing.T) {
 			`^GRANT OPERATE ON FUTURE TASKS IN DATABASE "test-db" TO ROLE "test-role-2"$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFutureTaskDatabaseGrant(mock)
-		err := resources.CreateTaskGrant(d, db)
-		b.NoError(err)
+		diags := resources.CreateTaskGrant(context.Background(), d, db)
+		b.Empty(diags)
 	})
 }
 
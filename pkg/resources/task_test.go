@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -47,8 +48,8 @@ func TestTaskCreate(t *testing.T) {
 
 		expectReadTask(mock)
 		expectReadTaskParams(mock)
-		err := resources.CreateTask(d, db)
-		r.NoError(err)
+		diags := resources.CreateTask(context.Background(), d, db)
+		r.Empty(diags)
 
 		r.Empty(d.Get("error_integration"), "Null string must be treated as empty")
 	})
@@ -82,8 +83,8 @@ func TestTaskCreateManagedWithInitSize(t *testing.T) {
 
 		expectReadTask(mock)
 		expectReadTaskParams(mock)
-		err := resources.CreateTask(d, db)
-		r.NoError(err)
+		diags := resources.CreateTask(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -113,8 +114,8 @@ func TestTaskCreateManagedWithoutInitSize(t *testing.T) {
 
 		expectReadTask(mock)
 		expectReadTaskParams(mock)
-		err := resources.CreateTask(d, db)
-		r.NoError(err)
+		diags := resources.CreateTask(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -148,8 +149,32 @@ func TestTaskRead(t *testing.T) {
 		r.NotEmpty(d.State())
 		q := snowflake.Task("test_task", "test_db", "test_schema").Show()
 		mock.ExpectQuery(q).WillReturnError(sql.ErrNoRows)
-		err := resources.ReadTask(d, db)
+		diags := resources.ReadTask(context.Background(), d, db)
 		r.Empty(d.State())
-		r.Nil(err)
+		r.Empty(diags)
 	})
 }
+
+func TestTaskCustomizeDiff(t *testing.T) {
+	r := require.New(t)
+
+	err := planDiff(resources.Task(), map[string]interface{}{
+		"name":          "test_task",
+		"database":      "test_db",
+		"schema":        "test_schema",
+		"sql_statement": "SELECT 1",
+		"schedule":      "5 MINUTE",
+		"after":         "other_task",
+	})
+	r.Error(err)
+	r.Contains(err.Error(), "only one of schedule, after can be set, got schedule, after")
+
+	err = planDiff(resources.Task(), map[string]interface{}{
+		"name":          "test_task",
+		"database":      "test_db",
+		"schema":        "test_schema",
+		"sql_statement": "SELECT 1",
+		"after":         "other_task",
+	})
+	r.NoError(err)
+}
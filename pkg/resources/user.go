@@ -1,11 +1,13 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"log"
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -134,22 +136,32 @@ var userSchema = map[string]*schema.Schema{
 
 func User() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateUser,
-		Read:   ReadUser,
-		Update: UpdateUser,
-		Delete: DeleteUser,
+		CreateContext: CreateUser,
+		ReadContext:   ReadUser,
+		UpdateContext: UpdateUser,
+		DeleteContext: DeleteUser,
 
-		Schema: userSchema,
+		Schema:        userSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(userSchema, upgradeUnchangedV0),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 	}
 }
 
 // func DeleteResource(t string, builder func(string) *snowflake.Builder) func(*schema.ResourceData, interface{}) error {
 
-func CreateUser(d *schema.ResourceData, meta interface{}) error {
-	return CreateResource("user", userProperties, userSchema, snowflake.User, ReadUser)(d, meta)
+func CreateUser(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return CreateResource("user", userProperties, userSchema, snowflake.User, ReadUser)(ctx, d, meta)
 }
 
 func UserExists(data *schema.ResourceData, meta interface{}) (bool, error) {
@@ -169,14 +181,14 @@ func UserExists(data *schema.ResourceData, meta interface{}) (bool, error) {
 	return false, nil
 }
 
-func ReadUser(d *schema.ResourceData, meta interface{}) error {
+func ReadUser(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	id := d.Id()
 
 	// We use User.Describe instead of User.Show because the "SHOW USERS ..." command
 	// requires the "MANAGE GRANTS" global privilege
 	stmt := snowflake.User(id).Describe()
-	rows, err := snowflake.Query(db, stmt)
+	rows, err := snowflake.QueryContext(ctx, db, stmt)
 	if err == sql.ErrNoRows {
 		// If not found, mark resource to be removed from statefile during apply or refresh
 		log.Printf("[DEBUG] user (%s) not found", d.Id())
@@ -184,78 +196,78 @@ func ReadUser(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	u, err := snowflake.ScanUserDescription(rows)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("name", u.Name.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("comment", u.Comment.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("login_name", u.LoginName.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("disabled", u.Disabled)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("default_role", u.DefaultRole.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("default_namespace", u.DefaultNamespace.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("default_warehouse", u.DefaultWarehouse.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("has_rsa_public_key", u.HasRsaPublicKey)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("email", u.Email.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("display_name", u.DisplayName.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("first_name", u.FirstName.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("last_name", u.LastName.String)
 
-	return err
+	return diag.FromErr(err)
 }
 
-func UpdateUser(d *schema.ResourceData, meta interface{}) error {
-	return UpdateResource("user", userProperties, userSchema, snowflake.User, ReadUser)(d, meta)
+func UpdateUser(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return UpdateResource("user", userProperties, userSchema, snowflake.User, ReadUser)(ctx, d, meta)
 }
 
-func DeleteUser(d *schema.ResourceData, meta interface{}) error {
-	return DeleteResource("user", snowflake.User)(d, meta)
+func DeleteUser(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return DeleteResource("user", snowflake.User)(ctx, d, meta)
 }
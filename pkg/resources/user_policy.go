@@ -0,0 +1,310 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+// userPolicyKind describes one of the kinds of policies set on the account or
+// on its users: password, session and authentication policies share their
+// lifecycle and only differ in their properties.
+type userPolicyKind struct {
+	name    string // e.g. password policy
+	builder func(name, db, schema string) *snowflake.UserPolicyBuilder
+	// prefix turns an attribute into the property it sets, e.g. min_length
+	// into PASSWORD_MIN_LENGTH
+	prefix     string
+	properties map[string]*schema.Schema
+	// keywords are the string properties set to a keyword, e.g.
+	// MFA_ENROLLMENT = REQUIRED, rather than to a string literal
+	keywords map[string]bool
+}
+
+// schema returns the attributes of the kind of policy: the properties and the
+// attributes naming the policy
+func (k *userPolicyKind) schema() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: fmt.Sprintf("Specifies the identifier for the %v; must be unique for the database and schema in which the %v is created.", k.name, k.name),
+		},
+		"database": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: fmt.Sprintf("The database in which to create the %v.", k.name),
+		},
+		"schema": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: fmt.Sprintf("The schema in which to create the %v.", k.name),
+		},
+		"comment": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: fmt.Sprintf("Specifies a comment for the %v.", k.name),
+		},
+		"fully_qualified_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("The fully qualified name of the %v, used to attach it.", k.name),
+		},
+	}
+	for key, property := range k.properties {
+		s[key] = property
+	}
+	return s
+}
+
+// property returns the name of the property set by the attribute key
+func (k *userPolicyKind) property(key string) string {
+	return k.prefix + strings.ToUpper(key)
+}
+
+// sortedProperties returns the attributes of the properties in a stable order
+func (k *userPolicyKind) sortedProperties() []string {
+	keys := make([]string, 0, len(k.properties))
+	for key := range k.properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// value returns the value of the attribute as set in the builder, and whether
+// it is set at all. Numbers are always set, zero being a valid setting.
+func (k *userPolicyKind) value(d *schema.ResourceData, key string) (interface{}, bool) {
+	switch k.properties[key].Type {
+	case schema.TypeInt:
+		return d.Get(key).(int), true
+	case schema.TypeList:
+		v := expandStringList(d.Get(key).([]interface{}))
+		return v, len(v) > 0
+	default:
+		v := d.Get(key).(string)
+		if k.keywords[key] {
+			return snowflake.Keyword(v), v != ""
+		}
+		return v, v != ""
+	}
+}
+
+type userPolicyID struct {
+	DatabaseName string
+	SchemaName   string
+	PolicyName   string
+}
+
+// String() takes in a userPolicyID object and returns a pipe-delimited string:
+// DatabaseName|SchemaName|PolicyName
+func (id *userPolicyID) String() (string, error) {
+	var buf bytes.Buffer
+	csvWriter := csv.NewWriter(&buf)
+	csvWriter.Comma = pipeIDDelimiter
+	dataIdentifiers := [][]string{{id.DatabaseName, id.SchemaName, id.PolicyName}}
+	err := csvWriter.WriteAll(dataIdentifiers)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// userPolicyIDFromString() takes in a pipe-delimited string: DatabaseName|SchemaName|PolicyName
+// and returns a userPolicyID object
+func userPolicyIDFromString(stringID string) (*userPolicyID, error) {
+	reader := csv.NewReader(strings.NewReader(stringID))
+	reader.Comma = pipeIDDelimiter
+	lines, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("Not CSV compatible")
+	}
+
+	if len(lines) != 1 {
+		return nil, fmt.Errorf("1 line per policy")
+	}
+	if len(lines[0]) != 3 {
+		return nil, fmt.Errorf("3 fields allowed")
+	}
+
+	names, err := objectNames(lines[0][:3])
+	if err != nil {
+		return nil, err
+	}
+
+	return &userPolicyID{
+		DatabaseName: names[0].Name(),
+		SchemaName:   names[1].Name(),
+		PolicyName:   names[2].Name(),
+	}, nil
+}
+
+func (k *userPolicyKind) create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	id := &userPolicyID{
+		DatabaseName: d.Get("database").(string),
+		SchemaName:   d.Get("schema").(string),
+		PolicyName:   d.Get("name").(string),
+	}
+
+	properties := map[string]interface{}{}
+	for _, key := range k.sortedProperties() {
+		if v, ok := k.value(d, key); ok {
+			properties[k.property(key)] = v
+		}
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		properties["COMMENT"] = v.(string)
+	}
+
+	stmt := k.builder(id.PolicyName, id.DatabaseName, id.SchemaName).Create(properties)
+	err := snowflake.ExecContext(ctx, db, stmt)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error creating %v %v", k.name, id.PolicyName))
+	}
+
+	dataIDInput, err := id.String()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(dataIDInput)
+
+	return k.read(ctx, d, meta)
+}
+
+func (k *userPolicyKind) read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	id, err := userPolicyIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	builder := k.builder(id.PolicyName, id.DatabaseName, id.SchemaName)
+
+	row := snowflake.QueryRowContext(ctx, db, builder.Show())
+	policy, err := snowflake.ScanUserPolicy(row)
+	if err == sql.ErrNoRows {
+		// If not found, mark resource to be removed from statefile during apply or refresh
+		log.Printf("[DEBUG] %v (%s) not found", k.name, d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	toSet := map[string]interface{}{
+		"name":                 policy.Name.String,
+		"database":             policy.DatabaseName.String,
+		"schema":               policy.SchemaName.String,
+		"comment":              policy.Comment.String,
+		"fully_qualified_name": builder.QualifiedName(),
+	}
+
+	described, err := snowflake.DescribeUserPolicy(ctx, db, builder.Describe())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for _, key := range k.sortedProperties() {
+		value, ok := described[k.property(key)]
+		if !ok {
+			continue
+		}
+		switch k.properties[key].Type {
+		case schema.TypeInt:
+			i, err := strconv.Atoi(value)
+			if err != nil {
+				return diag.FromErr(errors.Wrapf(err, "unable to parse %v of %v %v", k.property(key), k.name, d.Id()))
+			}
+			toSet[key] = i
+		case schema.TypeList:
+			toSet[key] = snowflake.ListPolicyValues(value)
+		default:
+			toSet[key] = value
+		}
+	}
+
+	for key, val := range toSet {
+		err = d.Set(key, val) //lintignore:R001
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func (k *userPolicyKind) update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	id, err := userPolicyIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	builder := k.builder(id.PolicyName, id.DatabaseName, id.SchemaName)
+
+	set := map[string]interface{}{}
+	unset := []string{}
+	for _, key := range k.sortedProperties() {
+		if !d.HasChange(key) {
+			continue
+		}
+		if v, ok := k.value(d, key); ok {
+			set[k.property(key)] = v
+		} else {
+			unset = append(unset, k.property(key))
+		}
+	}
+	if d.HasChange("comment") {
+		if c := d.Get("comment").(string); c != "" {
+			set["COMMENT"] = c
+		} else {
+			unset = append(unset, "COMMENT")
+		}
+	}
+
+	if len(set) > 0 {
+		err := snowflake.ExecContext(ctx, db, builder.ChangeProperties(set))
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error updating %v %v", k.name, d.Id()))
+		}
+	}
+	if len(unset) > 0 {
+		err := snowflake.ExecContext(ctx, db, builder.UnsetProperties(unset))
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error updating %v %v", k.name, d.Id()))
+		}
+	}
+
+	return k.read(ctx, d, meta)
+}
+
+func (k *userPolicyKind) delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	id, err := userPolicyIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	stmt := k.builder(id.PolicyName, id.DatabaseName, id.SchemaName).Drop()
+	err = snowflake.ExecContext(ctx, db, stmt)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error deleting %v %v", k.name, d.Id()))
+	}
+
+	d.SetId("")
+
+	return nil
+}
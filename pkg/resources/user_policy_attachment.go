@@ -0,0 +1,265 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+// attachmentKey returns the attribute of attachments naming the policy, e.g.
+// password_policy
+func (k *userPolicyKind) attachmentKey() string {
+	return strings.ReplaceAll(k.name, " ", "_")
+}
+
+// attachmentSchema returns the attributes of the attachments of the kind of
+// policy, modeled on network policy attachments
+func (k *userPolicyKind) attachmentSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		k.attachmentKey(): {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: fmt.Sprintf("The fully qualified name of the %v, e.g. `snowflake_%v.policy.fully_qualified_name`.", k.name, k.attachmentKey()),
+			ValidateFunc: func(val interface{}, key string) ([]string, []error) {
+				return snowflake.ValidateQualifiedName(val)
+			},
+		},
+		"set_for_account": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: fmt.Sprintf("Specifies whether the %v should be set on your Snowflake account. An account can only have one %v set at any given time: setting this fails if another one is set on the account already.", k.name, k.name),
+		},
+		"users": {
+			Type:        schema.TypeSet,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Description: fmt.Sprintf("Specifies which users the %v should be set on. A user can only have one %v set at any given time.", k.name, k.name),
+		},
+	}
+}
+
+// attachmentBuilder returns the builder of the policy named by policy, the
+// fully qualified name of the policy as written in SQL
+func (k *userPolicyKind) attachmentBuilder(policy string) (*snowflake.UserPolicyBuilder, error) {
+	ids, err := snowflake.ParseQualifiedIdentifier(policy)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) != 3 {
+		return nil, errors.Errorf("%v is not a fully qualified name, expected <database>.<schema>.<name>", policy)
+	}
+	return k.builder(ids[2].Name(), ids[0].Name(), ids[1].Name()), nil
+}
+
+// references returns whether the policy is set on the account and the users
+// it is set on, as listed by POLICY_REFERENCES, keyed by uppercase name so
+// that users are matched the way Snowflake resolves unquoted names
+func (k *userPolicyKind) references(ctx context.Context, db *sql.DB, builder *snowflake.UserPolicyBuilder) (bool, map[string]string, error) {
+	refs, err := snowflake.ListPolicyReferences(ctx, builder.ShowPolicyReferences(), db)
+	if err != nil {
+		return false, nil, err
+	}
+
+	onAccount := false
+	users := map[string]string{}
+	for _, ref := range refs {
+		switch strings.ToUpper(ref.RefEntityDomain.String) {
+		case "ACCOUNT":
+			onAccount = true
+		case "USER":
+			users[strings.ToUpper(ref.RefEntityName.String)] = ref.RefEntityName.String
+		}
+	}
+	return onAccount, users, nil
+}
+
+func (k *userPolicyKind) createAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	policy := d.Get(k.attachmentKey()).(string)
+	builder, err := k.attachmentBuilder(policy)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(policy)
+
+	if d.Get("set_for_account").(bool) {
+		err := snowflake.ExecContext(ctx, db, builder.SetOnAccount())
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error setting %v %v on account", k.name, policy))
+		}
+	}
+
+	for _, user := range expandStringList(d.Get("users").(*schema.Set).List()) {
+		err := snowflake.ExecContext(ctx, db, builder.SetOnUser(user))
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error setting %v %v on user %v", k.name, policy, user))
+		}
+	}
+
+	return k.readAttachment(ctx, d, meta)
+}
+
+// importAttachment attaches every user the policy is set on
+func (k *userPolicyKind) importAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	db := meta.(*sql.DB)
+	builder, err := k.attachmentBuilder(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	_, referenced, err := k.references(ctx, db, builder)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error listing references of %v %v", k.name, d.Id())
+	}
+	users := []string{}
+	for _, user := range referenced {
+		users = append(users, user)
+	}
+	if err := d.Set("users", users); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func (k *userPolicyKind) readAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	builder, err := k.attachmentBuilder(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	onAccount, referenced, err := k.references(ctx, db, builder)
+	if isNotFoundError(err) {
+		log.Printf("[DEBUG] %v (%s) not found, removing its attachment", k.name, d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// only the users of the attachment are tracked, the policy may be set on others
+	users := []string{}
+	for _, user := range expandStringList(d.Get("users").(*schema.Set).List()) {
+		if _, ok := referenced[strings.ToUpper(user)]; !ok {
+			log.Printf("[DEBUG] user (%s) does not have %v %s", user, k.name, d.Id())
+			continue
+		}
+		users = append(users, user)
+	}
+
+	toSet := map[string]interface{}{
+		k.attachmentKey(): d.Id(),
+		"set_for_account": onAccount,
+		"users":           users,
+	}
+	for key, val := range toSet {
+		err = d.Set(key, val) //lintignore:R001
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func (k *userPolicyKind) updateAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	builder, err := k.attachmentBuilder(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	onAccount, referenced, err := k.references(ctx, db, builder)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("set_for_account") {
+		if d.Get("set_for_account").(bool) {
+			err := snowflake.ExecContext(ctx, db, builder.SetOnAccount())
+			if err != nil {
+				return diag.FromErr(errors.Wrapf(err, "error setting %v %v on account", k.name, d.Id()))
+			}
+		} else if onAccount {
+			err := snowflake.ExecContext(ctx, db, builder.UnsetOnAccount())
+			if err != nil {
+				return diag.FromErr(errors.Wrapf(err, "error unsetting %v %v on account", k.name, d.Id()))
+			}
+		}
+	}
+
+	if d.HasChange("users") {
+		old, new := d.GetChange("users")
+		oldUsersSet := old.(*schema.Set)
+		newUsersSet := new.(*schema.Set)
+
+		// another policy may have been set on a removed user since, it is left alone
+		for _, user := range expandStringList(oldUsersSet.Difference(newUsersSet).List()) {
+			if _, ok := referenced[strings.ToUpper(user)]; !ok {
+				continue
+			}
+			err := snowflake.ExecContext(ctx, db, builder.UnsetOnUser(user))
+			if err != nil {
+				return diag.FromErr(errors.Wrapf(err, "error unsetting %v %v on user %v", k.name, d.Id(), user))
+			}
+		}
+
+		for _, user := range expandStringList(newUsersSet.Difference(oldUsersSet).List()) {
+			err := snowflake.ExecContext(ctx, db, builder.SetOnUser(user))
+			if err != nil {
+				return diag.FromErr(errors.Wrapf(err, "error setting %v %v on user %v", k.name, d.Id(), user))
+			}
+		}
+	}
+
+	return k.readAttachment(ctx, d, meta)
+}
+
+func (k *userPolicyKind) deleteAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	builder, err := k.attachmentBuilder(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the account and users only lose the policy if it is still the one set on them
+	onAccount, referenced, err := k.references(ctx, db, builder)
+	if isNotFoundError(err) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("set_for_account").(bool) && onAccount {
+		err := snowflake.ExecContext(ctx, db, builder.UnsetOnAccount())
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error unsetting %v %v on account", k.name, d.Id()))
+		}
+	}
+
+	for _, user := range expandStringList(d.Get("users").(*schema.Set).List()) {
+		if _, ok := referenced[strings.ToUpper(user)]; !ok {
+			continue
+		}
+		err := snowflake.ExecContext(ctx, db, builder.UnsetOnUser(user))
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error unsetting %v %v on user %v", k.name, d.Id(), user))
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
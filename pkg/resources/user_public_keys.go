@@ -1,6 +1,7 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/chanzuckerberg/go-misc/sets"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -47,22 +49,32 @@ var userPublicKeysSchema = map[string]*schema.Schema{
 
 func UserPublicKeys() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateUserPublicKeys,
-		Read:   ReadUserPublicKeys,
-		Update: UpdateUserPublicKeys,
-		Delete: DeleteUserPublicKeys,
-
-		Schema: userPublicKeysSchema,
+		CreateContext: CreateUserPublicKeys,
+		ReadContext:   ReadUserPublicKeys,
+		UpdateContext: UpdateUserPublicKeys,
+		DeleteContext: DeleteUserPublicKeys,
+
+		Schema:        userPublicKeysSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(userPublicKeysSchema, upgradeUnchangedV0),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 	}
 }
 
-func checkUserExists(db *sql.DB, name string) (bool, error) {
+func checkUserExists(ctx context.Context, db *sql.DB, name string) (bool, error) {
 	// First check if user exists
 	stmt := snowflake.User(name).Describe()
-	_, err := snowflake.Query(db, stmt)
+	_, err := snowflake.QueryContext(ctx, db, stmt)
 	if err == sql.ErrNoRows {
 		log.Printf("[DEBUG] user (%s) not found", name)
 		return false, nil
@@ -74,13 +86,13 @@ func checkUserExists(db *sql.DB, name string) (bool, error) {
 	return true, nil
 }
 
-func ReadUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
+func ReadUserPublicKeys(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	id := d.Id()
 
-	exists, err := checkUserExists(db, id)
+	exists, err := checkUserExists(ctx, db, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// If not found, mark resource to be removed from statefile during apply or refresh
 	if !exists {
@@ -91,7 +103,7 @@ func ReadUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func CreateUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
+func CreateUserPublicKeys(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 
@@ -100,17 +112,17 @@ func CreateUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
 		if !publicKeyOK {
 			continue
 		}
-		err := updateUserPublicKeys(db, name, prop, publicKey.(string))
+		err := updateUserPublicKeys(ctx, db, name, prop, publicKey.(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	d.SetId(name)
-	return ReadUserPublicKeys(d, meta)
+	return ReadUserPublicKeys(ctx, d, meta)
 }
 
-func UpdateUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
+func UpdateUserPublicKeys(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Id()
 
@@ -133,31 +145,31 @@ func UpdateUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
 
 	// set the keys we decided should be set
 	for prop, value := range propsToSet {
-		err := updateUserPublicKeys(db, name, prop, value)
+		err := updateUserPublicKeys(ctx, db, name, prop, value)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	// unset the keys we decided should be unset
 	for _, prop := range propsToUnset.List() {
-		err := unsetUserPublicKeys(db, name, prop)
+		err := unsetUserPublicKeys(ctx, db, name, prop)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	// re-sync
-	return ReadUserPublicKeys(d, meta)
+	return ReadUserPublicKeys(ctx, d, meta)
 }
 
-func DeleteUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
+func DeleteUserPublicKeys(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Id()
 
 	for _, prop := range userPublicKeyProperties {
-		err := unsetUserPublicKeys(db, name, prop)
+		err := unsetUserPublicKeys(ctx, db, name, prop)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -165,11 +177,11 @@ func DeleteUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func updateUserPublicKeys(db *sql.DB, name string, prop string, value string) error {
-	stmt := fmt.Sprintf(`ALTER USER "%s" SET %s = '%s'`, name, prop, value)
-	return snowflake.Exec(db, stmt)
+func updateUserPublicKeys(ctx context.Context, db *sql.DB, name string, prop string, value string) error {
+	stmt := fmt.Sprintf(`ALTER USER %v SET %s = '%s'`, snowflake.QuoteIdentifier(name), prop, value)
+	return snowflake.ExecContext(ctx, db, stmt)
 }
-func unsetUserPublicKeys(db *sql.DB, name string, prop string) error {
-	stmt := fmt.Sprintf(`ALTER USER "%s" UNSET %s`, name, prop)
-	return snowflake.Exec(db, stmt)
+func unsetUserPublicKeys(ctx context.Context, db *sql.DB, name string, prop string) error {
+	stmt := fmt.Sprintf(`ALTER USER %v UNSET %s`, snowflake.QuoteIdentifier(name), prop)
+	return snowflake.ExecContext(ctx, db, stmt)
 }
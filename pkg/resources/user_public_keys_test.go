@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -33,8 +34,8 @@ func TestUserPublicKeysCreate(t *testing.T) {
 		mock.ExpectExec(`ALTER USER "good_name" SET rsa_public_key = 'asdf'`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`ALTER USER "good_name" SET rsa_public_key_2 = 'asdf2'`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadUser(mock, "good_name")
-		err := resources.CreateUserPublicKeys(d, db)
-		r.NoError(err)
+		diags := resources.CreateUserPublicKeys(context.Background(), d, db)
+		r.Empty(diags)
 
 		r.Equal(in["name"], d.Id())
 	})
@@ -53,7 +54,7 @@ func TestUsePublicKeysrDelete(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`ALTER USER "good_name" UNSET rsa_public_key`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`ALTER USER "good_name" UNSET rsa_public_key_2`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteUserPublicKeys(d, db)
-		r.NoError(err)
+		diags := resources.DeleteUserPublicKeys(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
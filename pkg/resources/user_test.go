@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
@@ -48,8 +49,8 @@ func TestUserCreate(t *testing.T) {
 		q := fmt.Sprintf(`^CREATE USER "%s" COMMENT='great comment' DEFAULT_NAMESPACE='mynamespace' DEFAULT_ROLE='bestrole' DEFAULT_WAREHOUSE='mywarehouse' DISPLAY_NAME='Display Name' EMAIL='fake@email.com' FIRST_NAME='Marcin' LAST_NAME='Zukowski' LOGIN_NAME='gname' PASSWORD='awesomepassword' RSA_PUBLIC_KEY='asdf' RSA_PUBLIC_KEY_2='asdf2' DISABLED=true MUST_CHANGE_PASSWORD=true$`, name)
 		mock.ExpectExec(q).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadUser(mock, name)
-		err := resources.CreateUser(d, db)
-		r.NoError(err)
+		diags := resources.CreateUser(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -101,8 +102,8 @@ func TestUserRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadUser(mock, name)
-		err := resources.ReadUser(d, db)
-		r.NoError(err)
+		diags := resources.ReadUser(context.Background(), d, db)
+		r.Empty(diags)
 		r.Equal("mock comment", d.Get("comment").(string))
 		r.Equal("myloginname", d.Get("login_name").(string))
 		r.Equal(false, d.Get("disabled").(bool))
@@ -111,9 +112,9 @@ func TestUserRead(t *testing.T) {
 		r.NotEmpty(d.State())
 		q := snowflake.User(d.Id()).Describe()
 		mock.ExpectQuery(q).WillReturnError(sql.ErrNoRows)
-		err2 := resources.ReadUser(d, db)
+		diags2 := resources.ReadUser(context.Background(), d, db)
 		r.Empty(d.State())
-		r.Nil(err2)
+		r.Empty(diags2)
 	})
 }
 
@@ -137,7 +138,7 @@ func TestUserDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`^DROP USER "drop_it"$`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteUser(d, db)
-		r.NoError(err)
+		diags := resources.DeleteUser(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
@@ -1,13 +1,14 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
-	"fmt"
 	"log"
 	"regexp"
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -80,20 +81,30 @@ func DiffSuppressStatement(_, old, new string, d *schema.ResourceData) bool {
 // View returns a pointer to the resource representing a view
 func View() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateView,
-		Read:   ReadView,
-		Update: UpdateView,
-		Delete: DeleteView,
-
-		Schema: viewSchema,
+		CreateContext: CreateView,
+		ReadContext:   ReadView,
+		UpdateContext: UpdateView,
+		DeleteContext: DeleteView,
+
+		Schema:        viewSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(viewSchema, upgradeDelimitedIDV0),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 	}
 }
 
-// CreateView implements schema.CreateFunc
-func CreateView(d *schema.ResourceData, meta interface{}) error {
+// CreateView implements schema.CreateContextFunc
+func CreateView(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 	schema := d.Get("schema").(string)
@@ -122,28 +133,28 @@ func CreateView(d *schema.ResourceData, meta interface{}) error {
 
 	q, err := builder.Create()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	err = snowflake.Exec(db, q)
+	err = snowflake.ExecContext(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error creating view %v", name)
+		return diag.FromErr(errors.Wrapf(err, "error creating view %v", name))
 	}
 
-	d.SetId(fmt.Sprintf("%v|%v|%v", database, schema, name))
+	d.SetId(formatObjectID(database, schema, name))
 
-	return ReadView(d, meta)
+	return ReadView(ctx, d, meta)
 }
 
-// ReadView implements schema.ReadFunc
-func ReadView(d *schema.ResourceData, meta interface{}) error {
+// ReadView implements schema.ReadContextFunc
+func ReadView(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	dbName, schema, view, err := splitViewID(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	q := snowflake.View(view).WithDB(dbName).WithSchema(schema).Show()
-	row := snowflake.QueryRow(db, q)
+	row := snowflake.QueryRowContext(ctx, db, q)
 	v, err := snowflake.ScanView(row)
 	if err == sql.ErrNoRows {
 		// If not found, mark resource to be removed from statefile during apply or refresh
@@ -152,50 +163,48 @@ func ReadView(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("name", v.Name.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("is_secure", v.IsSecure)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("comment", v.Comment.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("schema", v.SchemaName.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Want to only capture the Select part of the query because before that is the Create part of the view which we no longer care about
-
-	extractor := snowflake.NewViewSelectStatementExtractor(v.Text.String)
-	substringOfQuery, err := extractor.Extract()
+	ddl, err := snowflake.ParseDDL(v.Text.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = d.Set("statement", substringOfQuery)
+	err = d.Set("statement", ddl.Body)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return d.Set("database", v.DatabaseName.String)
+	return diag.FromErr(d.Set("database", v.DatabaseName.String))
 }
 
-// UpdateView implements schema.UpdateFunc
-func UpdateView(d *schema.ResourceData, meta interface{}) error {
+// UpdateView implements schema.UpdateContextFunc
+func UpdateView(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	dbName, schema, view, err := splitViewID(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	builder := snowflake.View(view).WithDB(dbName).WithSchema(schema)
@@ -206,14 +215,14 @@ func UpdateView(d *schema.ResourceData, meta interface{}) error {
 
 		q, err := builder.Rename(name.(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
-		err = snowflake.Exec(db, q)
+		err = snowflake.ExecContext(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error renaming view %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error renaming view %v", d.Id()))
 		}
 
-		d.SetId(fmt.Sprintf("%v|%v|%v", dbName, schema, name.(string)))
+		d.SetId(formatObjectID(dbName, schema, name.(string)))
 	}
 
 	if d.HasChange("comment") {
@@ -222,20 +231,20 @@ func UpdateView(d *schema.ResourceData, meta interface{}) error {
 		if c := comment.(string); c == "" {
 			q, err := builder.RemoveComment()
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
-			err = snowflake.Exec(db, q)
+			err = snowflake.ExecContext(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error unsetting comment for view %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error unsetting comment for view %v", d.Id()))
 			}
 		} else {
 			q, err := builder.ChangeComment(c)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
-			err = snowflake.Exec(db, q)
+			err = snowflake.ExecContext(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error updating comment for view %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error updating comment for view %v", d.Id()))
 			}
 		}
 	}
@@ -245,70 +254,70 @@ func UpdateView(d *schema.ResourceData, meta interface{}) error {
 		if secure.(bool) {
 			q, err := builder.Secure()
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
-			err = snowflake.Exec(db, q)
+			err = snowflake.ExecContext(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error setting secure for view %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error setting secure for view %v", d.Id()))
 			}
 		} else {
 			q, err := builder.Unsecure()
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
-			err = snowflake.Exec(db, q)
+			err = snowflake.ExecContext(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error unsetting secure for view %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error unsetting secure for view %v", d.Id()))
 			}
 		}
 	}
-	handleTagChanges(db, d, builder)
+	handleTagChanges(ctx, db, d, builder)
 	if d.HasChange("tag") {
 		old, new := d.GetChange("tag")
 		removed, added, changed := getTags(old).diffs(getTags(new))
 		for _, tA := range removed {
 			q := builder.UnsetTag(tA.toSnowflakeTagValue())
-			err := snowflake.Exec(db, q)
+			err := snowflake.ExecContext(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error dropping tag on %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error dropping tag on %v", d.Id()))
 			}
 		}
 		for _, tA := range added {
 			q := builder.AddTag(tA.toSnowflakeTagValue())
 
-			err := snowflake.Exec(db, q)
+			err := snowflake.ExecContext(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error adding column on %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error adding column on %v", d.Id()))
 			}
 		}
 		for _, tA := range changed {
 			q := builder.ChangeTag(tA.toSnowflakeTagValue())
-			err := snowflake.Exec(db, q)
+			err := snowflake.ExecContext(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error changing property on %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error changing property on %v", d.Id()))
 			}
 		}
 	}
 
-	return ReadView(d, meta)
+	return ReadView(ctx, d, meta)
 }
 
-// DeleteView implements schema.DeleteFunc
-func DeleteView(d *schema.ResourceData, meta interface{}) error {
+// DeleteView implements schema.DeleteContextFunc
+func DeleteView(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	dbName, schema, view, err := splitViewID(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	q, err := snowflake.View(view).WithDB(dbName).WithSchema(schema).Drop()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = snowflake.Exec(db, q)
+	err = snowflake.ExecContext(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error deleting view %v", d.Id())
+		return diag.FromErr(errors.Wrapf(err, "error deleting view %v", d.Id()))
 	}
 
 	d.SetId("")
@@ -341,10 +350,14 @@ func ViewExists(data *schema.ResourceData, meta interface{}) (bool, error) {
 // splitViewID takes the <database_name>|<schema_name>|<view_name> ID and returns the database
 // name, schema name and view name.
 func splitViewID(v string) (string, string, string, error) {
-	arr := strings.Split(v, "|")
-	if len(arr) != 3 {
-		return "", "", "", fmt.Errorf("ID %v is invalid", v)
+	arr, err := splitObjectID(v, 3)
+	if err != nil {
+		return "", "", "", err
+	}
+	names, err := objectNames(arr)
+	if err != nil {
+		return "", "", "", err
 	}
 
-	return arr[0], arr[1], arr[2], nil
+	return names[0].Name(), names[1].Name(), names[2].Name(), nil
 }
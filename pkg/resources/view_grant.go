@@ -1,8 +1,11 @@
 package resources
 
 import (
+	"context"
+
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -73,22 +76,32 @@ var viewGrantSchema = map[string]*schema.Schema{
 func ViewGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create: CreateViewGrant,
-			Read:   ReadViewGrant,
-			Delete: DeleteViewGrant,
-			Update: UpdateViewGrant,
+			CreateContext: CreateViewGrant,
+			ReadContext:   ReadViewGrant,
+			DeleteContext: DeleteViewGrant,
+			UpdateContext: UpdateViewGrant,
 
-			Schema: viewGrantSchema,
+			Schema:        viewGrantSchema,
+			SchemaVersion: 1,
+			StateUpgraders: []schema.StateUpgrader{
+				stateUpgraderV0(viewGrantSchema, upgradeGrantIDV0),
+			},
 			Importer: &schema.ResourceImporter{
 				StateContext: schema.ImportStatePassthroughContext,
 			},
+			Timeouts: &schema.ResourceTimeout{
+				Create: schema.DefaultTimeout(defaultTimeout),
+				Read:   schema.DefaultTimeout(defaultTimeout),
+				Update: schema.DefaultTimeout(defaultTimeout),
+				Delete: schema.DefaultTimeout(defaultTimeout),
+			},
 		},
 		ValidPrivs: validViewPrivileges,
 	}
 }
 
-// CreateViewGrant implements schema.CreateFunc
-func CreateViewGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateViewGrant implements schema.CreateContextFunc
+func CreateViewGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var (
 		viewName   string
 		schemaName string
@@ -110,14 +123,14 @@ func CreateViewGrant(d *schema.ResourceData, meta interface{}) error {
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 
 	if (schemaName == "") && !futureViews {
-		return errors.New("schema_name must be set unless on_future is true.")
+		return diag.FromErr(errors.New("schema_name must be set unless on_future is true."))
 	}
 
 	if (viewName == "") && !futureViews {
-		return errors.New("view_name must be set unless on_future is true.")
+		return diag.FromErr(errors.New("view_name must be set unless on_future is true."))
 	}
 	if (viewName != "") && futureViews {
-		return errors.New("view_name must be empty if on_future is true.")
+		return diag.FromErr(errors.New("view_name must be empty if on_future is true."))
 	}
 
 	var builder snowflake.GrantBuilder
@@ -127,9 +140,9 @@ func CreateViewGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.ViewGrant(dbName, schemaName, viewName)
 	}
 
-	err := createGenericGrant(d, meta, builder)
+	err := createGenericGrant(ctx, d, meta, builder)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grant := &grantID{
@@ -142,18 +155,18 @@ func CreateViewGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := grant.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadViewGrant(d, meta)
+	return ReadViewGrant(ctx, d, meta)
 }
 
-// ReadViewGrant implements schema.ReadFunc
-func ReadViewGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadViewGrant implements schema.ReadContextFunc
+func ReadViewGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -162,11 +175,11 @@ func ReadViewGrant(d *schema.ResourceData, meta interface{}) error {
 
 	err = d.Set("database_name", dbName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("schema_name", schemaName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	futureViewsEnabled := false
 	if viewName == "" {
@@ -174,19 +187,19 @@ func ReadViewGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	err = d.Set("view_name", viewName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("on_future", futureViewsEnabled)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("privilege", priv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("with_grant_option", grantID.GrantOption)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var builder snowflake.GrantBuilder
@@ -196,14 +209,14 @@ func ReadViewGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.ViewGrant(dbName, schemaName, viewName)
 	}
 
-	return readGenericGrant(d, meta, viewGrantSchema, builder, futureViewsEnabled, validViewPrivileges)
+	return diag.FromErr(readGenericGrant(ctx, d, meta, viewGrantSchema, builder, futureViewsEnabled, validViewPrivileges))
 }
 
-// DeleteViewGrant implements schema.DeleteFunc
-func DeleteViewGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteViewGrant implements schema.DeleteContextFunc
+func DeleteViewGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -217,11 +230,11 @@ func DeleteViewGrant(d *schema.ResourceData, meta interface{}) error {
 	} else {
 		builder = snowflake.ViewGrant(dbName, schemaName, viewName)
 	}
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
 
-// UpdateViewGrant implements schema.UpdateFunc
-func UpdateViewGrant(d *schema.ResourceData, meta interface{}) error {
+// UpdateViewGrant implements schema.UpdateContextFunc
+func UpdateViewGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// for now the only thing we can update are roles or shares
 	// if nothing changed, nothing to update and we're done
 	if !d.HasChanges("roles", "shares") {
@@ -240,7 +253,7 @@ func UpdateViewGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := grantID.ResourceName
@@ -257,18 +270,18 @@ func UpdateViewGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// first revoke
-	err = deleteGenericGrantRolesAndShares(
+	err = deleteGenericGrantRolesAndShares(ctx,
 		meta, builder, grantID.Privilege, rolesToRevoke, sharesToRevoke)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// then add
-	err = createGenericGrantRolesAndShares(
+	err = createGenericGrantRolesAndShares(ctx,
 		meta, builder, grantID.Privilege, grantID.GrantOption, rolesToAdd, sharesToAdd)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Done, refresh state
-	return ReadViewGrant(d, meta)
+	return ReadViewGrant(ctx, d, meta)
 }
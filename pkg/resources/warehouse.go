@@ -1,12 +1,15 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"log"
 	"strconv"
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -127,20 +130,33 @@ var warehouseSchema = map[string]*schema.Schema{
 // Warehouse returns a pointer to the resource representing a warehouse
 func Warehouse() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateWarehouse,
-		Read:   ReadWarehouse,
-		Delete: DeleteWarehouse,
-		Update: UpdateWarehouse,
+		CreateContext: CreateWarehouse,
+		ReadContext:   ReadWarehouse,
+		DeleteContext: DeleteWarehouse,
+		UpdateContext: UpdateWarehouse,
 
-		Schema: warehouseSchema,
+		Schema:        warehouseSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgraderV0(warehouseSchema, upgradeUnchangedV0),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customdiff.All(
+			intAtMost("min_cluster_count", "max_cluster_count"),
+		),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 	}
 }
 
-// CreateWarehouse implements schema.CreateFunc
-func CreateWarehouse(d *schema.ResourceData, meta interface{}) error {
+// CreateWarehouse implements schema.CreateContextFunc
+func CreateWarehouse(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	props := append(warehouseProperties, warehouseCreateProperties...)
 	return CreateResource(
 		"warehouse",
@@ -150,16 +166,16 @@ func CreateWarehouse(d *schema.ResourceData, meta interface{}) error {
 			return snowflake.Warehouse(name).Builder
 		},
 		ReadWarehouse,
-	)(d, meta)
+	)(ctx, d, meta)
 }
 
-// ReadWarehouse implements schema.ReadFunc
-func ReadWarehouse(d *schema.ResourceData, meta interface{}) error {
+// ReadWarehouse implements schema.ReadContextFunc
+func ReadWarehouse(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	warehouseBuilder := snowflake.Warehouse(d.Id())
 	stmt := warehouseBuilder.Show()
 
-	row := snowflake.QueryRow(db, stmt)
+	row := snowflake.QueryRowContext(ctx, db, stmt)
 	w, err := snowflake.ScanWarehouse(row)
 	if err == sql.ErrNoRows {
 		// If not found, mark resource to be removed from statefile during apply or refresh
@@ -168,55 +184,55 @@ func ReadWarehouse(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("name", w.Name)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("comment", w.Comment)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("warehouse_size", w.Size)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("max_cluster_count", w.MaxClusterCount)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("min_cluster_count", w.MinClusterCount)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("scaling_policy", w.ScalingPolicy)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("auto_suspend", w.AutoSuspend)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("auto_resume", w.AutoResume)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("resource_monitor", w.ResourceMonitor)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	stmt = warehouseBuilder.ShowParameters()
-	paramRows, err := snowflake.Query(db, stmt)
+	paramRows, err := snowflake.QueryContext(ctx, db, stmt)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	warehouseParams, err := snowflake.ScanWarehouseParameters(paramRows)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	for _, param := range warehouseParams {
@@ -226,7 +242,7 @@ func ReadWarehouse(d *schema.ResourceData, meta interface{}) error {
 		if strings.EqualFold(param.Type, "number") {
 			i, err := strconv.ParseInt(param.Value, 10, 64)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			value = i
 		} else {
@@ -236,15 +252,15 @@ func ReadWarehouse(d *schema.ResourceData, meta interface{}) error {
 		key := strings.ToLower(param.Key)
 		err = d.Set(key, value)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	return nil
 }
 
-// UpdateWarehouse implements schema.UpdateFunc
-func UpdateWarehouse(d *schema.ResourceData, meta interface{}) error {
+// UpdateWarehouse implements schema.UpdateContextFunc
+func UpdateWarehouse(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return UpdateResource(
 		"warehouse",
 		warehouseProperties,
@@ -253,14 +269,14 @@ func UpdateWarehouse(d *schema.ResourceData, meta interface{}) error {
 			return snowflake.Warehouse(name).Builder
 		},
 		ReadWarehouse,
-	)(d, meta)
+	)(ctx, d, meta)
 }
 
-// DeleteWarehouse implements schema.DeleteFunc
-func DeleteWarehouse(d *schema.ResourceData, meta interface{}) error {
+// DeleteWarehouse implements schema.DeleteContextFunc
+func DeleteWarehouse(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return DeleteResource(
 		"warehouse", func(name string) *snowflake.Builder {
 			return snowflake.Warehouse(name).Builder
 		},
-	)(d, meta)
+	)(ctx, d, meta)
 }
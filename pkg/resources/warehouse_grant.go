@@ -1,8 +1,11 @@
 package resources
 
 import (
+	"context"
+
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -47,32 +50,42 @@ var warehouseGrantSchema = map[string]*schema.Schema{
 func WarehouseGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create: CreateWarehouseGrant,
-			Read:   ReadWarehouseGrant,
-			Delete: DeleteWarehouseGrant,
-			Update: UpdateWarehouseGrant,
-
-			Schema: warehouseGrantSchema,
+			CreateContext: CreateWarehouseGrant,
+			ReadContext:   ReadWarehouseGrant,
+			DeleteContext: DeleteWarehouseGrant,
+			UpdateContext: UpdateWarehouseGrant,
+
+			Schema:        warehouseGrantSchema,
+			SchemaVersion: 1,
+			StateUpgraders: []schema.StateUpgrader{
+				stateUpgraderV0(warehouseGrantSchema, upgradeGrantIDV0),
+			},
 			// FIXME - tests for this don't currently work
 			Importer: &schema.ResourceImporter{
 				StateContext: schema.ImportStatePassthroughContext,
 			},
+			Timeouts: &schema.ResourceTimeout{
+				Create: schema.DefaultTimeout(defaultTimeout),
+				Read:   schema.DefaultTimeout(defaultTimeout),
+				Update: schema.DefaultTimeout(defaultTimeout),
+				Delete: schema.DefaultTimeout(defaultTimeout),
+			},
 		},
 		ValidPrivs: validWarehousePrivileges,
 	}
 }
 
-// CreateWarehouseGrant implements schema.CreateFunc
-func CreateWarehouseGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateWarehouseGrant implements schema.CreateContextFunc
+func CreateWarehouseGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	w := d.Get("warehouse_name").(string)
 	priv := d.Get("privilege").(string)
 	grantOption := d.Get("with_grant_option").(bool)
 	builder := snowflake.WarehouseGrant(w)
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 
-	err := createGenericGrant(d, meta, builder)
+	err := createGenericGrant(ctx, d, meta, builder)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grant := &grantID{
@@ -83,55 +96,55 @@ func CreateWarehouseGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := grant.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadWarehouseGrant(d, meta)
+	return ReadWarehouseGrant(ctx, d, meta)
 }
 
-// ReadWarehouseGrant implements schema.ReadFunc
-func ReadWarehouseGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadWarehouseGrant implements schema.ReadContextFunc
+func ReadWarehouseGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	w := grantID.ResourceName
 	priv := grantID.Privilege
 
 	err = d.Set("warehouse_name", w)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("privilege", priv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("with_grant_option", grantID.GrantOption)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	builder := snowflake.WarehouseGrant(w)
 
-	return readGenericGrant(d, meta, warehouseGrantSchema, builder, false, validWarehousePrivileges)
+	return diag.FromErr(readGenericGrant(ctx, d, meta, warehouseGrantSchema, builder, false, validWarehousePrivileges))
 }
 
-// DeleteWarehouseGrant implements schema.DeleteFunc
-func DeleteWarehouseGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteWarehouseGrant implements schema.DeleteContextFunc
+func DeleteWarehouseGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	w := grantID.ResourceName
 
 	builder := snowflake.WarehouseGrant(w)
 
-	return deleteGenericGrant(d, meta, builder)
+	return diag.FromErr(deleteGenericGrant(ctx, d, meta, builder))
 }
 
-// UpdateWarehouseGrant implements schema.UpdateFunc
-func UpdateWarehouseGrant(d *schema.ResourceData, meta interface{}) error {
+// UpdateWarehouseGrant implements schema.UpdateContextFunc
+func UpdateWarehouseGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// for now the only thing we can update is roles. if nothing changed,
 	// nothing to update and we're done.
 	if !d.HasChanges("roles") {
@@ -142,25 +155,25 @@ func UpdateWarehouseGrant(d *schema.ResourceData, meta interface{}) error {
 
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// create the builder
 	builder := snowflake.WarehouseGrant(grantID.ResourceName)
 
 	// first revoke
-	if err := deleteGenericGrantRolesAndShares(
+	if err := deleteGenericGrantRolesAndShares(ctx,
 		meta,
 		builder,
 		grantID.Privilege,
 		rolesToRevoke,
 		nil,
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// then add
-	if err := createGenericGrantRolesAndShares(
+	if err := createGenericGrantRolesAndShares(ctx,
 		meta,
 		builder,
 		grantID.Privilege,
@@ -168,9 +181,9 @@ func UpdateWarehouseGrant(d *schema.ResourceData, meta interface{}) error {
 		rolesToAdd,
 		nil,
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Done, refresh state
-	return ReadWarehouseGrant(d, meta)
+	return ReadWarehouseGrant(ctx, d, meta)
 }
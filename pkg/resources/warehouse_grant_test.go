@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 	"time"
@@ -34,8 +35,8 @@ func TestWarehouseGrantCreate(t *testing.T) {
 		mock.ExpectExec(`^GRANT USAGE ON WAREHOUSE "test-warehouse" TO ROLE "test-role-1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT USAGE ON WAREHOUSE "test-warehouse" TO ROLE "test-role-2"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadWarehouseGrant(mock)
-		err := resources.CreateWarehouseGrant(d, db)
-		r.NoError(err)
+		diags := resources.CreateWarehouseGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
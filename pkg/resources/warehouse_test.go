@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -32,8 +33,8 @@ func TestWarehouseCreate(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`CREATE WAREHOUSE "good_name" COMMENT='great comment`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadWarehouse(mock)
-		err := resources.CreateWarehouse(d, db)
-		r.NoError(err)
+		diags := resources.CreateWarehouse(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -54,17 +55,17 @@ func TestWarehouseRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadWarehouse(mock)
-		err := resources.ReadWarehouse(d, db)
-		r.NoError(err)
+		diags := resources.ReadWarehouse(context.Background(), d, db)
+		r.Empty(diags)
 		r.Equal("mock comment", d.Get("comment").(string))
 
 		// Test when resource is not found, checking if state will be empty
 		r.NotEmpty(d.State())
 		q := snowflake.Warehouse(d.Id()).Show()
 		mock.ExpectQuery(q).WillReturnError(sql.ErrNoRows)
-		err2 := resources.ReadWarehouse(d, db)
+		diags2 := resources.ReadWarehouse(context.Background(), d, db)
 		r.Empty(d.State())
-		r.Nil(err2)
+		r.Empty(diags2)
 	})
 }
 
@@ -75,7 +76,32 @@ func TestWarehouseDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP WAREHOUSE "drop_it"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteWarehouse(d, db)
-		r.NoError(err)
+		diags := resources.DeleteWarehouse(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
+
+func TestWarehouseCustomizeDiff(t *testing.T) {
+	r := require.New(t)
+
+	err := planDiff(resources.Warehouse(), map[string]interface{}{
+		"name":              "good_name",
+		"min_cluster_count": 3,
+		"max_cluster_count": 2,
+	})
+	r.Error(err)
+	r.Contains(err.Error(), "min_cluster_count (3) must be less than or equal to max_cluster_count (2)")
+
+	err = planDiff(resources.Warehouse(), map[string]interface{}{
+		"name":              "good_name",
+		"min_cluster_count": 2,
+		"max_cluster_count": 3,
+	})
+	r.NoError(err)
+
+	err = planDiff(resources.Warehouse(), map[string]interface{}{
+		"name":              "good_name",
+		"min_cluster_count": 3,
+	})
+	r.NoError(err)
+}
@@ -0,0 +1,204 @@
+package snowflake
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// AlertBuilder abstracts the creation of sql queries for a snowflake alert
+type AlertBuilder struct {
+	name      string
+	db        string
+	schema    string
+	warehouse string
+	schedule  string
+	comment   string
+	condition string
+	action    string
+}
+
+// QualifiedName prepends the db and schema and escapes everything nicely
+func (ab *AlertBuilder) QualifiedName() string {
+	return QuoteIdentifier(ab.db, ab.schema, ab.name)
+}
+
+// Name returns the name of the alert
+func (ab *AlertBuilder) Name() string {
+	return ab.name
+}
+
+// WithWarehouse adds a warehouse to the AlertBuilder
+func (ab *AlertBuilder) WithWarehouse(s string) *AlertBuilder {
+	ab.warehouse = s
+	return ab
+}
+
+// WithSchedule adds a schedule to the AlertBuilder
+func (ab *AlertBuilder) WithSchedule(s string) *AlertBuilder {
+	ab.schedule = s
+	return ab
+}
+
+// WithComment adds a comment to the AlertBuilder
+func (ab *AlertBuilder) WithComment(c string) *AlertBuilder {
+	ab.comment = c
+	return ab
+}
+
+// WithCondition adds the query whose results trigger the alert to the AlertBuilder
+func (ab *AlertBuilder) WithCondition(condition string) *AlertBuilder {
+	ab.condition = condition
+	return ab
+}
+
+// WithAction adds the statement executed when the alert triggers to the AlertBuilder
+func (ab *AlertBuilder) WithAction(action string) *AlertBuilder {
+	ab.action = action
+	return ab
+}
+
+// Alert returns a pointer to a Builder that abstracts the DDL operations for an alert.
+//
+// Supported DDL operations are:
+//   - CREATE ALERT
+//   - ALTER ALERT
+//   - DROP ALERT
+//   - DESCRIBE ALERT
+//   - SHOW ALERTS
+//
+// [Snowflake Reference](https://docs.snowflake.com/en/sql-reference/commands-alert)
+func Alert(name, db, schema string) *AlertBuilder {
+	return &AlertBuilder{
+		name:   name,
+		db:     db,
+		schema: schema,
+	}
+}
+
+// Create returns the SQL that will create a new alert, suspended
+func (ab *AlertBuilder) Create() string {
+	q := strings.Builder{}
+	q.WriteString(fmt.Sprintf(`CREATE ALERT %v`, ab.QualifiedName()))
+
+	if ab.warehouse != "" {
+		q.WriteString(fmt.Sprintf(` WAREHOUSE = %v`, QuoteIdentifier(ab.warehouse)))
+	}
+
+	if ab.schedule != "" {
+		q.WriteString(fmt.Sprintf(` SCHEDULE = '%v'`, EscapeString(ab.schedule)))
+	}
+
+	if ab.comment != "" {
+		q.WriteString(fmt.Sprintf(` COMMENT = '%v'`, EscapeString(ab.comment)))
+	}
+
+	q.WriteString(fmt.Sprintf(` IF (EXISTS (%v))`, UnescapeString(ab.condition)))
+	q.WriteString(fmt.Sprintf(` THEN %v`, UnescapeString(ab.action)))
+
+	return q.String()
+}
+
+// ChangeWarehouse returns the sql that will change the warehouse for the alert.
+func (ab *AlertBuilder) ChangeWarehouse(newWh string) string {
+	return fmt.Sprintf(`ALTER ALERT %v SET WAREHOUSE = %v`, ab.QualifiedName(), QuoteIdentifier(newWh))
+}
+
+// ChangeSchedule returns the sql that will change the schedule for the alert.
+func (ab *AlertBuilder) ChangeSchedule(newSchedule string) string {
+	return fmt.Sprintf(`ALTER ALERT %v SET SCHEDULE = '%v'`, ab.QualifiedName(), EscapeString(newSchedule))
+}
+
+// ChangeComment returns the sql that will change the comment for the alert.
+func (ab *AlertBuilder) ChangeComment(newComment string) string {
+	return fmt.Sprintf(`ALTER ALERT %v SET COMMENT = '%v'`, ab.QualifiedName(), EscapeString(newComment))
+}
+
+// RemoveComment returns the sql that will remove the comment for the alert.
+func (ab *AlertBuilder) RemoveComment() string {
+	return fmt.Sprintf(`ALTER ALERT %v UNSET COMMENT`, ab.QualifiedName())
+}
+
+// ChangeCondition returns the sql that will update the condition for the alert.
+func (ab *AlertBuilder) ChangeCondition(newCondition string) string {
+	return fmt.Sprintf(`ALTER ALERT %v MODIFY CONDITION EXISTS (%v)`, ab.QualifiedName(), UnescapeString(newCondition))
+}
+
+// ChangeAction returns the sql that will update the action for the alert.
+func (ab *AlertBuilder) ChangeAction(newAction string) string {
+	return fmt.Sprintf(`ALTER ALERT %v MODIFY ACTION %v`, ab.QualifiedName(), UnescapeString(newAction))
+}
+
+// Suspend returns the sql that will suspend the alert.
+func (ab *AlertBuilder) Suspend() string {
+	return fmt.Sprintf(`ALTER ALERT %v SUSPEND`, ab.QualifiedName())
+}
+
+// Resume returns the sql that will resume the alert.
+func (ab *AlertBuilder) Resume() string {
+	return fmt.Sprintf(`ALTER ALERT %v RESUME`, ab.QualifiedName())
+}
+
+// Drop returns the sql that will remove the alert.
+func (ab *AlertBuilder) Drop() string {
+	return fmt.Sprintf(`DROP ALERT %v`, ab.QualifiedName())
+}
+
+// Describe returns the sql that will describe an alert.
+func (ab *AlertBuilder) Describe() string {
+	return fmt.Sprintf(`DESCRIBE ALERT %v`, ab.QualifiedName())
+}
+
+// Show returns the sql that will show an alert.
+func (ab *AlertBuilder) Show() string {
+	return fmt.Sprintf(`SHOW ALERTS LIKE '%v' IN SCHEMA %v`, EscapeString(ab.name), QuoteIdentifier(ab.db, ab.schema))
+}
+
+type alert struct {
+	CreatedOn    string         `db:"created_on"`
+	Name         string         `db:"name"`
+	DatabaseName string         `db:"database_name"`
+	SchemaName   string         `db:"schema_name"`
+	Owner        string         `db:"owner"`
+	Comment      sql.NullString `db:"comment"`
+	Warehouse    sql.NullString `db:"warehouse"`
+	Schedule     sql.NullString `db:"schedule"`
+	State        string         `db:"state"`
+	Condition    sql.NullString `db:"condition"`
+	Action       sql.NullString `db:"action"`
+}
+
+// IsEnabled returns whether the alert is resumed
+func (a *alert) IsEnabled() bool {
+	return strings.ToLower(a.State) == "started"
+}
+
+// ScanAlert turns a row of SHOW ALERTS or DESCRIBE ALERT into an alert object
+func ScanAlert(row *sqlx.Row) (*alert, error) {
+	a := &alert{}
+	e := row.StructScan(a)
+	return a, e
+}
+
+// ListAlerts returns the alerts in the given schema
+func ListAlerts(ctx context.Context, databaseName string, schemaName string, db *sql.DB) ([]alert, error) {
+	stmt := fmt.Sprintf(`SHOW ALERTS IN SCHEMA %v`, QuoteIdentifier(databaseName, schemaName))
+	rows, err := QueryContext(ctx, db, stmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	alerts := []alert{}
+	err = sqlx.StructScan(rows, &alerts)
+	if err == sql.ErrNoRows {
+		log.Printf("[DEBUG] no alerts found")
+		return nil, nil
+	}
+	return alerts, errors.Wrapf(err, "unable to scan row for %s", stmt)
+}
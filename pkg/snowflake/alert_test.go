@@ -0,0 +1,40 @@
+package snowflake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlertCreate(t *testing.T) {
+	r := require.New(t)
+	ab := Alert("test_alert", "test_db", "test_schema")
+	r.Equal(`"test_db"."test_schema"."test_alert"`, ab.QualifiedName())
+
+	ab.WithWarehouse("test_wh").WithSchedule("1 MINUTE").WithCondition("SELECT 1").WithAction("SELECT 2")
+	r.Equal(`CREATE ALERT "test_db"."test_schema"."test_alert" WAREHOUSE = "test_wh" SCHEDULE = '1 MINUTE' IF (EXISTS (SELECT 1)) THEN SELECT 2`, ab.Create())
+
+	ab.WithSchedule("USING CRON 0 9-17 * * SUN America/Los_Angeles").WithComment("test's comment")
+	r.Equal(`CREATE ALERT "test_db"."test_schema"."test_alert" WAREHOUSE = "test_wh" SCHEDULE = 'USING CRON 0 9-17 * * SUN America/Los_Angeles' COMMENT = 'test\'s comment' IF (EXISTS (SELECT 1)) THEN SELECT 2`, ab.Create())
+}
+
+func TestAlertChange(t *testing.T) {
+	r := require.New(t)
+	ab := Alert("test_alert", "test_db", "test_schema")
+	r.Equal(`ALTER ALERT "test_db"."test_schema"."test_alert" SET WAREHOUSE = "much_wh"`, ab.ChangeWarehouse("much_wh"))
+	r.Equal(`ALTER ALERT "test_db"."test_schema"."test_alert" SET SCHEDULE = '5 MINUTE'`, ab.ChangeSchedule("5 MINUTE"))
+	r.Equal(`ALTER ALERT "test_db"."test_schema"."test_alert" SET COMMENT = 'much comment'`, ab.ChangeComment("much comment"))
+	r.Equal(`ALTER ALERT "test_db"."test_schema"."test_alert" UNSET COMMENT`, ab.RemoveComment())
+	r.Equal(`ALTER ALERT "test_db"."test_schema"."test_alert" MODIFY CONDITION EXISTS (SELECT 1 FROM t)`, ab.ChangeCondition("SELECT 1 FROM t"))
+	r.Equal(`ALTER ALERT "test_db"."test_schema"."test_alert" MODIFY ACTION INSERT INTO t VALUES (1)`, ab.ChangeAction("INSERT INTO t VALUES (1)"))
+}
+
+func TestAlertStatements(t *testing.T) {
+	r := require.New(t)
+	ab := Alert("test_alert", "test_db", "test_schema")
+	r.Equal(`ALTER ALERT "test_db"."test_schema"."test_alert" SUSPEND`, ab.Suspend())
+	r.Equal(`ALTER ALERT "test_db"."test_schema"."test_alert" RESUME`, ab.Resume())
+	r.Equal(`DROP ALERT "test_db"."test_schema"."test_alert"`, ab.Drop())
+	r.Equal(`DESCRIBE ALERT "test_db"."test_schema"."test_alert"`, ab.Describe())
+	r.Equal(`SHOW ALERTS LIKE 'test_alert' IN SCHEMA "test_db"."test_schema"`, ab.Show())
+}
@@ -1,6 +1,7 @@
 package snowflake
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"strings"
@@ -50,8 +51,8 @@ func ScanCurrentAccount(row *sqlx.Row) (*account, error) {
 	return acc, err
 }
 
-func ReadCurrentAccount(db *sql.DB) (*account, error) {
-	row := QueryRow(db, SelectCurrentAccount())
+func ReadCurrentAccount(ctx context.Context, db *sql.DB) (*account, error) {
+	row := QueryRowContext(ctx, db, SelectCurrentAccount())
 	return ScanCurrentAccount(row)
 }
 
@@ -1,6 +1,7 @@
 package snowflake_test
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -60,7 +61,7 @@ func TestCurrentAccountRead(t *testing.T) {
 			rows := sqlmock.NewRows([]string{"account", "region"}).AddRow(testCase.account, testCase.region)
 			mock.ExpectQuery(`SELECT CURRENT_ACCOUNT\(\) AS "account", CURRENT_REGION\(\) AS "region";`).WillReturnRows(rows)
 
-			acc, err := snowflake.ReadCurrentAccount(sqlxDB.DB)
+			acc, err := snowflake.ReadCurrentAccount(context.Background(), sqlxDB.DB)
 			r.NoError(err)
 			r.Equal(testCase.account, acc.Account)
 			r.Equal(testCase.region, acc.Region)
@@ -1,6 +1,7 @@
 package snowflake
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
@@ -35,7 +36,7 @@ func DatabaseFromShare(name, provider, share string) *DatabaseShareBuilder {
 
 // Create returns the SQL statement required to create a database from a share
 func (dsb *DatabaseShareBuilder) Create() string {
-	return fmt.Sprintf(`CREATE DATABASE "%v" FROM SHARE "%v"."%v"`, dsb.name, dsb.provider, dsb.share)
+	return fmt.Sprintf(`CREATE DATABASE %v FROM SHARE %v`, QuoteIdentifier(dsb.name), QuoteIdentifier(dsb.provider, dsb.share))
 }
 
 // DatabaseCloneBuilder is a basic builder that just creates databases from a source database
@@ -54,7 +55,7 @@ func DatabaseFromDatabase(name, database string) *DatabaseCloneBuilder {
 
 // Create returns the SQL statement required to create a database from a source database
 func (dsb *DatabaseCloneBuilder) Create() string {
-	return fmt.Sprintf(`CREATE DATABASE "%v" CLONE "%v"`, dsb.name, dsb.database)
+	return fmt.Sprintf(`CREATE DATABASE %v CLONE %v`, QuoteIdentifier(dsb.name), QuoteIdentifier(dsb.database))
 }
 
 // DatabaseReplicaBuilder is a basic builder that just creates databases from an avilable replication source
@@ -73,7 +74,7 @@ func DatabaseFromReplica(name, replica string) *DatabaseReplicaBuilder {
 
 // Create returns the SQL statement required to create a database from an avilable replication source
 func (dsb *DatabaseReplicaBuilder) Create() string {
-	return fmt.Sprintf(`CREATE DATABASE "%v" AS REPLICA OF "%v"`, dsb.name, dsb.replica)
+	return fmt.Sprintf(`CREATE DATABASE %v AS REPLICA OF %v`, QuoteIdentifier(dsb.name), QuoteIdentifier(dsb.replica))
 }
 
 type database struct {
@@ -94,9 +95,9 @@ func ScanDatabase(row *sqlx.Row) (*database, error) {
 	return d, e
 }
 
-func ListDatabases(sdb *sqlx.DB) ([]database, error) {
+func ListDatabases(ctx context.Context, sdb *sqlx.DB) ([]database, error) {
 	stmt := "SHOW DATABASES"
-	rows, err := sdb.Queryx(stmt)
+	rows, err := sdb.QueryxContext(ctx, stmt)
 	if err != nil {
 		return nil, err
 	}
@@ -111,9 +112,9 @@ func ListDatabases(sdb *sqlx.DB) ([]database, error) {
 	return dbs, errors.Wrapf(err, "unable to scan row for %s", stmt)
 }
 
-func ListDatabase(sdb *sqlx.DB, databaseName string) (*database, error) {
-	stmt := fmt.Sprintf("SHOW DATABASES LIKE '%s'", databaseName)
-	rows, err := sdb.Queryx(stmt)
+func ListDatabase(ctx context.Context, sdb *sqlx.DB, databaseName string) (*database, error) {
+	stmt := fmt.Sprintf("SHOW DATABASES LIKE '%s'", EscapeString(databaseName))
+	rows, err := sdb.QueryxContext(ctx, stmt)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,134 @@
+package snowflake
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// DatabaseRole returns a pointer to a DatabaseRoleBuilder for a role scoped to
+// the database db
+func DatabaseRole(db, name string) *DatabaseRoleBuilder {
+	return &DatabaseRoleBuilder{
+		name: name,
+		db:   db,
+	}
+}
+
+// DatabaseRoleBuilder abstracts the creation of SQL queries for a Snowflake
+// database role
+type DatabaseRoleBuilder struct {
+	name    string
+	db      string
+	comment string
+}
+
+type databaseRole struct {
+	Name    sql.NullString `db:"name"`
+	Owner   sql.NullString `db:"owner"`
+	Comment sql.NullString `db:"comment"`
+}
+
+// QualifiedName prepends the database and escapes everything nicely
+func (b *DatabaseRoleBuilder) QualifiedName() string {
+	return QuoteIdentifier(b.db, b.name)
+}
+
+// WithComment adds a comment to the DatabaseRoleBuilder
+func (b *DatabaseRoleBuilder) WithComment(c string) *DatabaseRoleBuilder {
+	b.comment = c
+	return b
+}
+
+// Create returns the SQL query that will create a new database role.
+func (b *DatabaseRoleBuilder) Create() string {
+	q := strings.Builder{}
+	q.WriteString(fmt.Sprintf(`CREATE DATABASE ROLE %v`, b.QualifiedName()))
+	if b.comment != "" {
+		q.WriteString(fmt.Sprintf(` COMMENT = '%v'`, EscapeString(b.comment)))
+	}
+	return q.String()
+}
+
+// Rename returns the SQL query that will rename the database role.
+func (b *DatabaseRoleBuilder) Rename(newName string) string {
+	return fmt.Sprintf(`ALTER DATABASE ROLE %v RENAME TO %v`, b.QualifiedName(), QuoteIdentifier(b.db, newName))
+}
+
+// ChangeComment returns the SQL query that will update the comment on the database role.
+func (b *DatabaseRoleBuilder) ChangeComment(c string) string {
+	return fmt.Sprintf(`ALTER DATABASE ROLE %v SET COMMENT = '%v'`, b.QualifiedName(), EscapeString(c))
+}
+
+// RemoveComment returns the SQL query that will remove the comment on the database role.
+func (b *DatabaseRoleBuilder) RemoveComment() string {
+	return fmt.Sprintf(`ALTER DATABASE ROLE %v UNSET COMMENT`, b.QualifiedName())
+}
+
+// Drop returns the SQL query that will drop the database role.
+func (b *DatabaseRoleBuilder) Drop() string {
+	return fmt.Sprintf(`DROP DATABASE ROLE %v`, b.QualifiedName())
+}
+
+// Show returns the SQL query that will show the database role.
+func (b *DatabaseRoleBuilder) Show() string {
+	return fmt.Sprintf(`SHOW DATABASE ROLES LIKE '%v' IN DATABASE %v`, EscapeString(b.name), QuoteIdentifier(b.db))
+}
+
+// ShowGrantsOf returns the SQL query that will show the roles and database
+// roles the database role is granted to.
+func (b *DatabaseRoleBuilder) ShowGrantsOf() string {
+	return fmt.Sprintf(`SHOW GRANTS OF DATABASE ROLE %v`, b.QualifiedName())
+}
+
+// GrantToRole returns the SQL query that will grant the database role to an
+// account role.
+func (b *DatabaseRoleBuilder) GrantToRole(role string) string {
+	return fmt.Sprintf(`GRANT DATABASE ROLE %v TO %v`, b.QualifiedName(), formatGrantee(roleType, "", role))
+}
+
+// RevokeFromRole returns the SQL query that will revoke the database role from
+// an account role.
+func (b *DatabaseRoleBuilder) RevokeFromRole(role string) string {
+	return fmt.Sprintf(`REVOKE DATABASE ROLE %v FROM %v`, b.QualifiedName(), formatGrantee(roleType, "", role))
+}
+
+// GrantToDatabaseRole returns the SQL query that will grant the database role
+// to another role of the same database.
+func (b *DatabaseRoleBuilder) GrantToDatabaseRole(name string) string {
+	return fmt.Sprintf(`GRANT DATABASE ROLE %v TO %v`, b.QualifiedName(), formatGrantee(databaseRoleType, b.db, name))
+}
+
+// RevokeFromDatabaseRole returns the SQL query that will revoke the database
+// role from another role of the same database.
+func (b *DatabaseRoleBuilder) RevokeFromDatabaseRole(name string) string {
+	return fmt.Sprintf(`REVOKE DATABASE ROLE %v FROM %v`, b.QualifiedName(), formatGrantee(databaseRoleType, b.db, name))
+}
+
+func ScanDatabaseRole(row *sqlx.Row) (*databaseRole, error) {
+	r := &databaseRole{}
+	err := row.StructScan(r)
+	return r, err
+}
+
+func ListDatabaseRoles(ctx context.Context, databaseName string, db *sql.DB) ([]databaseRole, error) {
+	stmt := fmt.Sprintf(`SHOW DATABASE ROLES IN DATABASE %v`, QuoteIdentifier(databaseName))
+	rows, err := QueryContext(ctx, db, stmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	roles := []databaseRole{}
+	err = sqlx.StructScan(rows, &roles)
+	if err == sql.ErrNoRows {
+		log.Printf("[DEBUG] no database roles found")
+		return nil, nil
+	}
+	return roles, errors.Wrapf(err, "unable to scan row for %s", stmt)
+}
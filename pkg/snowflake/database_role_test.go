@@ -0,0 +1,46 @@
+package snowflake_test
+
+import (
+	"testing"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatabaseRole(t *testing.T) {
+	r := require.New(t)
+	b := snowflake.DatabaseRole("test_db", "test_role")
+
+	r.Equal(`"test_db"."test_role"`, b.QualifiedName())
+	r.Equal(`CREATE DATABASE ROLE "test_db"."test_role"`, b.Create())
+	r.Equal(`CREATE DATABASE ROLE "test_db"."test_role" COMMENT = 'it\'s a role'`, b.WithComment("it's a role").Create())
+	r.Equal(`ALTER DATABASE ROLE "test_db"."test_role" RENAME TO "test_db"."new_role"`, b.Rename("new_role"))
+	r.Equal(`ALTER DATABASE ROLE "test_db"."test_role" SET COMMENT = 'new comment'`, b.ChangeComment("new comment"))
+	r.Equal(`ALTER DATABASE ROLE "test_db"."test_role" UNSET COMMENT`, b.RemoveComment())
+	r.Equal(`DROP DATABASE ROLE "test_db"."test_role"`, b.Drop())
+	r.Equal(`SHOW DATABASE ROLES LIKE 'test_role' IN DATABASE "test_db"`, b.Show())
+	r.Equal(`SHOW GRANTS OF DATABASE ROLE "test_db"."test_role"`, b.ShowGrantsOf())
+}
+
+func TestDatabaseRoleGrants(t *testing.T) {
+	r := require.New(t)
+	b := snowflake.DatabaseRole("test_db", "test_role")
+
+	r.Equal(`GRANT DATABASE ROLE "test_db"."test_role" TO ROLE "bob"`, b.GrantToRole("bob"))
+	r.Equal(`REVOKE DATABASE ROLE "test_db"."test_role" FROM ROLE "bob"`, b.RevokeFromRole("bob"))
+	r.Equal(`GRANT DATABASE ROLE "test_db"."test_role" TO DATABASE ROLE "test_db"."parent"`, b.GrantToDatabaseRole("parent"))
+	r.Equal(`REVOKE DATABASE ROLE "test_db"."test_role" FROM DATABASE ROLE "test_db"."parent"`, b.RevokeFromDatabaseRole("parent"))
+}
+
+func TestGrantToDatabaseRole(t *testing.T) {
+	r := require.New(t)
+
+	s := snowflake.SchemaGrant("test_db", "PUBLIC").DatabaseRole("test_db", "reader").Grant("USAGE", false)
+	r.Equal(`GRANT USAGE ON SCHEMA "test_db"."PUBLIC" TO DATABASE ROLE "test_db"."reader"`, s)
+
+	revoke := snowflake.TableGrant("test_db", "PUBLIC", "t").DatabaseRole("test_db", "reader").Revoke("SELECT")
+	r.Equal([]string{`REVOKE SELECT ON TABLE "test_db"."PUBLIC"."t" FROM DATABASE ROLE "test_db"."reader"`}, revoke)
+
+	s = snowflake.FutureTableGrant("test_db", "PUBLIC").DatabaseRole("test_db", "reader").Grant("SELECT", true)
+	r.Equal(`GRANT SELECT ON FUTURE TABLES IN SCHEMA "test_db"."PUBLIC" TO DATABASE ROLE "test_db"."reader" WITH GRANT OPTION`, s)
+}
@@ -1,6 +1,7 @@
 package snowflake_test
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -49,6 +50,15 @@ func TestDatabase(t *testing.T) {
 	r.Equal(`CREATE DATABASE "db1" FOO='ba\'r'`, q)
 }
 
+func TestDatabaseQuotedName(t *testing.T) {
+	r := require.New(t)
+	db := snowflake.Database(`Bob's "db"`)
+
+	r.Equal(`SHOW DATABASES LIKE 'Bob\'s "db"'`, db.Show())
+	r.Equal(`DROP DATABASE "Bob's ""db"""`, db.Drop())
+	r.Equal(`ALTER DATABASE "Bob's ""db""" RENAME TO "My DB"`, db.Rename("My DB"))
+}
+
 func TestDatabaseCreateFromShare(t *testing.T) {
 	r := require.New(t)
 	db := snowflake.DatabaseFromShare("db1", "abc123", "share1")
@@ -78,6 +88,6 @@ func TestListDatabases(t *testing.T) {
 	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
 	rows := sqlmock.NewRows([]string{"created_on", "name", "is_default", "is_current", "origin", "owner", "comment", "options", "retention_time"}).AddRow("", "", "", "", "", "", "", "", "")
 	mock.ExpectQuery(`SHOW DATABASES`).WillReturnRows(rows)
-	_, err = snowflake.ListDatabases(sqlxDB)
+	_, err = snowflake.ListDatabases(context.Background(), sqlxDB)
 	r.NoError(err)
 }
@@ -0,0 +1,49 @@
+package snowflake
+
+import (
+	"database/sql"
+	"log"
+	"sync"
+)
+
+// Recorder collects the statements the executors would have run against a
+// database in dry-run mode.
+type Recorder struct {
+	mu         sync.Mutex
+	statements []string
+}
+
+// Record appends stmt to the recorded statements.
+func (r *Recorder) Record(stmt string) {
+	log.Print("[INFO] dry run, not executing stmt ", stmt)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.statements = append(r.statements, stmt)
+}
+
+// Take returns the statements recorded so far and clears them.
+func (r *Recorder) Take() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stmts := r.statements
+	r.statements = nil
+	return stmts
+}
+
+var recorders sync.Map
+
+// DryRun puts db in dry-run mode: Exec, ExecMulti and ExecSteps and their
+// Context variants record their statements in r instead of running them.
+// Queries still run so that resources can read existing objects.
+func DryRun(db *sql.DB, r *Recorder) {
+	recorders.Store(db, r)
+}
+
+// DryRunRecorder returns the Recorder of a database in dry-run mode, or nil.
+func DryRunRecorder(db *sql.DB) *Recorder {
+	r, ok := recorders.Load(db)
+	if !ok {
+		return nil
+	}
+	return r.(*Recorder)
+}
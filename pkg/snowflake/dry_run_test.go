@@ -0,0 +1,41 @@
+package snowflake_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDryRunRecordsStatements(t *testing.T) {
+	r := require.New(t)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rec := &snowflake.Recorder{}
+		snowflake.DryRun(db, rec)
+		r.Same(rec, snowflake.DryRunRecorder(db))
+
+		// queries still reach the database
+		mock.ExpectQuery(`^SHOW DATABASES LIKE 'db'$`).WillReturnRows(sqlmock.NewRows([]string{"name"}))
+
+		r.NoError(snowflake.Exec(db, `CREATE DATABASE "db"`))
+		rows, err := snowflake.Query(db, `SHOW DATABASES LIKE 'db'`)
+		r.NoError(err)
+		rows.Close()
+		r.NoError(snowflake.ExecMulti(db, []string{`GRANT USAGE ON DATABASE "db" TO ROLE "r"`}))
+		r.NoError(snowflake.ExecSteps(context.Background(), db, []snowflake.Step{
+			{Statement: `ALTER DATABASE "db" SET COMMENT = 'c'`, Undo: []string{`ALTER DATABASE "db" UNSET COMMENT`}},
+		}))
+
+		r.Equal([]string{
+			`CREATE DATABASE "db"`,
+			`GRANT USAGE ON DATABASE "db" TO ROLE "r"`,
+			`ALTER DATABASE "db" SET COMMENT = 'c'`,
+		}, rec.Take())
+		r.Empty(rec.Take())
+	})
+}
@@ -0,0 +1,237 @@
+package snowflake
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// DynamicTableDownstreamLag is the target lag of dynamic tables refreshed
+// only when the dynamic tables depending on them are
+const DynamicTableDownstreamLag = "DOWNSTREAM"
+
+// DynamicTableBuilder abstracts the creation of sql queries for a snowflake dynamic table
+type DynamicTableBuilder struct {
+	name        string
+	db          string
+	schema      string
+	warehouse   string
+	targetLag   string
+	refreshMode string
+	initialize  string
+	comment     string
+	clusterBy   []string
+	query       string
+}
+
+// QualifiedName prepends the db and schema and escapes everything nicely
+func (dtb *DynamicTableBuilder) QualifiedName() string {
+	return QuoteIdentifier(dtb.db, dtb.schema, dtb.name)
+}
+
+// Name returns the name of the dynamic table
+func (dtb *DynamicTableBuilder) Name() string {
+	return dtb.name
+}
+
+// WithWarehouse adds a warehouse to the DynamicTableBuilder
+func (dtb *DynamicTableBuilder) WithWarehouse(s string) *DynamicTableBuilder {
+	dtb.warehouse = s
+	return dtb
+}
+
+// WithTargetLag adds the target lag to the DynamicTableBuilder
+func (dtb *DynamicTableBuilder) WithTargetLag(s string) *DynamicTableBuilder {
+	dtb.targetLag = s
+	return dtb
+}
+
+// WithRefreshMode adds the refresh mode (AUTO, FULL or INCREMENTAL) to the DynamicTableBuilder
+func (dtb *DynamicTableBuilder) WithRefreshMode(s string) *DynamicTableBuilder {
+	dtb.refreshMode = s
+	return dtb
+}
+
+// WithInitialize adds when the dynamic table is first refreshed (ON_CREATE or ON_SCHEDULE) to the DynamicTableBuilder
+func (dtb *DynamicTableBuilder) WithInitialize(s string) *DynamicTableBuilder {
+	dtb.initialize = s
+	return dtb
+}
+
+// WithComment adds a comment to the DynamicTableBuilder
+func (dtb *DynamicTableBuilder) WithComment(c string) *DynamicTableBuilder {
+	dtb.comment = c
+	return dtb
+}
+
+// WithClustering adds cluster keys/expressions to the DynamicTableBuilder
+func (dtb *DynamicTableBuilder) WithClustering(c []string) *DynamicTableBuilder {
+	dtb.clusterBy = c
+	return dtb
+}
+
+// WithQuery adds the query the dynamic table materializes to the DynamicTableBuilder
+func (dtb *DynamicTableBuilder) WithQuery(q string) *DynamicTableBuilder {
+	dtb.query = q
+	return dtb
+}
+
+// DynamicTable returns a pointer to a Builder that abstracts the DDL operations for a dynamic table.
+//
+// Supported DDL operations are:
+//   - CREATE DYNAMIC TABLE
+//   - ALTER DYNAMIC TABLE
+//   - DROP DYNAMIC TABLE
+//   - SHOW DYNAMIC TABLES
+//
+// [Snowflake Reference](https://docs.snowflake.com/en/user-guide/dynamic-tables-about)
+func DynamicTable(name, db, schema string) *DynamicTableBuilder {
+	return &DynamicTableBuilder{
+		name:   name,
+		db:     db,
+		schema: schema,
+	}
+}
+
+// targetLag returns the target lag quoted unless it is DOWNSTREAM
+func targetLag(lag string) string {
+	if strings.EqualFold(lag, DynamicTableDownstreamLag) {
+		return DynamicTableDownstreamLag
+	}
+	return fmt.Sprintf(`'%v'`, EscapeString(lag))
+}
+
+// Create returns the SQL that will create a new dynamic table
+func (dtb *DynamicTableBuilder) Create() string {
+	q := strings.Builder{}
+	q.WriteString(fmt.Sprintf(`CREATE DYNAMIC TABLE %v`, dtb.QualifiedName()))
+	q.WriteString(fmt.Sprintf(` TARGET_LAG = %v`, targetLag(dtb.targetLag)))
+	q.WriteString(fmt.Sprintf(` WAREHOUSE = %v`, QuoteIdentifier(dtb.warehouse)))
+
+	if dtb.refreshMode != "" {
+		q.WriteString(fmt.Sprintf(` REFRESH_MODE = %v`, dtb.refreshMode))
+	}
+
+	if dtb.initialize != "" {
+		q.WriteString(fmt.Sprintf(` INITIALIZE = %v`, dtb.initialize))
+	}
+
+	if len(dtb.clusterBy) > 0 {
+		q.WriteString(fmt.Sprintf(` CLUSTER BY (%v)`, JoinStringList(dtb.clusterBy, ", ")))
+	}
+
+	if dtb.comment != "" {
+		q.WriteString(fmt.Sprintf(` COMMENT = '%v'`, EscapeString(dtb.comment)))
+	}
+
+	q.WriteString(fmt.Sprintf(` AS %v`, UnescapeString(dtb.query)))
+
+	return q.String()
+}
+
+// ChangeWarehouse returns the sql that will change the warehouse for the dynamic table.
+func (dtb *DynamicTableBuilder) ChangeWarehouse(newWh string) string {
+	return fmt.Sprintf(`ALTER DYNAMIC TABLE %v SET WAREHOUSE = %v`, dtb.QualifiedName(), QuoteIdentifier(newWh))
+}
+
+// ChangeTargetLag returns the sql that will change the target lag for the dynamic table.
+func (dtb *DynamicTableBuilder) ChangeTargetLag(newLag string) string {
+	return fmt.Sprintf(`ALTER DYNAMIC TABLE %v SET TARGET_LAG = %v`, dtb.QualifiedName(), targetLag(newLag))
+}
+
+// ChangeComment returns the sql that will change the comment for the dynamic table.
+func (dtb *DynamicTableBuilder) ChangeComment(newComment string) string {
+	return fmt.Sprintf(`ALTER DYNAMIC TABLE %v SET COMMENT = '%v'`, dtb.QualifiedName(), EscapeString(newComment))
+}
+
+// RemoveComment returns the sql that will remove the comment for the dynamic table.
+func (dtb *DynamicTableBuilder) RemoveComment() string {
+	return fmt.Sprintf(`ALTER DYNAMIC TABLE %v UNSET COMMENT`, dtb.QualifiedName())
+}
+
+// ChangeClusterBy returns the sql that will change the clustering of the dynamic table.
+func (dtb *DynamicTableBuilder) ChangeClusterBy(cb []string) string {
+	return fmt.Sprintf(`ALTER DYNAMIC TABLE %v CLUSTER BY (%v)`, dtb.QualifiedName(), JoinStringList(cb, ", "))
+}
+
+// DropClustering returns the sql that will remove the clustering of the dynamic table.
+func (dtb *DynamicTableBuilder) DropClustering() string {
+	return fmt.Sprintf(`ALTER DYNAMIC TABLE %v DROP CLUSTERING KEY`, dtb.QualifiedName())
+}
+
+// Suspend returns the sql that will suspend the refreshes of the dynamic table.
+func (dtb *DynamicTableBuilder) Suspend() string {
+	return fmt.Sprintf(`ALTER DYNAMIC TABLE %v SUSPEND`, dtb.QualifiedName())
+}
+
+// Resume returns the sql that will resume the refreshes of the dynamic table.
+func (dtb *DynamicTableBuilder) Resume() string {
+	return fmt.Sprintf(`ALTER DYNAMIC TABLE %v RESUME`, dtb.QualifiedName())
+}
+
+// Refresh returns the sql that will refresh the dynamic table manually.
+func (dtb *DynamicTableBuilder) Refresh() string {
+	return fmt.Sprintf(`ALTER DYNAMIC TABLE %v REFRESH`, dtb.QualifiedName())
+}
+
+// Drop returns the sql that will remove the dynamic table.
+func (dtb *DynamicTableBuilder) Drop() string {
+	return fmt.Sprintf(`DROP DYNAMIC TABLE %v`, dtb.QualifiedName())
+}
+
+// Show returns the sql that will show a dynamic table.
+func (dtb *DynamicTableBuilder) Show() string {
+	return fmt.Sprintf(`SHOW DYNAMIC TABLES LIKE '%v' IN SCHEMA %v`, EscapeString(dtb.name), QuoteIdentifier(dtb.db, dtb.schema))
+}
+
+type dynamicTable struct {
+	CreatedOn       string         `db:"created_on"`
+	Name            string         `db:"name"`
+	DatabaseName    string         `db:"database_name"`
+	SchemaName      string         `db:"schema_name"`
+	ClusterBy       sql.NullString `db:"cluster_by"`
+	Rows            sql.NullInt64  `db:"rows"`
+	Bytes           sql.NullInt64  `db:"bytes"`
+	Owner           string         `db:"owner"`
+	TargetLag       sql.NullString `db:"target_lag"`
+	RefreshMode     sql.NullString `db:"refresh_mode"`
+	Warehouse       sql.NullString `db:"warehouse"`
+	Comment         sql.NullString `db:"comment"`
+	Text            sql.NullString `db:"text"`
+	SchedulingState sql.NullString `db:"scheduling_state"`
+}
+
+// IsSuspended returns whether the refreshes of the dynamic table are suspended
+func (dt *dynamicTable) IsSuspended() bool {
+	return strings.EqualFold(dt.SchedulingState.String, "SUSPENDED")
+}
+
+// ScanDynamicTable turns a row of SHOW DYNAMIC TABLES into a dynamic table object
+func ScanDynamicTable(row *sqlx.Row) (*dynamicTable, error) {
+	dt := &dynamicTable{}
+	e := row.StructScan(dt)
+	return dt, e
+}
+
+// ListDynamicTables returns the dynamic tables in the given schema
+func ListDynamicTables(ctx context.Context, databaseName string, schemaName string, db *sql.DB) ([]dynamicTable, error) {
+	stmt := fmt.Sprintf(`SHOW DYNAMIC TABLES IN SCHEMA %v`, QuoteIdentifier(databaseName, schemaName))
+	rows, err := QueryContext(ctx, db, stmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	dts := []dynamicTable{}
+	err = sqlx.StructScan(rows, &dts)
+	if err == sql.ErrNoRows {
+		log.Printf("[DEBUG] no dynamic tables found")
+		return nil, nil
+	}
+	return dts, errors.Wrapf(err, "unable to scan row for %s", stmt)
+}
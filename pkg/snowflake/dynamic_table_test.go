@@ -0,0 +1,36 @@
+package snowflake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDynamicTableCreate(t *testing.T) {
+	r := require.New(t)
+	dtb := DynamicTable("test_dt", "test_db", "test_schema")
+	r.Equal(`"test_db"."test_schema"."test_dt"`, dtb.QualifiedName())
+
+	dtb.WithWarehouse("test_wh").WithTargetLag("1 minute").WithQuery("SELECT id FROM t")
+	r.Equal(`CREATE DYNAMIC TABLE "test_db"."test_schema"."test_dt" TARGET_LAG = '1 minute' WAREHOUSE = "test_wh" AS SELECT id FROM t`, dtb.Create())
+
+	dtb.WithTargetLag("downstream").WithRefreshMode("INCREMENTAL").WithInitialize("ON_SCHEDULE").WithClustering([]string{"id", "date_trunc('day', ts)"}).WithComment("test's comment")
+	r.Equal(`CREATE DYNAMIC TABLE "test_db"."test_schema"."test_dt" TARGET_LAG = DOWNSTREAM WAREHOUSE = "test_wh" REFRESH_MODE = INCREMENTAL INITIALIZE = ON_SCHEDULE CLUSTER BY (id, date_trunc('day', ts)) COMMENT = 'test\'s comment' AS SELECT id FROM t`, dtb.Create())
+}
+
+func TestDynamicTableAlter(t *testing.T) {
+	r := require.New(t)
+	dtb := DynamicTable("test_dt", "test_db", "test_schema")
+	r.Equal(`ALTER DYNAMIC TABLE "test_db"."test_schema"."test_dt" SET WAREHOUSE = "much_wh"`, dtb.ChangeWarehouse("much_wh"))
+	r.Equal(`ALTER DYNAMIC TABLE "test_db"."test_schema"."test_dt" SET TARGET_LAG = '5 minutes'`, dtb.ChangeTargetLag("5 minutes"))
+	r.Equal(`ALTER DYNAMIC TABLE "test_db"."test_schema"."test_dt" SET TARGET_LAG = DOWNSTREAM`, dtb.ChangeTargetLag("DOWNSTREAM"))
+	r.Equal(`ALTER DYNAMIC TABLE "test_db"."test_schema"."test_dt" SET COMMENT = 'much comment'`, dtb.ChangeComment("much comment"))
+	r.Equal(`ALTER DYNAMIC TABLE "test_db"."test_schema"."test_dt" UNSET COMMENT`, dtb.RemoveComment())
+	r.Equal(`ALTER DYNAMIC TABLE "test_db"."test_schema"."test_dt" CLUSTER BY (a, b)`, dtb.ChangeClusterBy([]string{"a", "b"}))
+	r.Equal(`ALTER DYNAMIC TABLE "test_db"."test_schema"."test_dt" DROP CLUSTERING KEY`, dtb.DropClustering())
+	r.Equal(`ALTER DYNAMIC TABLE "test_db"."test_schema"."test_dt" SUSPEND`, dtb.Suspend())
+	r.Equal(`ALTER DYNAMIC TABLE "test_db"."test_schema"."test_dt" RESUME`, dtb.Resume())
+	r.Equal(`ALTER DYNAMIC TABLE "test_db"."test_schema"."test_dt" REFRESH`, dtb.Refresh())
+	r.Equal(`DROP DYNAMIC TABLE "test_db"."test_schema"."test_dt"`, dtb.Drop())
+	r.Equal(`SHOW DYNAMIC TABLES LIKE 'test_dt' IN SCHEMA "test_db"."test_schema"`, dtb.Show())
+}
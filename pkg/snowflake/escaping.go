@@ -2,7 +2,6 @@ package snowflake
 
 import (
 	"fmt"
-	"regexp"
 	"strings"
 )
 
@@ -37,16 +36,9 @@ func UnescapeSnowflakeString(in string) string {
 
 // AddressEscape wraps a name inside double quotes only if required by Snowflake
 func AddressEscape(in ...string) string {
-	quoteCheck := regexp.MustCompile(`[^A-Z0-9_]`)
-	address := make([]string, len(in))
-
+	ids := make([]Identifier, len(in))
 	for i, n := range in {
-		if quoteCheck.MatchString(n) {
-			address[i] = fmt.Sprintf(`"%s"`, strings.Replace(n, `"`, `\"`, -1))
-		} else {
-			address[i] = n
-		}
+		ids[i] = QuotedIdentifier(n).Minimal()
 	}
-
-	return strings.Join(address, ".")
+	return JoinIdentifiers(ids...)
 }
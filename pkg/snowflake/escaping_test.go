@@ -55,6 +55,16 @@ func TestAddressEscape(t *testing.T) {
 			name:     []string{"hello", "world", "NOTHERE"},
 			expected: `"hello"."world".NOTHERE`,
 		},
+		{
+			id:       "leading digit",
+			name:     []string{"1HELLO"},
+			expected: `"1HELLO"`,
+		},
+		{
+			id:       "embedded quote",
+			name:     []string{`HELLO "WORLD"`},
+			expected: `"HELLO ""WORLD"""`,
+		},
 	}
 
 	for _, testCase := range testCases {
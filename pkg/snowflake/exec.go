@@ -1,50 +1,171 @@
 package snowflake
 
 import (
+	"context"
 	"database/sql"
+	"fmt"
 	"log"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"github.com/snowflakedb/gosnowflake"
 )
 
+// StatementError is returned by ExecMulti and ExecSteps when a statement in the
+// batch fails. It records which statement broke so the caller does not have to
+// guess from a bare driver error.
+type StatementError struct {
+	// Index is the zero-based position of the failing statement in the batch.
+	Index     int
+	Statement string
+	Err       error
+	// RollbackErr is set when undoing the statements that already ran failed
+	// as well.
+	RollbackErr error
+}
+
+func (e *StatementError) Error() string {
+	msg := fmt.Sprintf("statement %d (%s) failed: %v", e.Index, e.Statement, e.Err)
+	if e.RollbackErr != nil {
+		msg = fmt.Sprintf("%s; rollback failed: %v", msg, e.RollbackErr)
+	}
+	return msg
+}
+
+// Unwrap returns the original error of the failing statement.
+func (e *StatementError) Unwrap() error {
+	return e.Err
+}
+
+// Cause implements the causer interface of github.com/pkg/errors.
+func (e *StatementError) Cause() error {
+	return e.Err
+}
+
+// Code returns the Snowflake error number of the failing statement, or 0 when
+// the failure did not come from Snowflake.
+func (e *StatementError) Code() int {
+	var sfErr *gosnowflake.SnowflakeError
+	if errors.As(e.Err, &sfErr) {
+		return sfErr.Number
+	}
+	return 0
+}
+
+// Step is a statement run by ExecSteps along with the statements that undo it
+// should a later step fail.
+type Step struct {
+	Statement string
+	Undo      []string
+}
+
 func Exec(db *sql.DB, query string) error {
+	return ExecContext(context.Background(), db, query)
+}
+
+// ExecContext is like Exec but the statement is cancelled when ctx is done.
+func ExecContext(ctx context.Context, db *sql.DB, query string) error {
+	if r := DryRunRecorder(db); r != nil {
+		r.Record(query)
+		return nil
+	}
 	log.Print("[DEBUG] exec stmt ", query)
 
-	_, err := db.Exec(query)
+	_, err := db.ExecContext(ctx, query)
 	return err
 }
 
 func ExecMulti(db *sql.DB, queries []string) error {
+	return ExecMultiContext(context.Background(), db, queries)
+}
+
+// ExecMultiContext is like ExecMulti but the transaction is rolled back when
+// ctx is done.
+func ExecMultiContext(ctx context.Context, db *sql.DB, queries []string) error {
+	if r := DryRunRecorder(db); r != nil {
+		for _, query := range queries {
+			r.Record(query)
+		}
+		return nil
+	}
 	log.Print("[DEBUG] exec stmts ", queries)
 
-	tx, err := db.Begin()
+	tx, err := db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
 
-	for _, query := range queries {
-		_, err = tx.Exec(query)
+	for i, query := range queries {
+		_, err = tx.ExecContext(ctx, query)
 		if err != nil {
-			return tx.Rollback()
+			stmtErr := &StatementError{Index: i, Statement: query, Err: err}
+			if rbErr := tx.Rollback(); rbErr != nil {
+				stmtErr.RollbackErr = rbErr
+			}
+			return stmtErr
 		}
 	}
 	return tx.Commit()
 }
 
+// ExecSteps runs steps one by one outside of a transaction. Snowflake DDL
+// commits implicitly, so a transaction would not make such a batch atomic;
+// instead, when a step fails the Undo statements of the steps that already ran
+// are executed in reverse order and a *StatementError for the failing step is
+// returned.
+func ExecSteps(ctx context.Context, db *sql.DB, steps []Step) error {
+	if r := DryRunRecorder(db); r != nil {
+		for _, step := range steps {
+			r.Record(step.Statement)
+		}
+		return nil
+	}
+	for i, step := range steps {
+		log.Print("[DEBUG] exec stmt ", step.Statement)
+		_, err := db.ExecContext(ctx, step.Statement)
+		if err == nil {
+			continue
+		}
+
+		stmtErr := &StatementError{Index: i, Statement: step.Statement, Err: err}
+		for j := i - 1; j >= 0; j-- {
+			for _, undo := range steps[j].Undo {
+				log.Print("[DEBUG] undo stmt ", undo)
+				// keep undoing the remaining steps even if one of them fails
+				if _, undoErr := db.ExecContext(ctx, undo); undoErr != nil && stmtErr.RollbackErr == nil {
+					stmtErr.RollbackErr = undoErr
+				}
+			}
+		}
+		return stmtErr
+	}
+	return nil
+}
+
 // QueryRow will run stmt against the db and return the row. We use
 // [DB.Unsafe](https://godoc.org/github.com/jmoiron/sqlx#DB.Unsafe) so that we can scan to structs
 // without worrying about newly introduced columns
 func QueryRow(db *sql.DB, stmt string) *sqlx.Row {
+	return QueryRowContext(context.Background(), db, stmt)
+}
+
+// QueryRowContext is like QueryRow but the query is cancelled when ctx is done.
+func QueryRowContext(ctx context.Context, db *sql.DB, stmt string) *sqlx.Row {
 	log.Print("[DEBUG] query stmt ", stmt)
 	sdb := sqlx.NewDb(db, "snowflake").Unsafe()
-	return sdb.QueryRowx(stmt)
+	return sdb.QueryRowxContext(ctx, stmt)
 }
 
 // Query will run stmt against the db and return the rows. We use
 // [DB.Unsafe](https://godoc.org/github.com/jmoiron/sqlx#DB.Unsafe) so that we can scan to structs
 // without worrying about newly introduced columns
 func Query(db *sql.DB, stmt string) (*sqlx.Rows, error) {
+	return QueryContext(context.Background(), db, stmt)
+}
+
+// QueryContext is like Query but the query is cancelled when ctx is done.
+func QueryContext(ctx context.Context, db *sql.DB, stmt string) (*sqlx.Rows, error) {
 	log.Print("[DEBUG] query stmt ", stmt)
 	sdb := sqlx.NewDb(db, "snowflake").Unsafe()
-	return sdb.Queryx(stmt)
+	return sdb.QueryxContext(ctx, stmt)
 }
@@ -0,0 +1,85 @@
+package snowflake_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/snowflakedb/gosnowflake"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecMultiReportsFailingStatement(t *testing.T) {
+	r := require.New(t)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.MatchExpectationsInOrder(true)
+		sfErr := &gosnowflake.SnowflakeError{Number: 3001, Message: "Insufficient privileges"}
+		mock.ExpectBegin()
+		mock.ExpectExec(`^REVOKE SELECT ON VIEW "v" FROM ROLE "r"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^REVOKE INSERT ON VIEW "v" FROM ROLE "r"$`).WillReturnError(sfErr)
+		mock.ExpectRollback()
+
+		err := snowflake.ExecMulti(db, []string{
+			`REVOKE SELECT ON VIEW "v" FROM ROLE "r"`,
+			`REVOKE INSERT ON VIEW "v" FROM ROLE "r"`,
+		})
+		r.Error(err)
+
+		var stmtErr *snowflake.StatementError
+		r.True(errors.As(err, &stmtErr))
+		r.Equal(1, stmtErr.Index)
+		r.Equal(`REVOKE INSERT ON VIEW "v" FROM ROLE "r"`, stmtErr.Statement)
+		r.Equal(3001, stmtErr.Code())
+		r.NoError(stmtErr.RollbackErr)
+		r.True(errors.Is(err, sfErr))
+	})
+}
+
+func TestExecMultiReportsRollbackFailure(t *testing.T) {
+	r := require.New(t)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.MatchExpectationsInOrder(true)
+		mock.ExpectBegin()
+		mock.ExpectExec(`^SELECT 1$`).WillReturnError(errors.New("boom"))
+		mock.ExpectRollback().WillReturnError(errors.New("connection lost"))
+
+		err := snowflake.ExecMulti(db, []string{`SELECT 1`})
+		r.EqualError(err, "statement 0 (SELECT 1) failed: boom; rollback failed: connection lost")
+
+		var stmtErr *snowflake.StatementError
+		r.True(errors.As(err, &stmtErr))
+		r.Equal(0, stmtErr.Code())
+	})
+}
+
+func TestExecStepsUndoesCompletedSteps(t *testing.T) {
+	r := require.New(t)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.MatchExpectationsInOrder(true)
+		mock.ExpectExec(`^CREATE DATABASE "tmp"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^GRANT USAGE ON DATABASE "tmp" TO SHARE "s"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER SHARE "s" SET ACCOUNTS=a$`).WillReturnError(errors.New("no such account"))
+		mock.ExpectExec(`^REVOKE USAGE ON DATABASE "tmp" FROM SHARE "s"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^DROP DATABASE "tmp"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		err := snowflake.ExecSteps(context.Background(), db, []snowflake.Step{
+			{Statement: `CREATE DATABASE "tmp"`, Undo: []string{`DROP DATABASE "tmp"`}},
+			{Statement: `GRANT USAGE ON DATABASE "tmp" TO SHARE "s"`, Undo: []string{`REVOKE USAGE ON DATABASE "tmp" FROM SHARE "s"`}},
+			{Statement: `ALTER SHARE "s" SET ACCOUNTS=a`},
+			{Statement: `DROP DATABASE "tmp"`},
+		})
+
+		var stmtErr *snowflake.StatementError
+		r.True(errors.As(err, &stmtErr))
+		r.Equal(2, stmtErr.Index)
+		r.EqualError(stmtErr.Err, "no such account")
+		r.NoError(stmtErr.RollbackErr)
+	})
+}
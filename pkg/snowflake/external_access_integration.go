@@ -0,0 +1,218 @@
+package snowflake
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ExternalAccessIntegrationBuilder abstracts the creation of sql queries for a snowflake external access integration
+type ExternalAccessIntegrationBuilder struct {
+	name                                 string
+	allowedNetworkRules                  []string
+	allowedAPIAuthenticationIntegrations []string
+	allowedAuthenticationSecrets         []string
+	enabled                              bool
+	comment                              string
+}
+
+// QualifiedName escapes the name of the integration
+func (eaib *ExternalAccessIntegrationBuilder) QualifiedName() string {
+	return QuoteIdentifier(eaib.name)
+}
+
+// WithAllowedNetworkRules sets the qualified names of the network rules the integration allows egress to
+func (eaib *ExternalAccessIntegrationBuilder) WithAllowedNetworkRules(rules []string) *ExternalAccessIntegrationBuilder {
+	eaib.allowedNetworkRules = rules
+	return eaib
+}
+
+// WithAllowedAPIAuthenticationIntegrations sets the security integrations the integration allows
+func (eaib *ExternalAccessIntegrationBuilder) WithAllowedAPIAuthenticationIntegrations(integrations []string) *ExternalAccessIntegrationBuilder {
+	eaib.allowedAPIAuthenticationIntegrations = integrations
+	return eaib
+}
+
+// WithAllowedAuthenticationSecrets sets the qualified names of the secrets the integration allows
+func (eaib *ExternalAccessIntegrationBuilder) WithAllowedAuthenticationSecrets(secrets []string) *ExternalAccessIntegrationBuilder {
+	eaib.allowedAuthenticationSecrets = secrets
+	return eaib
+}
+
+// WithEnabled sets whether the integration is enabled
+func (eaib *ExternalAccessIntegrationBuilder) WithEnabled(enabled bool) *ExternalAccessIntegrationBuilder {
+	eaib.enabled = enabled
+	return eaib
+}
+
+// WithComment adds a comment to the ExternalAccessIntegrationBuilder
+func (eaib *ExternalAccessIntegrationBuilder) WithComment(c string) *ExternalAccessIntegrationBuilder {
+	eaib.comment = c
+	return eaib
+}
+
+// ExternalAccessIntegration returns a pointer to a Builder that abstracts the DDL operations for an external access integration.
+//
+// Supported DDL operations are:
+//   - CREATE EXTERNAL ACCESS INTEGRATION
+//   - ALTER EXTERNAL ACCESS INTEGRATION
+//   - DROP INTEGRATION
+//   - SHOW EXTERNAL ACCESS INTEGRATIONS
+//   - DESCRIBE INTEGRATION
+//
+// [Snowflake Reference](https://docs.snowflake.com/en/sql-reference/sql/create-external-access-integration)
+func ExternalAccessIntegration(name string) *ExternalAccessIntegrationBuilder {
+	return &ExternalAccessIntegrationBuilder{
+		name: name,
+	}
+}
+
+// formatNameList writes a list of names, which are used as written
+func formatNameList(names []string) string {
+	return fmt.Sprintf(`(%v)`, strings.Join(names, ", "))
+}
+
+// Create returns the SQL that will create a new external access integration
+func (eaib *ExternalAccessIntegrationBuilder) Create() string {
+	q := strings.Builder{}
+	q.WriteString(fmt.Sprintf(`CREATE EXTERNAL ACCESS INTEGRATION %v`, eaib.QualifiedName()))
+	q.WriteString(fmt.Sprintf(` ALLOWED_NETWORK_RULES = %v`, formatNameList(eaib.allowedNetworkRules)))
+
+	if len(eaib.allowedAPIAuthenticationIntegrations) > 0 {
+		q.WriteString(fmt.Sprintf(` ALLOWED_API_AUTHENTICATION_INTEGRATIONS = (%v)`, strings.Join(quoteStringList(eaib.allowedAPIAuthenticationIntegrations), ", ")))
+	}
+
+	if len(eaib.allowedAuthenticationSecrets) > 0 {
+		q.WriteString(fmt.Sprintf(` ALLOWED_AUTHENTICATION_SECRETS = %v`, formatNameList(eaib.allowedAuthenticationSecrets)))
+	}
+
+	q.WriteString(fmt.Sprintf(` ENABLED = %t`, eaib.enabled))
+
+	if eaib.comment != "" {
+		q.WriteString(fmt.Sprintf(` COMMENT = '%v'`, EscapeString(eaib.comment)))
+	}
+
+	return q.String()
+}
+
+// ChangeAllowedNetworkRules returns the sql that will change the network rules of the integration.
+func (eaib *ExternalAccessIntegrationBuilder) ChangeAllowedNetworkRules(rules []string) string {
+	return fmt.Sprintf(`ALTER EXTERNAL ACCESS INTEGRATION %v SET ALLOWED_NETWORK_RULES = %v`, eaib.QualifiedName(), formatNameList(rules))
+}
+
+// ChangeAllowedAPIAuthenticationIntegrations returns the sql that will change the security integrations of the integration.
+func (eaib *ExternalAccessIntegrationBuilder) ChangeAllowedAPIAuthenticationIntegrations(integrations []string) string {
+	return fmt.Sprintf(`ALTER EXTERNAL ACCESS INTEGRATION %v SET ALLOWED_API_AUTHENTICATION_INTEGRATIONS = (%v)`, eaib.QualifiedName(), strings.Join(quoteStringList(integrations), ", "))
+}
+
+// RemoveAllowedAPIAuthenticationIntegrations returns the sql that will remove the security integrations of the integration.
+func (eaib *ExternalAccessIntegrationBuilder) RemoveAllowedAPIAuthenticationIntegrations() string {
+	return fmt.Sprintf(`ALTER EXTERNAL ACCESS INTEGRATION %v UNSET ALLOWED_API_AUTHENTICATION_INTEGRATIONS`, eaib.QualifiedName())
+}
+
+// ChangeAllowedAuthenticationSecrets returns the sql that will change the secrets of the integration.
+func (eaib *ExternalAccessIntegrationBuilder) ChangeAllowedAuthenticationSecrets(secrets []string) string {
+	return fmt.Sprintf(`ALTER EXTERNAL ACCESS INTEGRATION %v SET ALLOWED_AUTHENTICATION_SECRETS = %v`, eaib.QualifiedName(), formatNameList(secrets))
+}
+
+// RemoveAllowedAuthenticationSecrets returns the sql that will remove the secrets of the integration.
+func (eaib *ExternalAccessIntegrationBuilder) RemoveAllowedAuthenticationSecrets() string {
+	return fmt.Sprintf(`ALTER EXTERNAL ACCESS INTEGRATION %v UNSET ALLOWED_AUTHENTICATION_SECRETS`, eaib.QualifiedName())
+}
+
+// ChangeEnabled returns the sql that will enable or disable the integration.
+func (eaib *ExternalAccessIntegrationBuilder) ChangeEnabled(enabled bool) string {
+	return fmt.Sprintf(`ALTER EXTERNAL ACCESS INTEGRATION %v SET ENABLED = %t`, eaib.QualifiedName(), enabled)
+}
+
+// ChangeComment returns the sql that will change the comment for the integration.
+func (eaib *ExternalAccessIntegrationBuilder) ChangeComment(c string) string {
+	return fmt.Sprintf(`ALTER EXTERNAL ACCESS INTEGRATION %v SET COMMENT = '%v'`, eaib.QualifiedName(), EscapeString(c))
+}
+
+// RemoveComment returns the sql that will remove the comment for the integration.
+func (eaib *ExternalAccessIntegrationBuilder) RemoveComment() string {
+	return fmt.Sprintf(`ALTER EXTERNAL ACCESS INTEGRATION %v UNSET COMMENT`, eaib.QualifiedName())
+}
+
+// Drop returns the sql that will remove the integration.
+func (eaib *ExternalAccessIntegrationBuilder) Drop() string {
+	return fmt.Sprintf(`DROP INTEGRATION %v`, eaib.QualifiedName())
+}
+
+// Describe returns the sql that will list the properties of the integration.
+func (eaib *ExternalAccessIntegrationBuilder) Describe() string {
+	return fmt.Sprintf(`DESCRIBE INTEGRATION %v`, eaib.QualifiedName())
+}
+
+// Show returns the sql that will show the integration.
+func (eaib *ExternalAccessIntegrationBuilder) Show() string {
+	return fmt.Sprintf(`SHOW EXTERNAL ACCESS INTEGRATIONS LIKE '%v'`, EscapeString(eaib.name))
+}
+
+type externalAccessIntegration struct {
+	Name     string         `db:"name"`
+	Type     sql.NullString `db:"type"`
+	Category sql.NullString `db:"category"`
+	Enabled  sql.NullBool   `db:"enabled"`
+	Comment  sql.NullString `db:"comment"`
+}
+
+// ScanExternalAccessIntegration turns a row of SHOW EXTERNAL ACCESS INTEGRATIONS into an integration object
+func ScanExternalAccessIntegration(row *sqlx.Row) (*externalAccessIntegration, error) {
+	eai := &externalAccessIntegration{}
+	e := row.StructScan(eai)
+	return eai, e
+}
+
+type integrationProperty struct {
+	Property      string         `db:"property"`
+	PropertyType  sql.NullString `db:"property_type"`
+	PropertyValue sql.NullString `db:"property_value"`
+}
+
+// ListValues returns the names listed in the value of a property, written
+// as [name, name] or name,name
+func (ip *integrationProperty) ListValues() []string {
+	return splitList(strings.Trim(ip.PropertyValue.String, "[]"))
+}
+
+// ScanIntegrationProperties turns the rows of DESCRIBE INTEGRATION into a
+// map of the properties by name
+func ScanIntegrationProperties(rows *sqlx.Rows) (map[string]integrationProperty, error) {
+	properties := map[string]integrationProperty{}
+	for rows.Next() {
+		p := integrationProperty{}
+		err := rows.StructScan(&p)
+		if err != nil {
+			return nil, err
+		}
+		properties[p.Property] = p
+	}
+	return properties, rows.Err()
+}
+
+// externalAccessClauses returns the clauses granting a function or procedure
+// the integrations and the secrets, by variable name, it uses.
+func externalAccessClauses(integrations []string, secrets map[string]string) string {
+	var q strings.Builder
+	if len(integrations) > 0 {
+		q.WriteString(fmt.Sprintf(` EXTERNAL_ACCESS_INTEGRATIONS = (%v)`, strings.Join(quoteStringList(integrations), ", ")))
+	}
+	if len(secrets) > 0 {
+		variables := make([]string, 0, len(secrets))
+		for v := range secrets {
+			variables = append(variables, v)
+		}
+		sort.Strings(variables)
+		pairs := make([]string, len(variables))
+		for i, v := range variables {
+			pairs[i] = fmt.Sprintf(`'%v' = %v`, EscapeString(v), secrets[v])
+		}
+		q.WriteString(fmt.Sprintf(` SECRETS = (%v)`, strings.Join(pairs, ", ")))
+	}
+	return q.String()
+}
@@ -0,0 +1,40 @@
+package snowflake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExternalAccessIntegrationCreate(t *testing.T) {
+	r := require.New(t)
+	eai := ExternalAccessIntegration("test_integration").WithAllowedNetworkRules([]string{`"db"."schema"."rule"`}).WithEnabled(true)
+	r.Equal(`CREATE EXTERNAL ACCESS INTEGRATION "test_integration" ALLOWED_NETWORK_RULES = ("db"."schema"."rule") ENABLED = true`, eai.Create())
+
+	eai.WithAllowedAPIAuthenticationIntegrations([]string{"oauth"}).WithAllowedAuthenticationSecrets([]string{"db.schema.token", "db.schema.cred"}).WithEnabled(false).WithComment("api access")
+	r.Equal(`CREATE EXTERNAL ACCESS INTEGRATION "test_integration" ALLOWED_NETWORK_RULES = ("db"."schema"."rule") ALLOWED_API_AUTHENTICATION_INTEGRATIONS = ("oauth") ALLOWED_AUTHENTICATION_SECRETS = (db.schema.token, db.schema.cred) ENABLED = false COMMENT = 'api access'`, eai.Create())
+}
+
+func TestExternalAccessIntegrationAlter(t *testing.T) {
+	r := require.New(t)
+	eai := ExternalAccessIntegration("test_integration")
+	r.Equal(`ALTER EXTERNAL ACCESS INTEGRATION "test_integration" SET ALLOWED_NETWORK_RULES = (db.schema.a, db.schema.b)`, eai.ChangeAllowedNetworkRules([]string{"db.schema.a", "db.schema.b"}))
+	r.Equal(`ALTER EXTERNAL ACCESS INTEGRATION "test_integration" SET ALLOWED_API_AUTHENTICATION_INTEGRATIONS = ("oauth")`, eai.ChangeAllowedAPIAuthenticationIntegrations([]string{"oauth"}))
+	r.Equal(`ALTER EXTERNAL ACCESS INTEGRATION "test_integration" UNSET ALLOWED_API_AUTHENTICATION_INTEGRATIONS`, eai.RemoveAllowedAPIAuthenticationIntegrations())
+	r.Equal(`ALTER EXTERNAL ACCESS INTEGRATION "test_integration" SET ALLOWED_AUTHENTICATION_SECRETS = (db.schema.token)`, eai.ChangeAllowedAuthenticationSecrets([]string{"db.schema.token"}))
+	r.Equal(`ALTER EXTERNAL ACCESS INTEGRATION "test_integration" UNSET ALLOWED_AUTHENTICATION_SECRETS`, eai.RemoveAllowedAuthenticationSecrets())
+	r.Equal(`ALTER EXTERNAL ACCESS INTEGRATION "test_integration" SET ENABLED = false`, eai.ChangeEnabled(false))
+	r.Equal(`ALTER EXTERNAL ACCESS INTEGRATION "test_integration" SET COMMENT = 'comment'`, eai.ChangeComment("comment"))
+	r.Equal(`ALTER EXTERNAL ACCESS INTEGRATION "test_integration" UNSET COMMENT`, eai.RemoveComment())
+	r.Equal(`DROP INTEGRATION "test_integration"`, eai.Drop())
+	r.Equal(`DESCRIBE INTEGRATION "test_integration"`, eai.Describe())
+	r.Equal(`SHOW EXTERNAL ACCESS INTEGRATIONS LIKE 'test_integration'`, eai.Show())
+}
+
+func TestIntegrationPropertyListValues(t *testing.T) {
+	r := require.New(t)
+	p := &integrationProperty{}
+	r.Empty(p.ListValues())
+	p.PropertyValue.String = "[DB.SCHEMA.A, DB.SCHEMA.B]"
+	r.Equal([]string{"DB.SCHEMA.A", "DB.SCHEMA.B"}, p.ListValues())
+}
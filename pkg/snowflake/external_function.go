@@ -1,6 +1,7 @@
 package snowflake
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
@@ -35,18 +36,18 @@ func (fb *ExternalFunctionBuilder) QualifiedName() string {
 	var n strings.Builder
 
 	if fb.db != "" && fb.schema != "" {
-		n.WriteString(fmt.Sprintf(`"%v"."%v".`, fb.db, fb.schema))
+		n.WriteString(fmt.Sprintf(`%v.`, QuoteIdentifier(fb.db, fb.schema)))
 	}
 
 	if fb.db != "" && fb.schema == "" {
-		n.WriteString(fmt.Sprintf(`"%v"..`, fb.db))
+		n.WriteString(fmt.Sprintf(`%v..`, QuoteIdentifier(fb.db)))
 	}
 
 	if fb.db == "" && fb.schema != "" {
-		n.WriteString(fmt.Sprintf(`"%v".`, fb.schema))
+		n.WriteString(fmt.Sprintf(`%v.`, QuoteIdentifier(fb.schema)))
 	}
 
-	n.WriteString(fmt.Sprintf(`"%v"`, fb.name))
+	n.WriteString(QuoteIdentifier(fb.name))
 
 	return n.String()
 }
@@ -224,7 +225,7 @@ func (fb *ExternalFunctionBuilder) Drop() string {
 
 // Show returns the SQL query that will show an external function.
 func (fb *ExternalFunctionBuilder) Show() string {
-	return fmt.Sprintf(`SHOW EXTERNAL FUNCTIONS LIKE '%v' IN SCHEMA "%v"."%v"`, fb.name, fb.db, fb.schema)
+	return fmt.Sprintf(`SHOW EXTERNAL FUNCTIONS LIKE '%v' IN SCHEMA %v`, EscapeString(fb.name), QuoteIdentifier(fb.db, fb.schema))
 }
 
 // Describe returns the SQL query that will describe an external function.
@@ -268,9 +269,9 @@ func ScanExternalFunctionDescription(rows *sqlx.Rows) ([]externalFunctionDescrip
 	return efds, rows.Err()
 }
 
-func ListExternalFunctions(databaseName string, schemaName string, db *sql.DB) ([]externalFunction, error) {
-	stmt := fmt.Sprintf(`SHOW EXTERNAL FUNCTIONS IN SCHEMA "%s"."%v"`, databaseName, schemaName)
-	rows, err := Query(db, stmt)
+func ListExternalFunctions(ctx context.Context, databaseName string, schemaName string, db *sql.DB) ([]externalFunction, error) {
+	stmt := fmt.Sprintf(`SHOW EXTERNAL FUNCTIONS IN SCHEMA %v`, QuoteIdentifier(databaseName, schemaName))
+	rows, err := QueryContext(ctx, db, stmt)
 	if err != nil {
 		return nil, err
 	}
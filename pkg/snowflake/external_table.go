@@ -1,6 +1,7 @@
 package snowflake
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
@@ -33,18 +34,18 @@ func (tb *ExternalTableBuilder) QualifiedName() string {
 	var n strings.Builder
 
 	if tb.db != "" && tb.schema != "" {
-		n.WriteString(fmt.Sprintf(`"%v"."%v".`, tb.db, tb.schema))
+		n.WriteString(fmt.Sprintf(`%v.`, QuoteIdentifier(tb.db, tb.schema)))
 	}
 
 	if tb.db != "" && tb.schema == "" {
-		n.WriteString(fmt.Sprintf(`"%v"..`, tb.db))
+		n.WriteString(fmt.Sprintf(`%v..`, QuoteIdentifier(tb.db)))
 	}
 
 	if tb.db == "" && tb.schema != "" {
-		n.WriteString(fmt.Sprintf(`"%v".`, tb.schema))
+		n.WriteString(fmt.Sprintf(`%v.`, QuoteIdentifier(tb.schema)))
 	}
 
-	n.WriteString(fmt.Sprintf(`"%v"`, tb.name))
+	n.WriteString(QuoteIdentifier(tb.name))
 
 	return n.String()
 }
@@ -122,7 +123,7 @@ func (tb *ExternalTableBuilder) Create() string {
 	q.WriteString(fmt.Sprintf(` (`))
 	columnDefinitions := []string{}
 	for _, columnDefinition := range tb.columns {
-		columnDefinitions = append(columnDefinitions, fmt.Sprintf(`"%v" %v AS %v`, EscapeString(columnDefinition["name"]), EscapeString(columnDefinition["type"]), columnDefinition["as"]))
+		columnDefinitions = append(columnDefinitions, fmt.Sprintf(`%v %v AS %v`, QuoteIdentifier(columnDefinition["name"]), EscapeString(columnDefinition["type"]), columnDefinition["as"]))
 	}
 	q.WriteString(strings.Join(columnDefinitions, ", "))
 	q.WriteString(fmt.Sprintf(`)`))
@@ -181,7 +182,7 @@ func (tb *ExternalTableBuilder) Drop() string {
 
 // Show returns the SQL query that will show a externalTable.
 func (tb *ExternalTableBuilder) Show() string {
-	return fmt.Sprintf(`SHOW EXTERNAL TABLES LIKE '%v' IN SCHEMA "%v"."%v"`, tb.name, tb.db, tb.schema)
+	return fmt.Sprintf(`SHOW EXTERNAL TABLES LIKE '%v' IN SCHEMA %v`, EscapeString(tb.name), QuoteIdentifier(tb.db, tb.schema))
 }
 
 func (tb *ExternalTableBuilder) GetTagValueString() string {
@@ -190,11 +191,11 @@ func (tb *ExternalTableBuilder) GetTagValueString() string {
 		fmt.Println(v)
 		if v.Schema != "" {
 			if v.Database != "" {
-				q.WriteString(fmt.Sprintf(`"%v".`, v.Database))
+				q.WriteString(fmt.Sprintf(`%v.`, QuoteIdentifier(v.Database)))
 			}
-			q.WriteString(fmt.Sprintf(`"%v".`, v.Schema))
+			q.WriteString(fmt.Sprintf(`%v.`, QuoteIdentifier(v.Schema)))
 		}
-		q.WriteString(fmt.Sprintf(`"%v" = "%v", `, v.Name, v.Value))
+		q.WriteString(fmt.Sprintf(`%v = '%v', `, QuoteIdentifier(v.Name), EscapeString(v.Value)))
 	}
 	return strings.TrimSuffix(q.String(), ", ")
 }
@@ -214,9 +215,9 @@ func ScanExternalTable(row *sqlx.Row) (*externalTable, error) {
 	return t, e
 }
 
-func ListExternalTables(databaseName string, schemaName string, db *sql.DB) ([]externalTable, error) {
-	stmt := fmt.Sprintf(`SHOW EXTERNAL TABLES IN SCHEMA "%s"."%v"`, databaseName, schemaName)
-	rows, err := Query(db, stmt)
+func ListExternalTables(ctx context.Context, databaseName string, schemaName string, db *sql.DB) ([]externalTable, error) {
+	stmt := fmt.Sprintf(`SHOW EXTERNAL TABLES IN SCHEMA %v`, QuoteIdentifier(databaseName, schemaName))
+	rows, err := QueryContext(ctx, db, stmt)
 	if err != nil {
 		return nil, err
 	}
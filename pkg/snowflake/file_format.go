@@ -1,6 +1,7 @@
 package snowflake
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
 	"fmt"
@@ -55,7 +56,7 @@ type FileFormatBuilder struct {
 func (ffb *FileFormatBuilder) QualifiedName() string {
 	var n strings.Builder
 
-	n.WriteString(fmt.Sprintf(`"%v"."%v"."%v"`, ffb.db, ffb.schema, ffb.name))
+	n.WriteString(QuoteIdentifier(ffb.db, ffb.schema, ffb.name))
 
 	return n.String()
 }
@@ -563,7 +564,7 @@ func (ffb *FileFormatBuilder) Describe() string {
 
 // Show returns the SQL query that will show a file format.
 func (ffb *FileFormatBuilder) Show() string {
-	return fmt.Sprintf(`SHOW FILE FORMATS LIKE '%v' IN SCHEMA "%v"."%v"`, ffb.name, ffb.db, ffb.schema)
+	return fmt.Sprintf(`SHOW FILE FORMATS LIKE '%v' IN SCHEMA %v`, EscapeString(ffb.name), QuoteIdentifier(ffb.db, ffb.schema))
 }
 
 type fileFormatShow struct {
@@ -624,9 +625,9 @@ func ParseFormatOptions(fileOptions string) (*fileFormatOptions, error) {
 	return ff, err
 }
 
-func ListFileFormats(databaseName string, schemaName string, db *sql.DB) ([]fileFormatShow, error) {
-	stmt := fmt.Sprintf(`SHOW FILE FORMATS IN SCHEMA "%s"."%v"`, databaseName, schemaName)
-	rows, err := Query(db, stmt)
+func ListFileFormats(ctx context.Context, databaseName string, schemaName string, db *sql.DB) ([]fileFormatShow, error) {
+	stmt := fmt.Sprintf(`SHOW FILE FORMATS IN SCHEMA %v`, QuoteIdentifier(databaseName, schemaName))
+	rows, err := QueryContext(ctx, db, stmt)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package snowflake
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -13,20 +14,22 @@ import (
 
 // FunctionBuilder abstracts the creation of Function
 type FunctionBuilder struct {
-	name              string
-	schema            string
-	db                string
-	argumentTypes     []string // (VARCHAR, VARCHAR)
-	args              []map[string]string
-	returnBehavior    string // VOLATILE, IMMUTABLE
-	nullInputBehavior string // "CALLED ON NULL INPUT" or "RETURNS NULL ON NULL INPUT"
-	returnType        string
-	language          string
-	imports           []string // for Java imports
-	handler           string   // for Java handler
-	targetPath        string   // for Java target path for compiled jar file
-	comment           string
-	statement         string
+	name                       string
+	schema                     string
+	db                         string
+	argumentTypes              []string // (VARCHAR, VARCHAR)
+	args                       []map[string]string
+	returnBehavior             string // VOLATILE, IMMUTABLE
+	nullInputBehavior          string // "CALLED ON NULL INPUT" or "RETURNS NULL ON NULL INPUT"
+	returnType                 string
+	language                   string
+	imports                    []string // for Java imports
+	handler                    string   // for Java handler
+	targetPath                 string   // for Java target path for compiled jar file
+	externalAccessIntegrations []string
+	secrets                    map[string]string // secret by variable name
+	comment                    string
+	statement                  string
 }
 
 // QualifiedName prepends the db and schema and appends argument types
@@ -34,7 +37,7 @@ func (pb *FunctionBuilder) QualifiedName() (string, error) {
 	if pb.db == "" || pb.schema == "" || pb.name == "" {
 		return "", errors.New("Functions must specify a database a schema and a name")
 	}
-	return fmt.Sprintf(`"%v"."%v"."%v"(%v)`, pb.db, pb.schema, pb.name, strings.Join(pb.argumentTypes, ", ")), nil
+	return fmt.Sprintf(`%v(%v)`, QuoteIdentifier(pb.db, pb.schema, pb.name), strings.Join(pb.argumentTypes, ", ")), nil
 }
 
 // QualifiedNameWithoutArguments prepends the db and schema if set
@@ -42,7 +45,7 @@ func (pb *FunctionBuilder) QualifiedNameWithoutArguments() (string, error) {
 	if pb.db == "" || pb.schema == "" || pb.name == "" {
 		return "", errors.New("Functions must specify a database a schema and a name")
 	}
-	return fmt.Sprintf(`"%v"."%v"."%v"`, pb.db, pb.schema, pb.name), nil
+	return QuoteIdentifier(pb.db, pb.schema, pb.name), nil
 }
 
 // Returns the arguments signature of the function in a form <function>(<type>, <type>, ..) RETURN <type>
@@ -104,6 +107,18 @@ func (pb *FunctionBuilder) WithTargetPath(s string) *FunctionBuilder {
 	return pb
 }
 
+// WithExternalAccessIntegrations sets the integrations the function reaches external networks through
+func (pb *FunctionBuilder) WithExternalAccessIntegrations(s []string) *FunctionBuilder {
+	pb.externalAccessIntegrations = s
+	return pb
+}
+
+// WithSecrets sets the qualified names of the secrets the function reads, by variable name
+func (pb *FunctionBuilder) WithSecrets(s map[string]string) *FunctionBuilder {
+	pb.secrets = s
+	return pb
+}
+
 // WithComment adds a comment to the FunctionBuilder
 func (pb *FunctionBuilder) WithComment(c string) *FunctionBuilder {
 	pb.comment = c
@@ -189,6 +204,7 @@ func (pb *FunctionBuilder) Create() (string, error) {
 	if pb.targetPath != "" {
 		q.WriteString(fmt.Sprintf(" TARGET_PATH = '%v'", pb.targetPath))
 	}
+	q.WriteString(externalAccessClauses(pb.externalAccessIntegrations, pb.secrets))
 	q.WriteString(fmt.Sprintf(" AS $$%v$$", pb.statement))
 	return q.String(), nil
 }
@@ -230,7 +246,7 @@ func (vb *FunctionBuilder) RemoveComment() (string, error) {
 // Show returns the SQL query that will show the row representing this function.
 // This show statement returns all functions with the given name (overloaded ones)
 func (pb *FunctionBuilder) Show() string {
-	return fmt.Sprintf(`SHOW USER FUNCTIONS LIKE '%v' IN SCHEMA "%v"."%v"`, pb.name, pb.db, pb.schema)
+	return fmt.Sprintf(`SHOW USER FUNCTIONS LIKE '%v' IN SCHEMA %v`, EscapeString(pb.name), QuoteIdentifier(pb.db, pb.schema))
 }
 
 // To describe the function the name must be specified as fully qualified name
@@ -297,9 +313,9 @@ func ScanFunctions(rows *sqlx.Rows) ([]*function, error) {
 	return pcs, rows.Err()
 }
 
-func ListFunctions(databaseName string, schemaName string, db *sql.DB) ([]function, error) {
-	stmt := fmt.Sprintf(`SHOW USER FUNCTIONS IN SCHEMA "%s"."%v"`, databaseName, schemaName)
-	rows, err := Query(db, stmt)
+func ListFunctions(ctx context.Context, databaseName string, schemaName string, db *sql.DB) ([]function, error) {
+	stmt := fmt.Sprintf(`SHOW USER FUNCTIONS IN SCHEMA %v`, QuoteIdentifier(databaseName, schemaName))
+	rows, err := QueryContext(ctx, db, stmt)
 	if err != nil {
 		return nil, err
 	}
@@ -124,6 +124,21 @@ func TestFunctionCreateWithJavaFunctionWithTargetPath(t *testing.T) {
 	r.Equal(expected, createStmnt)
 }
 
+func TestFunctionCreateWithJavaFunctionWithExternalAccess(t *testing.T) {
+	r := require.New(t)
+	s := getJavaFuction(true)
+	s.WithLanguage("JAVA")
+	s.WithHandler("CoolFunc.test")
+	s.WithExternalAccessIntegrations([]string{"api_access"})
+	s.WithSecrets(map[string]string{"token": `"db"."schema"."token"`, "cred": "db.schema.cred"})
+	createStmnt, _ := s.Create()
+	expected := `CREATE OR REPLACE FUNCTION "test_db"."test_schema"."test_func"` +
+		`(user VARCHAR, count NUMBER) RETURNS VARCHAR LANGUAGE JAVA HANDLER = 'CoolFunc.test'` +
+		` EXTERNAL_ACCESS_INTEGRATIONS = ("api_access") SECRETS = ('cred' = db.schema.cred, 'token' = "db"."schema"."token")` +
+		` AS $$` + javafunc + `$$`
+	r.Equal(expected, createStmnt)
+}
+
 func TestFunctionDrop(t *testing.T) {
 	r := require.New(t)
 
@@ -39,12 +39,12 @@ type FutureGrantBuilder struct {
 func getNameAndQualifiedName(db, schema string) (string, string, futureGrantTarget) {
 	name := schema
 	futureTarget := futureSchemaTarget
-	qualifiedName := fmt.Sprintf(`"%v"."%v"`, db, schema)
+	qualifiedName := QuoteIdentifier(db, schema)
 
 	if schema == "" {
 		name = db
 		futureTarget = futureDatabaseTarget
-		qualifiedName = fmt.Sprintf(`"%v"`, db)
+		qualifiedName = QuoteIdentifier(db)
 	}
 
 	return name, qualifiedName, futureTarget
@@ -63,7 +63,7 @@ func (fgb *FutureGrantBuilder) GrantType() string {
 func FutureSchemaGrant(db string) GrantBuilder {
 	return &FutureGrantBuilder{
 		name:              db,
-		qualifiedName:     fmt.Sprintf(`"%v"`, db),
+		qualifiedName:     QuoteIdentifier(db),
 		futureGrantType:   futureSchemaType,
 		futureGrantTarget: futureDatabaseTarget,
 	}
@@ -211,6 +211,8 @@ func (fgb *FutureGrantBuilder) Show() string {
 type FutureGrantExecutable struct {
 	grantName         string
 	granteeName       string
+	granteeType       granteeType
+	granteeDatabase   string
 	futureGrantType   futureGrantType
 	futureGrantTarget futureGrantTarget
 }
@@ -219,6 +221,19 @@ type FutureGrantExecutable struct {
 func (fgb *FutureGrantBuilder) Role(n string) GrantExecutable {
 	return &FutureGrantExecutable{
 		granteeName:       n,
+		granteeType:       roleType,
+		grantName:         fgb.qualifiedName,
+		futureGrantType:   fgb.futureGrantType,
+		futureGrantTarget: fgb.futureGrantTarget,
+	}
+}
+
+// DatabaseRole returns a pointer to a FutureGrantExecutable for a database role
+func (fgb *FutureGrantBuilder) DatabaseRole(db, name string) GrantExecutable {
+	return &FutureGrantExecutable{
+		granteeName:       name,
+		granteeType:       databaseRoleType,
+		granteeDatabase:   db,
 		grantName:         fgb.qualifiedName,
 		futureGrantType:   fgb.futureGrantType,
 		futureGrantTarget: fgb.futureGrantTarget,
@@ -234,19 +249,19 @@ func (gb *FutureGrantBuilder) Share(n string) GrantExecutable {
 func (fge *FutureGrantExecutable) Grant(p string, w bool) string {
 	var template string
 	if w {
-		template = `GRANT %v ON FUTURE %vS IN %v %v TO ROLE "%v" WITH GRANT OPTION`
+		template = `GRANT %v ON FUTURE %vS IN %v %v TO %v WITH GRANT OPTION`
 	} else {
-		template = `GRANT %v ON FUTURE %vS IN %v %v TO ROLE "%v"`
+		template = `GRANT %v ON FUTURE %vS IN %v %v TO %v`
 	}
 	return fmt.Sprintf(template,
-		p, fge.futureGrantType, fge.futureGrantTarget, fge.grantName, fge.granteeName)
+		p, fge.futureGrantType, fge.futureGrantTarget, fge.grantName, formatGrantee(fge.granteeType, fge.granteeDatabase, fge.granteeName))
 }
 
 // Revoke returns the SQL that will revoke future privileges on the grant from the grantee
 func (fge *FutureGrantExecutable) Revoke(p string) []string {
 	return []string{
-		fmt.Sprintf(`REVOKE %v ON FUTURE %vS IN %v %v FROM ROLE "%v"`,
-			p, fge.futureGrantType, fge.futureGrantTarget, fge.grantName, fge.granteeName),
+		fmt.Sprintf(`REVOKE %v ON FUTURE %vS IN %v %v FROM %v`,
+			p, fge.futureGrantType, fge.futureGrantTarget, fge.grantName, formatGrantee(fge.granteeType, fge.granteeDatabase, fge.granteeName)),
 	}
 }
 
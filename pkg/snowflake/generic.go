@@ -30,19 +30,19 @@ type Builder struct {
 }
 
 func (b *Builder) Show() string {
-	return fmt.Sprintf(`SHOW %sS LIKE '%s'`, b.entityType, b.name)
+	return fmt.Sprintf(`SHOW %sS LIKE '%s'`, b.entityType, EscapeString(b.name))
 }
 
 func (b *Builder) Describe() string {
-	return fmt.Sprintf(`DESCRIBE %s "%s"`, b.entityType, b.name)
+	return fmt.Sprintf(`DESCRIBE %s %v`, b.entityType, QuoteIdentifier(b.name))
 }
 
 func (b *Builder) Drop() string {
-	return fmt.Sprintf(`DROP %s "%s"`, b.entityType, b.name)
+	return fmt.Sprintf(`DROP %s %v`, b.entityType, QuoteIdentifier(b.name))
 }
 
 func (b *Builder) Rename(newName string) string {
-	return fmt.Sprintf(`ALTER %s "%s" RENAME TO "%s"`, b.entityType, b.name, newName)
+	return fmt.Sprintf(`ALTER %s %v RENAME TO %v`, b.entityType, QuoteIdentifier(b.name), QuoteIdentifier(newName))
 }
 
 // SettingBuilder is an interface for a builder that allows you to set key value pairs
@@ -115,18 +115,18 @@ func (ab *AlterPropertiesBuilder) GetTagValueString() string {
 		fmt.Println(v)
 		if v.Schema != "" {
 			if v.Database != "" {
-				q.WriteString(fmt.Sprintf(`"%v".`, v.Database))
+				q.WriteString(fmt.Sprintf(`%v.`, QuoteIdentifier(v.Database)))
 			}
-			q.WriteString(fmt.Sprintf(`"%v".`, v.Schema))
+			q.WriteString(fmt.Sprintf(`%v.`, QuoteIdentifier(v.Schema)))
 		}
-		q.WriteString(fmt.Sprintf(`"%v" = "%v", `, v.Name, v.Value))
+		q.WriteString(fmt.Sprintf(`%v = '%v', `, QuoteIdentifier(v.Name), EscapeString(v.Value)))
 	}
 	return strings.TrimSuffix(q.String(), ", ")
 }
 
 func (ab *AlterPropertiesBuilder) Statement() string {
 	var sb strings.Builder
-	sb.WriteString(fmt.Sprintf(`ALTER %s "%s" SET`, ab.entityType, ab.name)) // TODO handle error
+	sb.WriteString(fmt.Sprintf(`ALTER %s %v SET`, ab.entityType, QuoteIdentifier(ab.name))) // TODO handle error
 
 	sb.WriteString(ab.rawStatement)
 
@@ -216,18 +216,18 @@ func (b *CreateBuilder) GetTagValueString() string {
 		fmt.Println(v)
 		if v.Schema != "" {
 			if v.Database != "" {
-				q.WriteString(fmt.Sprintf(`"%v".`, v.Database))
+				q.WriteString(fmt.Sprintf(`%v.`, QuoteIdentifier(v.Database)))
 			}
-			q.WriteString(fmt.Sprintf(`"%v".`, v.Schema))
+			q.WriteString(fmt.Sprintf(`%v.`, QuoteIdentifier(v.Schema)))
 		}
-		q.WriteString(fmt.Sprintf(`"%v" = "%v", `, v.Name, v.Value))
+		q.WriteString(fmt.Sprintf(`%v = '%v', `, QuoteIdentifier(v.Name), EscapeString(v.Value)))
 	}
 	return strings.TrimSuffix(q.String(), ", ")
 }
 
 func (b *CreateBuilder) Statement() string {
 	var sb strings.Builder
-	sb.WriteString(fmt.Sprintf(`CREATE %s "%s"`, b.entityType, b.name)) // TODO handle error
+	sb.WriteString(fmt.Sprintf(`CREATE %s %v`, b.entityType, QuoteIdentifier(b.name))) // TODO handle error
 
 	sb.WriteString(b.rawStatement)
 
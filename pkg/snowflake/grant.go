@@ -43,6 +43,7 @@ type GrantBuilder interface {
 	GrantType() string
 	Role(string) GrantExecutable
 	Share(string) GrantExecutable
+	DatabaseRole(db, name string) GrantExecutable
 	Show() string
 }
 
@@ -110,6 +111,17 @@ func (gb *CurrentMaterializedViewGrantBuilder) Share(n string) GrantExecutable {
 	}
 }
 
+// DatabaseRole returns a pointer to a CurrentGrantExecutable for a database role
+func (gb *CurrentMaterializedViewGrantBuilder) DatabaseRole(db, name string) GrantExecutable {
+	return &CurrentGrantExecutable{
+		grantName:       gb.qualifiedName,
+		grantType:       viewType,
+		granteeName:     name,
+		granteeType:     databaseRoleType,
+		granteeDatabase: db,
+	}
+}
+
 ///////////////////////////////////////////////
 /// END CurrentMaterializedViewGrantBuilder ///
 ///////////////////////////////////////////////
@@ -125,7 +137,7 @@ func AccountGrant() GrantBuilder {
 func DatabaseGrant(name string) GrantBuilder {
 	return &CurrentGrantBuilder{
 		name:          name,
-		qualifiedName: fmt.Sprintf(`"%v"`, name),
+		qualifiedName: QuoteIdentifier(name),
 		grantType:     databaseType,
 	}
 }
@@ -134,7 +146,7 @@ func DatabaseGrant(name string) GrantBuilder {
 func SchemaGrant(db, schema string) GrantBuilder {
 	return &CurrentGrantBuilder{
 		name:          schema,
-		qualifiedName: fmt.Sprintf(`"%v"."%v"`, db, schema),
+		qualifiedName: QuoteIdentifier(db, schema),
 		grantType:     schemaType,
 	}
 }
@@ -143,7 +155,7 @@ func SchemaGrant(db, schema string) GrantBuilder {
 func StageGrant(db, schema, stage string) GrantBuilder {
 	return &CurrentGrantBuilder{
 		name:          stage,
-		qualifiedName: fmt.Sprintf(`"%v"."%v"."%v"`, db, schema, stage),
+		qualifiedName: QuoteIdentifier(db, schema, stage),
 		grantType:     stageType,
 	}
 }
@@ -152,7 +164,7 @@ func StageGrant(db, schema, stage string) GrantBuilder {
 func ViewGrant(db, schema, view string) GrantBuilder {
 	return &CurrentGrantBuilder{
 		name:          view,
-		qualifiedName: fmt.Sprintf(`"%v"."%v"."%v"`, db, schema, view),
+		qualifiedName: QuoteIdentifier(db, schema, view),
 		grantType:     viewType,
 	}
 }
@@ -161,7 +173,7 @@ func ViewGrant(db, schema, view string) GrantBuilder {
 func MaterializedViewGrant(db, schema, view string) GrantBuilder {
 	return &CurrentMaterializedViewGrantBuilder{
 		name:          view,
-		qualifiedName: fmt.Sprintf(`"%v"."%v"."%v"`, db, schema, view),
+		qualifiedName: QuoteIdentifier(db, schema, view),
 		grantType:     materializedViewType,
 	}
 }
@@ -170,7 +182,7 @@ func MaterializedViewGrant(db, schema, view string) GrantBuilder {
 func TableGrant(db, schema, table string) GrantBuilder {
 	return &CurrentGrantBuilder{
 		name:          table,
-		qualifiedName: fmt.Sprintf(`"%v"."%v"."%v"`, db, schema, table),
+		qualifiedName: QuoteIdentifier(db, schema, table),
 		grantType:     tableType,
 	}
 }
@@ -179,7 +191,7 @@ func TableGrant(db, schema, table string) GrantBuilder {
 func ResourceMonitorGrant(w string) GrantBuilder {
 	return &CurrentGrantBuilder{
 		name:          w,
-		qualifiedName: fmt.Sprintf(`"%v"`, w),
+		qualifiedName: QuoteIdentifier(w),
 		grantType:     resourceMonitorType,
 	}
 }
@@ -188,7 +200,7 @@ func ResourceMonitorGrant(w string) GrantBuilder {
 func IntegrationGrant(w string) GrantBuilder {
 	return &CurrentGrantBuilder{
 		name:          w,
-		qualifiedName: fmt.Sprintf(`"%v"`, w),
+		qualifiedName: QuoteIdentifier(w),
 		grantType:     integrationType,
 	}
 }
@@ -197,7 +209,7 @@ func IntegrationGrant(w string) GrantBuilder {
 func WarehouseGrant(w string) GrantBuilder {
 	return &CurrentGrantBuilder{
 		name:          w,
-		qualifiedName: fmt.Sprintf(`"%v"`, w),
+		qualifiedName: QuoteIdentifier(w),
 		grantType:     warehouseType,
 	}
 }
@@ -206,7 +218,7 @@ func WarehouseGrant(w string) GrantBuilder {
 func ExternalTableGrant(db, schema, externalTable string) GrantBuilder {
 	return &CurrentGrantBuilder{
 		name:          externalTable,
-		qualifiedName: fmt.Sprintf(`"%v"."%v"."%v"`, db, schema, externalTable),
+		qualifiedName: QuoteIdentifier(db, schema, externalTable),
 		grantType:     externalTableType,
 	}
 }
@@ -215,7 +227,7 @@ func ExternalTableGrant(db, schema, externalTable string) GrantBuilder {
 func FileFormatGrant(db, schema, fileFormat string) GrantBuilder {
 	return &CurrentGrantBuilder{
 		name:          fileFormat,
-		qualifiedName: fmt.Sprintf(`"%v"."%v"."%v"`, db, schema, fileFormat),
+		qualifiedName: QuoteIdentifier(db, schema, fileFormat),
 		grantType:     fileFormatType,
 	}
 }
@@ -224,7 +236,7 @@ func FileFormatGrant(db, schema, fileFormat string) GrantBuilder {
 func FunctionGrant(db, schema, function string, argumentTypes []string) GrantBuilder {
 	return &CurrentGrantBuilder{
 		name:          function,
-		qualifiedName: fmt.Sprintf(`"%v"."%v"."%v"(%v)`, db, schema, function, strings.Join(argumentTypes, ", ")),
+		qualifiedName: fmt.Sprintf(`%v(%v)`, QuoteIdentifier(db, schema, function), strings.Join(argumentTypes, ", ")),
 		grantType:     functionType,
 	}
 }
@@ -233,7 +245,7 @@ func FunctionGrant(db, schema, function string, argumentTypes []string) GrantBui
 func ProcedureGrant(db, schema, procedure string, argumentTypes []string) GrantBuilder {
 	return &CurrentGrantBuilder{
 		name:          procedure,
-		qualifiedName: fmt.Sprintf(`"%v"."%v"."%v"(%v)`, db, schema, procedure, strings.Join(argumentTypes, ", ")),
+		qualifiedName: fmt.Sprintf(`%v(%v)`, QuoteIdentifier(db, schema, procedure), strings.Join(argumentTypes, ", ")),
 		grantType:     procedureType,
 	}
 }
@@ -242,7 +254,7 @@ func ProcedureGrant(db, schema, procedure string, argumentTypes []string) GrantB
 func SequenceGrant(db, schema, sequence string) GrantBuilder {
 	return &CurrentGrantBuilder{
 		name:          sequence,
-		qualifiedName: fmt.Sprintf(`"%v"."%v"."%v"`, db, schema, sequence),
+		qualifiedName: QuoteIdentifier(db, schema, sequence),
 		grantType:     sequenceType,
 	}
 }
@@ -251,7 +263,7 @@ func SequenceGrant(db, schema, sequence string) GrantBuilder {
 func StreamGrant(db, schema, stream string) GrantBuilder {
 	return &CurrentGrantBuilder{
 		name:          stream,
-		qualifiedName: fmt.Sprintf(`"%v"."%v"."%v"`, db, schema, stream),
+		qualifiedName: QuoteIdentifier(db, schema, stream),
 		grantType:     streamType,
 	}
 }
@@ -260,7 +272,7 @@ func StreamGrant(db, schema, stream string) GrantBuilder {
 func MaskingPolicyGrant(db, schema, maskingPolicy string) GrantBuilder {
 	return &CurrentGrantBuilder{
 		name:          maskingPolicy,
-		qualifiedName: fmt.Sprintf(`"%v"."%v"."%v"`, db, schema, maskingPolicy),
+		qualifiedName: QuoteIdentifier(db, schema, maskingPolicy),
 		grantType:     maskingPolicyType,
 	}
 }
@@ -269,7 +281,7 @@ func MaskingPolicyGrant(db, schema, maskingPolicy string) GrantBuilder {
 func PipeGrant(db, schema, pipe string) GrantBuilder {
 	return &CurrentGrantBuilder{
 		name:          pipe,
-		qualifiedName: fmt.Sprintf(`"%v"."%v"."%v"`, db, schema, pipe),
+		qualifiedName: QuoteIdentifier(db, schema, pipe),
 		grantType:     pipeType,
 	}
 }
@@ -278,7 +290,7 @@ func PipeGrant(db, schema, pipe string) GrantBuilder {
 func TaskGrant(db, schema, task string) GrantBuilder {
 	return &CurrentGrantBuilder{
 		name:          task,
-		qualifiedName: fmt.Sprintf(`"%v"."%v"."%v"`, db, schema, task),
+		qualifiedName: QuoteIdentifier(db, schema, task),
 		grantType:     taskType,
 	}
 }
@@ -287,7 +299,7 @@ func TaskGrant(db, schema, task string) GrantBuilder {
 func RowAccessPolicyGrant(db, schema, rowAccessPolicy string) GrantBuilder {
 	return &CurrentGrantBuilder{
 		name:          rowAccessPolicy,
-		qualifiedName: fmt.Sprintf(`"%v"."%v"."%v"`, db, schema, rowAccessPolicy),
+		qualifiedName: QuoteIdentifier(db, schema, rowAccessPolicy),
 		grantType:     rowAccessPolicyType,
 	}
 }
@@ -295,18 +307,29 @@ func RowAccessPolicyGrant(db, schema, rowAccessPolicy string) GrantBuilder {
 type granteeType string
 
 const (
-	roleType  granteeType = "ROLE"
-	shareType granteeType = "SHARE"
-	userType  granteeType = "USER" // user is only supported for RoleGrants.
+	roleType         granteeType = "ROLE"
+	shareType        granteeType = "SHARE"
+	databaseRoleType granteeType = "DATABASE ROLE"
+	userType         granteeType = "USER" // user is only supported for RoleGrants.
 )
 
+// formatGrantee returns the grantee as it appears in GRANT and REVOKE
+// statements. Database roles are qualified with their database.
+func formatGrantee(t granteeType, db, name string) string {
+	if t == databaseRoleType {
+		return fmt.Sprintf(`%v %v`, t, QuoteIdentifier(db, name))
+	}
+	return fmt.Sprintf(`%v %v`, t, QuoteIdentifier(name))
+}
+
 // CurrentGrantExecutable abstracts the creation of SQL queries to build grants for
 // different resources
 type CurrentGrantExecutable struct {
-	grantName   string
-	grantType   grantType
-	granteeName string
-	granteeType granteeType
+	grantName       string
+	grantType       grantType
+	granteeName     string
+	granteeType     granteeType
+	granteeDatabase string
 }
 
 // Role returns a pointer to a CurrentGrantExecutable for a role
@@ -329,18 +352,29 @@ func (gb *CurrentGrantBuilder) Share(n string) GrantExecutable {
 	}
 }
 
+// DatabaseRole returns a pointer to a CurrentGrantExecutable for a database role
+func (gb *CurrentGrantBuilder) DatabaseRole(db, name string) GrantExecutable {
+	return &CurrentGrantExecutable{
+		grantName:       gb.qualifiedName,
+		grantType:       gb.grantType,
+		granteeName:     name,
+		granteeType:     databaseRoleType,
+		granteeDatabase: db,
+	}
+}
+
 // Grant returns the SQL that will grant privileges on the grant to the grantee
 func (ge *CurrentGrantExecutable) Grant(p string, w bool) string {
 	var template string
 	if p == `OWNERSHIP` {
-		template = `GRANT %v ON %v %v TO %v "%v" COPY CURRENT GRANTS`
+		template = `GRANT %v ON %v %v TO %v COPY CURRENT GRANTS`
 	} else if w {
-		template = `GRANT %v ON %v %v TO %v "%v" WITH GRANT OPTION`
+		template = `GRANT %v ON %v %v TO %v WITH GRANT OPTION`
 	} else {
-		template = `GRANT %v ON %v %v TO %v "%v"`
+		template = `GRANT %v ON %v %v TO %v`
 	}
 	return fmt.Sprintf(template,
-		p, ge.grantType, ge.grantName, ge.granteeType, ge.granteeName)
+		p, ge.grantType, ge.grantName, formatGrantee(ge.granteeType, ge.granteeDatabase, ge.granteeName))
 }
 
 // Revoke returns the SQL that will revoke privileges on the grant from the grantee
@@ -354,12 +388,35 @@ func (ge *CurrentGrantExecutable) Revoke(p string) []string {
 		}
 	}
 	return []string{
-		fmt.Sprintf(`REVOKE %v ON %v %v FROM %v "%v"`,
-			p, ge.grantType, ge.grantName, ge.granteeType, ge.granteeName),
+		fmt.Sprintf(`REVOKE %v ON %v %v FROM %v`,
+			p, ge.grantType, ge.grantName, formatGrantee(ge.granteeType, ge.granteeDatabase, ge.granteeName)),
 	}
 }
 
+// OwnershipCurrentGrants controls what happens to the grants already on an
+// object when its ownership is transferred.
+type OwnershipCurrentGrants string
+
+const (
+	CopyCurrentGrants   OwnershipCurrentGrants = "COPY"
+	RevokeCurrentGrants OwnershipCurrentGrants = "REVOKE"
+)
+
+// OwnershipGrantExecutable is a GrantExecutable on an existing object, whose
+// ownership can be transferred to the grantee.
+type OwnershipGrantExecutable interface {
+	GrantExecutable
+	Ownership(currentGrants OwnershipCurrentGrants) string
+}
+
+// Ownership returns the SQL that will transfer ownership of the grant to the
+// grantee, copying or revoking the grants already on it
+func (ge *CurrentGrantExecutable) Ownership(currentGrants OwnershipCurrentGrants) string {
+	return fmt.Sprintf(`GRANT OWNERSHIP ON %v %v TO %v %v CURRENT GRANTS`,
+		ge.grantType, ge.grantName, formatGrantee(ge.granteeType, ge.granteeDatabase, ge.granteeName), currentGrants)
+}
+
 // Show returns the SQL that will show all grants of the grantee
 func (ge *CurrentGrantExecutable) Show() string {
-	return fmt.Sprintf(`SHOW GRANTS OF %v "%v"`, ge.granteeType, ge.granteeName)
+	return fmt.Sprintf(`SHOW GRANTS OF %v`, formatGrantee(ge.granteeType, ge.granteeDatabase, ge.granteeName))
 }
@@ -332,3 +332,18 @@ func TestShowGrantsOf(t *testing.T) {
 	s = snowflake.ViewGrant("test_db", "PUBLIC", "testView").Share("testShare").Show()
 	r.Equal(`SHOW GRANTS OF SHARE "testShare"`, s)
 }
+
+func TestOwnershipGrant(t *testing.T) {
+	r := require.New(t)
+	ge, ok := snowflake.TableGrant("test_db", "PUBLIC", "testTable").Role("bob").(snowflake.OwnershipGrantExecutable)
+	r.True(ok)
+
+	s := ge.Ownership(snowflake.CopyCurrentGrants)
+	r.Equal(`GRANT OWNERSHIP ON TABLE "test_db"."PUBLIC"."testTable" TO ROLE "bob" COPY CURRENT GRANTS`, s)
+
+	s = ge.Ownership(snowflake.RevokeCurrentGrants)
+	r.Equal(`GRANT OWNERSHIP ON TABLE "test_db"."PUBLIC"."testTable" TO ROLE "bob" REVOKE CURRENT GRANTS`, s)
+
+	_, ok = snowflake.FutureTableGrant("test_db", "PUBLIC").Role("bob").(snowflake.OwnershipGrantExecutable)
+	r.False(ok)
+}
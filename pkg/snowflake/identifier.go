@@ -0,0 +1,194 @@
+package snowflake
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// maxIdentifierLength is the longest name Snowflake accepts for an object.
+const maxIdentifierLength = 255
+
+// Identifier is the name of an object as written in SQL. Snowflake stores
+// unquoted identifiers in uppercase and compares them case-insensitively,
+// while quoted identifiers are kept and compared exactly as written.
+type Identifier struct {
+	name   string
+	quoted bool
+}
+
+// QuotedIdentifier returns the case-sensitive identifier for name. Names in
+// configuration are always used this way: they are the name Snowflake lists
+// the object under.
+func QuotedIdentifier(name string) Identifier {
+	return Identifier{name: name, quoted: true}
+}
+
+// UnquotedIdentifier returns the identifier for name as if written without
+// quotes, resolved in uppercase by Snowflake.
+func UnquotedIdentifier(name string) Identifier {
+	return Identifier{name: name}
+}
+
+// ParseIdentifier parses a single identifier written in SQL, e.g. my_table or
+// "My ""quoted"" table".
+func ParseIdentifier(in string) (Identifier, error) {
+	ids, err := ParseQualifiedIdentifier(in)
+	if err != nil {
+		return Identifier{}, err
+	}
+	if len(ids) != 1 {
+		return Identifier{}, errors.Errorf("expected a single identifier, got %v", in)
+	}
+	return ids[0], nil
+}
+
+// ParseQualifiedIdentifier parses a dot separated path of identifiers
+// written in SQL, e.g. MY_DB."My Schema".my_table.
+func ParseQualifiedIdentifier(in string) ([]Identifier, error) {
+	ids := []Identifier{}
+	runes := []rune(in)
+	for i := 0; ; {
+		if i >= len(runes) {
+			return nil, errors.Errorf("expected an identifier at the end of %v", in)
+		}
+
+		var id Identifier
+		if runes[i] == '"' {
+			var b strings.Builder
+			i++
+			for {
+				if i >= len(runes) {
+					return nil, errors.Errorf("unterminated quoted identifier in %v", in)
+				}
+				if runes[i] == '"' {
+					if i+1 < len(runes) && runes[i+1] == '"' {
+						b.WriteRune('"')
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				b.WriteRune(runes[i])
+				i++
+			}
+			id = QuotedIdentifier(b.String())
+		} else {
+			start := i
+			for i < len(runes) && runes[i] != '.' {
+				i++
+			}
+			id = UnquotedIdentifier(string(runes[start:i]))
+			if err := id.validateUnquoted(); err != nil {
+				return nil, errors.Wrapf(err, "invalid identifier in %v", in)
+			}
+		}
+		if err := id.validateLength(); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+
+		if i == len(runes) {
+			return ids, nil
+		}
+		if runes[i] != '.' {
+			return nil, errors.Errorf("unexpected '%c' after identifier in %v", runes[i], in)
+		}
+		i++
+	}
+}
+
+func (id Identifier) validateLength() error {
+	if len(id.name) == 0 {
+		return errors.New("Identifier must be at least 1 character.")
+	}
+	if len([]rune(id.name)) > maxIdentifierLength {
+		return errors.Errorf("Identifier must be <= %d characters.", maxIdentifierLength)
+	}
+	return nil
+}
+
+func (id Identifier) validateUnquoted() error {
+	for k, r := range id.name {
+		if k == 0 && !isInitialIdentifierRune(r) {
+			return errors.Errorf("'%s' can not start an unquoted identifier.", string(r))
+		}
+		if !isIdentifierRune(r) {
+			return errors.Errorf("'%s' is not a valid unquoted identifier character.", string(r))
+		}
+	}
+	return nil
+}
+
+// Name is the name Snowflake stores the object under, as listed by SHOW.
+func (id Identifier) Name() string {
+	if id.quoted {
+		return id.name
+	}
+	return strings.ToUpper(id.name)
+}
+
+// IsQuoted reports whether the identifier is case-sensitive.
+func (id Identifier) IsQuoted() bool {
+	return id.quoted
+}
+
+// Equal reports whether both identifiers resolve to the same object name.
+func (id Identifier) Equal(other Identifier) bool {
+	return id.Name() == other.Name()
+}
+
+// String writes the identifier back in SQL. Quoted identifiers have their
+// embedded double quotes doubled.
+func (id Identifier) String() string {
+	if !id.quoted {
+		return id.name
+	}
+	return fmt.Sprintf(`"%s"`, strings.ReplaceAll(id.name, `"`, `""`))
+}
+
+// Minimal returns the shortest identifier resolving to the same name: names
+// that Snowflake would store as written when unquoted are left unquoted.
+func (id Identifier) Minimal() Identifier {
+	name := id.Name()
+	if UnquotedIdentifier(name).validateUnquoted() == nil && name == strings.ToUpper(name) {
+		return UnquotedIdentifier(name)
+	}
+	return QuotedIdentifier(name)
+}
+
+// IsQualifiedName reports whether name, a qualified name as written in SQL,
+// resolves to the object named by parts as listed by Snowflake.
+func IsQualifiedName(name string, parts ...string) bool {
+	ids, err := ParseQualifiedIdentifier(name)
+	if err != nil || len(ids) != len(parts) {
+		return false
+	}
+	for i, id := range ids {
+		if !id.Equal(QuotedIdentifier(parts[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+// JoinIdentifiers writes a qualified name, e.g. "db"."schema"."name".
+func JoinIdentifiers(ids ...Identifier) string {
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = id.String()
+	}
+	return strings.Join(parts, ".")
+}
+
+// QuoteIdentifier writes the names as a qualified name of case-sensitive
+// identifiers. Builders use it for every name coming from configuration.
+func QuoteIdentifier(names ...string) string {
+	ids := make([]Identifier, len(names))
+	for i, n := range names {
+		ids[i] = QuotedIdentifier(n)
+	}
+	return JoinIdentifiers(ids...)
+}
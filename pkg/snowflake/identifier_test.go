@@ -0,0 +1,68 @@
+package snowflake_test
+
+import (
+	"testing"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQualifiedIdentifier(t *testing.T) {
+	r := require.New(t)
+
+	ids, err := snowflake.ParseQualifiedIdentifier(`my_db."My Schema"."a ""quoted"".name"`)
+	r.NoError(err)
+	r.Len(ids, 3)
+
+	r.False(ids[0].IsQuoted())
+	r.Equal("MY_DB", ids[0].Name())
+	r.Equal("my_db", ids[0].String())
+
+	r.True(ids[1].IsQuoted())
+	r.Equal("My Schema", ids[1].Name())
+
+	r.Equal(`a "quoted".name`, ids[2].Name())
+	r.Equal(`"a ""quoted"".name"`, ids[2].String())
+}
+
+func TestParseQualifiedIdentifierErrors(t *testing.T) {
+	for _, in := range []string{"", "db.", `"unterminated`, `"a"b`, "my table", "1st", `""`} {
+		t.Run(in, func(t *testing.T) {
+			_, err := snowflake.ParseQualifiedIdentifier(in)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestParseIdentifier(t *testing.T) {
+	r := require.New(t)
+
+	id, err := snowflake.ParseIdentifier(`"My Table"`)
+	r.NoError(err)
+	r.Equal(snowflake.QuotedIdentifier("My Table"), id)
+
+	_, err = snowflake.ParseIdentifier("db.name")
+	r.Error(err)
+}
+
+func TestIdentifierEqual(t *testing.T) {
+	r := require.New(t)
+
+	r.True(snowflake.UnquotedIdentifier("foo").Equal(snowflake.QuotedIdentifier("FOO")))
+	r.False(snowflake.UnquotedIdentifier("foo").Equal(snowflake.QuotedIdentifier("foo")))
+}
+
+func TestIdentifierMinimal(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal("FOO", snowflake.QuotedIdentifier("FOO").Minimal().String())
+	r.Equal("FOO", snowflake.UnquotedIdentifier("foo").Minimal().String())
+	r.Equal(`"foo"`, snowflake.QuotedIdentifier("foo").Minimal().String())
+	r.Equal(`"1FOO"`, snowflake.QuotedIdentifier("1FOO").Minimal().String())
+}
+
+func TestQuoteIdentifier(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal(`"db"."My Schema"."say ""hi"""`, snowflake.QuoteIdentifier("db", "My Schema", `say "hi"`))
+}
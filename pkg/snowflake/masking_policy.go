@@ -1,6 +1,7 @@
 package snowflake
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
@@ -26,18 +27,18 @@ func (mpb *MaskingPolicyBuilder) QualifiedName() string {
 	var n strings.Builder
 
 	if mpb.db != "" && mpb.schema != "" {
-		n.WriteString(fmt.Sprintf(`"%v"."%v".`, mpb.db, mpb.schema))
+		n.WriteString(fmt.Sprintf(`%v.`, QuoteIdentifier(mpb.db, mpb.schema)))
 	}
 
 	if mpb.db != "" && mpb.schema == "" {
-		n.WriteString(fmt.Sprintf(`"%v"..`, mpb.db))
+		n.WriteString(fmt.Sprintf(`%v..`, QuoteIdentifier(mpb.db)))
 	}
 
 	if mpb.db == "" && mpb.schema != "" {
-		n.WriteString(fmt.Sprintf(`"%v".`, mpb.schema))
+		n.WriteString(fmt.Sprintf(`%v.`, QuoteIdentifier(mpb.schema)))
 	}
 
-	n.WriteString(fmt.Sprintf(`"%v"`, mpb.name))
+	n.WriteString(QuoteIdentifier(mpb.name))
 
 	return n.String()
 }
@@ -130,7 +131,7 @@ func (mpb *MaskingPolicyBuilder) Drop() string {
 
 // Show returns the SQL query that will show a masking policy.
 func (mpb *MaskingPolicyBuilder) Show() string {
-	return fmt.Sprintf(`SHOW MASKING POLICIES LIKE '%v' IN SCHEMA "%v"."%v"`, mpb.name, mpb.db, mpb.schema)
+	return fmt.Sprintf(`SHOW MASKING POLICIES LIKE '%v' IN SCHEMA %v`, EscapeString(mpb.name), QuoteIdentifier(mpb.db, mpb.schema))
 }
 
 type MaskingPolicyStruct struct {
@@ -149,9 +150,9 @@ func ScanMaskingPolicies(row *sqlx.Row) (*MaskingPolicyStruct, error) {
 	return m, err
 }
 
-func ListMaskingPolicies(databaseName string, schemaName string, db *sql.DB) ([]MaskingPolicyStruct, error) {
-	stmt := fmt.Sprintf(`SHOW MASKING POLICIES IN SCHEMA "%s"."%v"`, databaseName, schemaName)
-	rows, err := Query(db, stmt)
+func ListMaskingPolicies(ctx context.Context, databaseName string, schemaName string, db *sql.DB) ([]MaskingPolicyStruct, error) {
+	stmt := fmt.Sprintf(`SHOW MASKING POLICIES IN SCHEMA %v`, QuoteIdentifier(databaseName, schemaName))
+	rows, err := QueryContext(ctx, db, stmt)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package snowflake
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
@@ -28,18 +29,18 @@ func (vb *MaterializedViewBuilder) QualifiedName() string {
 	var n strings.Builder
 
 	if vb.db != "" && vb.schema != "" {
-		n.WriteString(fmt.Sprintf(`"%v"."%v".`, vb.db, vb.schema))
+		n.WriteString(fmt.Sprintf(`%v.`, QuoteIdentifier(vb.db, vb.schema)))
 	}
 
 	if vb.db != "" && vb.schema == "" {
-		n.WriteString(fmt.Sprintf(`"%v"..`, vb.db))
+		n.WriteString(fmt.Sprintf(`%v..`, QuoteIdentifier(vb.db)))
 	}
 
 	if vb.db == "" && vb.schema != "" {
-		n.WriteString(fmt.Sprintf(`"%v".`, vb.schema))
+		n.WriteString(fmt.Sprintf(`%v.`, QuoteIdentifier(vb.schema)))
 	}
 
-	n.WriteString(fmt.Sprintf(`"%v"`, vb.name))
+	n.WriteString(QuoteIdentifier(vb.name))
 
 	return n.String()
 }
@@ -95,17 +96,17 @@ func (vb *MaterializedViewBuilder) WithTags(tags []TagValue) *MaterializedViewBu
 
 // AddTag returns the SQL query that will add a new tag to the view.
 func (vb *MaterializedViewBuilder) AddTag(tag TagValue) string {
-	return fmt.Sprintf(`ALTER MATERIALIZED VIEW %s SET TAG "%v"."%v"."%v" = "%v"`, vb.QualifiedName(), tag.Database, tag.Schema, tag.Name, tag.Value)
+	return fmt.Sprintf(`ALTER MATERIALIZED VIEW %s SET TAG %v = '%v'`, vb.QualifiedName(), QuoteIdentifier(tag.Database, tag.Schema, tag.Name), EscapeString(tag.Value))
 }
 
 // ChangeTag returns the SQL query that will alter a tag on the view.
 func (vb *MaterializedViewBuilder) ChangeTag(tag TagValue) string {
-	return fmt.Sprintf(`ALTER MATERIALIZED VIEW %s SET TAG "%v"."%v"."%v" = "%v"`, vb.QualifiedName(), tag.Database, tag.Schema, tag.Name, tag.Value)
+	return fmt.Sprintf(`ALTER MATERIALIZED VIEW %s SET TAG %v = '%v'`, vb.QualifiedName(), QuoteIdentifier(tag.Database, tag.Schema, tag.Name), EscapeString(tag.Value))
 }
 
 // UnsetTag returns the SQL query that will unset a tag on the view.
 func (vb *MaterializedViewBuilder) UnsetTag(tag TagValue) string {
-	return fmt.Sprintf(`ALTER MATERIALIZED VIEW %s UNSET TAG "%v"."%v"."%v"`, vb.QualifiedName(), tag.Database, tag.Schema, tag.Name)
+	return fmt.Sprintf(`ALTER MATERIALIZED VIEW %s UNSET TAG %v`, vb.QualifiedName(), QuoteIdentifier(tag.Database, tag.Schema, tag.Name))
 }
 
 // View returns a pointer to a Builder that abstracts the DDL operations for a view.
@@ -190,9 +191,9 @@ func (vb *MaterializedViewBuilder) RemoveComment() string {
 // Show returns the SQL query that will show the row representing this view.
 func (vb *MaterializedViewBuilder) Show() string {
 	if vb.db == "" {
-		return fmt.Sprintf(`SHOW MATERIALIZED VIEWS LIKE '%v'`, vb.name)
+		return fmt.Sprintf(`SHOW MATERIALIZED VIEWS LIKE '%v'`, EscapeString(vb.name))
 	}
-	return fmt.Sprintf(`SHOW MATERIALIZED VIEWS LIKE '%v' IN DATABASE "%v"`, vb.name, vb.db)
+	return fmt.Sprintf(`SHOW MATERIALIZED VIEWS LIKE '%v' IN DATABASE %v`, EscapeString(vb.name), QuoteIdentifier(vb.db))
 }
 
 // Drop returns the SQL query that will drop the row representing this view.
@@ -216,9 +217,9 @@ func ScanMaterializedView(row *sqlx.Row) (*materializedView, error) {
 	return r, err
 }
 
-func ListMaterializedViews(databaseName string, schemaName string, db *sql.DB) ([]materializedView, error) {
-	stmt := fmt.Sprintf(`SHOW MATERIALIZED VIEWS IN SCHEMA "%s"."%v"`, databaseName, schemaName)
-	rows, err := Query(db, stmt)
+func ListMaterializedViews(ctx context.Context, databaseName string, schemaName string, db *sql.DB) ([]materializedView, error) {
+	stmt := fmt.Sprintf(`SHOW MATERIALIZED VIEWS IN SCHEMA %v`, QuoteIdentifier(databaseName, schemaName))
+	rows, err := QueryContext(ctx, db, stmt)
 	if err != nil {
 		return nil, err
 	}
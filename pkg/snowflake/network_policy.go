@@ -50,7 +50,7 @@ func NetworkPolicy(name string) *NetworkPolicyBuilder {
 
 // Create returns the SQL query that will create a network policy.
 func (npb *NetworkPolicyBuilder) Create() string {
-	createSql := fmt.Sprintf(`CREATE NETWORK POLICY "%v" ALLOWED_IP_LIST=%v`, npb.name, npb.allowedIpList)
+	createSql := fmt.Sprintf(`CREATE NETWORK POLICY %v ALLOWED_IP_LIST=%v`, QuoteIdentifier(npb.name), npb.allowedIpList)
 	if npb.blockedIpList != "" {
 		createSql = createSql + fmt.Sprintf(" BLOCKED_IP_LIST=%v", npb.blockedIpList)
 	}
@@ -63,32 +63,32 @@ func (npb *NetworkPolicyBuilder) Create() string {
 
 // Describe returns the SQL query that will describe a network policy
 func (npb *NetworkPolicyBuilder) Describe() string {
-	return fmt.Sprintf(`DESC NETWORK POLICY "%v"`, npb.name)
+	return fmt.Sprintf(`DESC NETWORK POLICY %v`, QuoteIdentifier(npb.name))
 }
 
 // ChangeComment returns the SQL query that will update the comment on the network policy.
 func (npb *NetworkPolicyBuilder) ChangeComment(c string) string {
-	return fmt.Sprintf(`ALTER NETWORK POLICY "%v" SET COMMENT = '%v'`, npb.name, EscapeString(c))
+	return fmt.Sprintf(`ALTER NETWORK POLICY %v SET COMMENT = '%v'`, QuoteIdentifier(npb.name), EscapeString(c))
 }
 
 // RemoveComment returns the SQL query that will remove the comment on the network policy.
 func (npb *NetworkPolicyBuilder) RemoveComment() string {
-	return fmt.Sprintf(`ALTER NETWORK POLICY "%v" UNSET COMMENT`, npb.name)
+	return fmt.Sprintf(`ALTER NETWORK POLICY %v UNSET COMMENT`, QuoteIdentifier(npb.name))
 }
 
 // ChangeIpList returns the SQL query that will update the ip list (of the specified listType) on the network policy.
 func (npb *NetworkPolicyBuilder) ChangeIpList(listType string, ips []string) string {
-	return fmt.Sprintf(`ALTER NETWORK POLICY "%v" SET %v_IP_LIST = %v`, npb.name, listType, IpListToString(ips))
+	return fmt.Sprintf(`ALTER NETWORK POLICY %v SET %v_IP_LIST = %v`, QuoteIdentifier(npb.name), listType, IpListToString(ips))
 }
 
 // Drop returns the SQL query that will drop a network policy.
 func (npb *NetworkPolicyBuilder) Drop() string {
-	return fmt.Sprintf(`DROP NETWORK POLICY "%v"`, npb.name)
+	return fmt.Sprintf(`DROP NETWORK POLICY %v`, QuoteIdentifier(npb.name))
 }
 
 // SetOnAccount returns the SQL query that will set the network policy globally on your Snowflake account
 func (npb *NetworkPolicyBuilder) SetOnAccount() string {
-	return fmt.Sprintf(`ALTER ACCOUNT SET NETWORK_POLICY = "%v"`, npb.name)
+	return fmt.Sprintf(`ALTER ACCOUNT SET NETWORK_POLICY = %v`, QuoteIdentifier(npb.name))
 }
 
 // UnsetOnAccount returns the SQL query that will unset the network policy globally on your Snowflake account
@@ -98,12 +98,22 @@ func (npb *NetworkPolicyBuilder) UnsetOnAccount() string {
 
 // SetOnUser returns the SQL query that will set the network policy on a given user
 func (npb *NetworkPolicyBuilder) SetOnUser(u string) string {
-	return fmt.Sprintf(`ALTER USER "%v" SET NETWORK_POLICY = "%v"`, u, npb.name)
+	return fmt.Sprintf(`ALTER USER %v SET NETWORK_POLICY = %v`, QuoteIdentifier(u), QuoteIdentifier(npb.name))
 }
 
 // UnsetOnUser returns the SQL query that will unset the network policy of a given user
 func (npb *NetworkPolicyBuilder) UnsetOnUser(u string) string {
-	return fmt.Sprintf(`ALTER USER "%v" UNSET NETWORK_POLICY`, u)
+	return fmt.Sprintf(`ALTER USER %v UNSET NETWORK_POLICY`, QuoteIdentifier(u))
+}
+
+// ShowOnAccount returns the SQL query that will show the network policy set globally on your Snowflake account
+func (npb *NetworkPolicyBuilder) ShowOnAccount() string {
+	return `SHOW PARAMETERS LIKE 'NETWORK_POLICY' IN ACCOUNT`
+}
+
+// ShowOnUser returns the SQL query that will show the network policy set on a given user
+func (npb *NetworkPolicyBuilder) ShowOnUser(u string) string {
+	return fmt.Sprintf(`SHOW PARAMETERS LIKE 'NETWORK_POLICY' IN USER %v`, QuoteIdentifier(u))
 }
 
 // ShowAllNetworkPolicies returns the SQL query that will SHOW *all* network policies in the Snowflake account
@@ -143,3 +153,28 @@ func ScanNetworkPolicies(rows *sqlx.Rows) ([]*NetworkPolicyStruct, error) {
 	}
 	return n, nil
 }
+
+type networkPolicyParameter struct {
+	Key   sql.NullString `db:"key"`
+	Value sql.NullString `db:"value"`
+	Level sql.NullString `db:"level"`
+}
+
+// ScanNetworkPolicyParameter returns the name of the network policy in a row
+// of SHOW PARAMETERS LIKE 'NETWORK_POLICY', empty when no policy is set at
+// level, e.g. USER. A user without a policy of its own lists the policy of the
+// account at level ACCOUNT.
+func ScanNetworkPolicyParameter(row *sqlx.Row, level string) (string, error) {
+	p := &networkPolicyParameter{}
+	if err := row.StructScan(p); err != nil {
+		return "", err
+	}
+	if p.Level.String != level {
+		return "", nil
+	}
+	// the policy may be listed as a quoted identifier, e.g. "my-policy"
+	if id, err := ParseIdentifier(p.Value.String); err == nil && id.IsQuoted() {
+		return id.Name(), nil
+	}
+	return p.Value.String, nil
+}
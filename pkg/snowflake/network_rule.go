@@ -0,0 +1,143 @@
+package snowflake
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// NetworkRuleBuilder abstracts the creation of sql queries for a snowflake network rule
+type NetworkRuleBuilder struct {
+	name      string
+	db        string
+	schema    string
+	ruleType  string
+	mode      string
+	valueList []string
+	comment   string
+}
+
+// QualifiedName prepends the db and schema and escapes everything nicely
+func (nrb *NetworkRuleBuilder) QualifiedName() string {
+	return QuoteIdentifier(nrb.db, nrb.schema, nrb.name)
+}
+
+// WithType sets the type of the identifiers of the rule, e.g. HOST_PORT or IPV4
+func (nrb *NetworkRuleBuilder) WithType(t string) *NetworkRuleBuilder {
+	nrb.ruleType = t
+	return nrb
+}
+
+// WithMode sets the direction of the traffic of the rule, e.g. INGRESS or EGRESS
+func (nrb *NetworkRuleBuilder) WithMode(m string) *NetworkRuleBuilder {
+	nrb.mode = m
+	return nrb
+}
+
+// WithValueList sets the identifiers the rule allows
+func (nrb *NetworkRuleBuilder) WithValueList(v []string) *NetworkRuleBuilder {
+	nrb.valueList = v
+	return nrb
+}
+
+// WithComment adds a comment to the NetworkRuleBuilder
+func (nrb *NetworkRuleBuilder) WithComment(c string) *NetworkRuleBuilder {
+	nrb.comment = c
+	return nrb
+}
+
+// NetworkRule returns a pointer to a Builder that abstracts the DDL operations for a network rule.
+//
+// Supported DDL operations are:
+//   - CREATE NETWORK RULE
+//   - ALTER NETWORK RULE
+//   - DROP NETWORK RULE
+//   - SHOW NETWORK RULES
+//   - DESCRIBE NETWORK RULE
+//
+// [Snowflake Reference](https://docs.snowflake.com/en/sql-reference/sql/create-network-rule)
+func NetworkRule(name, db, schema string) *NetworkRuleBuilder {
+	return &NetworkRuleBuilder{
+		name:   name,
+		db:     db,
+		schema: schema,
+	}
+}
+
+// Create returns the SQL that will create a new network rule
+func (nrb *NetworkRuleBuilder) Create() string {
+	q := strings.Builder{}
+	q.WriteString(fmt.Sprintf(`CREATE NETWORK RULE %v TYPE = %v MODE = %v`, nrb.QualifiedName(), nrb.ruleType, nrb.mode))
+	q.WriteString(fmt.Sprintf(` VALUE_LIST = %v`, formatValueList(nrb.valueList)))
+
+	if nrb.comment != "" {
+		q.WriteString(fmt.Sprintf(` COMMENT = '%v'`, EscapeString(nrb.comment)))
+	}
+
+	return q.String()
+}
+
+// formatValueList writes a list of strings, () when empty
+func formatValueList(values []string) string {
+	if len(values) == 0 {
+		return "()"
+	}
+	return formatStringList(values)
+}
+
+// ChangeValueList returns the sql that will change the identifiers the rule allows.
+func (nrb *NetworkRuleBuilder) ChangeValueList(v []string) string {
+	return fmt.Sprintf(`ALTER NETWORK RULE %v SET VALUE_LIST = %v`, nrb.QualifiedName(), formatValueList(v))
+}
+
+// ChangeComment returns the sql that will change the comment for the network rule.
+func (nrb *NetworkRuleBuilder) ChangeComment(c string) string {
+	return fmt.Sprintf(`ALTER NETWORK RULE %v SET COMMENT = '%v'`, nrb.QualifiedName(), EscapeString(c))
+}
+
+// RemoveComment returns the sql that will remove the comment for the network rule.
+func (nrb *NetworkRuleBuilder) RemoveComment() string {
+	return fmt.Sprintf(`ALTER NETWORK RULE %v UNSET COMMENT`, nrb.QualifiedName())
+}
+
+// Drop returns the sql that will remove the network rule.
+func (nrb *NetworkRuleBuilder) Drop() string {
+	return fmt.Sprintf(`DROP NETWORK RULE %v`, nrb.QualifiedName())
+}
+
+// Describe returns the sql that will describe the network rule.
+func (nrb *NetworkRuleBuilder) Describe() string {
+	return fmt.Sprintf(`DESCRIBE NETWORK RULE %v`, nrb.QualifiedName())
+}
+
+// Show returns the sql that will show the network rule.
+func (nrb *NetworkRuleBuilder) Show() string {
+	return fmt.Sprintf(`SHOW NETWORK RULES LIKE '%v' IN SCHEMA %v`, EscapeString(nrb.name), QuoteIdentifier(nrb.db, nrb.schema))
+}
+
+type networkRule struct {
+	CreatedOn    string         `db:"created_on"`
+	Name         string         `db:"name"`
+	DatabaseName string         `db:"database_name"`
+	SchemaName   string         `db:"schema_name"`
+	Owner        string         `db:"owner"`
+	Comment      sql.NullString `db:"comment"`
+	Type         string         `db:"type"`
+	Mode         string         `db:"mode"`
+	// ValueList is only returned by DESCRIBE NETWORK RULE
+	ValueList sql.NullString `db:"value_list"`
+}
+
+// ListValues returns the identifiers the rule allows
+func (nr *networkRule) ListValues() []string {
+	return splitList(nr.ValueList.String)
+}
+
+// ScanNetworkRule turns a row of SHOW NETWORK RULES or DESCRIBE NETWORK RULE into a network rule object
+func ScanNetworkRule(row *sqlx.Row) (*networkRule, error) {
+	nr := &networkRule{}
+	e := row.StructScan(nr)
+	return nr, e
+}
@@ -0,0 +1,36 @@
+package snowflake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkRuleCreate(t *testing.T) {
+	r := require.New(t)
+	nr := NetworkRule("test_rule", "test_db", "test_schema").WithType("HOST_PORT").WithMode("EGRESS").WithValueList([]string{"example.com", "example.com:8080"})
+	r.Equal(`"test_db"."test_schema"."test_rule"`, nr.QualifiedName())
+	r.Equal(`CREATE NETWORK RULE "test_db"."test_schema"."test_rule" TYPE = HOST_PORT MODE = EGRESS VALUE_LIST = ('example.com', 'example.com:8080')`, nr.Create())
+
+	nr.WithValueList(nil).WithComment("no hosts")
+	r.Equal(`CREATE NETWORK RULE "test_db"."test_schema"."test_rule" TYPE = HOST_PORT MODE = EGRESS VALUE_LIST = () COMMENT = 'no hosts'`, nr.Create())
+}
+
+func TestNetworkRuleAlter(t *testing.T) {
+	r := require.New(t)
+	nr := NetworkRule("test_rule", "test_db", "test_schema")
+	r.Equal(`ALTER NETWORK RULE "test_db"."test_schema"."test_rule" SET VALUE_LIST = ('10.0.0.0/8')`, nr.ChangeValueList([]string{"10.0.0.0/8"}))
+	r.Equal(`ALTER NETWORK RULE "test_db"."test_schema"."test_rule" SET COMMENT = 'comment'`, nr.ChangeComment("comment"))
+	r.Equal(`ALTER NETWORK RULE "test_db"."test_schema"."test_rule" UNSET COMMENT`, nr.RemoveComment())
+	r.Equal(`DROP NETWORK RULE "test_db"."test_schema"."test_rule"`, nr.Drop())
+	r.Equal(`DESCRIBE NETWORK RULE "test_db"."test_schema"."test_rule"`, nr.Describe())
+	r.Equal(`SHOW NETWORK RULES LIKE 'test_rule' IN SCHEMA "test_db"."test_schema"`, nr.Show())
+}
+
+func TestNetworkRuleListValues(t *testing.T) {
+	r := require.New(t)
+	nr := &networkRule{}
+	r.Empty(nr.ListValues())
+	nr.ValueList.String = "example.com,example.com:8080"
+	r.Equal([]string{"example.com", "example.com:8080"}, nr.ListValues())
+}
@@ -0,0 +1,203 @@
+package snowflake
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// OrganizationAccountBuilder abstracts the creation of SQL queries for the
+// accounts of an organization, managed with the ORGADMIN role
+type OrganizationAccountBuilder struct {
+	name               string
+	adminName          string
+	adminPassword      string
+	adminRSAPublicKey  string
+	mustChangePassword bool
+	email              string
+	edition            string
+	regionGroup        string
+	region             string
+	comment            string
+}
+
+// OrganizationAccount returns a pointer to a Builder that abstracts the DDL
+// operations for an account of the organization. Account names, regions and
+// region groups are plain identifiers and are never quoted, so callers have
+// to validate them.
+//
+// Supported DDL operations are:
+//   - CREATE ACCOUNT
+//   - ALTER ACCOUNT
+//   - DROP ACCOUNT
+//   - SHOW ORGANIZATION ACCOUNTS
+//
+// [Snowflake Reference](https://docs.snowflake.com/en/sql-reference/sql/create-account)
+func OrganizationAccount(name string) *OrganizationAccountBuilder {
+	return &OrganizationAccountBuilder{
+		name: name,
+	}
+}
+
+// WithAdminName sets the login name of the initial administrator of the account
+func (oab *OrganizationAccountBuilder) WithAdminName(n string) *OrganizationAccountBuilder {
+	oab.adminName = n
+	return oab
+}
+
+// WithAdminPassword sets the password of the initial administrator of the account
+func (oab *OrganizationAccountBuilder) WithAdminPassword(p string) *OrganizationAccountBuilder {
+	oab.adminPassword = p
+	return oab
+}
+
+// WithAdminRSAPublicKey sets the public key the initial administrator of the account authenticates with
+func (oab *OrganizationAccountBuilder) WithAdminRSAPublicKey(k string) *OrganizationAccountBuilder {
+	oab.adminRSAPublicKey = k
+	return oab
+}
+
+// WithMustChangePassword forces the initial administrator to change their password on first login
+func (oab *OrganizationAccountBuilder) WithMustChangePassword(m bool) *OrganizationAccountBuilder {
+	oab.mustChangePassword = m
+	return oab
+}
+
+// WithEmail sets the email address of the initial administrator of the account
+func (oab *OrganizationAccountBuilder) WithEmail(e string) *OrganizationAccountBuilder {
+	oab.email = e
+	return oab
+}
+
+// WithEdition sets the edition of the account, e.g. ENTERPRISE
+func (oab *OrganizationAccountBuilder) WithEdition(e string) *OrganizationAccountBuilder {
+	oab.edition = e
+	return oab
+}
+
+// WithRegionGroup sets the region group to create the account in
+func (oab *OrganizationAccountBuilder) WithRegionGroup(g string) *OrganizationAccountBuilder {
+	oab.regionGroup = g
+	return oab
+}
+
+// WithRegion sets the Snowflake region to create the account in, e.g. AWS_US_WEST_2
+func (oab *OrganizationAccountBuilder) WithRegion(r string) *OrganizationAccountBuilder {
+	oab.region = r
+	return oab
+}
+
+// WithComment adds a comment to the OrganizationAccountBuilder
+func (oab *OrganizationAccountBuilder) WithComment(c string) *OrganizationAccountBuilder {
+	oab.comment = c
+	return oab
+}
+
+// Create returns the SQL that will create a new account in the organization
+func (oab *OrganizationAccountBuilder) Create() string {
+	q := strings.Builder{}
+	q.WriteString(fmt.Sprintf(`CREATE ACCOUNT %v ADMIN_NAME = '%v'`, oab.name, EscapeString(oab.adminName)))
+	if oab.adminPassword != "" {
+		q.WriteString(fmt.Sprintf(` ADMIN_PASSWORD = '%v'`, EscapeString(oab.adminPassword)))
+	}
+	if oab.adminRSAPublicKey != "" {
+		q.WriteString(fmt.Sprintf(` ADMIN_RSA_PUBLIC_KEY = '%v'`, EscapeString(oab.adminRSAPublicKey)))
+	}
+	q.WriteString(fmt.Sprintf(` EMAIL = '%v'`, EscapeString(oab.email)))
+	if oab.mustChangePassword {
+		q.WriteString(` MUST_CHANGE_PASSWORD = TRUE`)
+	}
+	q.WriteString(fmt.Sprintf(` EDITION = %v`, oab.edition))
+	if oab.regionGroup != "" {
+		q.WriteString(fmt.Sprintf(` REGION_GROUP = %v`, oab.regionGroup))
+	}
+	if oab.region != "" {
+		q.WriteString(fmt.Sprintf(` REGION = %v`, oab.region))
+	}
+	if oab.comment != "" {
+		q.WriteString(fmt.Sprintf(` COMMENT = '%v'`, EscapeString(oab.comment)))
+	}
+	return q.String()
+}
+
+// ChangeComment returns the SQL that will change the comment of the account.
+func (oab *OrganizationAccountBuilder) ChangeComment(c string) string {
+	return fmt.Sprintf(`ALTER ACCOUNT %v SET COMMENT = '%v'`, oab.name, EscapeString(c))
+}
+
+// RemoveComment returns the SQL that will remove the comment of the account.
+func (oab *OrganizationAccountBuilder) RemoveComment() string {
+	return fmt.Sprintf(`ALTER ACCOUNT %v UNSET COMMENT`, oab.name)
+}
+
+// Drop returns the SQL that will drop the account. The account can be
+// restored with UNDROP ACCOUNT until the grace period, in days, is over.
+func (oab *OrganizationAccountBuilder) Drop(gracePeriodInDays int) string {
+	return fmt.Sprintf(`DROP ACCOUNT %v GRACE_PERIOD_IN_DAYS = %d`, oab.name, gracePeriodInDays)
+}
+
+// Show returns the SQL that will show the account. The name is matched as a
+// LIKE pattern, so the account has to be looked up among the rows returned.
+func (oab *OrganizationAccountBuilder) Show() string {
+	return fmt.Sprintf(`SHOW ORGANIZATION ACCOUNTS LIKE '%v'`, EscapeString(oab.name))
+}
+
+type organizationAccount struct {
+	OrganizationName  sql.NullString `db:"organization_name"`
+	AccountName       sql.NullString `db:"account_name"`
+	RegionGroup       sql.NullString `db:"region_group"`
+	SnowflakeRegion   sql.NullString `db:"snowflake_region"`
+	Edition           sql.NullString `db:"edition"`
+	AccountURL        sql.NullString `db:"account_url"`
+	CreatedOn         sql.NullString `db:"created_on"`
+	Comment           sql.NullString `db:"comment"`
+	AccountLocator    sql.NullString `db:"account_locator"`
+	AccountLocatorURL sql.NullString `db:"account_locator_url"`
+	IsOrgAdmin        sql.NullBool   `db:"is_org_admin"`
+}
+
+// ListOrganizationAccounts returns the accounts of the organization whose
+// names match the LIKE pattern, all of them when the pattern is empty
+func ListOrganizationAccounts(ctx context.Context, pattern string, db *sql.DB) ([]organizationAccount, error) {
+	stmt := `SHOW ORGANIZATION ACCOUNTS`
+	if pattern != "" {
+		stmt = OrganizationAccount(pattern).Show()
+	}
+	return listOrganizationAccounts(ctx, db, stmt)
+}
+
+func listOrganizationAccounts(ctx context.Context, db *sql.DB, stmt string) ([]organizationAccount, error) {
+	rows, err := QueryContext(ctx, db, stmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	accounts := []organizationAccount{}
+	err = sqlx.StructScan(rows, &accounts)
+	if err == sql.ErrNoRows {
+		log.Printf("[DEBUG] no organization accounts found")
+		return nil, nil
+	}
+	return accounts, errors.Wrapf(err, "unable to scan row for %s", stmt)
+}
+
+// ReadOrganizationAccount returns the account of the organization named name,
+// or sql.ErrNoRows if there is none
+func ReadOrganizationAccount(ctx context.Context, db *sql.DB, name string) (*organizationAccount, error) {
+	accounts, err := listOrganizationAccounts(ctx, db, OrganizationAccount(name).Show())
+	if err != nil {
+		return nil, err
+	}
+	for i := range accounts {
+		if strings.EqualFold(accounts[i].AccountName.String, name) {
+			return &accounts[i], nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
@@ -0,0 +1,44 @@
+package snowflake_test
+
+import (
+	"testing"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrganizationAccountCreate(t *testing.T) {
+	r := require.New(t)
+	b := snowflake.OrganizationAccount("SALES").
+		WithAdminName("admin").
+		WithAdminPassword("it's a secret").
+		WithMustChangePassword(true).
+		WithEmail("admin@example.com").
+		WithEdition("ENTERPRISE").
+		WithRegion("AWS_US_WEST_2").
+		WithComment("sales unit")
+
+	r.Equal(`CREATE ACCOUNT SALES ADMIN_NAME = 'admin' ADMIN_PASSWORD = 'it\'s a secret' EMAIL = 'admin@example.com' MUST_CHANGE_PASSWORD = TRUE EDITION = ENTERPRISE REGION = AWS_US_WEST_2 COMMENT = 'sales unit'`, b.Create())
+}
+
+func TestOrganizationAccountCreateWithKey(t *testing.T) {
+	r := require.New(t)
+	b := snowflake.OrganizationAccount("SALES").
+		WithAdminName("admin").
+		WithAdminRSAPublicKey("MIIBIjANBgkqh").
+		WithEmail("admin@example.com").
+		WithEdition("STANDARD").
+		WithRegionGroup("PUBLIC")
+
+	r.Equal(`CREATE ACCOUNT SALES ADMIN_NAME = 'admin' ADMIN_RSA_PUBLIC_KEY = 'MIIBIjANBgkqh' EMAIL = 'admin@example.com' EDITION = STANDARD REGION_GROUP = PUBLIC`, b.Create())
+}
+
+func TestOrganizationAccountAlterAndDrop(t *testing.T) {
+	r := require.New(t)
+	b := snowflake.OrganizationAccount("SALES")
+
+	r.Equal(`ALTER ACCOUNT SALES SET COMMENT = 'sales unit'`, b.ChangeComment("sales unit"))
+	r.Equal(`ALTER ACCOUNT SALES UNSET COMMENT`, b.RemoveComment())
+	r.Equal(`DROP ACCOUNT SALES GRACE_PERIOD_IN_DAYS = 14`, b.Drop(14))
+	r.Equal(`SHOW ORGANIZATION ACCOUNTS LIKE 'SALES'`, b.Show())
+}
@@ -0,0 +1,129 @@
+package snowflake
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ParameterBuilder abstracts the creation of SQL queries for parameters set on
+// the account or on an object in it
+type ParameterBuilder struct {
+	key        string
+	objectType string
+	names      []string
+}
+
+// AccountParameter returns a pointer to a Builder that abstracts the DDL
+// operations for a parameter set on the account.
+//
+// Supported DDL operations are:
+//   - ALTER ACCOUNT SET
+//   - ALTER ACCOUNT UNSET
+//   - SHOW PARAMETERS IN ACCOUNT
+//
+// [Snowflake Reference](https://docs.snowflake.com/en/sql-reference/parameters.html)
+func AccountParameter(key string) *ParameterBuilder {
+	return &ParameterBuilder{
+		key:        key,
+		objectType: "ACCOUNT",
+	}
+}
+
+// ObjectParameter returns a pointer to a Builder that abstracts the DDL
+// operations for a parameter set on an object, e.g. a DATABASE, named by the
+// parts of its qualified name. An empty key shows all parameters of the object.
+//
+// Supported DDL operations are:
+//   - ALTER <object type> SET
+//   - ALTER <object type> UNSET
+//   - SHOW PARAMETERS IN <object type>
+//
+// [Snowflake Reference](https://docs.snowflake.com/en/sql-reference/parameters.html)
+func ObjectParameter(key, objectType string, names ...string) *ParameterBuilder {
+	return &ParameterBuilder{
+		key:        key,
+		objectType: strings.ToUpper(objectType),
+		names:      names,
+	}
+}
+
+// object returns the object the parameter is set on as written after ALTER and IN
+func (pb *ParameterBuilder) object() string {
+	if pb.objectType == "ACCOUNT" {
+		return pb.objectType
+	}
+	return fmt.Sprintf(`%v %v`, pb.objectType, QuoteIdentifier(pb.names...))
+}
+
+// Set returns the SQL query that will set the parameter to value. Numbers and
+// booleans are written as they are, anything else as a string.
+func (pb *ParameterBuilder) Set(value string) string {
+	return fmt.Sprintf(`ALTER %v SET %v = %v`, pb.object(), pb.key, parameterValue(value))
+}
+
+// Unset returns the SQL query that will reset the parameter to the value inherited by the object.
+func (pb *ParameterBuilder) Unset() string {
+	return fmt.Sprintf(`ALTER %v UNSET %v`, pb.object(), pb.key)
+}
+
+// Show returns the SQL query that will show the parameter, or every parameter
+// of the object if no key is set. The key may be a LIKE pattern.
+func (pb *ParameterBuilder) Show() string {
+	if pb.key == "" {
+		return fmt.Sprintf(`SHOW PARAMETERS IN %v`, pb.object())
+	}
+	return fmt.Sprintf(`SHOW PARAMETERS LIKE '%v' IN %v`, EscapeString(pb.key), pb.object())
+}
+
+// numericLiteral matches the values written as numbers, e.g. 7 or -1.5
+var numericLiteral = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?$`)
+
+func parameterValue(value string) string {
+	if numericLiteral.MatchString(value) {
+		return value
+	}
+	if strings.EqualFold(value, "true") || strings.EqualFold(value, "false") {
+		return strings.ToUpper(value)
+	}
+	return fmt.Sprintf(`'%v'`, EscapeString(value))
+}
+
+type parameter struct {
+	Key          sql.NullString `db:"key"`
+	Value        sql.NullString `db:"value"`
+	DefaultValue sql.NullString `db:"default"`
+	Level        sql.NullString `db:"level"`
+	Description  sql.NullString `db:"description"`
+	Type         sql.NullString `db:"type"`
+}
+
+// IsSetOn reports whether the parameter is set on the given kind of object,
+// e.g. ACCOUNT or DATABASE, rather than inherited or left to its default.
+func (p *parameter) IsSetOn(objectType string) bool {
+	return strings.EqualFold(p.Level.String, objectType)
+}
+
+// ScanParameter reads a row of SHOW PARAMETERS
+func ScanParameter(row *sqlx.Row) (*parameter, error) {
+	p := &parameter{}
+	err := row.StructScan(p)
+	return p, err
+}
+
+// ScanParameters reads the rows of SHOW PARAMETERS
+func ScanParameters(rows *sqlx.Rows) ([]*parameter, error) {
+	params := []*parameter{}
+	for rows.Next() {
+		p := &parameter{}
+		err := rows.StructScan(p)
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, p)
+	}
+	return params, rows.Err()
+}
@@ -0,0 +1,39 @@
+package snowflake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountParameter(t *testing.T) {
+	r := require.New(t)
+	p := AccountParameter("TIMEZONE")
+	r.Equal(`ALTER ACCOUNT SET TIMEZONE = 'America/Los_Angeles'`, p.Set("America/Los_Angeles"))
+	r.Equal(`ALTER ACCOUNT UNSET TIMEZONE`, p.Unset())
+	r.Equal(`SHOW PARAMETERS LIKE 'TIMEZONE' IN ACCOUNT`, p.Show())
+
+	p = AccountParameter("MIN_DATA_RETENTION_TIME_IN_DAYS")
+	r.Equal(`ALTER ACCOUNT SET MIN_DATA_RETENTION_TIME_IN_DAYS = 7`, p.Set("7"))
+	r.Equal(`ALTER ACCOUNT SET MIN_DATA_RETENTION_TIME_IN_DAYS = -1.5`, p.Set("-1.5"))
+	r.Equal(`ALTER ACCOUNT SET MIN_DATA_RETENTION_TIME_IN_DAYS = 'NaN'`, p.Set("NaN"))
+	r.Equal(`ALTER ACCOUNT SET MIN_DATA_RETENTION_TIME_IN_DAYS = 'Inf'`, p.Set("Inf"))
+	r.Equal(`ALTER ACCOUNT SET MIN_DATA_RETENTION_TIME_IN_DAYS = '1e5'`, p.Set("1e5"))
+
+	p = AccountParameter("PERIODIC_DATA_REKEYING")
+	r.Equal(`ALTER ACCOUNT SET PERIODIC_DATA_REKEYING = TRUE`, p.Set("true"))
+}
+
+func TestObjectParameter(t *testing.T) {
+	r := require.New(t)
+	p := ObjectParameter("DATA_RETENTION_TIME_IN_DAYS", "schema", "test_db", "test_schema")
+	r.Equal(`ALTER SCHEMA "test_db"."test_schema" SET DATA_RETENTION_TIME_IN_DAYS = 30`, p.Set("30"))
+	r.Equal(`ALTER SCHEMA "test_db"."test_schema" UNSET DATA_RETENTION_TIME_IN_DAYS`, p.Unset())
+	r.Equal(`SHOW PARAMETERS LIKE 'DATA_RETENTION_TIME_IN_DAYS' IN SCHEMA "test_db"."test_schema"`, p.Show())
+
+	p = ObjectParameter("", "USER", "test_user")
+	r.Equal(`SHOW PARAMETERS IN USER "test_user"`, p.Show())
+
+	p = ObjectParameter("QUERY_TAG", "USER", "test_user")
+	r.Equal(`ALTER USER "test_user" SET QUERY_TAG = 'it\'s tagged'`, p.Set("it's tagged"))
+}
@@ -2,186 +2,486 @@ package snowflake
 
 import (
 	"fmt"
+	"log"
 	"strings"
 	"unicode"
 )
 
-// ViewSelectStatementExtractor is a simplistic parser that only exists to extract the select
-// statement from a create view statement
-//
-// The implementation is optimized for undertandable and predictable behavior. So far we only seek
-// to support queries of the sort that are generated by this project.
-//
-// Also there is little error handling and we assume queries are well-formed.
-type ViewSelectStatementExtractor struct {
-	input []rune
-	pos   int
-}
-
-func NewViewSelectStatementExtractor(input string) *ViewSelectStatementExtractor {
-	return &ViewSelectStatementExtractor{
-		input: []rune(input),
-	}
-}
-
-func (e *ViewSelectStatementExtractor) Extract() (string, error) {
-	fmt.Printf("[DEBUG] extracting view query %s\n", string(e.input))
-	e.consumeSpace()
-	e.consumeToken("create")
-	e.consumeSpace()
-	e.consumeToken("or replace")
-	e.consumeSpace()
-	e.consumeToken("secure")
-	e.consumeSpace()
-	e.consumeToken("recursive")
-	e.consumeSpace()
-	e.consumeToken("view")
-	e.consumeSpace()
-	e.consumeToken("if not exists")
-	e.consumeSpace()
-	e.consumeIdentifier()
-	// TODO column list
-	// TODO copy grants
-	e.consumeComment()
-	e.consumeSpace()
-	e.consumeComment()
-	e.consumeSpace()
-	e.consumeToken("as")
-	e.consumeSpace()
-
-	return string(e.input[e.pos:]), nil
-}
-
-func (e *ViewSelectStatementExtractor) ExtractMaterializedView() (string, error) {
-	fmt.Printf("[DEBUG] extracting materialized view query: %s\n", string(e.input))
-	e.consumeSpace()
-	e.consumeToken("use warehouse")
-	e.consumeSpace()
-	e.consumeNonSpace() // warehouse name
-	e.consumeSpace()
-	e.consumeToken("create")
-	e.consumeSpace()
-	e.consumeToken("or replace")
-	e.consumeSpace()
-	e.consumeToken("secure")
-	e.consumeSpace()
-	e.consumeToken("materialized view")
-	e.consumeSpace()
-	e.consumeToken("if not exists")
-	e.consumeSpace()
-	e.consumeIdentifier()
-	// TODO copy grants
-	// TODO column list
-	e.consumeComment()
-	e.consumeSpace()
-	e.consumeComment()
-	e.consumeSpace()
-	e.consumeToken("cluster by")
-	e.consumeSpace()
-	e.consumeClusterBy()
-	e.consumeSpace()
-	e.consumeToken("as")
-	e.consumeSpace()
-
-	return string(e.input[e.pos:]), nil
-}
-
-// consumeToken will move e.pos forward iff the token is the next part of the input. Comparison is
-// case-insensitive. Will return true if consumed.
-func (e *ViewSelectStatementExtractor) consumeToken(t string) bool {
-	found := 0
-	for i, r := range t {
-		// it is annoying that we have to convert the runes back to strings to do a case-insensitive
-		// comparison. Hopefully I am just missing something in the docs.
-		if e.pos+i > len(e.input) || !strings.EqualFold(string(r), string(e.input[e.pos+i])) {
-			break
+type tokenKind int
+
+const (
+	tokenSpace   tokenKind = iota
+	tokenComment           // -- and // line comments, /* */ block comments
+	tokenWord              // keywords, unquoted identifiers and numbers
+	tokenQuoted            // "quoted identifier"
+	tokenString            // 'string literal'
+	tokenDollar            // $$dollar quoted string$$
+	tokenPunct             // any other single character
+)
+
+// token is a lexeme of a SQL statement. pos and end are rune offsets in the
+// input, so that the original text between two tokens can be recovered.
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+	end  int
+}
+
+// isWord reports whether t is the keyword w, ignoring case.
+func (t token) isWord(w string) bool {
+	return t.kind == tokenWord && strings.EqualFold(t.text, w)
+}
+
+func (t token) isPunct(p string) bool {
+	return t.kind == tokenPunct && t.text == p
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || r == '$' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// lex splits input into tokens. Block comments may be nested.
+func lex(input []rune) ([]token, error) {
+	tokens := []token{}
+	hasPrefix := func(i int, p string) bool {
+		return strings.HasPrefix(string(input[i:min(len(input), i+len(p))]), p)
+	}
+
+	for i := 0; i < len(input); {
+		start := i
+		var kind tokenKind
+		switch r := input[i]; {
+		case unicode.IsSpace(r):
+			kind = tokenSpace
+			for i < len(input) && unicode.IsSpace(input[i]) {
+				i++
+			}
+		case hasPrefix(i, "--") || hasPrefix(i, "//"):
+			kind = tokenComment
+			for i < len(input) && input[i] != '\n' {
+				i++
+			}
+		case hasPrefix(i, "/*"):
+			kind = tokenComment
+			depth := 0
+			for {
+				if i >= len(input) {
+					return nil, fmt.Errorf("unterminated comment at offset %d", start)
+				}
+				if hasPrefix(i, "/*") {
+					depth++
+					i += 2
+				} else if hasPrefix(i, "*/") {
+					depth--
+					i += 2
+					if depth == 0 {
+						break
+					}
+				} else {
+					i++
+				}
+			}
+		case hasPrefix(i, "$$"):
+			kind = tokenDollar
+			i += 2
+			for !hasPrefix(i, "$$") {
+				if i >= len(input) {
+					return nil, fmt.Errorf("unterminated $$ string at offset %d", start)
+				}
+				i++
+			}
+			i += 2
+		case r == '\'':
+			kind = tokenString
+			i++
+			for {
+				if i >= len(input) {
+					return nil, fmt.Errorf("unterminated string at offset %d", start)
+				}
+				if input[i] == '\\' {
+					i += 2
+					continue
+				}
+				if input[i] == '\'' {
+					// '' is an escaped quote
+					if i+1 < len(input) && input[i+1] == '\'' {
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				i++
+			}
+		case r == '"':
+			kind = tokenQuoted
+			i++
+			for {
+				if i >= len(input) {
+					return nil, fmt.Errorf("unterminated quoted identifier at offset %d", start)
+				}
+				if input[i] == '"' {
+					if i+1 < len(input) && input[i+1] == '"' {
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				i++
+			}
+		case isWordRune(r):
+			kind = tokenWord
+			for i < len(input) && isWordRune(input[i]) && !hasPrefix(i, "$$") {
+				i++
+			}
+		default:
+			kind = tokenPunct
+			i++
 		}
-		found += 1
+		tokens = append(tokens, token{kind: kind, text: string(input[start:i]), pos: start, end: i})
 	}
+	return tokens, nil
+}
 
-	if found == len(t) {
-		e.pos += len(t)
-		return true
+func min(a, b int) int {
+	if a < b {
+		return a
 	}
-	return false
+	return b
 }
 
-func (e *ViewSelectStatementExtractor) consumeSpace() {
-	found := 0
-	for {
-		if e.pos+found > len(e.input)-1 || !unicode.IsSpace(e.input[e.pos+found]) {
-			break
+// unquoteString returns the value of a string literal, resolving backslash
+// escapes and doubled quotes.
+func unquoteString(literal string) string {
+	s := []rune(literal[1 : len(literal)-1])
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && i+1 < len(s):
+			i++
+			switch s[i] {
+			case 'n':
+				b.WriteRune('\n')
+			case 't':
+				b.WriteRune('\t')
+			case 'r':
+				b.WriteRune('\r')
+			case '0':
+				b.WriteRune(0)
+			default:
+				b.WriteRune(s[i])
+			}
+		case s[i] == '\'' && i+1 < len(s) && s[i+1] == '\'':
+			i++
+			b.WriteRune('\'')
+		default:
+			b.WriteRune(s[i])
 		}
-		found += 1
 	}
-	e.pos += found
+	return b.String()
 }
 
-func (e *ViewSelectStatementExtractor) consumeIdentifier() {
-	e.consumeNonSpace()
+// unquoteIdentifier strips the quotes of a quoted identifier. Unquoted
+// identifiers are returned as is.
+func unquoteIdentifier(t token) string {
+	if t.kind != tokenQuoted {
+		return t.text
+	}
+	return strings.ReplaceAll(t.text[1:len(t.text)-1], `""`, `"`)
+}
+
+// DDLColumn is an entry of the column list of a view, or an argument of a
+// function or procedure.
+type DDLColumn struct {
+	Name string
+	// Type is the data type of an argument, empty for view columns
+	Type    string
+	Comment string
+}
+
+// DDL holds the parts of a CREATE statement read by ParseDDL.
+type DDL struct {
+	// Kind is VIEW, MATERIALIZED VIEW, DYNAMIC TABLE, FUNCTION or PROCEDURE
+	Kind       string
+	Name       string
+	Secure     bool
+	CopyGrants bool
+	Columns    []DDLColumn
+	Comment    string
+	ClusterBy  []string
+	// Body is the query of a view or dynamic table as written, or the code of a function or
+	// procedure with the quotes of its string literal removed
+	Body string
+}
+
+var ddlKinds = [][]string{
+	{"MATERIALIZED", "VIEW"},
+	{"VIEW"},
+	{"DYNAMIC", "TABLE"},
+	{"FUNCTION"},
+	{"PROCEDURE"},
+}
+
+// ddlParser walks the significant tokens of a statement: spaces and
+// comments are skipped but remain in the input the tokens point into.
+type ddlParser struct {
+	input  []rune
+	tokens []token
+	i      int
+}
+
+func (p *ddlParser) done() bool {
+	return p.i >= len(p.tokens)
+}
+
+func (p *ddlParser) peek() token {
+	if p.done() {
+		return token{kind: tokenPunct, pos: len(p.input), end: len(p.input)}
+	}
+	return p.tokens[p.i]
+}
+
+// acceptWords consumes words if the next tokens are all of them.
+func (p *ddlParser) acceptWords(words ...string) bool {
+	if p.i+len(words) > len(p.tokens) {
+		return false
+	}
+	for j, w := range words {
+		if !p.tokens[p.i+j].isWord(w) {
+			return false
+		}
+	}
+	p.i += len(words)
+	return true
 }
 
-func (e *ViewSelectStatementExtractor) consumeNonSpace() {
-	found := 0
+// identifier consumes a possibly qualified name and returns it as written.
+func (p *ddlParser) identifier() (string, error) {
+	start := p.peek()
 	for {
-		if e.pos+found > len(e.input)-1 || unicode.IsSpace(e.input[e.pos+found]) {
+		t := p.peek()
+		if t.kind != tokenWord && t.kind != tokenQuoted {
+			return "", fmt.Errorf("expected an identifier at offset %d", t.pos)
+		}
+		p.i++
+		if !p.peek().isPunct(".") {
 			break
 		}
-		found += 1
+		p.i++
 	}
-	e.pos += found
+	return string(p.input[start.pos:p.tokens[p.i-1].end]), nil
 }
 
-func (e *ViewSelectStatementExtractor) consumeComment() {
-	if c := e.consumeToken("comment"); !c {
-		return
+// group consumes a parenthesized list and returns its items, split on the
+// commas that are not nested in other parentheses.
+func (p *ddlParser) group() ([][]token, error) {
+	open := p.peek()
+	if !open.isPunct("(") {
+		return nil, fmt.Errorf("expected ( at offset %d", open.pos)
 	}
+	p.i++
 
-	e.consumeSpace()
-
-	if c := e.consumeToken("="); !c {
-		return
+	items := [][]token{}
+	item := []token{}
+	depth := 0
+	for !p.done() {
+		t := p.tokens[p.i]
+		p.i++
+		switch {
+		case t.isPunct("("):
+			depth++
+		case t.isPunct(")") && depth == 0:
+			if len(item) > 0 {
+				items = append(items, item)
+			}
+			return items, nil
+		case t.isPunct(")"):
+			depth--
+		case t.isPunct(",") && depth == 0:
+			if len(item) == 0 {
+				return nil, fmt.Errorf("empty list item at offset %d", t.pos)
+			}
+			items = append(items, item)
+			item = []token{}
+			continue
+		}
+		item = append(item, t)
 	}
+	return nil, fmt.Errorf("unbalanced parenthesis at offset %d", open.pos)
+}
 
-	e.consumeSpace()
-
-	if c := e.consumeToken("'"); !c {
-		return
+// text returns the input spanned by tokens.
+func (p *ddlParser) text(tokens []token) string {
+	if len(tokens) == 0 {
+		return ""
 	}
+	return string(p.input[tokens[0].pos:tokens[len(tokens)-1].end])
+}
 
-	found := 0
-	escaped := false
-	for {
-		if e.pos+found > len(e.input)-1 {
+func (p *ddlParser) column(item []token, withType bool) DDLColumn {
+	c := DDLColumn{Name: unquoteIdentifier(item[0])}
+	rest := item[1:]
+	for j, t := range rest {
+		if t.isWord("COMMENT") && j+1 < len(rest) && rest[j+1].kind == tokenString {
+			c.Comment = unquoteString(rest[j+1].text)
+			rest = rest[:j]
 			break
 		}
+	}
+	if withType {
+		c.Type = p.text(rest)
+	}
+	return c
+}
 
-		if escaped {
-			escaped = false
-		} else if e.input[e.pos+found] == '\\' {
-			escaped = true
-		} else if e.input[e.pos+found] == '\'' {
-			break
+// columns consumes a parenthesized column or argument list.
+func (p *ddlParser) columns(withType bool) ([]DDLColumn, error) {
+	items, err := p.group()
+	if err != nil {
+		return nil, err
+	}
+	columns := []DDLColumn{}
+	for _, item := range items {
+		columns = append(columns, p.column(item, withType))
+	}
+	return columns, nil
+}
+
+func newDDLParser(input string) (*ddlParser, error) {
+	runes := []rune(input)
+	all, err := lex(runes)
+	if err != nil {
+		return nil, err
+	}
+	p := &ddlParser{input: runes}
+	for _, t := range all {
+		if t.kind != tokenSpace && t.kind != tokenComment {
+			p.tokens = append(p.tokens, t)
 		}
-		found += 1
 	}
-	e.pos += found
+	return p, nil
+}
 
-	if !e.consumeToken("'") {
-		return
+// ParseSignature parses the arguments of a function or procedure from the
+// signature property of DESCRIBE FUNCTION or DESCRIBE PROCEDURE, e.g.
+// (X NUMBER, "my arg" VARCHAR), the way ParseDDL parses the arguments of its
+// CREATE statement.
+func ParseSignature(signature string) ([]DDLColumn, error) {
+	p, err := newDDLParser(signature)
+	if err != nil {
+		return nil, err
 	}
+	columns, err := p.columns(true)
+	if err != nil {
+		return nil, err
+	}
+	if !p.done() {
+		return nil, fmt.Errorf("unexpected input after the arguments at offset %d", p.peek().pos)
+	}
+	return columns, nil
 }
 
-func (e *ViewSelectStatementExtractor) consumeClusterBy() {
-	if e.input[e.pos] != '(' {
-		return
+// ParseDDL parses the CREATE statement of a view, materialized view, dynamic
+// table, function or procedure, as returned by GET_DDL or in the text column
+// of SHOW VIEWS and SHOW DYNAMIC TABLES.
+// Text that is not a CREATE statement is returned as the body.
+func ParseDDL(input string) (*DDL, error) {
+	log.Printf("[DEBUG] parsing ddl %s", input)
+	p, err := newDDLParser(input)
+	if err != nil {
+		return nil, err
+	}
+
+	// materialized views are listed with the warehouse they were created
+	// with, e.g. "use warehouse wh; create materialized view ..."
+	if p.acceptWords("USE") {
+		for !p.done() && !p.peek().isWord("CREATE") {
+			p.i++
+		}
 	}
-	found := 1
+	// a bare query is its own body
+	if !p.acceptWords("CREATE") {
+		return &DDL{Body: strings.TrimSpace(input)}, nil
+	}
+	p.acceptWords("OR", "REPLACE")
+
+	ddl := &DDL{}
 	for {
-		if e.pos+found > len(e.input)-1 || e.input[e.pos+found-1] == ')' {
+		switch {
+		case p.acceptWords("SECURE"):
+			ddl.Secure = true
+			continue
+		case p.acceptWords("RECURSIVE"), p.acceptWords("TEMPORARY"), p.acceptWords("TEMP"),
+			p.acceptWords("VOLATILE"), p.acceptWords("LOCAL"), p.acceptWords("GLOBAL"):
+			continue
+		}
+		break
+	}
+	for _, kind := range ddlKinds {
+		if p.acceptWords(kind...) {
+			ddl.Kind = strings.Join(kind, " ")
 			break
 		}
-		found += 1
 	}
-	e.pos += found
+	if ddl.Kind == "" {
+		return nil, fmt.Errorf("unsupported statement at offset %d, expected a view, materialized view, dynamic table, function or procedure", p.peek().pos)
+	}
+	p.acceptWords("IF", "NOT", "EXISTS")
+
+	if ddl.Name, err = p.identifier(); err != nil {
+		return nil, err
+	}
+	routine := ddl.Kind == "FUNCTION" || ddl.Kind == "PROCEDURE"
+	if p.peek().isPunct("(") {
+		if ddl.Columns, err = p.columns(routine); err != nil {
+			return nil, err
+		}
+	}
+
+	for !p.done() {
+		t := p.peek()
+		switch {
+		case p.acceptWords("COPY", "GRANTS"):
+			ddl.CopyGrants = true
+		case p.acceptWords("COMMENT"):
+			if p.peek().isPunct("=") {
+				p.i++
+			}
+			if s := p.peek(); s.kind == tokenString {
+				ddl.Comment = unquoteString(s.text)
+				p.i++
+			}
+		case p.acceptWords("CLUSTER", "BY"):
+			items, err := p.group()
+			if err != nil {
+				return nil, err
+			}
+			for _, item := range items {
+				ddl.ClusterBy = append(ddl.ClusterBy, p.text(item))
+			}
+		case p.acceptWords("EXECUTE", "AS"):
+			// EXECUTE AS CALLER or OWNER of procedures
+		case t.isPunct("("):
+			if _, err := p.group(); err != nil {
+				return nil, err
+			}
+		case p.acceptWords("AS"):
+			body := p.peek()
+			if routine && (body.kind == tokenString || body.kind == tokenDollar) {
+				if body.kind == tokenString {
+					ddl.Body = unquoteString(body.text)
+				} else {
+					ddl.Body = body.text[2 : len(body.text)-2]
+				}
+				return ddl, nil
+			}
+			ddl.Body = strings.TrimLeftFunc(string(p.input[t.end:]), unicode.IsSpace)
+			return ddl, nil
+		default:
+			p.i++
+		}
+	}
+	return nil, fmt.Errorf("unable to find the body of %v %v", strings.ToLower(ddl.Kind), ddl.Name)
 }
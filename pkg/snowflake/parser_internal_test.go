@@ -1,3 +1,33 @@
 package snowflake
 
-// Internal tests for ViewSelectStatementExtractor
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLex(t *testing.T) {
+	r := require.New(t)
+
+	tokens, err := lex([]rune(`select "a b".$1, 'it\'s', $$x$$ -- c
+/* a /* b */ c */(1)`))
+	r.NoError(err)
+
+	kinds := []tokenKind{}
+	texts := []string{}
+	for _, t := range tokens {
+		if t.kind != tokenSpace {
+			kinds = append(kinds, t.kind)
+			texts = append(texts, t.text)
+		}
+	}
+	r.Equal([]tokenKind{tokenWord, tokenQuoted, tokenPunct, tokenWord, tokenPunct, tokenString, tokenPunct, tokenDollar, tokenComment, tokenComment, tokenPunct, tokenWord, tokenPunct}, kinds)
+	r.Equal([]string{"select", `"a b"`, ".", "$1", ",", `'it\'s'`, ",", "$$x$$", "-- c", "/* a /* b */ c */", "(", "1", ")"}, texts)
+}
+
+func TestUnquoteString(t *testing.T) {
+	r := require.New(t)
+	r.Equal("it's", unquoteString(`'it\'s'`))
+	r.Equal("it's", unquoteString(`'it''s'`))
+	r.Equal("a\nb\\", unquoteString(`'a\nb\\'`))
+}
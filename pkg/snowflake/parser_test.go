@@ -1,11 +1,12 @@
 package snowflake
 
 import (
-	"fmt"
 	"testing"
+
+	"github.com/stretchr/testify/require"
 )
 
-func TestViewSelectStatementExtractor_Extract(t *testing.T) {
+func TestParseDDL_View(t *testing.T) {
 	basic := "create view foo as select * from bar;"
 	caps := "CREATE VIEW FOO AS SELECT * FROM BAR;"
 	parens := "create view foo as (select * from bar);"
@@ -56,20 +57,19 @@ from bar;`
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			e := NewViewSelectStatementExtractor(tt.args.input)
-			got, err := e.Extract()
+			got, err := ParseDDL(tt.args.input)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("ViewSelectStatementExtractor.Extract() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("ParseDDL() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if got != tt.want {
-				t.Errorf("ViewSelectStatementExtractor.Extract() = '%v', want '%v'", got, tt.want)
+			if got.Body != tt.want {
+				t.Errorf("ParseDDL().Body = '%v', want '%v'", got.Body, tt.want)
 			}
 		})
 	}
 }
 
-func TestViewSelectStatementExtractor_ExtractMaterializedView(t *testing.T) {
+func TestParseDDL_MaterializedView(t *testing.T) {
 	basic := "create materialized view foo as select * from bar;"
 	caps := "CREATE MATERIALIZED VIEW FOO AS SELECT * FROM BAR;"
 	parens := "create materialized view foo as (select * from bar);"
@@ -93,6 +93,8 @@ from bar;`
 	clusterBy := "create materialized view foo cluster by (c1, c2) as select * from bar;"
 	identifier := `create materialized view "foo"."bar"."bam" comment='asdf\'s are fun' as select * from bar;`
 
+	warehouse := "use warehouse wh; create materialized view foo as select * from bar;"
+
 	full := `CREATE SECURE MATERIALIZED VIEW "rgdxfmnfhh"."PUBLIC"."rgdxfmnfhh" COMMENT = 'Terraform test resource' CLUSTER BY (C1, C2) AS SELECT ROLE_NAME, ROLE_OWNER FROM INFORMATION_SCHEMA.APPLICABLE_ROLES`
 
 	type args struct {
@@ -116,139 +118,109 @@ from bar;`
 		{"commentEscape", args{commentEscape}, "select * from bar;", false},
 		{"clusterBy", args{clusterBy}, "select * from bar;", false},
 		{"identifier", args{identifier}, "select * from bar;", false},
+		{"warehouse", args{warehouse}, "select * from bar;", false},
 		{"full", args{full}, "SELECT ROLE_NAME, ROLE_OWNER FROM INFORMATION_SCHEMA.APPLICABLE_ROLES", false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			e := NewViewSelectStatementExtractor(tt.args.input)
-			got, err := e.ExtractMaterializedView()
+			got, err := ParseDDL(tt.args.input)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("ViewSelectStatementExtractor.ExtractMaterializedView() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("ParseDDL() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if got != tt.want {
-				t.Errorf("ViewSelectStatementExtractor.ExtractMaterializedView() = '%v', want '%v'", got, tt.want)
+			if got.Body != tt.want {
+				t.Errorf("ParseDDL().Body = '%v', want '%v'", got.Body, tt.want)
 			}
 		})
 	}
 }
 
-func TestViewSelectStatementExtractor_consumeToken(t *testing.T) {
-	type fields struct {
-		input []rune
-		pos   int
-	}
-	type args struct {
-		t string
-	}
-	tests := []struct {
-		name     string
-		fields   fields
-		args     args
-		posAfter int
-	}{
-		{"basic - found", fields{[]rune("foo"), 0}, args{"foo"}, 3},
-		{"basic - not found", fields{[]rune("foo"), 0}, args{"bar"}, 0},
-		{"basic - not found", fields{[]rune("fob"), 0}, args{"foo"}, 0},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			e := &ViewSelectStatementExtractor{
-				input: tt.fields.input,
-				pos:   tt.fields.pos,
-			}
-			e.consumeToken(tt.args.t)
-
-			if e.pos != tt.posAfter {
-				t.Errorf("pos after = %v, want %v", e.pos, tt.posAfter)
-			}
-		})
-	}
+func TestParseDDL(t *testing.T) {
+	r := require.New(t)
+
+	ddl, err := ParseDDL(`create or replace secure view "my db"."PUBLIC"."my ""view""" (
+  id comment 'the id',
+  "Name" comment 'it''s the name'
+) copy grants comment = 'a view' as select id, name from t`)
+	r.NoError(err)
+	r.Equal("VIEW", ddl.Kind)
+	r.Equal(`"my db"."PUBLIC"."my ""view"""`, ddl.Name)
+	r.True(ddl.Secure)
+	r.True(ddl.CopyGrants)
+	r.Equal("a view", ddl.Comment)
+	r.Equal([]DDLColumn{{Name: "id", Comment: "the id"}, {Name: "Name", Comment: "it's the name"}}, ddl.Columns)
+	r.Equal("select id, name from t", ddl.Body)
+
+	ddl, err = ParseDDL(`create materialized view mv cluster by (date_trunc('day', ts), c2) /* as /* nested */ */ as select ts, c2 from t`)
+	r.NoError(err)
+	r.Equal("MATERIALIZED VIEW", ddl.Kind)
+	r.False(ddl.CopyGrants)
+	r.Equal([]string{"date_trunc('day', ts)", "c2"}, ddl.ClusterBy)
+	r.Equal("select ts, c2 from t", ddl.Body)
+
+	ddl, err = ParseDDL(`create or replace dynamic table "DB"."PUBLIC"."DT"(ID, NAME) lag = '1 minute' refresh_mode = AUTO initialize = ON_CREATE warehouse = WH
+ cluster by (id) comment = 'a dynamic table'
+ as select id, name from t`)
+	r.NoError(err)
+	r.Equal("DYNAMIC TABLE", ddl.Kind)
+	r.Equal([]string{"id"}, ddl.ClusterBy)
+	r.Equal("a dynamic table", ddl.Comment)
+	r.Equal("select id, name from t", ddl.Body)
+
+	ddl, err = ParseDDL(`CREATE OR REPLACE FUNCTION "DB"."PUBLIC"."ADD"("X" NUMBER(38,0), "Y" NUMBER(38,0))
+RETURNS NUMBER(38,0)
+LANGUAGE JAVASCRIPT
+COMMENT='adds'
+AS $$ return X + Y; // as $$`)
+	r.NoError(err)
+	r.Equal("FUNCTION", ddl.Kind)
+	r.Equal([]DDLColumn{{Name: "X", Type: "NUMBER(38,0)"}, {Name: "Y", Type: "NUMBER(38,0)"}}, ddl.Columns)
+	r.Equal("adds", ddl.Comment)
+	r.Equal(" return X + Y; // as ", ddl.Body)
+
+	ddl, err = ParseDDL(`CREATE OR REPLACE PROCEDURE "DB"."PUBLIC"."P"()
+RETURNS VARCHAR(16777216)
+LANGUAGE SQL
+EXECUTE AS OWNER
+AS 'begin return ''done''; end'`)
+	r.NoError(err)
+	r.Equal("PROCEDURE", ddl.Kind)
+	r.Empty(ddl.Columns)
+	r.Equal("begin return 'done'; end", ddl.Body)
+
+	ddl, err = ParseDDL("  select 1\n")
+	r.NoError(err)
+	r.Empty(ddl.Kind)
+	r.Equal("select 1", ddl.Body)
 }
 
-func TestViewSelectStatementExtractor_consumeSpace(t *testing.T) {
-	type fields struct {
-		input []rune
-		pos   int
-	}
-	tests := []struct {
-		name     string
-		fields   fields
-		posAfter int
-	}{
-		{"simple", fields{[]rune("   foo"), 0}, 3},
-		{"empty", fields{[]rune(""), 0}, 0},
-		{"middle", fields{[]rune("foo \t\n bar"), 3}, 7},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			fmt.Println(tt.name)
-			e := &ViewSelectStatementExtractor{
-				input: tt.fields.input,
-				pos:   tt.fields.pos,
-			}
-			e.consumeSpace()
-
-			if e.pos != tt.posAfter {
-				t.Errorf("pos after = %v, want %v", e.pos, tt.posAfter)
-			}
+func TestParseDDLErrors(t *testing.T) {
+	for name, input := range map[string]string{
+		"unsupported": "create table foo (c int)",
+		"no body":     "create view foo comment = 'x'",
+		"string":      "create view foo comment = 'x as select 1",
+		"comment":     "create view foo /* /* */ as select 1",
+		"dollar":      "create function f() returns int as $$ 1",
+		"parenthesis": "create view foo (a, b as select 1",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseDDL(input)
+			require.Error(t, err)
 		})
 	}
 }
 
-func TestViewSelectStatementExtractor_consumeComment(t *testing.T) {
-	type fields struct {
-		input []rune
-		pos   int
-	}
-	tests := []struct {
-		name     string
-		fields   fields
-		posAfter int
-	}{
-		{"basic", fields{[]rune("comment='foo'"), 0}, 13},
-		{"escaped", fields{[]rune(`comment='fo\'o'`), 0}, 15},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			e := &ViewSelectStatementExtractor{
-				input: tt.fields.input,
-				pos:   tt.fields.pos,
-			}
-			e.consumeComment()
+func TestParseSignature(t *testing.T) {
+	r := require.New(t)
 
-			if e.pos != tt.posAfter {
-				t.Errorf("pos after = %v, want %v", e.pos, tt.posAfter)
-			}
-		})
-	}
-}
+	args, err := ParseSignature(`(X NUMBER, "my arg" VARCHAR, TS TIMESTAMP_NTZ(9))`)
+	r.NoError(err)
+	r.Equal([]DDLColumn{{Name: "X", Type: "NUMBER"}, {Name: "my arg", Type: "VARCHAR"}, {Name: "TS", Type: "TIMESTAMP_NTZ(9)"}}, args)
 
-func TestViewSelectStatementExtractor_consumeClusterBy(t *testing.T) {
-	type fields struct {
-		input []rune
-		pos   int
-	}
-	tests := []struct {
-		name     string
-		fields   fields
-		posAfter int
-	}{
-		{"none", fields{[]rune("as foo"), 0}, 0},
-		{"single", fields{[]rune("(c1)"), 0}, 4},
-		{"double", fields{[]rune("(c1, c2)"), 0}, 8},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			e := &ViewSelectStatementExtractor{
-				input: tt.fields.input,
-				pos:   tt.fields.pos,
-			}
-			e.consumeClusterBy()
+	args, err = ParseSignature(`()`)
+	r.NoError(err)
+	r.Empty(args)
 
-			if e.pos != tt.posAfter {
-				t.Errorf("pos after = %v, want %v", e.pos, tt.posAfter)
-			}
-		})
-	}
+	_, err = ParseSignature(`(X NUMBER`)
+	r.Error(err)
 }
@@ -1,6 +1,7 @@
 package snowflake
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
@@ -28,18 +29,18 @@ func (pb *PipeBuilder) QualifiedName() string {
 	var n strings.Builder
 
 	if pb.db != "" && pb.schema != "" {
-		n.WriteString(fmt.Sprintf(`"%v"."%v".`, pb.db, pb.schema))
+		n.WriteString(fmt.Sprintf(`%v.`, QuoteIdentifier(pb.db, pb.schema)))
 	}
 
 	if pb.db != "" && pb.schema == "" {
-		n.WriteString(fmt.Sprintf(`"%v"..`, pb.db))
+		n.WriteString(fmt.Sprintf(`%v..`, QuoteIdentifier(pb.db)))
 	}
 
 	if pb.db == "" && pb.schema != "" {
-		n.WriteString(fmt.Sprintf(`"%v".`, pb.schema))
+		n.WriteString(fmt.Sprintf(`%v.`, QuoteIdentifier(pb.schema)))
 	}
 
-	n.WriteString(fmt.Sprintf(`"%v"`, pb.name))
+	n.WriteString(QuoteIdentifier(pb.name))
 
 	return n.String()
 }
@@ -157,7 +158,7 @@ func (pb *PipeBuilder) Drop() string {
 
 // Show returns the SQL query that will show a pipe.
 func (pb *PipeBuilder) Show() string {
-	return fmt.Sprintf(`SHOW PIPES LIKE '%v' IN SCHEMA "%v"."%v"`, pb.name, pb.db, pb.schema)
+	return fmt.Sprintf(`SHOW PIPES LIKE '%v' IN SCHEMA %v`, EscapeString(pb.name), QuoteIdentifier(pb.db, pb.schema))
 }
 
 type pipe struct {
@@ -179,9 +180,9 @@ func ScanPipe(row *sqlx.Row) (*pipe, error) {
 	return p, e
 }
 
-func ListPipes(databaseName string, schemaName string, db *sql.DB) ([]pipe, error) {
-	stmt := fmt.Sprintf(`SHOW PIPES IN SCHEMA "%s"."%v"`, databaseName, schemaName)
-	rows, err := Query(db, stmt)
+func ListPipes(ctx context.Context, databaseName string, schemaName string, db *sql.DB) ([]pipe, error) {
+	stmt := fmt.Sprintf(`SHOW PIPES IN SCHEMA %v`, QuoteIdentifier(databaseName, schemaName))
+	rows, err := QueryContext(ctx, db, stmt)
 	if err != nil {
 		return nil, err
 	}
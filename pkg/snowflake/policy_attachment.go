@@ -0,0 +1,150 @@
+package snowflake
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+const (
+	// MaskingPolicyKind is the POLICY_KIND of masking policies in POLICY_REFERENCES
+	MaskingPolicyKind = "MASKING_POLICY"
+	// RowAccessPolicyKind is the POLICY_KIND of row access policies in POLICY_REFERENCES
+	RowAccessPolicyKind = "ROW_ACCESS_POLICY"
+)
+
+// PolicyAttachmentBuilder abstracts the creation of SQL queries applying
+// masking and row access policies to a table or view
+type PolicyAttachmentBuilder struct {
+	objectType string
+	name       string
+	db         string
+	schema     string
+}
+
+// PolicyAttachment returns a pointer to a Builder that abstracts the DDL
+// operations attaching policies to a table or a view, objectType being TABLE
+// or VIEW.
+//
+// Supported DDL operations are:
+//   - ALTER TABLE|VIEW ... MODIFY COLUMN ... SET|UNSET MASKING POLICY
+//   - ALTER TABLE|VIEW ... ADD|DROP ROW ACCESS POLICY
+//   - POLICY_REFERENCES
+//
+// [Snowflake Reference](https://docs.snowflake.com/en/sql-reference/functions/policy_references.html)
+func PolicyAttachment(objectType, name, db, schema string) *PolicyAttachmentBuilder {
+	return &PolicyAttachmentBuilder{
+		objectType: strings.ToUpper(objectType),
+		name:       name,
+		db:         db,
+		schema:     schema,
+	}
+}
+
+// QualifiedName prepends the db and schema and escapes everything nicely
+func (pab *PolicyAttachmentBuilder) QualifiedName() string {
+	return QuoteIdentifier(pab.db, pab.schema, pab.name)
+}
+
+// SetMaskingPolicy returns the SQL query that will set the masking policy on a
+// column. The policy is a qualified name as written in SQL. using lists the
+// column itself followed by the columns of a conditional policy, and force
+// replaces a masking policy already set on the column.
+func (pab *PolicyAttachmentBuilder) SetMaskingPolicy(column, policy string, using []string, force bool) string {
+	var q strings.Builder
+	q.WriteString(fmt.Sprintf(`ALTER %v %v MODIFY COLUMN %v SET MASKING POLICY %v`, pab.objectType, pab.QualifiedName(), QuoteIdentifier(column), policy))
+	if len(using) > 0 {
+		q.WriteString(fmt.Sprintf(` USING (%v)`, strings.Join(quoteStringList(using), ", ")))
+	}
+	if force {
+		q.WriteString(` FORCE`)
+	}
+	return q.String()
+}
+
+// UnsetMaskingPolicy returns the SQL query that will unset the masking policy
+// of a column.
+func (pab *PolicyAttachmentBuilder) UnsetMaskingPolicy(column string) string {
+	return fmt.Sprintf(`ALTER %v %v MODIFY COLUMN %v UNSET MASKING POLICY`, pab.objectType, pab.QualifiedName(), QuoteIdentifier(column))
+}
+
+// AddRowAccessPolicy returns the SQL query that will add the row access policy
+// on the columns given.
+func (pab *PolicyAttachmentBuilder) AddRowAccessPolicy(policy string, columns []string) string {
+	return fmt.Sprintf(`ALTER %v %v ADD ROW ACCESS POLICY %v ON (%v)`, pab.objectType, pab.QualifiedName(), policy, strings.Join(quoteStringList(columns), ", "))
+}
+
+// DropRowAccessPolicy returns the SQL query that will drop the row access
+// policy.
+func (pab *PolicyAttachmentBuilder) DropRowAccessPolicy(policy string) string {
+	return fmt.Sprintf(`ALTER %v %v DROP ROW ACCESS POLICY %v`, pab.objectType, pab.QualifiedName(), policy)
+}
+
+// ReplaceRowAccessPolicy returns the SQL query that will drop the row access
+// policy old and add the policy new in a single statement, so that the rows
+// are never left unprotected.
+func (pab *PolicyAttachmentBuilder) ReplaceRowAccessPolicy(old, new string, columns []string) string {
+	return fmt.Sprintf(`ALTER %v %v DROP ROW ACCESS POLICY %v, ADD ROW ACCESS POLICY %v ON (%v)`, pab.objectType, pab.QualifiedName(), old, new, strings.Join(quoteStringList(columns), ", "))
+}
+
+// ShowPolicyReferences returns the SQL query listing the policies set on the
+// object. Views are in the table domain as well.
+func (pab *PolicyAttachmentBuilder) ShowPolicyReferences() string {
+	return fmt.Sprintf(`SELECT * FROM TABLE(%v.INFORMATION_SCHEMA.POLICY_REFERENCES(REF_ENTITY_NAME => '%v', REF_ENTITY_DOMAIN => 'table'))`, QuoteIdentifier(pab.db), EscapeString(pab.QualifiedName()))
+}
+
+// PolicyReference is a row of POLICY_REFERENCES
+type PolicyReference struct {
+	PolicyDB          sql.NullString `db:"POLICY_DB"`
+	PolicySchema      sql.NullString `db:"POLICY_SCHEMA"`
+	PolicyName        sql.NullString `db:"POLICY_NAME"`
+	PolicyKind        sql.NullString `db:"POLICY_KIND"`
+	RefColumnName     sql.NullString `db:"REF_COLUMN_NAME"`
+	RefArgColumnNames sql.NullString `db:"REF_ARG_COLUMN_NAMES"`
+	RefEntityName     sql.NullString `db:"REF_ENTITY_NAME"`
+	RefEntityDomain   sql.NullString `db:"REF_ENTITY_DOMAIN"`
+}
+
+// Policy returns the qualified name of the policy, quoted only where required.
+func (pr *PolicyReference) Policy() string {
+	return AddressEscape(pr.PolicyDB.String, pr.PolicySchema.String, pr.PolicyName.String)
+}
+
+// IsPolicy reports whether policy, a qualified name as written in SQL, names
+// the referenced policy.
+func (pr *PolicyReference) IsPolicy(policy string) bool {
+	return IsQualifiedName(policy, pr.PolicyDB.String, pr.PolicySchema.String, pr.PolicyName.String)
+}
+
+// ArgColumns returns the columns passed to the policy besides the masked
+// column for masking policies, or the columns the row access policy is on.
+func (pr *PolicyReference) ArgColumns() ([]string, error) {
+	columns := []string{}
+	if !pr.RefArgColumnNames.Valid || pr.RefArgColumnNames.String == "" {
+		return columns, nil
+	}
+	err := json.Unmarshal([]byte(pr.RefArgColumnNames.String), &columns)
+	return columns, errors.Wrapf(err, "unable to parse argument columns %v of policy %v", pr.RefArgColumnNames.String, pr.Policy())
+}
+
+// ListPolicyReferences runs the POLICY_REFERENCES query stmt and returns the
+// policies set on the object
+func ListPolicyReferences(ctx context.Context, stmt string, db *sql.DB) ([]PolicyReference, error) {
+	rows, err := QueryContext(ctx, db, stmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	refs := []PolicyReference{}
+	err = sqlx.StructScan(rows, &refs)
+	if err == sql.ErrNoRows {
+		return refs, nil
+	}
+	return refs, errors.Wrapf(err, "unable to scan row for %s", stmt)
+}
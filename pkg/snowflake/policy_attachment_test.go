@@ -0,0 +1,60 @@
+package snowflake_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyAttachmentMaskingPolicy(t *testing.T) {
+	r := require.New(t)
+	b := snowflake.PolicyAttachment("table", "test_table", "test_db", "test_schema")
+
+	r.Equal(`ALTER TABLE "test_db"."test_schema"."test_table" MODIFY COLUMN "email" SET MASKING POLICY DB.SCHEMA.POLICY`, b.SetMaskingPolicy("email", "DB.SCHEMA.POLICY", nil, false))
+	r.Equal(`ALTER TABLE "test_db"."test_schema"."test_table" MODIFY COLUMN "email" SET MASKING POLICY DB.SCHEMA.POLICY USING ("email", "visibility") FORCE`, b.SetMaskingPolicy("email", "DB.SCHEMA.POLICY", []string{"email", "visibility"}, true))
+	r.Equal(`ALTER TABLE "test_db"."test_schema"."test_table" MODIFY COLUMN "email" UNSET MASKING POLICY`, b.UnsetMaskingPolicy("email"))
+}
+
+func TestPolicyAttachmentRowAccessPolicy(t *testing.T) {
+	r := require.New(t)
+	b := snowflake.PolicyAttachment("VIEW", "test_view", "test_db", "test_schema")
+
+	r.Equal(`ALTER VIEW "test_db"."test_schema"."test_view" ADD ROW ACCESS POLICY DB.SCHEMA.POLICY ON ("region", "id")`, b.AddRowAccessPolicy("DB.SCHEMA.POLICY", []string{"region", "id"}))
+	r.Equal(`ALTER VIEW "test_db"."test_schema"."test_view" DROP ROW ACCESS POLICY DB.SCHEMA.POLICY`, b.DropRowAccessPolicy("DB.SCHEMA.POLICY"))
+	r.Equal(`ALTER VIEW "test_db"."test_schema"."test_view" DROP ROW ACCESS POLICY DB.SCHEMA.OLD, ADD ROW ACCESS POLICY DB.SCHEMA.NEW ON ("region")`, b.ReplaceRowAccessPolicy("DB.SCHEMA.OLD", "DB.SCHEMA.NEW", []string{"region"}))
+}
+
+func TestPolicyAttachmentShowPolicyReferences(t *testing.T) {
+	r := require.New(t)
+	b := snowflake.PolicyAttachment("TABLE", "it's", "test_db", "test_schema")
+
+	r.Equal(`SELECT * FROM TABLE("test_db".INFORMATION_SCHEMA.POLICY_REFERENCES(REF_ENTITY_NAME => '"test_db"."test_schema"."it\'s"', REF_ENTITY_DOMAIN => 'table'))`, b.ShowPolicyReferences())
+}
+
+func TestPolicyReference(t *testing.T) {
+	r := require.New(t)
+	ref := snowflake.PolicyReference{
+		PolicyDB:          sql.NullString{String: "DB", Valid: true},
+		PolicySchema:      sql.NullString{String: "my schema", Valid: true},
+		PolicyName:        sql.NullString{String: "POLICY", Valid: true},
+		RefArgColumnNames: sql.NullString{String: `[ "VISIBILITY", "region" ]`, Valid: true},
+	}
+
+	r.Equal(`DB."my schema".POLICY`, ref.Policy())
+	r.True(ref.IsPolicy(`db."my schema".policy`))
+	r.True(ref.IsPolicy(`"DB"."my schema"."POLICY"`))
+	r.False(ref.IsPolicy(`DB.MY_SCHEMA.POLICY`))
+	r.False(ref.IsPolicy(`"db"."my schema"."POLICY"`))
+	r.False(ref.IsPolicy(`POLICY`))
+
+	args, err := ref.ArgColumns()
+	r.NoError(err)
+	r.Equal([]string{"VISIBILITY", "region"}, args)
+
+	ref.RefArgColumnNames = sql.NullString{}
+	args, err = ref.ArgColumns()
+	r.NoError(err)
+	r.Empty(args)
+}
@@ -1,6 +1,7 @@
 package snowflake
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -13,17 +14,25 @@ import (
 
 // ProcedureBuilder abstracts the creation of Stored Procedure
 type ProcedureBuilder struct {
-	name              string
-	schema            string
-	db                string
-	argumentTypes     []string // (VARCHAR, VARCHAR)
-	args              []map[string]string
-	returnBehavior    string // VOLATILE, IMMUTABLE
-	nullInputBehavior string // "CALLED ON NULL INPUT" or "RETURNS NULL ON NULL INPUT"
-	returnType        string
-	executeAs         string
-	comment           string
-	statement         string
+	name                       string
+	schema                     string
+	db                         string
+	argumentTypes              []string // (VARCHAR, VARCHAR)
+	args                       []map[string]string
+	returnBehavior             string // VOLATILE, IMMUTABLE
+	nullInputBehavior          string // "CALLED ON NULL INPUT" or "RETURNS NULL ON NULL INPUT"
+	returnType                 string
+	executeAs                  string
+	language                   string
+	runtimeVersion             string   // for Python, Java and Scala runtimes
+	packages                   []string // for Python, Java and Scala packages
+	imports                    []string // for Python, Java and Scala imports
+	handler                    string   // for Python, Java and Scala handler
+	targetPath                 string   // for Java and Scala target path for compiled jar file
+	externalAccessIntegrations []string
+	secrets                    map[string]string // secret by variable name
+	comment                    string
+	statement                  string
 }
 
 // QualifiedName prepends the db and schema and appends argument types
@@ -31,7 +40,7 @@ func (pb *ProcedureBuilder) QualifiedName() (string, error) {
 	if pb.db == "" || pb.schema == "" || pb.name == "" {
 		return "", errors.New("Procedures must specify a database a schema and a name")
 	}
-	return fmt.Sprintf(`"%v"."%v"."%v"(%v)`, pb.db, pb.schema, pb.name, strings.Join(pb.argumentTypes, ", ")), nil
+	return fmt.Sprintf(`%v(%v)`, QuoteIdentifier(pb.db, pb.schema, pb.name), strings.Join(pb.argumentTypes, ", ")), nil
 }
 
 // QualifiedNameWithoutArguments prepends the db and schema if set
@@ -39,7 +48,7 @@ func (pb *ProcedureBuilder) QualifiedNameWithoutArguments() (string, error) {
 	if pb.db == "" || pb.schema == "" || pb.name == "" {
 		return "", errors.New("Procedures must specify a database a schema and a name")
 	}
-	return fmt.Sprintf(`"%v"."%v"."%v"`, pb.db, pb.schema, pb.name), nil
+	return QuoteIdentifier(pb.db, pb.schema, pb.name), nil
 }
 
 // Returns the arguments signature of the procedure in a form <PROCEDURE>(<TYPE>, <TYPE>, ..)
@@ -83,6 +92,54 @@ func (pb *ProcedureBuilder) WithExecuteAs(s string) *ProcedureBuilder {
 	return pb
 }
 
+// WithLanguage sets the language to JAVASCRIPT, SQL, PYTHON, JAVA or SCALA
+func (pb *ProcedureBuilder) WithLanguage(s string) *ProcedureBuilder {
+	pb.language = s
+	return pb
+}
+
+// WithRuntimeVersion sets the runtime version for Python, Java or Scala procedure
+func (pb *ProcedureBuilder) WithRuntimeVersion(s string) *ProcedureBuilder {
+	pb.runtimeVersion = s
+	return pb
+}
+
+// WithPackages adds packages, e.g. snowflake-snowpark-python, for Python, Java or Scala procedure
+func (pb *ProcedureBuilder) WithPackages(s []string) *ProcedureBuilder {
+	pb.packages = s
+	return pb
+}
+
+// WithImports adds files to import for Python, Java or Scala procedure
+func (pb *ProcedureBuilder) WithImports(s []string) *ProcedureBuilder {
+	pb.imports = s
+	return pb
+}
+
+// WithHandler sets the handler function or method for Python, Java or Scala procedure
+func (pb *ProcedureBuilder) WithHandler(s string) *ProcedureBuilder {
+	pb.handler = s
+	return pb
+}
+
+// WithTargetPath sets the target path for compiled jar file for Java or Scala procedure
+func (pb *ProcedureBuilder) WithTargetPath(s string) *ProcedureBuilder {
+	pb.targetPath = s
+	return pb
+}
+
+// WithExternalAccessIntegrations sets the integrations the procedure reaches external networks through
+func (pb *ProcedureBuilder) WithExternalAccessIntegrations(s []string) *ProcedureBuilder {
+	pb.externalAccessIntegrations = s
+	return pb
+}
+
+// WithSecrets sets the qualified names of the secrets the procedure reads, by variable name
+func (pb *ProcedureBuilder) WithSecrets(s map[string]string) *ProcedureBuilder {
+	pb.secrets = s
+	return pb
+}
+
 // WithComment adds a comment to the ProcedureBuilder
 func (pb *ProcedureBuilder) WithComment(c string) *ProcedureBuilder {
 	pb.comment = c
@@ -141,7 +198,11 @@ func (pb *ProcedureBuilder) Create() (string, error) {
 	q.WriteString(`)`)
 
 	q.WriteString(fmt.Sprintf(" RETURNS %v", pb.returnType))
-	q.WriteString(" LANGUAGE javascript")
+	language := pb.language
+	if language == "" {
+		language = "javascript"
+	}
+	q.WriteString(fmt.Sprintf(" LANGUAGE %v", language))
 	if pb.nullInputBehavior != "" {
 		q.WriteString(fmt.Sprintf(` %v`, EscapeString(pb.nullInputBehavior)))
 	}
@@ -151,11 +212,50 @@ func (pb *ProcedureBuilder) Create() (string, error) {
 	if pb.comment != "" {
 		q.WriteString(fmt.Sprintf(" COMMENT = '%v'", EscapeString(pb.comment)))
 	}
+	if pb.runtimeVersion != "" {
+		q.WriteString(fmt.Sprintf(" RUNTIME_VERSION = '%v'", EscapeString(pb.runtimeVersion)))
+	}
+	if len(pb.packages) > 0 {
+		q.WriteString(fmt.Sprintf(" PACKAGES = %v", formatStringList(pb.packages)))
+	}
+	if len(pb.imports) > 0 {
+		q.WriteString(fmt.Sprintf(" IMPORTS = %v", formatStringList(pb.imports)))
+	}
+	if pb.handler != "" {
+		q.WriteString(fmt.Sprintf(" HANDLER = '%v'", EscapeString(pb.handler)))
+	}
+	if pb.targetPath != "" {
+		q.WriteString(fmt.Sprintf(" TARGET_PATH = '%v'", EscapeString(pb.targetPath)))
+	}
+	q.WriteString(externalAccessClauses(pb.externalAccessIntegrations, pb.secrets))
 	q.WriteString(fmt.Sprintf(" EXECUTE AS %v", pb.executeAs))
-	q.WriteString(fmt.Sprintf(" AS $$%v$$", pb.statement))
+	q.WriteString(fmt.Sprintf(" AS %v", procedureBody(pb.statement)))
 	return q.String(), nil
 }
 
+// TrimBodyDelimiters removes the $$ delimiters a body written as a dollar-quoted
+// string is wrapped in, Snowflake describes the body without them.
+func TrimBodyDelimiters(body string) string {
+	trimmed := strings.TrimSpace(body)
+	if len(trimmed) >= 4 && strings.HasPrefix(trimmed, "$$") && strings.HasSuffix(trimmed, "$$") {
+		inner := trimmed[2 : len(trimmed)-2]
+		if !strings.Contains(inner, "$$") {
+			return inner
+		}
+	}
+	return body
+}
+
+// procedureBody writes the body of the procedure as a dollar-quoted string,
+// or as a string literal when the body itself contains $$
+func procedureBody(body string) string {
+	body = TrimBodyDelimiters(body)
+	if strings.Contains(body, "$$") {
+		return fmt.Sprintf(`'%v'`, EscapeString(body))
+	}
+	return fmt.Sprintf(`$$%v$$`, body)
+}
+
 // Rename returns the SQL query that will rename the procedure.
 func (pb *ProcedureBuilder) Rename(newName string) (string, error) {
 	oldName, err := pb.QualifiedName()
@@ -202,7 +302,7 @@ func (vb *ProcedureBuilder) ChangeExecuteAs(c string) (string, error) {
 // Show returns the SQL query that will show the row representing this procedure.
 // This show statement returns all procedures with the given name (overloaded ones)
 func (pb *ProcedureBuilder) Show() string {
-	return fmt.Sprintf(`SHOW PROCEDURES LIKE '%v' IN SCHEMA "%v"."%v"`, pb.name, pb.db, pb.schema)
+	return fmt.Sprintf(`SHOW PROCEDURES LIKE '%v' IN SCHEMA %v`, EscapeString(pb.name), QuoteIdentifier(pb.db, pb.schema))
 }
 
 // To describe the procedure the name must be specified as fully qualified name
@@ -254,6 +354,18 @@ func ScanProcedureDescription(rows *sqlx.Rows) ([]procedureDescription, error) {
 	return pdsl, rows.Err()
 }
 
+// SplitDescribedList splits a list as described by Snowflake, e.g. the
+// packages ['a', 'b'] of a procedure or the integrations [A, B] of a function.
+func SplitDescribedList(value string) []string {
+	values := []string{}
+	for _, v := range splitList(strings.Trim(value, "[]")) {
+		if v = strings.Trim(v, "'"); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
 // SHOW PROCEDURE can return more than one item because of procedure names overloading
 // https://docs.snowflake.com/en/sql-reference/sql/show-procedures.html
 func ScanProcedures(rows *sqlx.Rows) ([]*procedure, error) {
@@ -269,9 +381,9 @@ func ScanProcedures(rows *sqlx.Rows) ([]*procedure, error) {
 	return pcs, rows.Err()
 }
 
-func ListProcedures(databaseName string, schemaName string, db *sql.DB) ([]procedure, error) {
-	stmt := fmt.Sprintf(`SHOW PROCEDURES IN SCHEMA "%s"."%v"`, databaseName, schemaName)
-	rows, err := Query(db, stmt)
+func ListProcedures(ctx context.Context, databaseName string, schemaName string, db *sql.DB) ([]procedure, error) {
+	stmt := fmt.Sprintf(`SHOW PROCEDURES IN SCHEMA %v`, QuoteIdentifier(databaseName, schemaName))
+	rows, err := QueryContext(ctx, db, stmt)
 	if err != nil {
 		return nil, err
 	}
@@ -54,6 +54,76 @@ func TestProcedureCreateWithOptionalParams(t *testing.T) {
 	r.Equal(expected, createStmnt)
 }
 
+func TestProcedureCreateWithExternalAccess(t *testing.T) {
+	r := require.New(t)
+	s := getProcedure(true)
+	s.WithExternalAccessIntegrations([]string{"api_access", "other_access"})
+	s.WithSecrets(map[string]string{"cred": "db.schema.cred"})
+	createStmnt, _ := s.Create()
+	expected := `CREATE OR REPLACE PROCEDURE "test_db"."test_schema"."test_proc"` +
+		`(user VARCHAR, eventdt DATE) RETURNS VARCHAR LANGUAGE javascript` +
+		` EXTERNAL_ACCESS_INTEGRATIONS = ("api_access", "other_access") SECRETS = ('cred' = db.schema.cred)` +
+		` EXECUTE AS CALLER AS $$` +
+		`var message = "Hi"` + "\nreturn message$$"
+	r.Equal(expected, createStmnt)
+}
+
+func TestProcedureCreateWithPython(t *testing.T) {
+	r := require.New(t)
+	s := getProcedure(false)
+	s.WithLanguage("PYTHON")
+	s.WithRuntimeVersion("3.8")
+	s.WithPackages([]string{"snowflake-snowpark-python"})
+	s.WithImports([]string{"@stage/helpers.py"})
+	s.WithHandler("run")
+	s.WithStatement("def run(session):\n  return 'Hi'")
+	createStmnt, _ := s.Create()
+	expected := `CREATE OR REPLACE PROCEDURE "test_db"."test_schema"."test_proc"` +
+		`() RETURNS VARCHAR LANGUAGE PYTHON RUNTIME_VERSION = '3.8'` +
+		` PACKAGES = ('snowflake-snowpark-python') IMPORTS = ('@stage/helpers.py') HANDLER = 'run'` +
+		" EXECUTE AS CALLER AS $$def run(session):\n  return 'Hi'$$"
+	r.Equal(expected, createStmnt)
+}
+
+func TestProcedureCreateWithJava(t *testing.T) {
+	r := require.New(t)
+	s := getProcedure(false)
+	s.WithLanguage("JAVA")
+	s.WithRuntimeVersion("11")
+	s.WithPackages([]string{"com.snowflake:snowpark:latest"})
+	s.WithHandler("Greeter.run")
+	s.WithTargetPath("@stage/greeter.jar")
+	createStmnt, _ := s.Create()
+	r.Contains(createStmnt, ` LANGUAGE JAVA RUNTIME_VERSION = '11' PACKAGES = ('com.snowflake:snowpark:latest')`+
+		` HANDLER = 'Greeter.run' TARGET_PATH = '@stage/greeter.jar' EXECUTE AS CALLER AS $$`)
+}
+
+func TestProcedureCreateWithDelimitedBody(t *testing.T) {
+	r := require.New(t)
+	s := getProcedure(false)
+	s.WithLanguage("SQL")
+
+	// a body written with its own delimiters is not delimited twice
+	s.WithStatement("$$\nBEGIN\n  RETURN 'Hi';\nEND;\n$$")
+	createStmnt, _ := s.Create()
+	r.Equal(`CREATE OR REPLACE PROCEDURE "test_db"."test_schema"."test_proc"`+
+		"() RETURNS VARCHAR LANGUAGE SQL EXECUTE AS CALLER AS $$\nBEGIN\n  RETURN 'Hi';\nEND;\n$$", createStmnt)
+
+	// a body containing $$ is written as a string literal
+	s.WithStatement("BEGIN\n  RETURN 'a$$b';\nEND;")
+	createStmnt, _ = s.Create()
+	r.Equal(`CREATE OR REPLACE PROCEDURE "test_db"."test_schema"."test_proc"`+
+		"() RETURNS VARCHAR LANGUAGE SQL EXECUTE AS CALLER AS 'BEGIN\n  RETURN \\'a$$b\\';\nEND;'", createStmnt)
+}
+
+func TestTrimBodyDelimiters(t *testing.T) {
+	r := require.New(t)
+	r.Equal("\nreturn 1;\n", TrimBodyDelimiters("$$\nreturn 1;\n$$"))
+	r.Equal("return 1;", TrimBodyDelimiters("  $$return 1;$$\n"))
+	r.Equal("return 1;", TrimBodyDelimiters("return 1;"))
+	r.Equal("$$a$$ || $$b$$", TrimBodyDelimiters("$$a$$ || $$b$$"))
+}
+
 func TestProcedureDrop(t *testing.T) {
 	r := require.New(t)
 
@@ -129,3 +199,11 @@ func TestProcedureArgumentsSignature(t *testing.T) {
 	sign, _ = s.ArgumentsSignature()
 	r.Equal("TEST_PROC(VARCHAR, DATE)", sign)
 }
+
+func TestSplitDescribedList(t *testing.T) {
+	r := require.New(t)
+	r.Equal([]string{"snowflake-snowpark-python", "numpy==1.24.3"}, SplitDescribedList("['snowflake-snowpark-python', 'numpy==1.24.3']"))
+	r.Equal([]string{"INTEGRATION_A", "INTEGRATION_B"}, SplitDescribedList("[INTEGRATION_A, INTEGRATION_B]"))
+	r.Empty(SplitDescribedList("[]"))
+	r.Empty(SplitDescribedList(""))
+}
@@ -1,16 +1,23 @@
 package snowflake
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+	"strings"
+	"sync"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
 )
 
 // Replication returns a pointer to a Builder that abstracts the DDL operations for a replication.
 //
 // Supported DDL operations are:
 //   - SHOW REPLICATION DATABASES
+//   - ALTER DATABASE ... ENABLE|DISABLE REPLICATION TO ACCOUNTS
+//   - ALTER DATABASE ... ENABLE|DISABLE FAILOVER TO ACCOUNTS
+//   - ALTER DATABASE ... REFRESH
 //
 // [Snowflake Reference](https://docs.snowflake.com/en/user-guide/database-replication-config.html)
 
@@ -51,6 +58,127 @@ func ScanReplication(rows *sqlx.Rows, AccName string) (*replication, error) {
 	return nil, sql.ErrNoRows
 }
 
+// ReplicationAllowedToAccounts returns the accounts, as organization.account,
+// the database can be replicated to, the account of the database excluded.
+func (r *replication) ReplicationAllowedToAccounts() []string {
+	return r.otherAccounts(r.ReplAccounts.String)
+}
+
+// FailoverAllowedToAccounts returns the accounts, as organization.account, the
+// database can fail over to, the account of the database excluded.
+func (r *replication) FailoverAllowedToAccounts() []string {
+	return r.otherAccounts(r.FailoverAccounts.String)
+}
+
+// otherAccounts parses a list of accounts prefixed with their region group,
+// e.g. AWS_US_WEST_2.MYORG.ACCOUNT1, AWS_US_EAST_1.MYORG.ACCOUNT2
+func (r *replication) otherAccounts(list string) []string {
+	self := fmt.Sprintf("%v.%v", r.Org.String, r.AccountName.String)
+	accounts := []string{}
+	for _, account := range strings.Split(list, ",") {
+		parts := strings.Split(strings.TrimSpace(account), ".")
+		if len(parts) < 2 {
+			continue
+		}
+		account = strings.Join(parts[len(parts)-2:], ".")
+		if strings.EqualFold(account, self) {
+			continue
+		}
+		accounts = append(accounts, account)
+	}
+	return accounts
+}
+
 func (rb *ReplicationBuilder) Show() string {
-	return fmt.Sprintf(`SHOW REPLICATION DATABASES LIKE '%s'`, rb.database)
+	return fmt.Sprintf(`SHOW REPLICATION DATABASES LIKE '%s'`, EscapeString(rb.database))
+}
+
+// EnableReplication returns the SQL query that will promote the database to
+// a primary database, replicated to the accounts given as
+// organization.account.
+func (rb *ReplicationBuilder) EnableReplication(accounts []string, ignoreEditionCheck bool) string {
+	q := fmt.Sprintf(`ALTER DATABASE %v ENABLE REPLICATION TO ACCOUNTS %v`, QuoteIdentifier(rb.database), strings.Join(accounts, ", "))
+	if ignoreEditionCheck {
+		q += ` IGNORE EDITION CHECK`
+	}
+	return q
+}
+
+// DisableReplication returns the SQL query that will stop replicating the
+// database to the accounts given.
+func (rb *ReplicationBuilder) DisableReplication(accounts []string) string {
+	return fmt.Sprintf(`ALTER DATABASE %v DISABLE REPLICATION TO ACCOUNTS %v`, QuoteIdentifier(rb.database), strings.Join(accounts, ", "))
+}
+
+// EnableFailover returns the SQL query that will allow the replicas of the
+// database in the accounts given to be promoted to primary.
+func (rb *ReplicationBuilder) EnableFailover(accounts []string) string {
+	return fmt.Sprintf(`ALTER DATABASE %v ENABLE FAILOVER TO ACCOUNTS %v`, QuoteIdentifier(rb.database), strings.Join(accounts, ", "))
+}
+
+// DisableFailover returns the SQL query that will stop the replicas of the
+// database in the accounts given from being promoted to primary.
+func (rb *ReplicationBuilder) DisableFailover(accounts []string) string {
+	return fmt.Sprintf(`ALTER DATABASE %v DISABLE FAILOVER TO ACCOUNTS %v`, QuoteIdentifier(rb.database), strings.Join(accounts, ", "))
+}
+
+// Refresh returns the SQL query that will refresh a secondary database from
+// its primary.
+func (rb *ReplicationBuilder) Refresh() string {
+	return fmt.Sprintf(`ALTER DATABASE %v REFRESH`, QuoteIdentifier(rb.database))
+}
+
+// ListReplicationDatabases runs the SHOW REPLICATION DATABASES query stmt and
+// returns the databases of every account of the organization
+func ListReplicationDatabases(ctx context.Context, stmt string, db *sql.DB) ([]replication, error) {
+	rows, err := QueryContext(ctx, db, stmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	dbs := []replication{}
+	err = sqlx.StructScan(rows, &dbs)
+	if err == sql.ErrNoRows {
+		return dbs, nil
+	}
+	return dbs, errors.Wrapf(err, "unable to scan row for %s", stmt)
+}
+
+// ReadReplicationDatabase returns the database named name of the current
+// account among the replication databases of the organization, nil if it is
+// neither a primary nor a secondary database.
+func ReadReplicationDatabase(ctx context.Context, db *sql.DB, name string) (*replication, error) {
+	locator, err := readCurrentAccountLocator(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	dbs, err := ListReplicationDatabases(ctx, Replication(name).Show(), db)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range dbs {
+		if r.DBName.String == name && strings.EqualFold(r.AccountLocator.String, locator) {
+			return &r, nil
+		}
+	}
+	return nil, nil
+}
+
+var accountLocators sync.Map
+
+// readCurrentAccountLocator returns the locator of the current account, which
+// identifies its rows among the objects replicated between accounts. It is
+// read once per connection.
+func readCurrentAccountLocator(ctx context.Context, db *sql.DB) (string, error) {
+	if locator, ok := accountLocators.Load(db); ok {
+		return locator.(string), nil
+	}
+	acc, err := ScanCurrentAccount(QueryRowContext(ctx, db, SelectCurrentAccount()))
+	if err != nil {
+		return "", errors.Wrap(err, "unable to read the current account")
+	}
+	accountLocators.Store(db, acc.Account)
+	return acc.Account, nil
 }
@@ -0,0 +1,367 @@
+package snowflake
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+const (
+	// FailoverGroupKind is the kind of groups that can be failed over to a secondary account
+	FailoverGroupKind = "FAILOVER GROUP"
+	// ReplicationGroupKind is the kind of groups that are replicated read-only
+	ReplicationGroupKind = "REPLICATION GROUP"
+)
+
+// ReplicationGroupBuilder abstracts the creation of SQL queries for failover
+// and replication groups, which replicate account objects and databases
+// together
+type ReplicationGroupBuilder struct {
+	kind                    string
+	name                    string
+	objectTypes             []string
+	allowedDatabases        []string
+	allowedShares           []string
+	allowedIntegrationTypes []string
+	allowedAccounts         []string
+	ignoreEditionCheck      bool
+	replicationSchedule     string
+}
+
+// FailoverGroup returns a pointer to a Builder that abstracts the DDL
+// operations for a failover group.
+//
+// Supported DDL operations are:
+//   - CREATE FAILOVER GROUP
+//   - CREATE FAILOVER GROUP ... AS REPLICA OF
+//   - ALTER FAILOVER GROUP
+//   - DROP FAILOVER GROUP
+//   - SHOW FAILOVER GROUPS
+//
+// [Snowflake Reference](https://docs.snowflake.com/en/sql-reference/sql/create-failover-group.html)
+func FailoverGroup(name string) *ReplicationGroupBuilder {
+	return &ReplicationGroupBuilder{
+		kind: FailoverGroupKind,
+		name: name,
+	}
+}
+
+// ReplicationGroup returns a pointer to a Builder that abstracts the DDL
+// operations for a replication group.
+//
+// Supported DDL operations are:
+//   - CREATE REPLICATION GROUP
+//   - CREATE REPLICATION GROUP ... AS REPLICA OF
+//   - ALTER REPLICATION GROUP
+//   - DROP REPLICATION GROUP
+//   - SHOW REPLICATION GROUPS
+//
+// [Snowflake Reference](https://docs.snowflake.com/en/sql-reference/sql/create-replication-group.html)
+func ReplicationGroup(name string) *ReplicationGroupBuilder {
+	return &ReplicationGroupBuilder{
+		kind: ReplicationGroupKind,
+		name: name,
+	}
+}
+
+// Kind returns the kind of the group, FAILOVER GROUP or REPLICATION GROUP
+func (rgb *ReplicationGroupBuilder) Kind() string {
+	return rgb.kind
+}
+
+// QualifiedName escapes the name of the group
+func (rgb *ReplicationGroupBuilder) QualifiedName() string {
+	return QuoteIdentifier(rgb.name)
+}
+
+// WithObjectTypes sets the types of the objects replicated, e.g. DATABASES or
+// ROLES
+func (rgb *ReplicationGroupBuilder) WithObjectTypes(types []string) *ReplicationGroupBuilder {
+	rgb.objectTypes = types
+	return rgb
+}
+
+// WithAllowedDatabases sets the databases replicated when DATABASES are
+func (rgb *ReplicationGroupBuilder) WithAllowedDatabases(databases []string) *ReplicationGroupBuilder {
+	rgb.allowedDatabases = databases
+	return rgb
+}
+
+// WithAllowedShares sets the shares replicated when SHARES are
+func (rgb *ReplicationGroupBuilder) WithAllowedShares(shares []string) *ReplicationGroupBuilder {
+	rgb.allowedShares = shares
+	return rgb
+}
+
+// WithAllowedIntegrationTypes sets the types of the integrations replicated
+// when INTEGRATIONS are, e.g. SECURITY INTEGRATIONS
+func (rgb *ReplicationGroupBuilder) WithAllowedIntegrationTypes(types []string) *ReplicationGroupBuilder {
+	rgb.allowedIntegrationTypes = types
+	return rgb
+}
+
+// WithAllowedAccounts sets the accounts, as organization.account, the group
+// is replicated to
+func (rgb *ReplicationGroupBuilder) WithAllowedAccounts(accounts []string) *ReplicationGroupBuilder {
+	rgb.allowedAccounts = accounts
+	return rgb
+}
+
+// WithIgnoreEditionCheck allows replicating to accounts on a lower edition
+func (rgb *ReplicationGroupBuilder) WithIgnoreEditionCheck(ignore bool) *ReplicationGroupBuilder {
+	rgb.ignoreEditionCheck = ignore
+	return rgb
+}
+
+// WithReplicationSchedule sets the schedule secondary groups are refreshed
+// on, e.g. 10 MINUTE or USING CRON 0 0 * * * UTC
+func (rgb *ReplicationGroupBuilder) WithReplicationSchedule(schedule string) *ReplicationGroupBuilder {
+	rgb.replicationSchedule = schedule
+	return rgb
+}
+
+// Create returns the SQL query that will create a primary group.
+func (rgb *ReplicationGroupBuilder) Create() string {
+	q := strings.Builder{}
+	q.WriteString(fmt.Sprintf(`CREATE %v %v OBJECT_TYPES = %v`, rgb.kind, rgb.QualifiedName(), strings.Join(rgb.objectTypes, ", ")))
+
+	if len(rgb.allowedDatabases) > 0 {
+		q.WriteString(fmt.Sprintf(` ALLOWED_DATABASES = %v`, strings.Join(quoteStringList(rgb.allowedDatabases), ", ")))
+	}
+	if len(rgb.allowedShares) > 0 {
+		q.WriteString(fmt.Sprintf(` ALLOWED_SHARES = %v`, strings.Join(quoteStringList(rgb.allowedShares), ", ")))
+	}
+	if len(rgb.allowedIntegrationTypes) > 0 {
+		q.WriteString(fmt.Sprintf(` ALLOWED_INTEGRATION_TYPES = %v`, strings.Join(rgb.allowedIntegrationTypes, ", ")))
+	}
+	if len(rgb.allowedAccounts) > 0 {
+		q.WriteString(fmt.Sprintf(` ALLOWED_ACCOUNTS = %v`, strings.Join(rgb.allowedAccounts, ", ")))
+	}
+	if rgb.ignoreEditionCheck {
+		q.WriteString(` IGNORE EDITION CHECK`)
+	}
+	if rgb.replicationSchedule != "" {
+		q.WriteString(fmt.Sprintf(` REPLICATION_SCHEDULE = '%v'`, EscapeString(rgb.replicationSchedule)))
+	}
+
+	return q.String()
+}
+
+// CreateReplica returns the SQL query that will create a secondary group
+// replicating the primary group, given as organization.account.group.
+func (rgb *ReplicationGroupBuilder) CreateReplica(primary string) string {
+	return fmt.Sprintf(`CREATE %v %v AS REPLICA OF %v`, rgb.kind, rgb.QualifiedName(), primary)
+}
+
+// Rename returns the SQL query that will rename the group.
+func (rgb *ReplicationGroupBuilder) Rename(newName string) string {
+	return fmt.Sprintf(`ALTER %v %v RENAME TO %v`, rgb.kind, rgb.QualifiedName(), QuoteIdentifier(newName))
+}
+
+// SetObjectTypes returns the SQL query that will replace the types of the
+// objects replicated.
+func (rgb *ReplicationGroupBuilder) SetObjectTypes(types []string) string {
+	return fmt.Sprintf(`ALTER %v %v SET OBJECT_TYPES = %v`, rgb.kind, rgb.QualifiedName(), strings.Join(types, ", "))
+}
+
+// SetAllowedIntegrationTypes returns the SQL query that will replace the
+// types of the integrations replicated.
+func (rgb *ReplicationGroupBuilder) SetAllowedIntegrationTypes(types []string) string {
+	return fmt.Sprintf(`ALTER %v %v SET ALLOWED_INTEGRATION_TYPES = %v`, rgb.kind, rgb.QualifiedName(), strings.Join(types, ", "))
+}
+
+// SetReplicationSchedule returns the SQL query that will set the schedule
+// secondary groups are refreshed on.
+func (rgb *ReplicationGroupBuilder) SetReplicationSchedule(schedule string) string {
+	return fmt.Sprintf(`ALTER %v %v SET REPLICATION_SCHEDULE = '%v'`, rgb.kind, rgb.QualifiedName(), EscapeString(schedule))
+}
+
+// UnsetReplicationSchedule returns the SQL query that will unset the
+// replication schedule, secondary groups being refreshed manually then.
+func (rgb *ReplicationGroupBuilder) UnsetReplicationSchedule() string {
+	return fmt.Sprintf(`ALTER %v %v UNSET REPLICATION_SCHEDULE`, rgb.kind, rgb.QualifiedName())
+}
+
+// AddAllowedDatabases returns the SQL query that will add databases to the group.
+func (rgb *ReplicationGroupBuilder) AddAllowedDatabases(databases []string) string {
+	return fmt.Sprintf(`ALTER %v %v ADD %v TO ALLOWED_DATABASES`, rgb.kind, rgb.QualifiedName(), strings.Join(quoteStringList(databases), ", "))
+}
+
+// RemoveAllowedDatabases returns the SQL query that will remove databases from the group.
+func (rgb *ReplicationGroupBuilder) RemoveAllowedDatabases(databases []string) string {
+	return fmt.Sprintf(`ALTER %v %v REMOVE %v FROM ALLOWED_DATABASES`, rgb.kind, rgb.QualifiedName(), strings.Join(quoteStringList(databases), ", "))
+}
+
+// AddAllowedShares returns the SQL query that will add shares to the group.
+func (rgb *ReplicationGroupBuilder) AddAllowedShares(shares []string) string {
+	return fmt.Sprintf(`ALTER %v %v ADD %v TO ALLOWED_SHARES`, rgb.kind, rgb.QualifiedName(), strings.Join(quoteStringList(shares), ", "))
+}
+
+// RemoveAllowedShares returns the SQL query that will remove shares from the group.
+func (rgb *ReplicationGroupBuilder) RemoveAllowedShares(shares []string) string {
+	return fmt.Sprintf(`ALTER %v %v REMOVE %v FROM ALLOWED_SHARES`, rgb.kind, rgb.QualifiedName(), strings.Join(quoteStringList(shares), ", "))
+}
+
+// AddAllowedAccounts returns the SQL query that will replicate the group to
+// more accounts.
+func (rgb *ReplicationGroupBuilder) AddAllowedAccounts(accounts []string, ignoreEditionCheck bool) string {
+	q := fmt.Sprintf(`ALTER %v %v ADD %v TO ALLOWED_ACCOUNTS`, rgb.kind, rgb.QualifiedName(), strings.Join(accounts, ", "))
+	if ignoreEditionCheck {
+		q += ` IGNORE EDITION CHECK`
+	}
+	return q
+}
+
+// RemoveAllowedAccounts returns the SQL query that will stop replicating the
+// group to accounts.
+func (rgb *ReplicationGroupBuilder) RemoveAllowedAccounts(accounts []string) string {
+	return fmt.Sprintf(`ALTER %v %v REMOVE %v FROM ALLOWED_ACCOUNTS`, rgb.kind, rgb.QualifiedName(), strings.Join(accounts, ", "))
+}
+
+// Refresh returns the SQL query that will refresh a secondary group from its primary.
+func (rgb *ReplicationGroupBuilder) Refresh() string {
+	return fmt.Sprintf(`ALTER %v %v REFRESH`, rgb.kind, rgb.QualifiedName())
+}
+
+// Primary returns the SQL query that will promote a secondary failover group
+// to primary, the primary group becoming a secondary one.
+func (rgb *ReplicationGroupBuilder) Primary() string {
+	return fmt.Sprintf(`ALTER %v %v PRIMARY`, rgb.kind, rgb.QualifiedName())
+}
+
+// Drop returns the SQL query that will drop the group.
+func (rgb *ReplicationGroupBuilder) Drop() string {
+	return fmt.Sprintf(`DROP %v %v`, rgb.kind, rgb.QualifiedName())
+}
+
+// Show returns the SQL query that will show the groups of the kind of the
+// organization's accounts the current account is linked to.
+func (rgb *ReplicationGroupBuilder) Show() string {
+	return fmt.Sprintf(`SHOW %vS`, rgb.kind)
+}
+
+// ShowDatabases returns the SQL query that will show the databases of the group.
+func (rgb *ReplicationGroupBuilder) ShowDatabases() string {
+	return fmt.Sprintf(`SHOW DATABASES IN %v %v`, rgb.kind, rgb.QualifiedName())
+}
+
+// ShowShares returns the SQL query that will show the shares of the group.
+func (rgb *ReplicationGroupBuilder) ShowShares() string {
+	return fmt.Sprintf(`SHOW SHARES IN %v %v`, rgb.kind, rgb.QualifiedName())
+}
+
+type replicationGroup struct {
+	AccountName             sql.NullString `db:"account_name"`
+	Name                    sql.NullString `db:"name"`
+	IsPrimary               sql.NullBool   `db:"is_primary"`
+	Primary                 sql.NullString `db:"primary"`
+	ObjectTypes             sql.NullString `db:"object_types"`
+	AllowedIntegrationTypes sql.NullString `db:"allowed_integration_types"`
+	AllowedAccounts         sql.NullString `db:"allowed_accounts"`
+	Org                     sql.NullString `db:"organization_name"`
+	AccountLocator          sql.NullString `db:"account_locator"`
+	ReplicationSchedule     sql.NullString `db:"replication_schedule"`
+	SecondaryState          sql.NullString `db:"secondary_state"`
+}
+
+// ListObjectTypes returns the types of the objects replicated
+func (rg *replicationGroup) ListObjectTypes() []string {
+	return splitList(rg.ObjectTypes.String)
+}
+
+// ListAllowedIntegrationTypes returns the types of the integrations replicated
+func (rg *replicationGroup) ListAllowedIntegrationTypes() []string {
+	return splitList(rg.AllowedIntegrationTypes.String)
+}
+
+// PrimaryGroup returns the primary group of a secondary group, as
+// organization.account.group, without the region group prefixing it.
+func (rg *replicationGroup) PrimaryGroup() string {
+	parts := strings.Split(rg.Primary.String, ".")
+	if len(parts) > 3 {
+		parts = parts[len(parts)-3:]
+	}
+	return strings.Join(parts, ".")
+}
+
+// ListAllowedAccounts returns the accounts, as organization.account, the
+// group is replicated to, the account of the group excluded.
+func (rg *replicationGroup) ListAllowedAccounts() []string {
+	self := fmt.Sprintf("%v.%v", rg.Org.String, rg.AccountName.String)
+	accounts := []string{}
+	for _, account := range splitList(rg.AllowedAccounts.String) {
+		if !strings.EqualFold(account, self) {
+			accounts = append(accounts, account)
+		}
+	}
+	return accounts
+}
+
+func splitList(list string) []string {
+	values := []string{}
+	for _, v := range strings.Split(list, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// ReadReplicationGroup returns the group of the current account named as the
+// builder's, nil if there is none.
+func ReadReplicationGroup(ctx context.Context, db *sql.DB, rgb *ReplicationGroupBuilder) (*replicationGroup, error) {
+	locator, err := readCurrentAccountLocator(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	stmt := rgb.Show()
+	rows, err := QueryContext(ctx, db, stmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	groups := []replicationGroup{}
+	err = sqlx.StructScan(rows, &groups)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, errors.Wrapf(err, "unable to scan row for %s", stmt)
+	}
+	for _, g := range groups {
+		if g.Name.String == rgb.name && strings.EqualFold(g.AccountLocator.String, locator) {
+			return &g, nil
+		}
+	}
+	return nil, nil
+}
+
+type replicationGroupObject struct {
+	Name sql.NullString `db:"name"`
+}
+
+// ListReplicationGroupObjects runs the SHOW DATABASES|SHARES IN ... GROUP
+// query stmt and returns the names of the objects. The names of shares are
+// listed without the account prefixing them.
+func ListReplicationGroupObjects(ctx context.Context, stmt string, db *sql.DB) ([]string, error) {
+	rows, err := QueryContext(ctx, db, stmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	objects := []replicationGroupObject{}
+	err = sqlx.StructScan(rows, &objects)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, errors.Wrapf(err, "unable to scan row for %s", stmt)
+	}
+	names := make([]string, len(objects))
+	for i, o := range objects {
+		parts := strings.Split(o.Name.String, ".")
+		names[i] = parts[len(parts)-1]
+	}
+	return names, nil
+}
@@ -0,0 +1,90 @@
+package snowflake_test
+
+import (
+	"context"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFailoverGroupCreate(t *testing.T) {
+	r := require.New(t)
+	b := snowflake.FailoverGroup("fg").
+		WithObjectTypes([]string{"DATABASES", "ROLES", "INTEGRATIONS"}).
+		WithAllowedDatabases([]string{"db1", "db2"}).
+		WithAllowedIntegrationTypes([]string{"SECURITY INTEGRATIONS"}).
+		WithAllowedAccounts([]string{"myorg.account2"}).
+		WithIgnoreEditionCheck(true).
+		WithReplicationSchedule("10 MINUTE")
+
+	r.Equal(`CREATE FAILOVER GROUP "fg" OBJECT_TYPES = DATABASES, ROLES, INTEGRATIONS ALLOWED_DATABASES = "db1", "db2" ALLOWED_INTEGRATION_TYPES = SECURITY INTEGRATIONS ALLOWED_ACCOUNTS = myorg.account2 IGNORE EDITION CHECK REPLICATION_SCHEDULE = '10 MINUTE'`, b.Create())
+	r.Equal(`CREATE FAILOVER GROUP "fg" AS REPLICA OF myorg.account1.fg`, b.CreateReplica("myorg.account1.fg"))
+}
+
+func TestReplicationGroupAlter(t *testing.T) {
+	r := require.New(t)
+	b := snowflake.ReplicationGroup("rg")
+
+	r.Equal(`ALTER REPLICATION GROUP "rg" RENAME TO "rg2"`, b.Rename("rg2"))
+	r.Equal(`ALTER REPLICATION GROUP "rg" SET OBJECT_TYPES = USERS, SHARES`, b.SetObjectTypes([]string{"USERS", "SHARES"}))
+	r.Equal(`ALTER REPLICATION GROUP "rg" SET ALLOWED_INTEGRATION_TYPES = API INTEGRATIONS`, b.SetAllowedIntegrationTypes([]string{"API INTEGRATIONS"}))
+	r.Equal(`ALTER REPLICATION GROUP "rg" SET REPLICATION_SCHEDULE = 'USING CRON 0 0 * * * UTC'`, b.SetReplicationSchedule("USING CRON 0 0 * * * UTC"))
+	r.Equal(`ALTER REPLICATION GROUP "rg" UNSET REPLICATION_SCHEDULE`, b.UnsetReplicationSchedule())
+	r.Equal(`ALTER REPLICATION GROUP "rg" ADD "db1" TO ALLOWED_DATABASES`, b.AddAllowedDatabases([]string{"db1"}))
+	r.Equal(`ALTER REPLICATION GROUP "rg" REMOVE "db1" FROM ALLOWED_DATABASES`, b.RemoveAllowedDatabases([]string{"db1"}))
+	r.Equal(`ALTER REPLICATION GROUP "rg" ADD "s1" TO ALLOWED_SHARES`, b.AddAllowedShares([]string{"s1"}))
+	r.Equal(`ALTER REPLICATION GROUP "rg" REMOVE "s1" FROM ALLOWED_SHARES`, b.RemoveAllowedShares([]string{"s1"}))
+	r.Equal(`ALTER REPLICATION GROUP "rg" ADD myorg.account2 TO ALLOWED_ACCOUNTS IGNORE EDITION CHECK`, b.AddAllowedAccounts([]string{"myorg.account2"}, true))
+	r.Equal(`ALTER REPLICATION GROUP "rg" REMOVE myorg.account2 FROM ALLOWED_ACCOUNTS`, b.RemoveAllowedAccounts([]string{"myorg.account2"}))
+	r.Equal(`ALTER REPLICATION GROUP "rg" REFRESH`, b.Refresh())
+	r.Equal(`DROP REPLICATION GROUP "rg"`, b.Drop())
+	r.Equal(`SHOW REPLICATION GROUPS`, b.Show())
+	r.Equal(`SHOW DATABASES IN REPLICATION GROUP "rg"`, b.ShowDatabases())
+	r.Equal(`SHOW SHARES IN REPLICATION GROUP "rg"`, b.ShowShares())
+	r.Equal(`ALTER FAILOVER GROUP "fg" PRIMARY`, snowflake.FailoverGroup("fg").Primary())
+}
+
+func TestReadReplicationGroup(t *testing.T) {
+	r := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer db.Close()
+
+	mock.ExpectQuery(`^SELECT CURRENT_ACCOUNT`).WillReturnRows(
+		sqlmock.NewRows([]string{"account", "region"}).AddRow("AB12345", "AWS_US_WEST_2"),
+	)
+	mock.ExpectQuery(`^SHOW FAILOVER GROUPS$`).WillReturnRows(
+		sqlmock.NewRows([]string{"account_name", "name", "is_primary", "object_types", "allowed_integration_types", "allowed_accounts", "organization_name", "account_locator", "replication_schedule"}).
+			AddRow("ACCOUNT1", "other", true, "ROLES", "", "MYORG.ACCOUNT1", "MYORG", "AB12345", "").
+			AddRow("ACCOUNT2", "fg", false, "DATABASES, ROLES", "", "MYORG.ACCOUNT1, MYORG.ACCOUNT2", "MYORG", "CD67890", "10 MINUTE").
+			AddRow("ACCOUNT1", "fg", true, "DATABASES, ROLES", "SECURITY INTEGRATIONS", "MYORG.ACCOUNT1, MYORG.ACCOUNT2", "MYORG", "AB12345", "10 MINUTE"),
+	)
+
+	g, err := snowflake.ReadReplicationGroup(context.Background(), db, snowflake.FailoverGroup("fg"))
+	r.NoError(err)
+	r.NotNil(g)
+	r.True(g.IsPrimary.Bool)
+	r.Equal([]string{"DATABASES", "ROLES"}, g.ListObjectTypes())
+	r.Equal([]string{"SECURITY INTEGRATIONS"}, g.ListAllowedIntegrationTypes())
+	r.Equal([]string{"MYORG.ACCOUNT2"}, g.ListAllowedAccounts())
+	r.NoError(mock.ExpectationsWereMet())
+}
+
+func TestListReplicationGroupObjects(t *testing.T) {
+	r := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer db.Close()
+
+	mock.ExpectQuery(`^SHOW SHARES IN FAILOVER GROUP "fg"$`).WillReturnRows(
+		sqlmock.NewRows([]string{"kind", "name"}).AddRow("OUTBOUND", "MYORG.ACCOUNT1.S1").AddRow("OUTBOUND", "S2"),
+	)
+
+	names, err := snowflake.ListReplicationGroupObjects(context.Background(), snowflake.FailoverGroup("fg").ShowShares(), db)
+	r.NoError(err)
+	r.Equal([]string{"S1", "S2"}, names)
+}
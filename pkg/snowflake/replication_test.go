@@ -0,0 +1,79 @@
+package snowflake_test
+
+import (
+	"context"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplication(t *testing.T) {
+	r := require.New(t)
+	b := snowflake.Replication("db1")
+
+	r.Equal(`SHOW REPLICATION DATABASES LIKE 'db1'`, b.Show())
+	r.Equal(`ALTER DATABASE "db1" ENABLE REPLICATION TO ACCOUNTS myorg.account1, myorg.account2`, b.EnableReplication([]string{"myorg.account1", "myorg.account2"}, false))
+	r.Equal(`ALTER DATABASE "db1" ENABLE REPLICATION TO ACCOUNTS myorg.account1 IGNORE EDITION CHECK`, b.EnableReplication([]string{"myorg.account1"}, true))
+	r.Equal(`ALTER DATABASE "db1" DISABLE REPLICATION TO ACCOUNTS myorg.account1`, b.DisableReplication([]string{"myorg.account1"}))
+	r.Equal(`ALTER DATABASE "db1" ENABLE FAILOVER TO ACCOUNTS myorg.account1`, b.EnableFailover([]string{"myorg.account1"}))
+	r.Equal(`ALTER DATABASE "db1" DISABLE FAILOVER TO ACCOUNTS myorg.account1`, b.DisableFailover([]string{"myorg.account1"}))
+	r.Equal(`ALTER DATABASE "db1" REFRESH`, b.Refresh())
+}
+
+func TestReadReplicationDatabase(t *testing.T) {
+	r := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer db.Close()
+
+	mock.ExpectQuery(`^SELECT CURRENT_ACCOUNT\(\) AS "account", CURRENT_REGION\(\) AS "region";$`).WillReturnRows(
+		sqlmock.NewRows([]string{"account", "region"}).AddRow("AB12345", "AWS_US_WEST_2"),
+	)
+	mock.ExpectQuery(`^SHOW REPLICATION DATABASES LIKE 'db1'$`).WillReturnRows(
+		sqlmock.NewRows([]string{"snowflake_region", "account_name", "name", "is_primary", "primary", "replication_allowed_to_accounts", "failover_allowed_to_accounts", "organization_name", "account_locator"}).
+			AddRow("AWS_US_EAST_1", "ACCOUNT2", "db1", false, "AWS_US_WEST_2.MYORG.ACCOUNT1.DB1", "AWS_US_WEST_2.MYORG.ACCOUNT1, AWS_US_EAST_1.MYORG.ACCOUNT2", "AWS_US_WEST_2.MYORG.ACCOUNT1", "MYORG", "CD67890").
+			AddRow("AWS_US_WEST_2", "ACCOUNT1", "db1", true, "AWS_US_WEST_2.MYORG.ACCOUNT1.DB1", "AWS_US_WEST_2.MYORG.ACCOUNT1, AWS_US_EAST_1.MYORG.ACCOUNT2", "AWS_US_WEST_2.MYORG.ACCOUNT1", "MYORG", "AB12345"),
+	)
+
+	repl, err := snowflake.ReadReplicationDatabase(context.Background(), db, "db1")
+	r.NoError(err)
+	r.NotNil(repl)
+	r.True(repl.IsPrimary.Bool)
+	r.Equal([]string{"MYORG.ACCOUNT2"}, repl.ReplicationAllowedToAccounts())
+	r.Empty(repl.FailoverAllowedToAccounts())
+
+	// the current account is only read once per connection
+	mock.ExpectQuery(`^SHOW REPLICATION DATABASES LIKE 'db1'$`).WillReturnRows(
+		sqlmock.NewRows([]string{"account_name", "name", "is_primary", "organization_name", "account_locator"}).
+			AddRow("ACCOUNT1", "db1", true, "MYORG", "AB12345"),
+	)
+	repl, err = snowflake.ReadReplicationDatabase(context.Background(), db, "db1")
+	r.NoError(err)
+	r.NotNil(repl)
+	r.NoError(mock.ExpectationsWereMet())
+}
+
+func TestReadReplicationDatabaseNotFound(t *testing.T) {
+	r := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer db.Close()
+
+	mock.ExpectQuery(`^SELECT CURRENT_ACCOUNT`).WillReturnRows(
+		sqlmock.NewRows([]string{"account", "region"}).AddRow("AB12345", "AWS_US_WEST_2"),
+	)
+	// the database of another account only
+	mock.ExpectQuery(`^SHOW REPLICATION DATABASES LIKE 'db1'$`).WillReturnRows(
+		sqlmock.NewRows([]string{"account_name", "name", "is_primary", "organization_name", "account_locator"}).
+			AddRow("ACCOUNT2", "db1", true, "MYORG", "CD67890"),
+	)
+
+	repl, err := snowflake.ReadReplicationDatabase(context.Background(), db, "db1")
+	r.NoError(err)
+	r.Nil(repl)
+	r.NoError(mock.ExpectationsWereMet())
+}
@@ -1,6 +1,7 @@
 package snowflake
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
@@ -94,7 +95,7 @@ func (rcb *ResourceMonitorCreateBuilder) SuspendImmediatelyAt(pct int) *Resource
 // Statement returns the SQL statement needed to actually create the resource
 func (rcb *ResourceMonitorCreateBuilder) Statement() string {
 	var sb strings.Builder
-	sb.WriteString(fmt.Sprintf(`CREATE %v "%v"`, rcb.entityType, rcb.name))
+	sb.WriteString(fmt.Sprintf(`CREATE %v %v`, rcb.entityType, QuoteIdentifier(rcb.name)))
 
 	for k, v := range rcb.stringProperties {
 		sb.WriteString(fmt.Sprintf(` %v='%v'`, strings.ToUpper(k), EscapeString(v)))
@@ -121,12 +122,12 @@ func (rcb *ResourceMonitorCreateBuilder) Statement() string {
 
 // SetOnAccount returns the SQL query that will set the resource monitor globally on your Snowflake account
 func (rcb *ResourceMonitorCreateBuilder) SetOnAccount() string {
-	return fmt.Sprintf(`ALTER ACCOUNT SET RESOURCE_MONITOR = "%v"`, rcb.name)
+	return fmt.Sprintf(`ALTER ACCOUNT SET RESOURCE_MONITOR = %v`, QuoteIdentifier(rcb.name))
 }
 
 // SetOnWarehouse returns the SQL query that will set the resource monitor on the specified warehouse
 func (rcb *ResourceMonitorCreateBuilder) SetOnWarehouse(warehouse string) string {
-	return fmt.Sprintf(`ALTER WAREHOUSE "%v" SET RESOURCE_MONITOR = "%v"`, warehouse, rcb.name)
+	return fmt.Sprintf(`ALTER WAREHOUSE %v SET RESOURCE_MONITOR = %v`, QuoteIdentifier(warehouse), QuoteIdentifier(rcb.name))
 }
 
 type resourceMonitor struct {
@@ -152,9 +153,9 @@ func ScanResourceMonitor(row *sqlx.Row) (*resourceMonitor, error) {
 	return rm, err
 }
 
-func ListResourceMonitors(db *sql.DB) ([]resourceMonitor, error) {
+func ListResourceMonitors(ctx context.Context, db *sql.DB) ([]resourceMonitor, error) {
 	stmt := "SHOW RESOURCE MONITORS"
-	rows, err := Query(db, stmt)
+	rows, err := QueryContext(ctx, db, stmt)
 	if err != nil {
 		return nil, err
 	}
@@ -1,9 +1,12 @@
 package snowflake
 
 import (
+	"context"
 	"database/sql"
+	"log"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
 )
 
 func Role(name string) *Builder {
@@ -23,3 +26,21 @@ func ScanRole(row *sqlx.Row) (*role, error) {
 	err := row.StructScan(r)
 	return r, err
 }
+
+// ListRoles returns every role of the account visible to the current role
+func ListRoles(ctx context.Context, db *sql.DB) ([]role, error) {
+	stmt := "SHOW ROLES"
+	rows, err := QueryContext(ctx, db, stmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	roles := []role{}
+	err = sqlx.StructScan(rows, &roles)
+	if err == sql.ErrNoRows {
+		log.Printf("[DEBUG] no roles found")
+		return nil, nil
+	}
+	return roles, errors.Wrapf(err, "unable to scan row for %s", stmt)
+}
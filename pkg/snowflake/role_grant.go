@@ -1,6 +1,14 @@
 package snowflake
 
-import "fmt"
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
 
 type RoleGrantBuilder struct {
 	name string
@@ -33,9 +41,32 @@ func (gb *RoleGrantBuilder) Role(role string) *RoleGrantExecutable {
 }
 
 func (gr *RoleGrantExecutable) Grant() string {
-	return fmt.Sprintf(`GRANT ROLE "%s" TO %s "%s"`, gr.name, gr.granteeType, gr.grantee) // nolint: gosec
+	return fmt.Sprintf(`GRANT ROLE %v TO %s %v`, QuoteIdentifier(gr.name), gr.granteeType, QuoteIdentifier(gr.grantee)) // nolint: gosec
 }
 
 func (gr *RoleGrantExecutable) Revoke() string {
-	return fmt.Sprintf(`REVOKE ROLE "%s" FROM %s "%s"`, gr.name, gr.granteeType, gr.grantee) // nolint: gosec
+	return fmt.Sprintf(`REVOKE ROLE %v FROM %s %v`, QuoteIdentifier(gr.name), gr.granteeType, QuoteIdentifier(gr.grantee)) // nolint: gosec
+}
+
+type roleGrantee struct {
+	GrantedTo   sql.NullString `db:"granted_to"`
+	GranteeName sql.NullString `db:"grantee_name"`
+}
+
+// ListRoleGrantees returns the roles and users a role is granted to
+func ListRoleGrantees(ctx context.Context, roleName string, db *sql.DB) ([]roleGrantee, error) {
+	stmt := fmt.Sprintf(`SHOW GRANTS OF ROLE %v`, QuoteIdentifier(roleName))
+	rows, err := QueryContext(ctx, db, stmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	grantees := []roleGrantee{}
+	err = sqlx.StructScan(rows, &grantees)
+	if err == sql.ErrNoRows {
+		log.Printf("[DEBUG] no grants of role %v found", roleName)
+		return nil, nil
+	}
+	return grantees, errors.Wrapf(err, "unable to scan row for %s", stmt)
 }
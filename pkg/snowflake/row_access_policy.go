@@ -1,6 +1,7 @@
 package snowflake
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
@@ -25,18 +26,18 @@ func (rapb *RowAccessPolicyBuilder) QualifiedName() string {
 	var n strings.Builder
 
 	if rapb.db != "" && rapb.schema != "" {
-		n.WriteString(fmt.Sprintf(`"%v"."%v".`, rapb.db, rapb.schema))
+		n.WriteString(fmt.Sprintf(`%v.`, QuoteIdentifier(rapb.db, rapb.schema)))
 	}
 
 	if rapb.db != "" && rapb.schema == "" {
-		n.WriteString(fmt.Sprintf(`"%v"..`, rapb.db))
+		n.WriteString(fmt.Sprintf(`%v..`, QuoteIdentifier(rapb.db)))
 	}
 
 	if rapb.db == "" && rapb.schema != "" {
-		n.WriteString(fmt.Sprintf(`"%v".`, rapb.schema))
+		n.WriteString(fmt.Sprintf(`%v.`, QuoteIdentifier(rapb.schema)))
 	}
 
-	n.WriteString(fmt.Sprintf(`"%v"`, rapb.name))
+	n.WriteString(QuoteIdentifier(rapb.name))
 
 	return n.String()
 }
@@ -129,7 +130,7 @@ func (rapb *RowAccessPolicyBuilder) Drop() string {
 
 // Show returns the SQL query that will show a row access policy.
 func (rapb *RowAccessPolicyBuilder) Show() string {
-	return fmt.Sprintf(`SHOW ROW ACCESS POLICIES LIKE '%v' IN SCHEMA "%v"."%v"`, rapb.name, rapb.db, rapb.schema)
+	return fmt.Sprintf(`SHOW ROW ACCESS POLICIES LIKE '%v' IN SCHEMA %v`, EscapeString(rapb.name), QuoteIdentifier(rapb.db, rapb.schema))
 }
 
 type RowAccessPolicyStruct struct {
@@ -148,9 +149,9 @@ func ScanRowAccessPolicies(row *sqlx.Row) (*RowAccessPolicyStruct, error) {
 	return m, err
 }
 
-func ListRowAccessPolicies(databaseName string, schemaName string, db *sql.DB) ([]RowAccessPolicyStruct, error) {
-	stmt := fmt.Sprintf(`SHOW ROW ACCESS POLICIES IN SCHEMA "%s"."%v"`, databaseName, schemaName)
-	rows, err := Query(db, stmt)
+func ListRowAccessPolicies(ctx context.Context, databaseName string, schemaName string, db *sql.DB) ([]RowAccessPolicyStruct, error) {
+	stmt := fmt.Sprintf(`SHOW ROW ACCESS POLICIES IN SCHEMA %v`, QuoteIdentifier(databaseName, schemaName))
+	rows, err := QueryContext(ctx, db, stmt)
 	if err != nil {
 		return nil, err
 	}
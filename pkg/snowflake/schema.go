@@ -1,6 +1,7 @@
 package snowflake
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
@@ -27,10 +28,10 @@ func (sb *SchemaBuilder) QualifiedName() string {
 	var n strings.Builder
 
 	if sb.db != "" {
-		n.WriteString(fmt.Sprintf(`"%v".`, sb.db))
+		n.WriteString(fmt.Sprintf(`%v.`, QuoteIdentifier(sb.db)))
 	}
 
-	n.WriteString(fmt.Sprintf(`"%v"`, sb.name))
+	n.WriteString(QuoteIdentifier(sb.name))
 
 	return n.String()
 }
@@ -75,17 +76,17 @@ func (sb *SchemaBuilder) WithTags(tags []TagValue) *SchemaBuilder {
 
 // AddTag returns the SQL query that will add a new tag to the schema.
 func (sb *SchemaBuilder) AddTag(tag TagValue) string {
-	return fmt.Sprintf(`ALTER SCHEMA %s SET TAG "%v"."%v"."%v" = "%v"`, sb.QualifiedName(), tag.Database, tag.Schema, tag.Name, tag.Value)
+	return fmt.Sprintf(`ALTER SCHEMA %s SET TAG %v = '%v'`, sb.QualifiedName(), QuoteIdentifier(tag.Database, tag.Schema, tag.Name), EscapeString(tag.Value))
 }
 
 // ChangeTag returns the SQL query that will alter a tag on the schema.
 func (sb *SchemaBuilder) ChangeTag(tag TagValue) string {
-	return fmt.Sprintf(`ALTER SCHEMA %s SET TAG "%v"."%v"."%v" = "%v"`, sb.QualifiedName(), tag.Database, tag.Schema, tag.Name, tag.Value)
+	return fmt.Sprintf(`ALTER SCHEMA %s SET TAG %v = '%v'`, sb.QualifiedName(), QuoteIdentifier(tag.Database, tag.Schema, tag.Name), EscapeString(tag.Value))
 }
 
 // UnsetTag returns the SQL query that will unset a tag on the schema.
 func (sb *SchemaBuilder) UnsetTag(tag TagValue) string {
-	return fmt.Sprintf(`ALTER SCHEMA %s UNSET TAG "%v"."%v"."%v"`, sb.QualifiedName(), tag.Database, tag.Schema, tag.Name)
+	return fmt.Sprintf(`ALTER SCHEMA %s UNSET TAG %v`, sb.QualifiedName(), QuoteIdentifier(tag.Database, tag.Schema, tag.Name))
 }
 
 // Schema returns a pointer to a Builder that abstracts the DDL operations for a schema.
@@ -133,13 +134,13 @@ func (sb *SchemaBuilder) Create() string {
 
 // Rename returns the SQL query that will rename the schema.
 func (sb *SchemaBuilder) Rename(newName string) string {
-	return fmt.Sprintf(`ALTER SCHEMA %v RENAME TO "%v"`, sb.QualifiedName(), newName)
+	return fmt.Sprintf(`ALTER SCHEMA %v RENAME TO %v`, sb.QualifiedName(), QuoteIdentifier(newName))
 }
 
 // Swap returns the SQL query that Swaps all objects (tables, views, etc.) and
 // metadata, including identifiers, between the two specified schemas.
 func (sb *SchemaBuilder) Swap(targetSchema string) string {
-	return fmt.Sprintf(`ALTER SCHEMA %v SWAP WITH "%v"`, sb.QualifiedName(), targetSchema)
+	return fmt.Sprintf(`ALTER SCHEMA %v SWAP WITH %v`, sb.QualifiedName(), QuoteIdentifier(targetSchema))
 }
 
 // ChangeComment returns the SQL query that will update the comment on the schema.
@@ -191,10 +192,10 @@ func (sb *SchemaBuilder) Use() string {
 func (sb *SchemaBuilder) Show() string {
 	q := strings.Builder{}
 
-	q.WriteString(fmt.Sprintf(`SHOW SCHEMAS LIKE '%v'`, sb.name))
+	q.WriteString(fmt.Sprintf(`SHOW SCHEMAS LIKE '%v'`, EscapeString(sb.name)))
 
 	if sb.db != "" {
-		q.WriteString(fmt.Sprintf(` IN DATABASE "%v"`, sb.db))
+		q.WriteString(fmt.Sprintf(` IN DATABASE %v`, QuoteIdentifier(sb.db)))
 	}
 
 	return q.String()
@@ -214,9 +215,9 @@ func ScanSchema(row *sqlx.Row) (*schema, error) {
 	return r, err
 }
 
-func ListSchemas(databaseName string, db *sql.DB) ([]schema, error) {
-	stmt := fmt.Sprintf(`SHOW SCHEMAS IN DATABASE "%v"`, databaseName)
-	rows, err := Query(db, stmt)
+func ListSchemas(ctx context.Context, databaseName string, db *sql.DB) ([]schema, error) {
+	stmt := fmt.Sprintf(`SHOW SCHEMAS IN DATABASE %v`, QuoteIdentifier(databaseName))
+	rows, err := QueryContext(ctx, db, stmt)
 	if err != nil {
 		return nil, err
 	}
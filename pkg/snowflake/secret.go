@@ -0,0 +1,202 @@
+package snowflake
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	// SecretTypeGenericString is the type of secrets holding an arbitrary string
+	SecretTypeGenericString = "GENERIC_STRING"
+	// SecretTypePassword is the type of secrets holding a username and a password
+	SecretTypePassword = "PASSWORD"
+	// SecretTypeOAuth2 is the type of secrets authenticating through an API authentication integration
+	SecretTypeOAuth2 = "OAUTH2"
+)
+
+// SecretBuilder abstracts the creation of sql queries for a snowflake secret
+type SecretBuilder struct {
+	name                        string
+	db                          string
+	schema                      string
+	secretType                  string
+	secretString                string
+	username                    string
+	password                    string
+	apiAuthentication           string
+	oauthScopes                 []string
+	oauthRefreshToken           string
+	oauthRefreshTokenExpiryTime string
+	comment                     string
+}
+
+// QualifiedName prepends the db and schema and escapes everything nicely
+func (sb *SecretBuilder) QualifiedName() string {
+	return QuoteIdentifier(sb.db, sb.schema, sb.name)
+}
+
+// WithType sets the type of the secret, one of GENERIC_STRING, PASSWORD or OAUTH2
+func (sb *SecretBuilder) WithType(t string) *SecretBuilder {
+	sb.secretType = t
+	return sb
+}
+
+// WithSecretString adds the string of a GENERIC_STRING secret to the SecretBuilder
+func (sb *SecretBuilder) WithSecretString(s string) *SecretBuilder {
+	sb.secretString = s
+	return sb
+}
+
+// WithUsername adds the username of a PASSWORD secret to the SecretBuilder
+func (sb *SecretBuilder) WithUsername(s string) *SecretBuilder {
+	sb.username = s
+	return sb
+}
+
+// WithPassword adds the password of a PASSWORD secret to the SecretBuilder
+func (sb *SecretBuilder) WithPassword(s string) *SecretBuilder {
+	sb.password = s
+	return sb
+}
+
+// WithAPIAuthentication adds the security integration of an OAUTH2 secret to the SecretBuilder
+func (sb *SecretBuilder) WithAPIAuthentication(s string) *SecretBuilder {
+	sb.apiAuthentication = s
+	return sb
+}
+
+// WithOAuthScopes adds the scopes of an OAUTH2 secret using the client credentials flow to the SecretBuilder
+func (sb *SecretBuilder) WithOAuthScopes(s []string) *SecretBuilder {
+	sb.oauthScopes = s
+	return sb
+}
+
+// WithOAuthRefreshToken adds the refresh token of an OAUTH2 secret using the authorization code flow to the SecretBuilder
+func (sb *SecretBuilder) WithOAuthRefreshToken(token, expiryTime string) *SecretBuilder {
+	sb.oauthRefreshToken = token
+	sb.oauthRefreshTokenExpiryTime = expiryTime
+	return sb
+}
+
+// WithComment adds a comment to the SecretBuilder
+func (sb *SecretBuilder) WithComment(c string) *SecretBuilder {
+	sb.comment = c
+	return sb
+}
+
+// Secret returns a pointer to a Builder that abstracts the DDL operations for a secret.
+//
+// Supported DDL operations are:
+//   - CREATE SECRET
+//   - ALTER SECRET
+//   - DROP SECRET
+//   - SHOW SECRETS
+//   - DESCRIBE SECRET
+//
+// [Snowflake Reference](https://docs.snowflake.com/en/sql-reference/sql/create-secret)
+func Secret(name, db, schema string) *SecretBuilder {
+	return &SecretBuilder{
+		name:   name,
+		db:     db,
+		schema: schema,
+	}
+}
+
+// Create returns the SQL that will create a new secret
+func (sb *SecretBuilder) Create() string {
+	q := strings.Builder{}
+	q.WriteString(fmt.Sprintf(`CREATE SECRET %v TYPE = %v`, sb.QualifiedName(), sb.secretType))
+
+	switch sb.secretType {
+	case SecretTypeGenericString:
+		q.WriteString(fmt.Sprintf(` SECRET_STRING = '%v'`, EscapeString(sb.secretString)))
+	case SecretTypePassword:
+		q.WriteString(fmt.Sprintf(` USERNAME = '%v' PASSWORD = '%v'`, EscapeString(sb.username), EscapeString(sb.password)))
+	case SecretTypeOAuth2:
+		q.WriteString(fmt.Sprintf(` API_AUTHENTICATION = %v`, QuoteIdentifier(sb.apiAuthentication)))
+		if len(sb.oauthScopes) > 0 {
+			q.WriteString(fmt.Sprintf(` OAUTH_SCOPES = %v`, formatStringList(sb.oauthScopes)))
+		}
+		if sb.oauthRefreshToken != "" {
+			q.WriteString(fmt.Sprintf(` OAUTH_REFRESH_TOKEN = '%v'`, EscapeString(sb.oauthRefreshToken)))
+			q.WriteString(fmt.Sprintf(` OAUTH_REFRESH_TOKEN_EXPIRY_TIME = '%v'`, EscapeString(sb.oauthRefreshTokenExpiryTime)))
+		}
+	}
+
+	if sb.comment != "" {
+		q.WriteString(fmt.Sprintf(` COMMENT = '%v'`, EscapeString(sb.comment)))
+	}
+
+	return q.String()
+}
+
+// ChangeSecretString returns the sql that will change the string of a GENERIC_STRING secret.
+func (sb *SecretBuilder) ChangeSecretString(s string) string {
+	return fmt.Sprintf(`ALTER SECRET %v SET SECRET_STRING = '%v'`, sb.QualifiedName(), EscapeString(s))
+}
+
+// ChangeCredentials returns the sql that will change the username and password of a PASSWORD secret.
+func (sb *SecretBuilder) ChangeCredentials(username, password string) string {
+	return fmt.Sprintf(`ALTER SECRET %v SET USERNAME = '%v' PASSWORD = '%v'`, sb.QualifiedName(), EscapeString(username), EscapeString(password))
+}
+
+// ChangeOAuthScopes returns the sql that will change the scopes of an OAUTH2 secret.
+func (sb *SecretBuilder) ChangeOAuthScopes(scopes []string) string {
+	return fmt.Sprintf(`ALTER SECRET %v SET OAUTH_SCOPES = %v`, sb.QualifiedName(), formatStringList(scopes))
+}
+
+// ChangeOAuthRefreshToken returns the sql that will change the refresh token of an OAUTH2 secret.
+func (sb *SecretBuilder) ChangeOAuthRefreshToken(token, expiryTime string) string {
+	return fmt.Sprintf(`ALTER SECRET %v SET OAUTH_REFRESH_TOKEN = '%v' OAUTH_REFRESH_TOKEN_EXPIRY_TIME = '%v'`, sb.QualifiedName(), EscapeString(token), EscapeString(expiryTime))
+}
+
+// ChangeComment returns the sql that will change the comment for the secret.
+func (sb *SecretBuilder) ChangeComment(c string) string {
+	return fmt.Sprintf(`ALTER SECRET %v SET COMMENT = '%v'`, sb.QualifiedName(), EscapeString(c))
+}
+
+// RemoveComment returns the sql that will remove the comment for the secret.
+func (sb *SecretBuilder) RemoveComment() string {
+	return fmt.Sprintf(`ALTER SECRET %v UNSET COMMENT`, sb.QualifiedName())
+}
+
+// Drop returns the sql that will remove the secret.
+func (sb *SecretBuilder) Drop() string {
+	return fmt.Sprintf(`DROP SECRET %v`, sb.QualifiedName())
+}
+
+// Describe returns the sql that will describe the secret.
+func (sb *SecretBuilder) Describe() string {
+	return fmt.Sprintf(`DESCRIBE SECRET %v`, sb.QualifiedName())
+}
+
+// Show returns the sql that will show the secret.
+func (sb *SecretBuilder) Show() string {
+	return fmt.Sprintf(`SHOW SECRETS LIKE '%v' IN SCHEMA %v`, EscapeString(sb.name), QuoteIdentifier(sb.db, sb.schema))
+}
+
+type secret struct {
+	CreatedOn    string         `db:"created_on"`
+	Name         string         `db:"name"`
+	DatabaseName string         `db:"database_name"`
+	SchemaName   string         `db:"schema_name"`
+	Owner        string         `db:"owner"`
+	Comment      sql.NullString `db:"comment"`
+	SecretType   string         `db:"secret_type"`
+	OAuthScopes  sql.NullString `db:"oauth_scopes"`
+}
+
+// ListOAuthScopes returns the scopes of an OAUTH2 secret, listed as [scope, scope]
+func (s *secret) ListOAuthScopes() []string {
+	return splitList(strings.Trim(s.OAuthScopes.String, "[]"))
+}
+
+// ScanSecret turns a row of SHOW SECRETS into a secret object
+func ScanSecret(row *sqlx.Row) (*secret, error) {
+	s := &secret{}
+	e := row.StructScan(s)
+	return s, e
+}
@@ -0,0 +1,46 @@
+package snowflake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretCreate(t *testing.T) {
+	r := require.New(t)
+
+	s := Secret("test_secret", "test_db", "test_schema").WithType(SecretTypeGenericString).WithSecretString("it's secret")
+	r.Equal(`"test_db"."test_schema"."test_secret"`, s.QualifiedName())
+	r.Equal(`CREATE SECRET "test_db"."test_schema"."test_secret" TYPE = GENERIC_STRING SECRET_STRING = 'it\'s secret'`, s.Create())
+
+	s = Secret("test_secret", "test_db", "test_schema").WithType(SecretTypePassword).WithUsername("user").WithPassword("pass").WithComment("creds")
+	r.Equal(`CREATE SECRET "test_db"."test_schema"."test_secret" TYPE = PASSWORD USERNAME = 'user' PASSWORD = 'pass' COMMENT = 'creds'`, s.Create())
+
+	s = Secret("test_secret", "test_db", "test_schema").WithType(SecretTypeOAuth2).WithAPIAuthentication("oauth_integration").WithOAuthScopes([]string{"read", "write"})
+	r.Equal(`CREATE SECRET "test_db"."test_schema"."test_secret" TYPE = OAUTH2 API_AUTHENTICATION = "oauth_integration" OAUTH_SCOPES = ('read', 'write')`, s.Create())
+
+	s = Secret("test_secret", "test_db", "test_schema").WithType(SecretTypeOAuth2).WithAPIAuthentication("oauth_integration").WithOAuthRefreshToken("token", "2030-01-01 00:00:00")
+	r.Equal(`CREATE SECRET "test_db"."test_schema"."test_secret" TYPE = OAUTH2 API_AUTHENTICATION = "oauth_integration" OAUTH_REFRESH_TOKEN = 'token' OAUTH_REFRESH_TOKEN_EXPIRY_TIME = '2030-01-01 00:00:00'`, s.Create())
+}
+
+func TestSecretAlter(t *testing.T) {
+	r := require.New(t)
+	s := Secret("test_secret", "test_db", "test_schema")
+	r.Equal(`ALTER SECRET "test_db"."test_schema"."test_secret" SET SECRET_STRING = 'new'`, s.ChangeSecretString("new"))
+	r.Equal(`ALTER SECRET "test_db"."test_schema"."test_secret" SET USERNAME = 'user' PASSWORD = 'pass'`, s.ChangeCredentials("user", "pass"))
+	r.Equal(`ALTER SECRET "test_db"."test_schema"."test_secret" SET OAUTH_SCOPES = ('read')`, s.ChangeOAuthScopes([]string{"read"}))
+	r.Equal(`ALTER SECRET "test_db"."test_schema"."test_secret" SET OAUTH_REFRESH_TOKEN = 'token' OAUTH_REFRESH_TOKEN_EXPIRY_TIME = '2030-01-01'`, s.ChangeOAuthRefreshToken("token", "2030-01-01"))
+	r.Equal(`ALTER SECRET "test_db"."test_schema"."test_secret" SET COMMENT = 'comment'`, s.ChangeComment("comment"))
+	r.Equal(`ALTER SECRET "test_db"."test_schema"."test_secret" UNSET COMMENT`, s.RemoveComment())
+	r.Equal(`DROP SECRET "test_db"."test_schema"."test_secret"`, s.Drop())
+	r.Equal(`DESCRIBE SECRET "test_db"."test_schema"."test_secret"`, s.Describe())
+	r.Equal(`SHOW SECRETS LIKE 'test_secret' IN SCHEMA "test_db"."test_schema"`, s.Show())
+}
+
+func TestSecretListOAuthScopes(t *testing.T) {
+	r := require.New(t)
+	s := &secret{}
+	r.Empty(s.ListOAuthScopes())
+	s.OAuthScopes.String = "[read, write]"
+	r.Equal([]string{"read", "write"}, s.ListOAuthScopes())
+}
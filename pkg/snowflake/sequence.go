@@ -1,6 +1,7 @@
 package snowflake
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
@@ -48,7 +49,7 @@ func (sb *SequenceBuilder) Drop() string {
 
 // Drop returns the SQL query that will drop a sequence.
 func (sb *SequenceBuilder) Show() string {
-	return fmt.Sprintf(`SHOW SEQUENCES LIKE '%v' IN SCHEMA "%v"."%v"`, sb.name, sb.db, sb.schema)
+	return fmt.Sprintf(`SHOW SEQUENCES LIKE '%v' IN SCHEMA %v`, EscapeString(sb.name), QuoteIdentifier(sb.db, sb.schema))
 }
 
 func (sb *SequenceBuilder) Create() string {
@@ -82,7 +83,7 @@ func (sb *SequenceBuilder) WithStart(start int) *SequenceBuilder {
 }
 
 func (sb *SequenceBuilder) QualifiedName() string {
-	return fmt.Sprintf(`"%v"."%v"."%v"`, sb.db, sb.schema, sb.name)
+	return QuoteIdentifier(sb.db, sb.schema, sb.name)
 }
 
 func (sb *SequenceBuilder) Address() string {
@@ -95,9 +96,9 @@ func ScanSequence(row *sqlx.Row) (*sequence, error) {
 	return d, e
 }
 
-func ListSequences(databaseName string, schemaName string, db *sql.DB) ([]sequence, error) {
-	stmt := fmt.Sprintf(`SHOW SEQUENCES IN SCHEMA "%s"."%v"`, databaseName, schemaName)
-	rows, err := Query(db, stmt)
+func ListSequences(ctx context.Context, databaseName string, schemaName string, db *sql.DB) ([]sequence, error) {
+	stmt := fmt.Sprintf(`SHOW SEQUENCES IN SCHEMA %v`, QuoteIdentifier(databaseName, schemaName))
+	rows, err := QueryContext(ctx, db, stmt)
 	if err != nil {
 		return nil, err
 	}
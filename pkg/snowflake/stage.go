@@ -1,6 +1,7 @@
 package snowflake
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
@@ -34,7 +35,7 @@ type StageBuilder struct {
 func (sb *StageBuilder) QualifiedName() string {
 	var n strings.Builder
 
-	n.WriteString(fmt.Sprintf(`"%v"."%v"."%v"`, sb.db, sb.schema, sb.name))
+	n.WriteString(QuoteIdentifier(sb.db, sb.schema, sb.name))
 
 	return n.String()
 }
@@ -95,17 +96,17 @@ func (sb *StageBuilder) WithTags(tags []TagValue) *StageBuilder {
 
 // AddTag returns the SQL query that will add a new tag to the view.
 func (sb *StageBuilder) AddTag(tag TagValue) string {
-	return fmt.Sprintf(`ALTER STAGE %s SET TAG "%v"."%v"."%v" = "%v"`, sb.QualifiedName(), tag.Database, tag.Schema, tag.Name, tag.Value)
+	return fmt.Sprintf(`ALTER STAGE %s SET TAG %v = '%v'`, sb.QualifiedName(), QuoteIdentifier(tag.Database, tag.Schema, tag.Name), EscapeString(tag.Value))
 }
 
 // ChangeTag returns the SQL query that will alter a tag on the view.
 func (sb *StageBuilder) ChangeTag(tag TagValue) string {
-	return fmt.Sprintf(`ALTER STAGE %s SET TAG "%v"."%v"."%v" = "%v"`, sb.QualifiedName(), tag.Database, tag.Schema, tag.Name, tag.Value)
+	return fmt.Sprintf(`ALTER STAGE %s SET TAG %v = '%v'`, sb.QualifiedName(), QuoteIdentifier(tag.Database, tag.Schema, tag.Name), EscapeString(tag.Value))
 }
 
 // UnsetTag returns the SQL query that will unset a tag on the view.
 func (sb *StageBuilder) UnsetTag(tag TagValue) string {
-	return fmt.Sprintf(`ALTER STAGE %s UNSET TAG "%v"."%v"."%v"`, sb.QualifiedName(), tag.Database, tag.Schema, tag.Name)
+	return fmt.Sprintf(`ALTER STAGE %s UNSET TAG %v`, sb.QualifiedName(), QuoteIdentifier(tag.Database, tag.Schema, tag.Name))
 }
 
 // Stage returns a pointer to a Builder that abstracts the DDL operations for a stage.
@@ -170,7 +171,7 @@ func (sb *StageBuilder) Create() string {
 
 // Rename returns the SQL query that will rename the stage.
 func (sb *StageBuilder) Rename(newName string) string {
-	return fmt.Sprintf(`ALTER STAGE %v RENAME TO "%v"`, sb.QualifiedName(), newName)
+	return fmt.Sprintf(`ALTER STAGE %v RENAME TO %v`, sb.QualifiedName(), QuoteIdentifier(newName))
 }
 
 // ChangeComment returns the SQL query that will update the comment on the stage.
@@ -230,7 +231,7 @@ func (sb *StageBuilder) Describe() string {
 
 // Show returns the SQL query that will show a stage.
 func (sb *StageBuilder) Show() string {
-	return fmt.Sprintf(`SHOW STAGES LIKE '%v' IN SCHEMA "%v"."%v"`, sb.name, sb.db, sb.schema)
+	return fmt.Sprintf(`SHOW STAGES LIKE '%v' IN SCHEMA %v`, EscapeString(sb.name), QuoteIdentifier(sb.db, sb.schema))
 }
 
 type stage struct {
@@ -312,9 +313,9 @@ func DescStage(db *sql.DB, query string) (*descStageResult, error) {
 	return r, nil
 }
 
-func ListStages(databaseName string, schemaName string, db *sql.DB) ([]stage, error) {
-	stmt := fmt.Sprintf(`SHOW STAGES IN SCHEMA "%s"."%v"`, databaseName, schemaName)
-	rows, err := Query(db, stmt)
+func ListStages(ctx context.Context, databaseName string, schemaName string, db *sql.DB) ([]stage, error) {
+	stmt := fmt.Sprintf(`SHOW STAGES IN SCHEMA %v`, QuoteIdentifier(databaseName, schemaName))
+	rows, err := QueryContext(ctx, db, stmt)
 	if err != nil {
 		return nil, err
 	}
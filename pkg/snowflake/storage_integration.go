@@ -1,6 +1,7 @@
 package snowflake
 
 import (
+	"context"
 	"database/sql"
 	"log"
 
@@ -40,9 +41,9 @@ func ScanStorageIntegration(row *sqlx.Row) (*storageIntegration, error) {
 	return r, err
 }
 
-func ListStorageIntegrations(db *sql.DB) ([]storageIntegration, error) {
+func ListStorageIntegrations(ctx context.Context, db *sql.DB) ([]storageIntegration, error) {
 	stmt := "SHOW STORAGE INTEGRATIONS"
-	rows, err := Query(db, stmt)
+	rows, err := QueryContext(ctx, db, stmt)
 	if err != nil {
 		return nil, err
 	}
@@ -56,3 +57,30 @@ func ListStorageIntegrations(db *sql.DB) ([]storageIntegration, error) {
 	}
 	return dbs, errors.Wrapf(err, "unable to scan row for %s", stmt)
 }
+
+type storageIntegrationProperty struct {
+	Property      sql.NullString `db:"property"`
+	PropertyValue sql.NullString `db:"property_value"`
+}
+
+// DescribeStorageIntegration returns the properties of a storage integration
+// by name, e.g. STORAGE_PROVIDER or STORAGE_ALLOWED_LOCATIONS.
+func DescribeStorageIntegration(ctx context.Context, name string, db *sql.DB) (map[string]string, error) {
+	stmt := StorageIntegration(name).Describe()
+	rows, err := QueryContext(ctx, db, stmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	properties := []storageIntegrationProperty{}
+	err = sqlx.StructScan(rows, &properties)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to scan row for %s", stmt)
+	}
+	values := map[string]string{}
+	for _, p := range properties {
+		values[p.Property.String] = p.PropertyValue.String
+	}
+	return values, nil
+}
@@ -1,6 +1,7 @@
 package snowflake
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
@@ -15,7 +16,10 @@ type StreamBuilder struct {
 	name            string
 	db              string
 	schema          string
-	onTable         string
+	sourceType      string // TABLE, VIEW, EXTERNAL TABLE or STAGE
+	source          string
+	at              *StreamOffset
+	before          *StreamOffset
 	appendOnly      bool
 	insertOnly      bool
 	showInitialRows bool
@@ -27,18 +31,18 @@ func (sb *StreamBuilder) QualifiedName() string {
 	var n strings.Builder
 
 	if sb.db != "" && sb.schema != "" {
-		n.WriteString(fmt.Sprintf(`"%v"."%v".`, sb.db, sb.schema))
+		n.WriteString(fmt.Sprintf(`%v.`, QuoteIdentifier(sb.db, sb.schema)))
 	}
 
 	if sb.db != "" && sb.schema == "" {
-		n.WriteString(fmt.Sprintf(`"%v"..`, sb.db))
+		n.WriteString(fmt.Sprintf(`%v..`, QuoteIdentifier(sb.db)))
 	}
 
 	if sb.db == "" && sb.schema != "" {
-		n.WriteString(fmt.Sprintf(`"%v".`, sb.schema))
+		n.WriteString(fmt.Sprintf(`%v.`, QuoteIdentifier(sb.schema)))
 	}
 
-	n.WriteString(fmt.Sprintf(`"%v"`, sb.name))
+	n.WriteString(QuoteIdentifier(sb.name))
 
 	return n.String()
 }
@@ -48,8 +52,63 @@ func (sb *StreamBuilder) WithComment(c string) *StreamBuilder {
 	return sb
 }
 
+// StreamOffset is the point a stream starts tracking changes from, given as
+// exactly one of a timestamp, an offset in seconds from the current time, the
+// id of a query or the name of a stream whose current offset is reused.
+type StreamOffset struct {
+	Timestamp string
+	Offset    int
+	Statement string
+	Stream    string
+}
+
+func (o *StreamOffset) clause() string {
+	switch {
+	case o.Timestamp != "":
+		return fmt.Sprintf(`TIMESTAMP => TO_TIMESTAMP_TZ('%v')`, EscapeString(o.Timestamp))
+	case o.Statement != "":
+		return fmt.Sprintf(`STATEMENT => '%v'`, EscapeString(o.Statement))
+	case o.Stream != "":
+		return fmt.Sprintf(`STREAM => '%v'`, EscapeString(o.Stream))
+	default:
+		return fmt.Sprintf(`OFFSET => %v`, o.Offset)
+	}
+}
+
 func (sb *StreamBuilder) WithOnTable(d string, s string, t string) *StreamBuilder {
-	sb.onTable = fmt.Sprintf(`"%v"."%v"."%v"`, d, s, t)
+	sb.sourceType = "TABLE"
+	sb.source = QuoteIdentifier(d, s, t)
+	return sb
+}
+
+func (sb *StreamBuilder) WithOnView(d string, s string, v string) *StreamBuilder {
+	sb.sourceType = "VIEW"
+	sb.source = QuoteIdentifier(d, s, v)
+	return sb
+}
+
+func (sb *StreamBuilder) WithOnExternalTable(d string, s string, t string) *StreamBuilder {
+	sb.sourceType = "EXTERNAL TABLE"
+	sb.source = QuoteIdentifier(d, s, t)
+	return sb
+}
+
+// WithOnStage creates a stream on the directory table of the stage
+func (sb *StreamBuilder) WithOnStage(d string, s string, st string) *StreamBuilder {
+	sb.sourceType = "STAGE"
+	sb.source = QuoteIdentifier(d, s, st)
+	return sb
+}
+
+// WithAt sets the offset the stream starts from, including changes made at that point
+func (sb *StreamBuilder) WithAt(o *StreamOffset) *StreamBuilder {
+	sb.at = o
+	return sb
+}
+
+// WithBefore sets the offset the stream starts from, excluding changes made at that point
+func (sb *StreamBuilder) WithBefore(o *StreamOffset) *StreamBuilder {
+	sb.before = o
 	return sb
 }
 
@@ -90,17 +149,32 @@ func (sb *StreamBuilder) Create() string {
 	q := strings.Builder{}
 	q.WriteString(fmt.Sprintf(`CREATE STREAM %v`, sb.QualifiedName()))
 
-	q.WriteString(fmt.Sprintf(` ON TABLE %v`, sb.onTable))
+	q.WriteString(fmt.Sprintf(` ON %v %v`, sb.sourceType, sb.source))
+
+	if sb.at != nil {
+		q.WriteString(fmt.Sprintf(` AT (%v)`, sb.at.clause()))
+	}
+
+	if sb.before != nil {
+		q.WriteString(fmt.Sprintf(` BEFORE (%v)`, sb.before.clause()))
+	}
 
 	if sb.comment != "" {
 		q.WriteString(fmt.Sprintf(` COMMENT = '%v'`, EscapeString(sb.comment)))
 	}
 
-	q.WriteString(fmt.Sprintf(` APPEND_ONLY = %v`, sb.appendOnly))
+	// each kind of source only takes the properties that apply to it
+	if sb.sourceType == "TABLE" || sb.sourceType == "VIEW" {
+		q.WriteString(fmt.Sprintf(` APPEND_ONLY = %v`, sb.appendOnly))
+	}
 
-	q.WriteString(fmt.Sprintf(` INSERT_ONLY = %v`, sb.insertOnly))
+	if sb.sourceType == "TABLE" || sb.sourceType == "EXTERNAL TABLE" {
+		q.WriteString(fmt.Sprintf(` INSERT_ONLY = %v`, sb.insertOnly))
+	}
 
-	q.WriteString(fmt.Sprintf(` SHOW_INITIAL_ROWS = %v`, sb.showInitialRows))
+	if sb.sourceType == "TABLE" || sb.sourceType == "VIEW" {
+		q.WriteString(fmt.Sprintf(` SHOW_INITIAL_ROWS = %v`, sb.showInitialRows))
+	}
 
 	return q.String()
 }
@@ -122,7 +196,7 @@ func (sb *StreamBuilder) Drop() string {
 
 // Show returns the SQL query that will show a stream.
 func (sb *StreamBuilder) Show() string {
-	return fmt.Sprintf(`SHOW STREAMS LIKE '%v' IN SCHEMA "%v"."%v"`, sb.name, sb.db, sb.schema)
+	return fmt.Sprintf(`SHOW STREAMS LIKE '%v' IN SCHEMA %v`, EscapeString(sb.name), QuoteIdentifier(sb.db, sb.schema))
 }
 
 type descStreamRow struct {
@@ -136,9 +210,11 @@ type descStreamRow struct {
 	InsertOnly      bool           `db:"insert_only"`
 	ShowInitialRows bool           `db:"show_initial_rows"`
 	TableName       sql.NullString `db:"table_name"`
+	SourceType      sql.NullString `db:"source_type"`
 	Type            sql.NullString `db:"type"`
 	Stale           sql.NullString `db:"stale"`
 	Mode            sql.NullString `db:"mode"`
+	StaleAfter      sql.NullString `db:"stale_after"`
 }
 
 func ScanStream(row *sqlx.Row) (*descStreamRow, error) {
@@ -147,9 +223,9 @@ func ScanStream(row *sqlx.Row) (*descStreamRow, error) {
 	return t, e
 }
 
-func ListStreams(databaseName string, schemaName string, db *sql.DB) ([]descStreamRow, error) {
-	stmt := fmt.Sprintf(`SHOW STREAMS IN SCHEMA "%s"."%v"`, databaseName, schemaName)
-	rows, err := Query(db, stmt)
+func ListStreams(ctx context.Context, databaseName string, schemaName string, db *sql.DB) ([]descStreamRow, error) {
+	stmt := fmt.Sprintf(`SHOW STREAMS IN SCHEMA %v`, QuoteIdentifier(databaseName, schemaName))
+	rows, err := QueryContext(ctx, db, stmt)
 	if err != nil {
 		return nil, err
 	}
@@ -26,6 +26,42 @@ func TestStreamCreate(t *testing.T) {
 	r.Equal(s.Create(), `CREATE STREAM "test_db"."test_schema"."test_stream" ON TABLE "test_db"."test_schema"."test_target_table" COMMENT = 'Test Comment' APPEND_ONLY = true INSERT_ONLY = true SHOW_INITIAL_ROWS = true`)
 }
 
+func TestStreamCreateOnOtherSources(t *testing.T) {
+	r := require.New(t)
+	s := Stream("test_stream", "test_db", "test_schema")
+	s.WithAppendOnly(true)
+	s.WithInsertOnly(true)
+	s.WithShowInitialRows(true)
+
+	s.WithOnView("test_db", "test_schema", "test_view")
+	r.Equal(`CREATE STREAM "test_db"."test_schema"."test_stream" ON VIEW "test_db"."test_schema"."test_view" APPEND_ONLY = true SHOW_INITIAL_ROWS = true`, s.Create())
+
+	s.WithOnExternalTable("test_db", "test_schema", "test_external_table")
+	r.Equal(`CREATE STREAM "test_db"."test_schema"."test_stream" ON EXTERNAL TABLE "test_db"."test_schema"."test_external_table" INSERT_ONLY = true`, s.Create())
+
+	s.WithOnStage("test_db", "test_schema", "test_stage")
+	r.Equal(`CREATE STREAM "test_db"."test_schema"."test_stream" ON STAGE "test_db"."test_schema"."test_stage"`, s.Create())
+}
+
+func TestStreamCreateWithOffset(t *testing.T) {
+	r := require.New(t)
+	s := Stream("test_stream", "test_db", "test_schema")
+	s.WithOnTable("test_db", "test_schema", "test_target_table")
+
+	s.WithAt(&StreamOffset{Offset: -3600})
+	r.Equal(`CREATE STREAM "test_db"."test_schema"."test_stream" ON TABLE "test_db"."test_schema"."test_target_table" AT (OFFSET => -3600) APPEND_ONLY = false INSERT_ONLY = false SHOW_INITIAL_ROWS = false`, s.Create())
+
+	s.WithAt(&StreamOffset{Timestamp: "2022-01-01 00:00:00 -0700"})
+	r.Contains(s.Create(), ` AT (TIMESTAMP => TO_TIMESTAMP_TZ('2022-01-01 00:00:00 -0700'))`)
+
+	s.WithAt(&StreamOffset{Stream: "other_stream"})
+	r.Contains(s.Create(), ` AT (STREAM => 'other_stream')`)
+
+	s.WithAt(nil)
+	s.WithBefore(&StreamOffset{Statement: "8e5d0ca9-005e-44e6-b858-a8f5b37c5726"})
+	r.Equal(`CREATE STREAM "test_db"."test_schema"."test_stream" ON TABLE "test_db"."test_schema"."test_target_table" BEFORE (STATEMENT => '8e5d0ca9-005e-44e6-b858-a8f5b37c5726') APPEND_ONLY = false INSERT_ONLY = false SHOW_INITIAL_ROWS = false`, s.Create())
+}
+
 func TestStreamChangeComment(t *testing.T) {
 	r := require.New(t)
 	s := Stream("test_stream", "test_db", "test_schema")
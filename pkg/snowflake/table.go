@@ -1,6 +1,7 @@
 package snowflake
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
@@ -116,6 +117,8 @@ type Column struct {
 	_default *ColumnDefault // default is reserved
 	identity *ColumnIdentity
 	comment  string // pointer as value is nullable
+	// maskingPolicy is the qualified name of the masking policy as written in SQL
+	maskingPolicy string
 }
 
 // WithName set the column name
@@ -152,13 +155,19 @@ func (c *Column) WithIdentity(id *ColumnIdentity) *Column {
 	return c
 }
 
+// WithMaskingPolicy set the masking policy of the column
+func (c *Column) WithMaskingPolicy(policy string) *Column {
+	c.maskingPolicy = policy
+	return c
+}
+
 func (c *Column) getColumnDefinition(withInlineConstraints bool, withComment bool) string {
 
 	if c == nil {
 		return ""
 	}
 	var colDef strings.Builder
-	colDef.WriteString(fmt.Sprintf(`"%v" %v`, EscapeString(c.name), EscapeString(c._type)))
+	colDef.WriteString(fmt.Sprintf(`%v %v`, QuoteIdentifier(c.name), EscapeString(c._type)))
 
 	if withInlineConstraints {
 		if !c.nullable {
@@ -174,6 +183,10 @@ func (c *Column) getColumnDefinition(withInlineConstraints bool, withComment boo
 		colDef.WriteString(fmt.Sprintf(` IDENTITY(%v, %v)`, c.identity.startNum, c.identity.stepNum))
 	}
 
+	if withInlineConstraints && c.maskingPolicy != "" {
+		colDef.WriteString(fmt.Sprintf(` WITH MASKING POLICY %v`, c.maskingPolicy))
+	}
+
 	if withComment {
 		colDef.WriteString(fmt.Sprintf(` COMMENT '%v'`, EscapeString(c.comment)))
 	}
@@ -309,18 +322,18 @@ func (tb *TableBuilder) QualifiedName() string {
 	var n strings.Builder
 
 	if tb.db != "" && tb.schema != "" {
-		n.WriteString(fmt.Sprintf(`"%v"."%v".`, tb.db, tb.schema))
+		n.WriteString(fmt.Sprintf(`%v.`, QuoteIdentifier(tb.db, tb.schema)))
 	}
 
 	if tb.db != "" && tb.schema == "" {
-		n.WriteString(fmt.Sprintf(`"%v"..`, tb.db))
+		n.WriteString(fmt.Sprintf(`%v..`, QuoteIdentifier(tb.db)))
 	}
 
 	if tb.db == "" && tb.schema != "" {
-		n.WriteString(fmt.Sprintf(`"%v".`, tb.schema))
+		n.WriteString(fmt.Sprintf(`%v.`, QuoteIdentifier(tb.schema)))
 	}
 
-	n.WriteString(fmt.Sprintf(`"%v"`, tb.name))
+	n.WriteString(QuoteIdentifier(tb.name))
 
 	return n.String()
 }
@@ -369,17 +382,17 @@ func (tb *TableBuilder) WithTags(tags []TagValue) *TableBuilder {
 
 // AddTag returns the SQL query that will add a new tag to the table.
 func (tb *TableBuilder) AddTag(tag TagValue) string {
-	return fmt.Sprintf(`ALTER TABLE %s SET TAG "%v"."%v"."%v" = "%v"`, tb.QualifiedName(), tag.Database, tag.Schema, tag.Name, tag.Value)
+	return fmt.Sprintf(`ALTER TABLE %s SET TAG %v = '%v'`, tb.QualifiedName(), QuoteIdentifier(tag.Database, tag.Schema, tag.Name), EscapeString(tag.Value))
 }
 
 // ChangeTag returns the SQL query that will alter a tag on the table.
 func (tb *TableBuilder) ChangeTag(tag TagValue) string {
-	return fmt.Sprintf(`ALTER TABLE %s SET TAG "%v"."%v"."%v" = "%v"`, tb.QualifiedName(), tag.Database, tag.Schema, tag.Name, tag.Value)
+	return fmt.Sprintf(`ALTER TABLE %s SET TAG %v = '%v'`, tb.QualifiedName(), QuoteIdentifier(tag.Database, tag.Schema, tag.Name), EscapeString(tag.Value))
 }
 
 // UnsetTag returns the SQL query that will unset a tag on the table.
 func (tb *TableBuilder) UnsetTag(tag TagValue) string {
-	return fmt.Sprintf(`ALTER TABLE %s UNSET TAG "%v"."%v"."%v"`, tb.QualifiedName(), tag.Database, tag.Schema, tag.Name)
+	return fmt.Sprintf(`ALTER TABLE %s UNSET TAG %v`, tb.QualifiedName(), QuoteIdentifier(tag.Database, tag.Schema, tag.Name))
 }
 
 //Function to get clustering definition
@@ -394,11 +407,11 @@ func (tb *TableBuilder) GetTagValueString() string {
 		fmt.Println(v)
 		if v.Schema != "" {
 			if v.Database != "" {
-				q.WriteString(fmt.Sprintf(`"%v".`, v.Database))
+				q.WriteString(fmt.Sprintf(`%v.`, QuoteIdentifier(v.Database)))
 			}
-			q.WriteString(fmt.Sprintf(`"%v".`, v.Schema))
+			q.WriteString(fmt.Sprintf(`%v.`, QuoteIdentifier(v.Schema)))
 		}
-		q.WriteString(fmt.Sprintf(`"%v" = "%v", `, v.Name, v.Value))
+		q.WriteString(fmt.Sprintf(`%v = '%v', `, QuoteIdentifier(v.Name), EscapeString(v.Value)))
 	}
 	return strings.TrimSuffix(q.String(), ", ")
 }
@@ -412,7 +425,7 @@ func JoinStringList(instrings []string, delimiter string) string {
 func quoteStringList(instrings []string) []string {
 	var clean []string
 	for _, word := range instrings {
-		quoted := fmt.Sprintf(`"%s"`, word)
+		quoted := QuoteIdentifier(word)
 		clean = append(clean, quoted)
 
 	}
@@ -429,7 +442,7 @@ func (tb *TableBuilder) getCreateStatementBody() string {
 		colDef = strings.TrimSuffix(colDef, ")") //strip trailing
 		q.WriteString(colDef)
 		if tb.primaryKey.name != "" {
-			q.WriteString(fmt.Sprintf(` ,CONSTRAINT "%v" PRIMARY KEY(%v)`, tb.primaryKey.name, JoinStringList(quoteStringList(tb.primaryKey.keys), ",")))
+			q.WriteString(fmt.Sprintf(` ,CONSTRAINT %v PRIMARY KEY(%v)`, QuoteIdentifier(tb.primaryKey.name), JoinStringList(quoteStringList(tb.primaryKey.keys), ",")))
 
 		} else {
 			q.WriteString(fmt.Sprintf(` ,PRIMARY KEY(%v)`, JoinStringList(quoteStringList(tb.primaryKey.keys), ",")))
@@ -554,7 +567,7 @@ func (tb *TableBuilder) AddColumn(name string, dataType string, nullable bool, _
 
 // DropColumn returns the SQL query that will add a new column to the table.
 func (tb *TableBuilder) DropColumn(name string) string {
-	return fmt.Sprintf(`ALTER TABLE %s DROP COLUMN "%s"`, tb.QualifiedName(), name)
+	return fmt.Sprintf(`ALTER TABLE %s DROP COLUMN %v`, tb.QualifiedName(), QuoteIdentifier(name))
 }
 
 // ChangeColumnType returns the SQL query that will change the type of the named column to the given type.
@@ -568,11 +581,29 @@ func (tb *TableBuilder) ChangeColumnType(name string, dataType string) string {
 }
 
 func (tb *TableBuilder) ChangeColumnComment(name string, comment string) string {
-	return fmt.Sprintf(`ALTER TABLE %s MODIFY COLUMN "%v" COMMENT '%v'`, tb.QualifiedName(), EscapeString(name), EscapeString(comment))
+	return fmt.Sprintf(`ALTER TABLE %s MODIFY COLUMN %v COMMENT '%v'`, tb.QualifiedName(), QuoteIdentifier(name), EscapeString(comment))
+}
+
+// SetColumnMaskingPolicy returns the SQL query that will set the masking
+// policy of the named column, force replacing the policy already set.
+func (tb *TableBuilder) SetColumnMaskingPolicy(name string, policy string, force bool) string {
+	return PolicyAttachment("TABLE", tb.name, tb.db, tb.schema).SetMaskingPolicy(name, policy, nil, force)
+}
+
+// UnsetColumnMaskingPolicy returns the SQL query that will unset the masking
+// policy of the named column.
+func (tb *TableBuilder) UnsetColumnMaskingPolicy(name string) string {
+	return PolicyAttachment("TABLE", tb.name, tb.db, tb.schema).UnsetMaskingPolicy(name)
+}
+
+// ShowPolicyReferences returns the SQL query listing the policies set on the
+// table and its columns.
+func (tb *TableBuilder) ShowPolicyReferences() string {
+	return PolicyAttachment("TABLE", tb.name, tb.db, tb.schema).ShowPolicyReferences()
 }
 
 func (tb *TableBuilder) DropColumnDefault(name string) string {
-	return fmt.Sprintf(`ALTER TABLE %s MODIFY COLUMN "%v" DROP DEFAULT`, tb.QualifiedName(), EscapeString(name))
+	return fmt.Sprintf(`ALTER TABLE %s MODIFY COLUMN %v DROP DEFAULT`, tb.QualifiedName(), QuoteIdentifier(name))
 }
 
 // RemoveComment returns the SQL query that will remove the comment on the table.
@@ -583,9 +614,9 @@ func (tb *TableBuilder) RemoveComment() string {
 // Return sql to set/unset null constraint on column
 func (tb *TableBuilder) ChangeNullConstraint(name string, nullable bool) string {
 	if nullable {
-		return fmt.Sprintf(`ALTER TABLE %s MODIFY COLUMN "%s" DROP NOT NULL`, tb.QualifiedName(), name)
+		return fmt.Sprintf(`ALTER TABLE %s MODIFY COLUMN %v DROP NOT NULL`, tb.QualifiedName(), QuoteIdentifier(name))
 	} else {
-		return fmt.Sprintf(`ALTER TABLE %s MODIFY COLUMN "%s" SET NOT NULL`, tb.QualifiedName(), name)
+		return fmt.Sprintf(`ALTER TABLE %s MODIFY COLUMN %v SET NOT NULL`, tb.QualifiedName(), QuoteIdentifier(name))
 	}
 }
 
@@ -593,7 +624,7 @@ func (tb *TableBuilder) ChangePrimaryKey(newPk PrimaryKey) string {
 	tb.WithPrimaryKey(newPk)
 	pks := JoinStringList(quoteStringList(newPk.keys), ", ")
 	if tb.primaryKey.name != "" {
-		return fmt.Sprintf(`ALTER TABLE %s ADD CONSTRAINT "%v" PRIMARY KEY(%v)`, tb.QualifiedName(), tb.primaryKey.name, pks)
+		return fmt.Sprintf(`ALTER TABLE %s ADD CONSTRAINT %v PRIMARY KEY(%v)`, tb.QualifiedName(), QuoteIdentifier(tb.primaryKey.name), pks)
 	}
 	return fmt.Sprintf(`ALTER TABLE %s ADD PRIMARY KEY(%v)`, tb.QualifiedName(), pks)
 }
@@ -614,7 +645,7 @@ func (tb *TableBuilder) Drop() string {
 
 // Show returns the SQL query that will show a table.
 func (tb *TableBuilder) Show() string {
-	return fmt.Sprintf(`SHOW TABLES LIKE '%v' IN SCHEMA "%v"."%v"`, tb.name, tb.db, tb.schema)
+	return fmt.Sprintf(`SHOW TABLES LIKE '%v' IN SCHEMA %v`, EscapeString(tb.name), QuoteIdentifier(tb.db, tb.schema))
 }
 
 func (tb *TableBuilder) ShowColumns() string {
@@ -742,9 +773,9 @@ func ScanPrimaryKeyDescription(rows *sqlx.Rows) ([]primaryKeyDescription, error)
 	return pkds, rows.Err()
 }
 
-func ListTables(databaseName string, schemaName string, db *sql.DB) ([]table, error) {
-	stmt := fmt.Sprintf(`SHOW TABLES IN SCHEMA "%s"."%v"`, databaseName, schemaName)
-	rows, err := Query(db, stmt)
+func ListTables(ctx context.Context, databaseName string, schemaName string, db *sql.DB) ([]table, error) {
+	stmt := fmt.Sprintf(`SHOW TABLES IN SCHEMA %v`, QuoteIdentifier(databaseName, schemaName))
+	rows, err := QueryContext(ctx, db, stmt)
 	if err != nil {
 		return nil, err
 	}
@@ -78,7 +78,7 @@ func TestTableCreate(t *testing.T) {
 	r.Equal(s.Create(), `CREATE TABLE "test_db"."test_schema"."test_table" ("column1" OBJECT COMMENT '', "column2" VARCHAR COMMENT 'only populated when data is available', "column3" NUMBER(38,0) NOT NULL DEFAULT "test_db"."test_schema"."test_seq".NEXTVAL COMMENT '', "column4" VARCHAR NOT NULL DEFAULT 'test default''s' COMMENT '', "column5" TIMESTAMP_NTZ NOT NULL DEFAULT CURRENT_TIMESTAMP() COMMENT '' ,CONSTRAINT "MY_KEY" PRIMARY KEY("column1")) COMMENT = 'Test Comment' CLUSTER BY LINEAR(column1) DATA_RETENTION_TIME_IN_DAYS = 10 CHANGE_TRACKING = true`)
 
 	s.WithTags(tags)
-	r.Equal(s.Create(), `CREATE TABLE "test_db"."test_schema"."test_table" ("column1" OBJECT COMMENT '', "column2" VARCHAR COMMENT 'only populated when data is available', "column3" NUMBER(38,0) NOT NULL DEFAULT "test_db"."test_schema"."test_seq".NEXTVAL COMMENT '', "column4" VARCHAR NOT NULL DEFAULT 'test default''s' COMMENT '', "column5" TIMESTAMP_NTZ NOT NULL DEFAULT CURRENT_TIMESTAMP() COMMENT '' ,CONSTRAINT "MY_KEY" PRIMARY KEY("column1")) COMMENT = 'Test Comment' CLUSTER BY LINEAR(column1) DATA_RETENTION_TIME_IN_DAYS = 10 CHANGE_TRACKING = true WITH TAG ("test_db"."test_schema"."tag" = "value", "test_db"."test_schema"."tag2" = "value2")`)
+	r.Equal(s.Create(), `CREATE TABLE "test_db"."test_schema"."test_table" ("column1" OBJECT COMMENT '', "column2" VARCHAR COMMENT 'only populated when data is available', "column3" NUMBER(38,0) NOT NULL DEFAULT "test_db"."test_schema"."test_seq".NEXTVAL COMMENT '', "column4" VARCHAR NOT NULL DEFAULT 'test default''s' COMMENT '', "column5" TIMESTAMP_NTZ NOT NULL DEFAULT CURRENT_TIMESTAMP() COMMENT '' ,CONSTRAINT "MY_KEY" PRIMARY KEY("column1")) COMMENT = 'Test Comment' CLUSTER BY LINEAR(column1) DATA_RETENTION_TIME_IN_DAYS = 10 CHANGE_TRACKING = true WITH TAG ("test_db"."test_schema"."tag" = 'value', "test_db"."test_schema"."tag2" = 'value2')`)
 }
 
 func TestTableCreateIdentity(t *testing.T) {
@@ -110,6 +110,22 @@ func TestTableCreateIdentity(t *testing.T) {
 	r.Equal(`CREATE TABLE "test_db"."test_schema"."test_table" ("column1" OBJECT COMMENT '', "column2" VARCHAR COMMENT 'only populated when data is available', "column3" NUMBER(38,0) NOT NULL IDENTITY(2, 5) COMMENT '') DATA_RETENTION_TIME_IN_DAYS = 0 CHANGE_TRACKING = false`, s.Create())
 }
 
+func TestTableCreateMaskingPolicy(t *testing.T) {
+	r := require.New(t)
+	s := Table("test_table", "test_db", "test_schema")
+	cols := []Column{
+		{
+			name:          "email",
+			_type:         "VARCHAR",
+			nullable:      true,
+			maskingPolicy: `TEST_DB.TEST_SCHEMA."email_mask"`,
+		},
+	}
+
+	s.WithColumns(Columns(cols))
+	r.Equal(`CREATE TABLE "test_db"."test_schema"."test_table" ("email" VARCHAR WITH MASKING POLICY TEST_DB.TEST_SCHEMA."email_mask" COMMENT '') DATA_RETENTION_TIME_IN_DAYS = 0 CHANGE_TRACKING = false`, s.Create())
+}
+
 func TestTableChangeComment(t *testing.T) {
 	r := require.New(t)
 	s := Table("test_table", "test_db", "test_schema")
@@ -152,6 +168,14 @@ func TestTableDropColumn(t *testing.T) {
 	r.Equal(s.DropColumn("old_column"), `ALTER TABLE "test_db"."test_schema"."test_table" DROP COLUMN "old_column"`)
 }
 
+func TestTableColumnMaskingPolicy(t *testing.T) {
+	r := require.New(t)
+	s := Table("test_table", "test_db", "test_schema")
+	r.Equal(`ALTER TABLE "test_db"."test_schema"."test_table" MODIFY COLUMN "email" SET MASKING POLICY DB.SCHEMA.POLICY`, s.SetColumnMaskingPolicy("email", "DB.SCHEMA.POLICY", false))
+	r.Equal(`ALTER TABLE "test_db"."test_schema"."test_table" MODIFY COLUMN "email" SET MASKING POLICY DB.SCHEMA.POLICY FORCE`, s.SetColumnMaskingPolicy("email", "DB.SCHEMA.POLICY", true))
+	r.Equal(`ALTER TABLE "test_db"."test_schema"."test_table" MODIFY COLUMN "email" UNSET MASKING POLICY`, s.UnsetColumnMaskingPolicy("email"))
+}
+
 func TestTableChangeColumnType(t *testing.T) {
 	r := require.New(t)
 	s := Table("test_table", "test_db", "test_schema")
@@ -233,13 +257,14 @@ func TestTableChangePrimaryKeysWithoutConstraintName(t *testing.T) {
 func TestTableAddTag(t *testing.T) {
 	r := require.New(t)
 	s := Table("test_table", "test_db", "test_schema")
-	r.Equal(s.AddTag(TagValue{Name: "tag", Schema: "test_schema", Database: "test_db", Value: "value"}), `ALTER TABLE "test_db"."test_schema"."test_table" SET TAG "test_db"."test_schema"."tag" = "value"`)
+	r.Equal(s.AddTag(TagValue{Name: "tag", Schema: "test_schema", Database: "test_db", Value: "value"}), `ALTER TABLE "test_db"."test_schema"."test_table" SET TAG "test_db"."test_schema"."tag" = 'value'`)
 }
 
 func TestTableChangeTag(t *testing.T) {
 	r := require.New(t)
 	s := Table("test_table", "test_db", "test_schema")
-	r.Equal(s.ChangeTag(TagValue{Name: "tag", Schema: "test_schema", Database: "test_db", Value: "value"}), `ALTER TABLE "test_db"."test_schema"."test_table" SET TAG "test_db"."test_schema"."tag" = "value"`)
+	r.Equal(s.ChangeTag(TagValue{Name: "tag", Schema: "test_schema", Database: "test_db", Value: "value"}), `ALTER TABLE "test_db"."test_schema"."test_table" SET TAG "test_db"."test_schema"."tag" = 'value'`)
+	r.Equal(s.ChangeTag(TagValue{Name: "tag", Schema: "test_schema", Database: "test_db", Value: `it's "value"`}), `ALTER TABLE "test_db"."test_schema"."test_table" SET TAG "test_db"."test_schema"."tag" = 'it\'s "value"'`)
 }
 
 func TestTableUnsetTag(t *testing.T) {
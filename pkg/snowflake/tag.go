@@ -1,7 +1,9 @@
 package snowflake
 
 import (
+	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"log"
 	"strings"
@@ -12,10 +14,11 @@ import (
 
 // TagBuilder abstracts the creation of SQL queries for a Snowflake tag
 type TagBuilder struct {
-	name    string
-	db      string
-	schema  string
-	comment string
+	name          string
+	db            string
+	schema        string
+	comment       string
+	allowedValues []string
 }
 
 // QualifiedName prepends the db and schema if set and escapes everything nicely
@@ -23,14 +26,14 @@ func (tb *TagBuilder) QualifiedName() string {
 	var n strings.Builder
 
 	if tb.db != "" {
-		n.WriteString(fmt.Sprintf(`"%v".`, tb.db))
+		n.WriteString(fmt.Sprintf(`%v.`, QuoteIdentifier(tb.db)))
 	}
 
 	if tb.schema != "" {
-		n.WriteString(fmt.Sprintf(`"%v".`, tb.schema))
+		n.WriteString(fmt.Sprintf(`%v.`, QuoteIdentifier(tb.schema)))
 	}
 
-	n.WriteString(fmt.Sprintf(`"%v"`, tb.name))
+	n.WriteString(QuoteIdentifier(tb.name))
 
 	return n.String()
 }
@@ -41,6 +44,12 @@ func (tb *TagBuilder) WithComment(c string) *TagBuilder {
 	return tb
 }
 
+// WithAllowedValues restricts the values the tag can be set to
+func (tb *TagBuilder) WithAllowedValues(values []string) *TagBuilder {
+	tb.allowedValues = values
+	return tb
+}
+
 // WithDB adds the name of the database to the TagBuilder
 func (tb *TagBuilder) WithDB(db string) *TagBuilder {
 	tb.db = db
@@ -76,6 +85,10 @@ func (tb *TagBuilder) Create() string {
 
 	q.WriteString(fmt.Sprintf(` TAG %v`, tb.QualifiedName()))
 
+	if len(tb.allowedValues) > 0 {
+		q.WriteString(fmt.Sprintf(` ALLOWED_VALUES %v`, allowedValuesList(tb.allowedValues)))
+	}
+
 	if tb.comment != "" {
 		q.WriteString(fmt.Sprintf(` COMMENT = '%v'`, EscapeString(tb.comment)))
 	}
@@ -85,7 +98,7 @@ func (tb *TagBuilder) Create() string {
 
 // Rename returns the SQL query that will rename the tag.
 func (tb *TagBuilder) Rename(newName string) string {
-	return fmt.Sprintf(`ALTER TAG %v RENAME TO "%v"`, tb.QualifiedName(), newName)
+	return fmt.Sprintf(`ALTER TAG %v RENAME TO %v`, tb.QualifiedName(), QuoteIdentifier(newName))
 }
 
 // ChangeComment returns the SQL query that will update the comment on the tag.
@@ -98,6 +111,43 @@ func (tb *TagBuilder) RemoveComment() string {
 	return fmt.Sprintf(`ALTER TAG %v UNSET COMMENT`, tb.QualifiedName())
 }
 
+// AddAllowedValues returns the SQL query that will add values to the allowed
+// values of the tag.
+func (tb *TagBuilder) AddAllowedValues(values []string) string {
+	return fmt.Sprintf(`ALTER TAG %v ADD ALLOWED_VALUES %v`, tb.QualifiedName(), allowedValuesList(values))
+}
+
+// DropAllowedValues returns the SQL query that will remove values from the
+// allowed values of the tag.
+func (tb *TagBuilder) DropAllowedValues(values []string) string {
+	return fmt.Sprintf(`ALTER TAG %v DROP ALLOWED_VALUES %v`, tb.QualifiedName(), allowedValuesList(values))
+}
+
+// UnsetAllowedValues returns the SQL query that will allow any value for the
+// tag.
+func (tb *TagBuilder) UnsetAllowedValues() string {
+	return fmt.Sprintf(`ALTER TAG %v UNSET ALLOWED_VALUES`, tb.QualifiedName())
+}
+
+// SetMaskingPolicy returns the SQL query that will set a masking policy on the
+// tag, masking the columns the tag is set on. The policy is a qualified name
+// as written in SQL.
+func (tb *TagBuilder) SetMaskingPolicy(policy string) string {
+	return fmt.Sprintf(`ALTER TAG %v SET MASKING POLICY %v`, tb.QualifiedName(), policy)
+}
+
+// UnsetMaskingPolicy returns the SQL query that will unset a masking policy
+// from the tag.
+func (tb *TagBuilder) UnsetMaskingPolicy(policy string) string {
+	return fmt.Sprintf(`ALTER TAG %v UNSET MASKING POLICY %v`, tb.QualifiedName(), policy)
+}
+
+// ShowPolicyReferences returns the SQL query listing the masking policies set
+// on the tag.
+func (tb *TagBuilder) ShowPolicyReferences() string {
+	return fmt.Sprintf(`SELECT * FROM TABLE(%v.INFORMATION_SCHEMA.POLICY_REFERENCES(REF_ENTITY_NAME => '%v', REF_ENTITY_DOMAIN => 'tag'))`, QuoteIdentifier(tb.db), EscapeString(tb.QualifiedName()))
+}
+
 // Drop returns the SQL query that will drop a tag.
 func (tb *TagBuilder) Drop() string {
 	return fmt.Sprintf(`DROP TAG %v`, tb.QualifiedName())
@@ -112,22 +162,40 @@ func (tb *TagBuilder) Undrop() string {
 func (tb *TagBuilder) Show() string {
 	q := strings.Builder{}
 
-	q.WriteString(fmt.Sprintf(`SHOW TAGS LIKE '%v'`, tb.name))
+	q.WriteString(fmt.Sprintf(`SHOW TAGS LIKE '%v'`, EscapeString(tb.name)))
 
 	if tb.schema != "" && tb.db != "" {
-		q.WriteString(fmt.Sprintf(` IN SCHEMA "%v"."%v"`, tb.db, tb.schema))
+		q.WriteString(fmt.Sprintf(` IN SCHEMA %v`, QuoteIdentifier(tb.db, tb.schema)))
 	} else if tb.db != "" {
-		q.WriteString(fmt.Sprintf(` IN DATABASE "%v"`, tb.db))
+		q.WriteString(fmt.Sprintf(` IN DATABASE %v`, QuoteIdentifier(tb.db)))
 	}
 
 	return q.String()
 }
 
 type tag struct {
-	Name         sql.NullString `db:"name"`
-	DatabaseName sql.NullString `db:"database_name"`
-	SchemaName   sql.NullString `db:"schema_name"`
-	Comment      sql.NullString `db:"comment"`
+	Name          sql.NullString `db:"name"`
+	DatabaseName  sql.NullString `db:"database_name"`
+	SchemaName    sql.NullString `db:"schema_name"`
+	Comment       sql.NullString `db:"comment"`
+	AllowedValues sql.NullString `db:"allowed_values"`
+}
+
+// ListAllowedValues returns the allowed values of the tag, listed as a JSON
+// array by SHOW TAGS. It is empty when any value is allowed.
+func (t *tag) ListAllowedValues() ([]string, error) {
+	values := []string{}
+	if !t.AllowedValues.Valid || t.AllowedValues.String == "" {
+		return values, nil
+	}
+	err := json.Unmarshal([]byte(t.AllowedValues.String), &values)
+	return values, errors.Wrapf(err, "unable to parse allowed values %v of tag %v", t.AllowedValues.String, t.Name.String)
+}
+
+// allowedValuesList writes values as the list of string literals taken by
+// ALLOWED_VALUES, which has no parentheses.
+func allowedValuesList(values []string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(formatStringList(values), "("), ")")
 }
 
 type TagValue struct {
@@ -144,9 +212,9 @@ func ScanTag(row *sqlx.Row) (*tag, error) {
 }
 
 // ListTags returns a list of tags in a database or schema
-func ListTags(databaseName, schemaName string, db *sql.DB) ([]tag, error) {
-	stmt := fmt.Sprintf(`SHOW TAGS IN SCHEMA "%v"."%v"`, databaseName, schemaName)
-	rows, err := Query(db, stmt)
+func ListTags(ctx context.Context, databaseName, schemaName string, db *sql.DB) ([]tag, error) {
+	stmt := fmt.Sprintf(`SHOW TAGS IN SCHEMA %v`, QuoteIdentifier(databaseName, schemaName))
+	rows, err := QueryContext(ctx, db, stmt)
 	if err != nil {
 		return nil, err
 	}
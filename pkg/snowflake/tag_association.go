@@ -0,0 +1,128 @@
+package snowflake
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// TagAssociationBuilder abstracts the creation of SQL queries setting a tag
+// on an object or on the column of a table or view
+type TagAssociationBuilder struct {
+	tag        string
+	objectType string
+	names      []string
+	column     string
+}
+
+// TagAssociation returns a pointer to a Builder that abstracts the DDL
+// operations setting the tag, a qualified name as written in SQL, on the
+// object of type objectType named by names, e.g. the database, schema and
+// name of a table.
+//
+// Supported DDL operations are:
+//   - ALTER <object type> ... SET|UNSET TAG
+//   - ALTER TABLE|VIEW ... MODIFY COLUMN ... SET|UNSET TAG
+//   - TAG_REFERENCES
+//
+// [Snowflake Reference](https://docs.snowflake.com/en/user-guide/object-tagging.html)
+func TagAssociation(tag, objectType string, names ...string) *TagAssociationBuilder {
+	return &TagAssociationBuilder{
+		tag:        tag,
+		objectType: strings.ToUpper(objectType),
+		names:      names,
+	}
+}
+
+// WithColumn sets the tag on a column of the table or view instead
+func (tab *TagAssociationBuilder) WithColumn(column string) *TagAssociationBuilder {
+	tab.column = column
+	return tab
+}
+
+// QualifiedName escapes the names of the object
+func (tab *TagAssociationBuilder) QualifiedName() string {
+	return QuoteIdentifier(tab.names...)
+}
+
+func (tab *TagAssociationBuilder) alter() string {
+	if tab.column != "" {
+		return fmt.Sprintf(`ALTER %v %v MODIFY COLUMN %v`, tab.objectType, tab.QualifiedName(), QuoteIdentifier(tab.column))
+	}
+	return fmt.Sprintf(`ALTER %v %v`, tab.objectType, tab.QualifiedName())
+}
+
+// Set returns the SQL query that will set the tag to value, replacing the
+// value previously set if any.
+func (tab *TagAssociationBuilder) Set(value string) string {
+	return fmt.Sprintf(`%v SET TAG %v = '%v'`, tab.alter(), tab.tag, EscapeString(value))
+}
+
+// Unset returns the SQL query that will unset the tag.
+func (tab *TagAssociationBuilder) Unset() string {
+	return fmt.Sprintf(`%v UNSET TAG %v`, tab.alter(), tab.tag)
+}
+
+// Domain returns the domain of the object as listed by TAG_REFERENCES. Views
+// and other tables are in the table domain.
+func (tab *TagAssociationBuilder) Domain() string {
+	switch {
+	case tab.column != "":
+		return "COLUMN"
+	case strings.HasSuffix(tab.objectType, "TABLE"), strings.HasSuffix(tab.objectType, "VIEW"):
+		return "TABLE"
+	default:
+		return tab.objectType
+	}
+}
+
+// ShowTagReferences returns the SQL query listing the tags set on the object,
+// including the tags it inherits. The table function is read from the
+// information schema of db, the database of the tag.
+func (tab *TagAssociationBuilder) ShowTagReferences(db string) string {
+	name := tab.QualifiedName()
+	if tab.column != "" {
+		name = fmt.Sprintf(`%v.%v`, name, QuoteIdentifier(tab.column))
+	}
+	return fmt.Sprintf(`SELECT * FROM TABLE(%v.INFORMATION_SCHEMA.TAG_REFERENCES('%v', '%v'))`, QuoteIdentifier(db), EscapeString(name), tab.Domain())
+}
+
+// TagReference is a row of TAG_REFERENCES
+type TagReference struct {
+	TagDatabase sql.NullString `db:"TAG_DATABASE"`
+	TagSchema   sql.NullString `db:"TAG_SCHEMA"`
+	TagName     sql.NullString `db:"TAG_NAME"`
+	TagValue    sql.NullString `db:"TAG_VALUE"`
+	// Level is the domain of the object the tag is set on, which differs from
+	// Domain for inherited tags
+	Level      sql.NullString `db:"LEVEL"`
+	Domain     sql.NullString `db:"DOMAIN"`
+	ColumnName sql.NullString `db:"COLUMN_NAME"`
+}
+
+// IsTag reports whether tag, a qualified name as written in SQL, names the
+// referenced tag.
+func (tr *TagReference) IsTag(tag string) bool {
+	return IsQualifiedName(tag, tr.TagDatabase.String, tr.TagSchema.String, tr.TagName.String)
+}
+
+// ListTagReferences runs the TAG_REFERENCES query stmt and returns the tags
+// set on the object
+func ListTagReferences(ctx context.Context, stmt string, db *sql.DB) ([]TagReference, error) {
+	rows, err := QueryContext(ctx, db, stmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	refs := []TagReference{}
+	err = sqlx.StructScan(rows, &refs)
+	if err == sql.ErrNoRows {
+		return refs, nil
+	}
+	return refs, errors.Wrapf(err, "unable to scan row for %s", stmt)
+}
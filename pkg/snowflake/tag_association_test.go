@@ -0,0 +1,47 @@
+package snowflake_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagAssociation(t *testing.T) {
+	r := require.New(t)
+	b := snowflake.TagAssociation("DB.SCHEMA.COST_CENTER", "warehouse", "my warehouse")
+
+	r.Equal(`ALTER WAREHOUSE "my warehouse" SET TAG DB.SCHEMA.COST_CENTER = 'it\'s finance'`, b.Set("it's finance"))
+	r.Equal(`ALTER WAREHOUSE "my warehouse" UNSET TAG DB.SCHEMA.COST_CENTER`, b.Unset())
+	r.Equal(`SELECT * FROM TABLE("DB".INFORMATION_SCHEMA.TAG_REFERENCES('"my warehouse"', 'WAREHOUSE'))`, b.ShowTagReferences("DB"))
+}
+
+func TestTagAssociationColumn(t *testing.T) {
+	r := require.New(t)
+	b := snowflake.TagAssociation("DB.SCHEMA.PII", "TABLE", "db", "schema", "table").WithColumn("email")
+
+	r.Equal(`ALTER TABLE "db"."schema"."table" MODIFY COLUMN "email" SET TAG DB.SCHEMA.PII = 'true'`, b.Set("true"))
+	r.Equal(`ALTER TABLE "db"."schema"."table" MODIFY COLUMN "email" UNSET TAG DB.SCHEMA.PII`, b.Unset())
+	r.Equal("COLUMN", b.Domain())
+	r.Equal(`SELECT * FROM TABLE("DB".INFORMATION_SCHEMA.TAG_REFERENCES('"db"."schema"."table"."email"', 'COLUMN'))`, b.ShowTagReferences("DB"))
+}
+
+func TestTagAssociationDomain(t *testing.T) {
+	r := require.New(t)
+	r.Equal("TABLE", snowflake.TagAssociation("t", "VIEW", "v").Domain())
+	r.Equal("TABLE", snowflake.TagAssociation("t", "materialized view", "v").Domain())
+	r.Equal("TABLE", snowflake.TagAssociation("t", "EXTERNAL TABLE", "v").Domain())
+	r.Equal("SCHEMA", snowflake.TagAssociation("t", "SCHEMA", "db", "s").Domain())
+}
+
+func TestTagReferenceIsTag(t *testing.T) {
+	r := require.New(t)
+	ref := snowflake.TagReference{
+		TagDatabase: sql.NullString{String: "DB", Valid: true},
+		TagSchema:   sql.NullString{String: "SCHEMA", Valid: true},
+		TagName:     sql.NullString{String: "cost center", Valid: true},
+	}
+	r.True(ref.IsTag(`db.schema."cost center"`))
+	r.False(ref.IsTag(`db.schema.cost_center`))
+}
@@ -1,6 +1,7 @@
 package snowflake
 
 import (
+	"database/sql"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -64,3 +65,33 @@ func TestTagShow(t *testing.T) {
 	o.WithSchema("schema")
 	r.Equal(o.Show(), `SHOW TAGS LIKE 'test' IN SCHEMA "db"."schema"`)
 }
+
+func TestTagAllowedValues(t *testing.T) {
+	r := require.New(t)
+	o := Tag("test").WithDB("db").WithSchema("schema").WithAllowedValues([]string{"finance", "it's"})
+	r.Equal(`CREATE TAG "db"."schema"."test" ALLOWED_VALUES 'finance', 'it\'s'`, o.Create())
+	r.Equal(`ALTER TAG "db"."schema"."test" ADD ALLOWED_VALUES 'hr'`, o.AddAllowedValues([]string{"hr"}))
+	r.Equal(`ALTER TAG "db"."schema"."test" DROP ALLOWED_VALUES 'finance', 'hr'`, o.DropAllowedValues([]string{"finance", "hr"}))
+	r.Equal(`ALTER TAG "db"."schema"."test" UNSET ALLOWED_VALUES`, o.UnsetAllowedValues())
+}
+
+func TestTagListAllowedValues(t *testing.T) {
+	r := require.New(t)
+	tg := &tag{AllowedValues: sql.NullString{String: `["finance","hr"]`, Valid: true}}
+	values, err := tg.ListAllowedValues()
+	r.NoError(err)
+	r.Equal([]string{"finance", "hr"}, values)
+
+	tg.AllowedValues = sql.NullString{}
+	values, err = tg.ListAllowedValues()
+	r.NoError(err)
+	r.Empty(values)
+}
+
+func TestTagMaskingPolicy(t *testing.T) {
+	r := require.New(t)
+	o := Tag("test").WithDB("db").WithSchema("schema")
+	r.Equal(`ALTER TAG "db"."schema"."test" SET MASKING POLICY DB.SCHEMA.MASK`, o.SetMaskingPolicy("DB.SCHEMA.MASK"))
+	r.Equal(`ALTER TAG "db"."schema"."test" UNSET MASKING POLICY DB.SCHEMA.MASK`, o.UnsetMaskingPolicy("DB.SCHEMA.MASK"))
+	r.Equal(`SELECT * FROM TABLE("db".INFORMATION_SCHEMA.POLICY_REFERENCES(REF_ENTITY_NAME => '"db"."schema"."test"', REF_ENTITY_DOMAIN => 'tag'))`, o.ShowPolicyReferences())
+}
@@ -1,11 +1,11 @@
 package snowflake
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 	"sort"
-	"strconv"
 	"strings"
 
 	"github.com/jmoiron/sqlx"
@@ -34,7 +34,7 @@ type TaskBuilder struct {
 func (tb *TaskBuilder) GetFullName(in string) string {
 	var n strings.Builder
 
-	n.WriteString(fmt.Sprintf(`"%v"."%v"."%v"`, tb.db, tb.schema, in))
+	n.WriteString(QuoteIdentifier(tb.db, tb.schema, in))
 
 	return n.String()
 }
@@ -135,7 +135,7 @@ func (tb *TaskBuilder) Create() string {
 	q.WriteString(fmt.Sprintf(` TASK %v`, tb.QualifiedName()))
 
 	if tb.warehouse != "" {
-		q.WriteString(fmt.Sprintf(` WAREHOUSE = "%v"`, EscapeString(tb.warehouse)))
+		q.WriteString(fmt.Sprintf(` WAREHOUSE = %v`, QuoteIdentifier(tb.warehouse)))
 	} else {
 		if tb.user_task_managed_initial_warehouse_size != "" {
 			q.WriteString(fmt.Sprintf(` USER_TASK_MANAGED_INITIAL_WAREHOUSE_SIZE = '%v'`, EscapeString(tb.user_task_managed_initial_warehouse_size)))
@@ -189,7 +189,7 @@ func (tb *TaskBuilder) Create() string {
 
 // ChangeWarehouse returns the sql that will change the warehouse for the task.
 func (tb *TaskBuilder) ChangeWarehouse(newWh string) string {
-	return fmt.Sprintf(`ALTER TASK %v SET WAREHOUSE = "%v"`, tb.QualifiedName(), EscapeString(newWh))
+	return fmt.Sprintf(`ALTER TASK %v SET WAREHOUSE = %v`, tb.QualifiedName(), QuoteIdentifier(newWh))
 }
 
 // SwitchWarehouseToManaged returns the sql that will switch to managed warehouse.
@@ -301,7 +301,7 @@ func (tb *TaskBuilder) Describe() string {
 
 // Show returns the sql that will show a task.
 func (tb *TaskBuilder) Show() string {
-	return fmt.Sprintf(`SHOW TASKS LIKE '%v' IN SCHEMA "%v"."%v"`, EscapeString(tb.name), EscapeString(tb.db), EscapeString(tb.schema))
+	return fmt.Sprintf(`SHOW TASKS LIKE '%v' IN SCHEMA %v`, EscapeString(tb.name), QuoteIdentifier(tb.db, tb.schema))
 }
 
 // ShowParameters returns the query to show the session parameters for the task
@@ -356,12 +356,12 @@ func (t *task) GetPredecessorName() string {
 		return ""
 	}
 
-	pre := strings.Split(*t.Predecessors, ".")
-	name, err := strconv.Unquote(pre[len(pre)-1])
+	ids, err := ParseQualifiedIdentifier(*t.Predecessors)
 	if err != nil {
+		pre := strings.Split(*t.Predecessors, ".")
 		return pre[len(pre)-1]
 	}
-	return name
+	return ids[len(ids)-1].Name()
 }
 
 // ScanTask turns a sql row into a task object
@@ -396,9 +396,9 @@ func ScanTaskParameters(rows *sqlx.Rows) ([]*taskParams, error) {
 	return t, nil
 }
 
-func ListTasks(databaseName string, schemaName string, db *sql.DB) ([]task, error) {
-	stmt := fmt.Sprintf(`SHOW TASKS IN SCHEMA "%s"."%v"`, databaseName, schemaName)
-	rows, err := Query(db, stmt)
+func ListTasks(ctx context.Context, databaseName string, schemaName string, db *sql.DB) ([]task, error) {
+	stmt := fmt.Sprintf(`SHOW TASKS IN SCHEMA %v`, QuoteIdentifier(databaseName, schemaName))
+	rows, err := QueryContext(ctx, db, stmt)
 	if err != nil {
 		return nil, err
 	}
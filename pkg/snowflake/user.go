@@ -1,9 +1,12 @@
 package snowflake
 
 import (
+	"context"
 	"database/sql"
+	"log"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
 )
 
 func User(name string) *Builder {
@@ -34,6 +37,24 @@ func ScanUser(row *sqlx.Row) (*user, error) {
 	return r, err
 }
 
+// ListUsers returns every user of the account visible to the current role
+func ListUsers(ctx context.Context, db *sql.DB) ([]user, error) {
+	stmt := "SHOW USERS"
+	rows, err := QueryContext(ctx, db, stmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := []user{}
+	err = sqlx.StructScan(rows, &users)
+	if err == sql.ErrNoRows {
+		log.Printf("[DEBUG] no users found")
+		return nil, nil
+	}
+	return users, errors.Wrapf(err, "unable to scan row for %s", stmt)
+}
+
 func ScanUserDescription(rows *sqlx.Rows) (*user, error) {
 	r := &user{}
 	var err error
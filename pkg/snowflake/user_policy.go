@@ -0,0 +1,242 @@
+package snowflake
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+const (
+	// PasswordPolicyKind is the POLICY_KIND of password policies in POLICY_REFERENCES
+	PasswordPolicyKind = "PASSWORD_POLICY"
+	// SessionPolicyKind is the POLICY_KIND of session policies in POLICY_REFERENCES
+	SessionPolicyKind = "SESSION_POLICY"
+	// AuthenticationPolicyKind is the POLICY_KIND of authentication policies in POLICY_REFERENCES
+	AuthenticationPolicyKind = "AUTHENTICATION_POLICY"
+)
+
+// Keyword is a property value written as a bare keyword, e.g. REQUIRED,
+// instead of as a string literal
+type Keyword string
+
+// UserPolicyBuilder abstracts the creation of SQL queries for the password,
+// session and authentication policies set on the account or on its users
+type UserPolicyBuilder struct {
+	kind   string // PASSWORD, SESSION or AUTHENTICATION
+	name   string
+	db     string
+	schema string
+}
+
+// PasswordPolicy returns a pointer to a Builder that abstracts the DDL operations for a password policy.
+//
+// Supported DDL operations are:
+//   - CREATE PASSWORD POLICY
+//   - ALTER PASSWORD POLICY
+//   - DROP PASSWORD POLICY
+//   - SHOW PASSWORD POLICIES
+//   - DESCRIBE PASSWORD POLICY
+//   - ALTER ACCOUNT|USER SET|UNSET PASSWORD POLICY
+//
+// [Snowflake Reference](https://docs.snowflake.com/en/sql-reference/sql/create-password-policy)
+func PasswordPolicy(name, db, schema string) *UserPolicyBuilder {
+	return &UserPolicyBuilder{kind: "PASSWORD", name: name, db: db, schema: schema}
+}
+
+// SessionPolicy returns a pointer to a Builder that abstracts the DDL operations for a session policy.
+//
+// Supported DDL operations are:
+//   - CREATE SESSION POLICY
+//   - ALTER SESSION POLICY
+//   - DROP SESSION POLICY
+//   - SHOW SESSION POLICIES
+//   - DESCRIBE SESSION POLICY
+//   - ALTER ACCOUNT|USER SET|UNSET SESSION POLICY
+//
+// [Snowflake Reference](https://docs.snowflake.com/en/sql-reference/sql/create-session-policy)
+func SessionPolicy(name, db, schema string) *UserPolicyBuilder {
+	return &UserPolicyBuilder{kind: "SESSION", name: name, db: db, schema: schema}
+}
+
+// AuthenticationPolicy returns a pointer to a Builder that abstracts the DDL operations for an authentication policy.
+//
+// Supported DDL operations are:
+//   - CREATE AUTHENTICATION POLICY
+//   - ALTER AUTHENTICATION POLICY
+//   - DROP AUTHENTICATION POLICY
+//   - SHOW AUTHENTICATION POLICIES
+//   - DESCRIBE AUTHENTICATION POLICY
+//   - ALTER ACCOUNT|USER SET|UNSET AUTHENTICATION POLICY
+//
+// [Snowflake Reference](https://docs.snowflake.com/en/sql-reference/sql/create-authentication-policy)
+func AuthenticationPolicy(name, db, schema string) *UserPolicyBuilder {
+	return &UserPolicyBuilder{kind: "AUTHENTICATION", name: name, db: db, schema: schema}
+}
+
+// QualifiedName prepends the db and schema and escapes everything nicely
+func (pb *UserPolicyBuilder) QualifiedName() string {
+	return QuoteIdentifier(pb.db, pb.schema, pb.name)
+}
+
+// Create returns the SQL query that will create the policy with the
+// properties given, keyed by property name. Values are ints, keywords,
+// strings or string lists.
+func (pb *UserPolicyBuilder) Create(properties map[string]interface{}) string {
+	return fmt.Sprintf(`CREATE %v POLICY %v%v`, pb.kind, pb.QualifiedName(), policyProperties(properties))
+}
+
+// ChangeProperties returns the SQL query that will set the properties given on the policy.
+func (pb *UserPolicyBuilder) ChangeProperties(properties map[string]interface{}) string {
+	return fmt.Sprintf(`ALTER %v POLICY %v SET%v`, pb.kind, pb.QualifiedName(), policyProperties(properties))
+}
+
+// UnsetProperties returns the SQL query that will reset the properties given to their defaults.
+func (pb *UserPolicyBuilder) UnsetProperties(keys []string) string {
+	upper := make([]string, len(keys))
+	for i, k := range keys {
+		upper[i] = strings.ToUpper(k)
+	}
+	sort.Strings(upper)
+	return fmt.Sprintf(`ALTER %v POLICY %v UNSET %v`, pb.kind, pb.QualifiedName(), strings.Join(upper, ", "))
+}
+
+// Drop returns the SQL query that will drop the policy.
+func (pb *UserPolicyBuilder) Drop() string {
+	return fmt.Sprintf(`DROP %v POLICY %v`, pb.kind, pb.QualifiedName())
+}
+
+// Describe returns the SQL query that will describe the policy.
+func (pb *UserPolicyBuilder) Describe() string {
+	return fmt.Sprintf(`DESCRIBE %v POLICY %v`, pb.kind, pb.QualifiedName())
+}
+
+// Show returns the SQL query that will show the policy.
+func (pb *UserPolicyBuilder) Show() string {
+	return fmt.Sprintf(`SHOW %v POLICIES LIKE '%v' IN SCHEMA %v`, pb.kind, EscapeString(pb.name), QuoteIdentifier(pb.db, pb.schema))
+}
+
+// SetOnAccount returns the SQL query that will set the policy on the account.
+func (pb *UserPolicyBuilder) SetOnAccount() string {
+	return fmt.Sprintf(`ALTER ACCOUNT SET %v POLICY %v`, pb.kind, pb.QualifiedName())
+}
+
+// UnsetOnAccount returns the SQL query that will unset the policy of the kind on the account.
+func (pb *UserPolicyBuilder) UnsetOnAccount() string {
+	return fmt.Sprintf(`ALTER ACCOUNT UNSET %v POLICY`, pb.kind)
+}
+
+// SetOnUser returns the SQL query that will set the policy on a given user.
+func (pb *UserPolicyBuilder) SetOnUser(u string) string {
+	return fmt.Sprintf(`ALTER USER %v SET %v POLICY %v`, QuoteIdentifier(u), pb.kind, pb.QualifiedName())
+}
+
+// UnsetOnUser returns the SQL query that will unset the policy of the kind on a given user.
+func (pb *UserPolicyBuilder) UnsetOnUser(u string) string {
+	return fmt.Sprintf(`ALTER USER %v UNSET %v POLICY`, QuoteIdentifier(u), pb.kind)
+}
+
+// ShowPolicyReferences returns the SQL query listing the account and the
+// users the policy is set on.
+func (pb *UserPolicyBuilder) ShowPolicyReferences() string {
+	return fmt.Sprintf(`SELECT * FROM TABLE(%v.INFORMATION_SCHEMA.POLICY_REFERENCES(POLICY_NAME => '%v'))`, QuoteIdentifier(pb.db), EscapeString(pb.QualifiedName()))
+}
+
+// policyProperties writes the properties in a stable order, e.g.
+// ` A = 1 B = ('x') C = KEYWORD D = 'text'`
+func policyProperties(properties map[string]interface{}) string {
+	keys := make([]string, 0, len(properties))
+	for k := range properties {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var q strings.Builder
+	for _, k := range keys {
+		switch v := properties[k].(type) {
+		case int:
+			q.WriteString(fmt.Sprintf(` %v = %d`, strings.ToUpper(k), v))
+		case Keyword:
+			q.WriteString(fmt.Sprintf(` %v = %v`, strings.ToUpper(k), v))
+		case []string:
+			q.WriteString(fmt.Sprintf(` %v = %v`, strings.ToUpper(k), formatStringList(v)))
+		default:
+			q.WriteString(fmt.Sprintf(` %v = '%v'`, strings.ToUpper(k), EscapeString(fmt.Sprint(v))))
+		}
+	}
+	return q.String()
+}
+
+type userPolicy struct {
+	CreatedOn    sql.NullString `db:"created_on"`
+	Name         sql.NullString `db:"name"`
+	DatabaseName sql.NullString `db:"database_name"`
+	SchemaName   sql.NullString `db:"schema_name"`
+	Kind         sql.NullString `db:"kind"`
+	Owner        sql.NullString `db:"owner"`
+	Comment      sql.NullString `db:"comment"`
+}
+
+// ScanUserPolicy turns a row of SHOW PASSWORD|SESSION|AUTHENTICATION POLICIES into a userPolicy object
+func ScanUserPolicy(row *sqlx.Row) (*userPolicy, error) {
+	p := &userPolicy{}
+	err := row.StructScan(p)
+	return p, err
+}
+
+// DescribeUserPolicy runs the DESCRIBE query stmt and returns the values of
+// the properties of the policy, keyed by uppercase property name. Password and
+// authentication policies are described by a row per property, session
+// policies by a single row with a column per property.
+func DescribeUserPolicy(ctx context.Context, db *sql.DB, stmt string) (map[string]string, error) {
+	rows, err := QueryContext(ctx, db, stmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	properties := map[string]string{}
+	for rows.Next() {
+		row := map[string]interface{}{}
+		if err := rows.MapScan(row); err != nil {
+			return nil, errors.Wrapf(err, "unable to scan row for %s", stmt)
+		}
+		columns := map[string]string{}
+		for k, v := range row {
+			columns[strings.ToLower(k)] = describedValue(v)
+		}
+		if property, ok := columns["property"]; ok {
+			properties[strings.ToUpper(property)] = columns["value"]
+			continue
+		}
+		for k, v := range columns {
+			properties[strings.ToUpper(k)] = v
+		}
+	}
+	return properties, rows.Err()
+}
+
+func describedValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case []byte:
+		return string(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// ListPolicyValues splits a list of values as described by Snowflake, e.g.
+// [PASSWORD, SAML] or ['ALL']
+func ListPolicyValues(value string) []string {
+	values := []string{}
+	for _, v := range splitList(strings.Trim(value, "[]")) {
+		values = append(values, strings.Trim(v, `'"`))
+	}
+	return values
+}
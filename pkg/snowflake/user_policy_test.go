@@ -0,0 +1,55 @@
+package snowflake_test
+
+import (
+	"testing"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasswordPolicy(t *testing.T) {
+	r := require.New(t)
+	b := snowflake.PasswordPolicy("test_policy", "test_db", "test_schema")
+
+	r.Equal(`"test_db"."test_schema"."test_policy"`, b.QualifiedName())
+	r.Equal(`CREATE PASSWORD POLICY "test_db"."test_schema"."test_policy" COMMENT = 'it\'s strict' PASSWORD_MAX_RETRIES = 3 PASSWORD_MIN_LENGTH = 12`, b.Create(map[string]interface{}{
+		"PASSWORD_MIN_LENGTH":  12,
+		"PASSWORD_MAX_RETRIES": 3,
+		"COMMENT":              "it's strict",
+	}))
+	r.Equal(`ALTER PASSWORD POLICY "test_db"."test_schema"."test_policy" SET PASSWORD_HISTORY = 0`, b.ChangeProperties(map[string]interface{}{"PASSWORD_HISTORY": 0}))
+	r.Equal(`ALTER PASSWORD POLICY "test_db"."test_schema"."test_policy" UNSET COMMENT, PASSWORD_MIN_LENGTH`, b.UnsetProperties([]string{"password_min_length", "comment"}))
+	r.Equal(`DROP PASSWORD POLICY "test_db"."test_schema"."test_policy"`, b.Drop())
+	r.Equal(`DESCRIBE PASSWORD POLICY "test_db"."test_schema"."test_policy"`, b.Describe())
+	r.Equal(`SHOW PASSWORD POLICIES LIKE 'test_policy' IN SCHEMA "test_db"."test_schema"`, b.Show())
+}
+
+func TestUserPolicyAttachments(t *testing.T) {
+	r := require.New(t)
+	b := snowflake.SessionPolicy("test_policy", "test_db", "test_schema")
+
+	r.Equal(`ALTER ACCOUNT SET SESSION POLICY "test_db"."test_schema"."test_policy"`, b.SetOnAccount())
+	r.Equal(`ALTER ACCOUNT UNSET SESSION POLICY`, b.UnsetOnAccount())
+	r.Equal(`ALTER USER "test_user" SET SESSION POLICY "test_db"."test_schema"."test_policy"`, b.SetOnUser("test_user"))
+	r.Equal(`ALTER USER "test_user" UNSET SESSION POLICY`, b.UnsetOnUser("test_user"))
+	r.Equal(`SELECT * FROM TABLE("test_db".INFORMATION_SCHEMA.POLICY_REFERENCES(POLICY_NAME => '"test_db"."test_schema"."test_policy"'))`, b.ShowPolicyReferences())
+}
+
+func TestAuthenticationPolicyCreate(t *testing.T) {
+	r := require.New(t)
+	b := snowflake.AuthenticationPolicy("test_policy", "test_db", "test_schema")
+
+	r.Equal(`CREATE AUTHENTICATION POLICY "test_db"."test_schema"."test_policy" AUTHENTICATION_METHODS = ('PASSWORD', 'SAML') COMMENT = 'it\'s required' MFA_ENROLLMENT = REQUIRED`, b.Create(map[string]interface{}{
+		"AUTHENTICATION_METHODS": []string{"PASSWORD", "SAML"},
+		"COMMENT":                "it's required",
+		"MFA_ENROLLMENT":         snowflake.Keyword("REQUIRED"),
+	}))
+}
+
+func TestListPolicyValues(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal([]string{"PASSWORD", "SAML"}, snowflake.ListPolicyValues("[PASSWORD, SAML]"))
+	r.Equal([]string{"ALL"}, snowflake.ListPolicyValues("['ALL']"))
+	r.Empty(snowflake.ListPolicyValues("[]"))
+}
@@ -1,9 +1,15 @@
 package snowflake
 
-import "github.com/pkg/errors"
+import (
+	"strings"
 
-// ValidateIdentifier implements a strict definition of valid identifiers from
-// https://docs.snowflake.net/manuals/sql-reference/identifiers-syntax.html
+	"github.com/pkg/errors"
+)
+
+// ValidateIdentifier validates the name of an object given in configuration.
+// Names are used as case-sensitive quoted identifiers, see
+// https://docs.snowflake.net/manuals/sql-reference/identifiers-syntax.html, so
+// any character is allowed but the name must not be quoted already.
 func ValidateIdentifier(val interface{}) (warns []string, errs []error) {
 	name, ok := val.(string)
 	if !ok {
@@ -11,29 +17,39 @@ func ValidateIdentifier(val interface{}) (warns []string, errs []error) {
 		return
 	}
 
-	if len(name) == 0 {
-		errs = append(errs, errors.Errorf("Identifier must be at least 1 character."))
+	if err := QuotedIdentifier(name).validateLength(); err != nil {
+		errs = append(errs, err)
 		return
 	}
 
-	if len(name) > 256 {
-		errs = append(errs, errors.Errorf("Identifier must be <= 256 characters."))
-		return
+	if len(name) > 1 && strings.HasPrefix(name, `"`) && strings.HasSuffix(name, `"`) {
+		errs = append(errs, errors.Errorf("Identifier %s is quoted, names are case-sensitive and quoted by the provider; remove the surrounding double quotes.", name))
 	}
+	return
+}
 
-	// TODO handle quoted identifiers
-	for k, r := range name {
-		if k == 0 && !isInitialIdentifierRune(r) {
-			errs = append(errs, errors.Errorf("'%s' can not start an identifier.", string(r)))
-			continue
-		}
+// ValidateQualifiedName validates a fully qualified object name written in
+// SQL, such as the fully_qualified_name of a policy: database, schema and
+// object, each quoted as required.
+func ValidateQualifiedName(val interface{}) (warns []string, errs []error) {
+	name, ok := val.(string)
+	if !ok {
+		errs = append(errs, errors.Errorf("Unable to assert qualified name as string type."))
+		return
+	}
+	if name == "" {
+		return
+	}
 
-		if !isIdentifierRune(r) {
-			errs = append(errs, errors.Errorf("'%s' is not a valid identifier character.", string(r)))
-		}
+	ids, err := ParseQualifiedIdentifier(name)
+	if err != nil {
+		errs = append(errs, err)
+		return
+	}
+	if len(ids) != 3 {
+		errs = append(errs, errors.Errorf("%v is not a fully qualified name, expected <database>.<schema>.<name>.", name))
 	}
 	return
-
 }
 
 func isIdentifierRune(r rune) bool {
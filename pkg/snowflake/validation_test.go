@@ -1,6 +1,7 @@
 package snowflake_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
@@ -16,9 +17,13 @@ func TestValidateIdentifier(t *testing.T) {
 		{"_1", true},
 		{"Aword", true},
 		{"azAZ09_$", true},
-		{"invalidcharacter!", false},
-		{"1startwithnumber", false},
-		{"$startwithdollar", false},
+		{"special character!", true},
+		{"1startwithnumber", true},
+		{"$startwithdollar", true},
+		{`with "quotes"`, true},
+		{"", false},
+		{`"quoted"`, false},
+		{strings.Repeat("a", 256), false},
 	}
 
 	for _, tc := range cases {
@@ -38,3 +43,28 @@ func TestValidateIdentifier(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateQualifiedName(t *testing.T) {
+	cases := []struct {
+		candidate string
+		valid     bool
+	}{
+		{"", true},
+		{"DB.SCHEMA.POLICY", true},
+		{`"db"."My Schema"."policy"`, true},
+		{"POLICY", false},
+		{"DB.POLICY", false},
+		{"DB..POLICY", false},
+		{`DB.SCHEMA."policy`, false},
+		{"DB.SCHEMA.POLICY.EXTRA", false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.candidate, func(t *testing.T) {
+			_, errs := snowflake.ValidateQualifiedName(tc.candidate)
+			if actual := len(errs) == 0; actual != tc.valid {
+				t.Fatalf("qualified name %s valid: expected %t, got %t (%v)", tc.candidate, tc.valid, actual, errs)
+			}
+		})
+	}
+}
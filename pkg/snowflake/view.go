@@ -1,6 +1,7 @@
 package snowflake
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -29,7 +30,7 @@ func (vb *ViewBuilder) QualifiedName() (string, error) {
 		return "", errors.New("Views must specify a database and a schema")
 	}
 
-	return fmt.Sprintf(`"%v"."%v"."%v"`, vb.db, vb.schema, vb.name), nil
+	return QuoteIdentifier(vb.db, vb.schema, vb.name), nil
 }
 
 // WithComment adds a comment to the ViewBuilder
@@ -78,19 +79,19 @@ func (vb *ViewBuilder) WithTags(tags []TagValue) *ViewBuilder {
 // AddTag returns the SQL query that will add a new tag to the view.
 func (vb *ViewBuilder) AddTag(tag TagValue) string {
 	qn, _ := vb.QualifiedName()
-	return fmt.Sprintf(`ALTER VIEW %s SET TAG "%v"."%v"."%v" = "%v"`, qn, tag.Database, tag.Schema, tag.Name, tag.Value)
+	return fmt.Sprintf(`ALTER VIEW %s SET TAG %v = '%v'`, qn, QuoteIdentifier(tag.Database, tag.Schema, tag.Name), EscapeString(tag.Value))
 }
 
 // ChangeTag returns the SQL query that will alter a tag on the view.
 func (vb *ViewBuilder) ChangeTag(tag TagValue) string {
 	qn, _ := vb.QualifiedName()
-	return fmt.Sprintf(`ALTER VIEW %s SET TAG "%v"."%v"."%v" = "%v"`, qn, tag.Database, tag.Schema, tag.Name, tag.Value)
+	return fmt.Sprintf(`ALTER VIEW %s SET TAG %v = '%v'`, qn, QuoteIdentifier(tag.Database, tag.Schema, tag.Name), EscapeString(tag.Value))
 }
 
 // UnsetTag returns the SQL query that will unset a tag on the view.
 func (vb *ViewBuilder) UnsetTag(tag TagValue) string {
 	qn, _ := vb.QualifiedName()
-	return fmt.Sprintf(`ALTER VIEW %s UNSET TAG "%v"."%v"."%v"`, qn, tag.Database, tag.Schema, tag.Name)
+	return fmt.Sprintf(`ALTER VIEW %s UNSET TAG %v`, qn, QuoteIdentifier(tag.Database, tag.Schema, tag.Name))
 }
 
 // View returns a pointer to a Builder that abstracts the DDL operations for a view.
@@ -197,7 +198,7 @@ func (vb *ViewBuilder) RemoveComment() (string, error) {
 
 // Show returns the SQL query that will show the row representing this view.
 func (vb *ViewBuilder) Show() string {
-	return fmt.Sprintf(`SHOW VIEWS LIKE '%v' IN SCHEMA "%v"."%v"`, vb.name, vb.db, vb.schema)
+	return fmt.Sprintf(`SHOW VIEWS LIKE '%v' IN SCHEMA %v`, EscapeString(vb.name), QuoteIdentifier(vb.db, vb.schema))
 }
 
 // Drop returns the SQL query that will drop the row representing this view.
@@ -224,9 +225,9 @@ func ScanView(row *sqlx.Row) (*view, error) {
 	return r, err
 }
 
-func ListViews(databaseName string, schemaName string, db *sql.DB) ([]view, error) {
-	stmt := fmt.Sprintf(`SHOW VIEWS IN SCHEMA "%s"."%v"`, databaseName, schemaName)
-	rows, err := Query(db, stmt)
+func ListViews(ctx context.Context, databaseName string, schemaName string, db *sql.DB) ([]view, error) {
+	stmt := fmt.Sprintf(`SHOW VIEWS IN SCHEMA %v`, QuoteIdentifier(databaseName, schemaName))
+	rows, err := QueryContext(ctx, db, stmt)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package snowflake
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
@@ -117,9 +118,9 @@ func ScanWarehouseParameters(rows *sqlx.Rows) ([]*warehouseParams, error) {
 	return params, nil
 }
 
-func ListWarehouses(db *sql.DB) ([]warehouse, error) {
+func ListWarehouses(ctx context.Context, db *sql.DB) ([]warehouse, error) {
 	stmt := "SHOW WAREHOUSES"
-	rows, err := Query(db, stmt)
+	rows, err := QueryContext(ctx, db, stmt)
 	if err != nil {
 		return nil, err
 	}